@@ -0,0 +1,56 @@
+package app
+
+import (
+	"cosmossdk.io/core/appmodule"
+	storetypes "cosmossdk.io/store/types"
+	"cosmossdk.io/x/evidence"
+	evidencekeeper "cosmossdk.io/x/evidence/keeper"
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	identitymodulekeeper "dtc/x/identity/keeper"
+	identitymoduletypes "dtc/x/identity/types"
+)
+
+// registerEvidenceModule registers the evidence keeper and module.
+// The evidence module is not wired through depinject because its router must be
+// set on the same keeper instance used by the module, which app wiring does not allow.
+func (app *App) registerEvidenceModule() error {
+	if err := app.RegisterStores(storetypes.NewKVStoreKey(evidencetypes.StoreKey)); err != nil {
+		return err
+	}
+
+	evidenceKeeper := evidencekeeper.NewKeeper(
+		app.appCodec,
+		runtime.NewKVStoreService(app.GetKey(evidencetypes.StoreKey)),
+		app.StakingKeeper,
+		app.SlashingKeeper,
+		app.AuthKeeper.AddressCodec(),
+		runtime.ProvideCometInfoService(),
+	)
+
+	// identity 模块处理重复身份证据，负债的合并与注销由 credit 模块完成
+	evidenceRouter := evidencetypes.NewRouter().
+		AddRoute(identitymoduletypes.RouteDuplicateIdentity, identitymodulekeeper.NewDuplicateIdentityEvidenceHandler(app.IdentityKeeper, app.CreditKeeper))
+	evidenceKeeper.SetRouter(evidenceRouter)
+	app.EvidenceKeeper = *evidenceKeeper
+
+	return app.RegisterModules(evidence.NewAppModule(app.EvidenceKeeper))
+}
+
+// RegisterEvidence registers the manually wired evidence module on the client side.
+func RegisterEvidence(cdc codec.Codec) map[string]appmodule.AppModule {
+	modules := map[string]appmodule.AppModule{
+		evidencetypes.ModuleName: evidence.NewAppModule(evidencekeeper.Keeper{}),
+	}
+
+	for _, m := range modules {
+		if mr, ok := m.(module.AppModuleBasic); ok {
+			mr.RegisterInterfaces(cdc.InterfaceRegistry())
+		}
+	}
+
+	return modules
+}
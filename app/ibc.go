@@ -30,6 +30,10 @@ import (
 	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 	solomachine "github.com/cosmos/ibc-go/v10/modules/light-clients/06-solomachine"
 	ibctm "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
+
+	identitymodule "dtc/x/identity/module"
+	identitymoduletypes "dtc/x/identity/types"
+	taskmodule "dtc/x/task/module"
 )
 
 // registerIBCModules register IBC keepers and non dependency inject modules.
@@ -101,8 +105,8 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 
 	// create IBC module from bottom to top of stack
 	var (
-		transferStack      porttypes.IBCModule = ibctransfer.NewIBCModule(app.TransferKeeper)
-		transferStackV2    ibcapi.IBCModule    = ibctransferv2.NewIBCModule(app.TransferKeeper)
+		transferStack      porttypes.IBCModule = taskmodule.NewTransferMiddleware(ibctransfer.NewIBCModule(app.TransferKeeper), app.TaskKeeper)
+		transferStackV2    ibcapi.IBCModule    = taskmodule.NewTransferMiddlewareV2(ibctransferv2.NewIBCModule(app.TransferKeeper), app.TaskKeeper)
 		icaControllerStack porttypes.IBCModule = icacontroller.NewIBCMiddleware(app.ICAControllerKeeper)
 		icaHostStack       porttypes.IBCModule = icahost.NewIBCModule(app.ICAHostKeeper)
 	)
@@ -117,6 +121,9 @@ func (app *App) registerIBCModules(appOpts servertypes.AppOptions) error {
 	ibcv2Router := ibcapi.NewRouter().
 		AddRoute(ibctransfertypes.PortID, transferStackV2)
 
+	// identity 模块在自己的端口上提供跨链人格证明查询
+	ibcRouter.AddRoute(identitymoduletypes.PortID, identitymodule.NewIBCModule(app.appCodec, app.IdentityKeeper))
+
 	// this line is used by starport scaffolding # ibc/app/module
 
 	app.IBCKeeper.SetRouter(ibcRouter)
@@ -82,6 +82,13 @@ func NewRootCmd() *cobra.Command {
 		autoCliOpts.Modules[name] = mod
 	}
 
+	// The evidence module is wired manually so that custom evidence routes can be
+	// registered, so it must be registered on the client side as well.
+	for name, mod := range app.RegisterEvidence(clientCtx.Codec) {
+		moduleBasicManager[name] = module.CoreAppModuleBasicAdaptor(name, mod)
+		autoCliOpts.Modules[name] = mod
+	}
+
 	initRootCmd(rootCmd, clientCtx.TxConfig, moduleBasicManager)
 
 	if err := autoCliOpts.EnhanceRootCommand(rootCmd); err != nil {
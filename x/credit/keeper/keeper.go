@@ -28,10 +28,13 @@ type Keeper struct {
 	CreditAccountLiability      collections.Map[string, uint64]
 	CreditAccountLastMintHeight collections.Map[string, uint64]
 	CreditAccountBirthHeight    collections.Map[string, uint64]
+	// CreditAccountRepaid 按地址累计已偿还的负债
+	CreditAccountRepaid collections.Map[string, uint64]
 
 	bankKeeper    types.BankKeeper
 	authKeeper   types.AuthKeeper
 	identityKeeper types.IdentityKeeper
+	roleKeeper     types.RoleKeeper
 }
 
 func NewKeeper(
@@ -43,6 +46,7 @@ func NewKeeper(
 	bankKeeper types.BankKeeper,
 	authKeeper types.AuthKeeper,
 	identityKeeper types.IdentityKeeper,
+	roleKeeper types.RoleKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -59,10 +63,12 @@ func NewKeeper(
 		bankKeeper:     bankKeeper,
 		authKeeper:     authKeeper,
 		identityKeeper: identityKeeper,
+		roleKeeper:     roleKeeper,
 		Params:                    collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 		CreditAccountLiability:    collections.NewMap(sb, types.CreditAccountLiabilityPrefix, "ca_liability", collections.StringKey, collections.Uint64Value),
 		CreditAccountLastMintHeight: collections.NewMap(sb, types.CreditAccountLastMintHeightPrefix, "ca_last_mint", collections.StringKey, collections.Uint64Value),
 		CreditAccountBirthHeight:  collections.NewMap(sb, types.CreditAccountBirthHeightPrefix, "ca_birth", collections.StringKey, collections.Uint64Value),
+		CreditAccountRepaid:       collections.NewMap(sb, types.CreditAccountRepaidPrefix, "ca_repaid", collections.StringKey, collections.Uint64Value),
 	}
 
 	schema, err := sb.Build()
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"cosmossdk.io/core/address"
@@ -16,12 +17,23 @@ import (
 	"dtc/x/credit/keeper"
 	module "dtc/x/credit/module"
 	"dtc/x/credit/types"
+	dtctypes "dtc/x/dtc/types"
 )
 
 type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	roleKeeper   *mockRoleKeeper
+}
+
+// mockRoleKeeper 按 "role/address/scope" 记录持有的角色
+type mockRoleKeeper struct {
+	roles map[string]bool
+}
+
+func (m *mockRoleKeeper) HasRole(_ context.Context, role dtctypes.Role, address, scope string) bool {
+	return m.roles[fmt.Sprintf("%s/%s/%s", role, address, scope)]
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -35,6 +47,7 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	roleKeeper := &mockRoleKeeper{roles: map[string]bool{}}
 
 	k := keeper.NewKeeper(
 		storeService,
@@ -44,6 +57,7 @@ func initFixture(t *testing.T) *fixture {
 		nil,
 		nil,
 		nil, // identityKeeper
+		roleKeeper,
 	)
 
 	// Initialize params
@@ -55,5 +69,6 @@ func initFixture(t *testing.T) *fixture {
 		ctx:          ctx,
 		keeper:       k,
 		addressCodec: addressCodec,
+		roleKeeper:   roleKeeper,
 	}
 }
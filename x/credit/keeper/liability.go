@@ -0,0 +1,172 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dtc/x/credit/types"
+)
+
+// MergeLiability 将 from 账户的信用账户合并到 to 账户：负债累加，出生高度取较早者，
+// 最近铸币高度取较晚者（防止同一人借重复身份在同一周期内多次铸币），随后关闭 from 账户。
+// 返回被合并的负债
+func (k Keeper) MergeLiability(ctx context.Context, from, to string) (uint64, error) {
+	liability, err := k.CreditAccountLiability.Get(ctx, from)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	toLiability, err := k.CreditAccountLiability.Get(ctx, to)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+	if err := k.CreditAccountLiability.Set(ctx, to, toLiability+liability); err != nil {
+		return 0, err
+	}
+
+	fromBirth, err := k.CreditAccountBirthHeight.Get(ctx, from)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+	toBirth, err := k.CreditAccountBirthHeight.Get(ctx, to)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+	if toBirth == 0 || (fromBirth > 0 && fromBirth < toBirth) {
+		toBirth = fromBirth
+	}
+	if err := k.CreditAccountBirthHeight.Set(ctx, to, toBirth); err != nil {
+		return 0, err
+	}
+
+	fromLastMint, err := k.CreditAccountLastMintHeight.Get(ctx, from)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+	toLastMint, err := k.CreditAccountLastMintHeight.Get(ctx, to)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+	if err := k.CreditAccountLastMintHeight.Set(ctx, to, max(fromLastMint, toLastMint)); err != nil {
+		return 0, err
+	}
+
+	fromRepaid, err := k.GetRepaidLiability(ctx, from)
+	if err != nil {
+		return 0, err
+	}
+	if fromRepaid > 0 {
+		toRepaid, err := k.GetRepaidLiability(ctx, to)
+		if err != nil {
+			return 0, err
+		}
+		if err := k.CreditAccountRepaid.Set(ctx, to, toRepaid+fromRepaid); err != nil {
+			return 0, err
+		}
+	}
+
+	return liability, k.removeCreditAccount(ctx, from)
+}
+
+// ForfeitLiability 关闭账户的信用账户：没收并销毁不超过负债的可用余额，剩余负债注销。
+// 返回被注销的负债
+func (k Keeper) ForfeitLiability(ctx context.Context, address string) (uint64, error) {
+	liability, err := k.CreditAccountLiability.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	addrBytes, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return 0, err
+	}
+	spendable := k.bankKeeper.SpendableCoins(ctx, addrBytes).AmountOf(sdk.DefaultBondDenom)
+	seized := math.MinInt(spendable, math.NewIntFromUint64(liability))
+	if seized.IsPositive() {
+		coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, seized))
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addrBytes, types.ModuleName, coins); err != nil {
+			return 0, err
+		}
+		if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+			return 0, err
+		}
+	}
+
+	return liability, k.removeCreditAccount(ctx, address)
+}
+
+// RepayLiability 用 senderModule 模块账户中的资金为 address 偿还负债：划转并销毁不超过负债的部分，
+// 负债清零时删除负债记录。只接受链上基础币种，其他币种不偿还。返回实际偿还的金额
+func (k Keeper) RepayLiability(ctx context.Context, senderModule, address string, amount sdk.Coin) (sdk.Coin, error) {
+	repaid := sdk.NewCoin(amount.Denom, math.ZeroInt())
+	if amount.Denom != sdk.DefaultBondDenom || !amount.IsPositive() {
+		return repaid, nil
+	}
+
+	liability, err := k.CreditAccountLiability.Get(ctx, address)
+	if errors.Is(err, collections.ErrNotFound) {
+		return repaid, nil
+	}
+	if err != nil {
+		return repaid, err
+	}
+
+	repaid.Amount = math.MinInt(amount.Amount, math.NewIntFromUint64(liability))
+	if !repaid.IsPositive() {
+		return repaid, nil
+	}
+	coins := sdk.NewCoins(repaid)
+	if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, senderModule, types.ModuleName, coins); err != nil {
+		return repaid, err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return repaid, err
+	}
+
+	total, err := k.GetRepaidLiability(ctx, address)
+	if err != nil {
+		return repaid, err
+	}
+	if err := k.CreditAccountRepaid.Set(ctx, address, total+repaid.Amount.Uint64()); err != nil {
+		return repaid, err
+	}
+
+	remaining := liability - repaid.Amount.Uint64()
+	if remaining == 0 {
+		return repaid, k.CreditAccountLiability.Remove(ctx, address)
+	}
+	return repaid, k.CreditAccountLiability.Set(ctx, address, remaining)
+}
+
+// GetRepaidLiability 返回地址累计已偿还的负债，负债清零后仍然保留
+func (k Keeper) GetRepaidLiability(ctx context.Context, address string) (uint64, error) {
+	repaid, err := k.CreditAccountRepaid.Get(ctx, address)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+	return repaid, nil
+}
+
+// removeCreditAccount 删除地址的全部信用账户记录
+func (k Keeper) removeCreditAccount(ctx context.Context, address string) error {
+	if err := k.CreditAccountLiability.Remove(ctx, address); err != nil {
+		return err
+	}
+	if err := k.CreditAccountBirthHeight.Remove(ctx, address); err != nil {
+		return err
+	}
+	if err := k.CreditAccountRepaid.Remove(ctx, address); err != nil {
+		return err
+	}
+	return k.CreditAccountLastMintHeight.Remove(ctx, address)
+}
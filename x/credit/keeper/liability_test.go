@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/credit/types"
+)
+
+func TestMergeLiability(t *testing.T) {
+	f := initMintCreditFixture(t)
+
+	from, err := f.addressCodec.BytesToString([]byte("duplicateAddr_______________"))
+	require.NoError(t, err)
+	to, err := f.addressCodec.BytesToString([]byte("originalAddr________________"))
+	require.NoError(t, err)
+
+	// 没有信用账户时无需合并
+	merged, err := f.keeper.MergeLiability(f.ctx, from, to)
+	require.NoError(t, err)
+	require.Zero(t, merged)
+
+	require.NoError(t, f.keeper.CreditAccountLiability.Set(f.ctx, from, 300))
+	require.NoError(t, f.keeper.CreditAccountBirthHeight.Set(f.ctx, from, 50))
+	require.NoError(t, f.keeper.CreditAccountLastMintHeight.Set(f.ctx, from, 90))
+	require.NoError(t, f.keeper.CreditAccountRepaid.Set(f.ctx, from, 40))
+	require.NoError(t, f.keeper.CreditAccountLiability.Set(f.ctx, to, 200))
+	require.NoError(t, f.keeper.CreditAccountBirthHeight.Set(f.ctx, to, 60))
+	require.NoError(t, f.keeper.CreditAccountLastMintHeight.Set(f.ctx, to, 70))
+
+	merged, err = f.keeper.MergeLiability(f.ctx, from, to)
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), merged)
+
+	liability, err := f.keeper.CreditAccountLiability.Get(f.ctx, to)
+	require.NoError(t, err)
+	require.Equal(t, uint64(500), liability)
+	birth, err := f.keeper.CreditAccountBirthHeight.Get(f.ctx, to)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), birth)
+	lastMint, err := f.keeper.CreditAccountLastMintHeight.Get(f.ctx, to)
+	require.NoError(t, err)
+	require.Equal(t, uint64(90), lastMint)
+	repaid, err := f.keeper.GetRepaidLiability(f.ctx, to)
+	require.NoError(t, err)
+	require.Equal(t, uint64(40), repaid)
+
+	has, err := f.keeper.CreditAccountLiability.Has(f.ctx, from)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.CreditAccountRepaid.Has(f.ctx, from)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestForfeitLiability(t *testing.T) {
+	f := initMintCreditFixture(t)
+
+	addrBytes := []byte("duplicateAddr_______________")
+	addr, err := f.addressCodec.BytesToString(addrBytes)
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.CreditAccountLiability.Set(f.ctx, addr, 300))
+	require.NoError(t, f.keeper.CreditAccountBirthHeight.Set(f.ctx, addr, 50))
+	require.NoError(t, f.keeper.CreditAccountLastMintHeight.Set(f.ctx, addr, 90))
+	f.bankKeeper.accountBalances[sdk.AccAddress(addrBytes).String()] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000))
+
+	forfeited, err := f.keeper.ForfeitLiability(f.ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(300), forfeited)
+
+	// 只没收不超过负债的余额并销毁
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 700)), f.bankKeeper.GetAccountBalance(sdk.AccAddress(addrBytes)))
+	require.True(t, f.bankKeeper.GetModuleBalance(types.ModuleName).IsZero())
+
+	has, err := f.keeper.CreditAccountLiability.Has(f.ctx, addr)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.CreditAccountLastMintHeight.Has(f.ctx, addr)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestRepayLiability(t *testing.T) {
+	f := initMintCreditFixture(t)
+
+	addr, err := f.addressCodec.BytesToString([]byte("borrower____________________"))
+	require.NoError(t, err)
+	f.bankKeeper.moduleBalances["task"] = sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1000), sdk.NewInt64Coin("other", 1000))
+
+	// 没有负债或币种不是基础币种时不偿还
+	repaid, err := f.keeper.RepayLiability(f.ctx, "task", addr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, err)
+	require.True(t, repaid.IsZero())
+	require.NoError(t, f.keeper.CreditAccountLiability.Set(f.ctx, addr, 150))
+	repaid, err = f.keeper.RepayLiability(f.ctx, "task", addr, sdk.NewInt64Coin("other", 100))
+	require.NoError(t, err)
+	require.True(t, repaid.IsZero())
+
+	repaid, err = f.keeper.RepayLiability(f.ctx, "task", addr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, err)
+	require.Equal(t, int64(100), repaid.Amount.Int64())
+	liability, err := f.keeper.CreditAccountLiability.Get(f.ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(50), liability)
+
+	// 偿还金额不超过剩余负债，清零后删除负债记录，划入的资金全部销毁
+	repaid, err = f.keeper.RepayLiability(f.ctx, "task", addr, sdk.NewInt64Coin(sdk.DefaultBondDenom, 100))
+	require.NoError(t, err)
+	require.Equal(t, int64(50), repaid.Amount.Int64())
+	has, err := f.keeper.CreditAccountLiability.Has(f.ctx, addr)
+	require.NoError(t, err)
+	require.False(t, has)
+	require.Equal(t, int64(850), f.bankKeeper.GetModuleBalance("task").AmountOf(sdk.DefaultBondDenom).Int64())
+	require.True(t, f.bankKeeper.GetModuleBalance(types.ModuleName).IsZero())
+
+	// 累计偿还金额在负债清零后仍然保留
+	total, err := f.keeper.GetRepaidLiability(f.ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, uint64(150), total)
+}
@@ -31,10 +31,14 @@ func (k msgServer) MintCredit(ctx context.Context, msg *types.MsgMintCredit) (*t
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	// 1. 身份准入检查：Creator 必须已注册 DID
-	_, found := k.identityKeeper.GetDidDocument(sdkCtx, msg.Creator)
+	didDocument, found := k.identityKeeper.GetDidDocument(sdkCtx, msg.Creator)
 	if !found {
 		return nil, errorsmod.Wrap(types.ErrIdentityNotRegistered, msg.Creator)
 	}
+	// 个人信用只面向自然人，组织 DID 不可铸币
+	if didDocument.IsOrganisation() {
+		return nil, errorsmod.Wrap(types.ErrOrganisationNotEligible, didDocument.Did)
+	}
 
 	// 2. 铸币间隔检查：距上次铸币不足 BlocksPerMonth 则拒绝
 	lastMintHeight, err := k.CreditAccountLastMintHeight.Get(ctx, msg.Creator)
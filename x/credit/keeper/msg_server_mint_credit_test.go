@@ -22,11 +22,13 @@ import (
 	"dtc/x/credit/types"
 )
 
-// mockIdentityKeeper 用于测试：始终返回已注册（found=true）
-type mockIdentityKeeper struct{}
+// mockIdentityKeeper 用于测试：始终返回已注册（found=true），kind 默认为自然人
+type mockIdentityKeeper struct {
+	kind identitytypes.DidKind
+}
 
-func (mockIdentityKeeper) GetDidDocument(ctx sdk.Context, address string) (identitytypes.DidDocument, bool) {
-	return identitytypes.DidDocument{Controller: address}, true
+func (m mockIdentityKeeper) GetDidDocument(ctx sdk.Context, address string) (identitytypes.DidDocument, bool) {
+	return identitytypes.DidDocument{Controller: address, Kind: m.kind}, true
 }
 
 // mintCreditBankKeeper 是一个可以跟踪铸币和转账的 mock BankKeeper
@@ -109,12 +111,28 @@ func (m *mintCreditBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context,
 }
 
 func (m *mintCreditBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
-	// 未在测试中使用，但需要实现接口
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.accountBalances[senderAddr.String()] = m.accountBalances[senderAddr.String()].Sub(amt...)
+	m.moduleBalances[recipientModule] = m.moduleBalances[recipientModule].Add(amt...)
+	return nil
+}
+
+func (m *mintCreditBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.moduleBalances[senderModule] = m.moduleBalances[senderModule].Sub(amt...)
+	m.moduleBalances[recipientModule] = m.moduleBalances[recipientModule].Add(amt...)
 	return nil
 }
 
 func (m *mintCreditBankKeeper) BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error {
-	// 未在测试中使用，但需要实现接口
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.moduleBalances[moduleName] = m.moduleBalances[moduleName].Sub(amt...)
 	return nil
 }
 
@@ -163,6 +181,11 @@ type mintCreditFixture struct {
 
 func initMintCreditFixture(t *testing.T) *mintCreditFixture {
 	t.Helper()
+	return initMintCreditFixtureWithIdentity(t, mockIdentityKeeper{})
+}
+
+func initMintCreditFixtureWithIdentity(t *testing.T, identityKeeper types.IdentityKeeper) *mintCreditFixture {
+	t.Helper()
 
 	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
 	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
@@ -187,7 +210,8 @@ func initMintCreditFixture(t *testing.T) *mintCreditFixture {
 		authority,
 		bankKeeper,
 		nil,
-		mockIdentityKeeper{}, // 测试中视为已注册
+		identityKeeper,
+		nil,
 	)
 
 	// Initialize params with default gbdp_rate = 100 (1%)
@@ -301,3 +325,19 @@ func TestMintCredit_Success(t *testing.T) {
 	require.NoError(t, err, "应该能获取用户的最近铸币高度")
 	require.Greater(t, lastMintHeight, uint64(0), "最近铸币高度应该大于 0")
 }
+
+// TestMintCredit_OrganisationNotEligible 测试组织 DID 不能铸造个人信用
+func TestMintCredit_OrganisationNotEligible(t *testing.T) {
+	f := initMintCreditFixtureWithIdentity(t, mockIdentityKeeper{kind: identitytypes.DID_KIND_ORGANISATION})
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	creator, err := f.addressCodec.BytesToString([]byte("testCreator________________"))
+	require.NoError(t, err)
+
+	_, err = srv.MintCredit(f.ctx, &types.MsgMintCredit{Creator: creator})
+	require.ErrorIs(t, err, types.ErrOrganisationNotEligible)
+	require.Empty(t, f.bankKeeper.GetSendCalls())
+
+	_, err = f.keeper.CreditAccountLiability.Get(f.ctx, creator)
+	require.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"dtc/x/credit/types"
+	dtctypes "dtc/x/dtc/types"
 
 	errorsmod "cosmossdk.io/errors"
 )
@@ -13,6 +14,14 @@ func (k msgServer) SubmitDeathCertificate(ctx context.Context, msg *types.MsgSub
 		return nil, errorsmod.Wrap(err, "invalid authority address")
 	}
 
+	// 仅持有死亡登记角色的账户可提交死亡证明
+	if !k.roleKeeper.HasRole(ctx, dtctypes.ROLE_DEATH_REGISTRAR, msg.Creator, "") {
+		return nil, errorsmod.Wrapf(types.ErrNotDeathRegistrar, "creator %s", msg.Creator)
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.Address); err != nil {
+		return nil, errorsmod.Wrap(err, "invalid deceased address")
+	}
+
 	// TODO: Handle the message
 
 	return &types.MsgSubmitDeathCertificateResponse{}, nil
@@ -0,0 +1,32 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/credit/keeper"
+	"dtc/x/credit/types"
+	dtctypes "dtc/x/dtc/types"
+)
+
+func TestSubmitDeathCertificate(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	registrar, err := f.addressCodec.BytesToString([]byte("registrarAddr_______________"))
+	require.NoError(t, err)
+	deceased, err := f.addressCodec.BytesToString([]byte("deceasedAddr________________"))
+	require.NoError(t, err)
+
+	// 未持有死亡登记角色不能提交
+	_, err = srv.SubmitDeathCertificate(f.ctx, &types.MsgSubmitDeathCertificate{Creator: registrar, Address: deceased})
+	require.ErrorIs(t, err, types.ErrNotDeathRegistrar)
+
+	f.roleKeeper.roles[fmt.Sprintf("%s/%s/", dtctypes.ROLE_DEATH_REGISTRAR, registrar)] = true
+	_, err = srv.SubmitDeathCertificate(f.ctx, &types.MsgSubmitDeathCertificate{Creator: registrar, Address: "invalid"})
+	require.Error(t, err)
+	_, err = srv.SubmitDeathCertificate(f.ctx, &types.MsgSubmitDeathCertificate{Creator: registrar, Address: deceased})
+	require.NoError(t, err)
+}
@@ -36,6 +36,7 @@ type ModuleInputs struct {
 	AuthKeeper     types.AuthKeeper
 	BankKeeper     types.BankKeeper
 	IdentityKeeper types.IdentityKeeper
+	RoleKeeper     types.RoleKeeper
 }
 
 type ModuleOutputs struct {
@@ -59,6 +60,7 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.BankKeeper,
 		in.AuthKeeper,
 		in.IdentityKeeper,
+		in.RoleKeeper,
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
@@ -11,4 +11,6 @@ var (
 	ErrInvalidSigner           = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
 	ErrIdentityNotRegistered   = errors.Register(ModuleName, 1101, "creator address is not registered (no DID document)")
 	ErrMintTooFrequent         = errors.Register(ModuleName, 1102, "mint is too frequent; must wait at least one month since last mint")
+	ErrOrganisationNotEligible = errors.Register(ModuleName, 1103, "organisation dids are not eligible for personal credit minting")
+	ErrNotDeathRegistrar       = errors.Register(ModuleName, 1104, "creator does not hold the death registrar role")
 )
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	dtctypes "dtc/x/dtc/types"
 	identitytypes "dtc/x/identity/types"
 )
 
@@ -22,6 +23,7 @@ type BankKeeper interface {
 	MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
 }
 
@@ -30,6 +32,11 @@ type IdentityKeeper interface {
 	GetDidDocument(ctx sdk.Context, address string) (val identitytypes.DidDocument, found bool)
 }
 
+// RoleKeeper defines the expected interface for the x/dtc role registry.
+type RoleKeeper interface {
+	HasRole(ctx context.Context, role dtctypes.Role, address, scope string) bool
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
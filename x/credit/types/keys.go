@@ -29,3 +29,6 @@ var CreditAccountLastMintHeightPrefix = collections.NewPrefix("ca_last_mint_")
 
 // CreditAccountBirthHeightPrefix 按地址存储账户创建高度（用于计算年龄）
 var CreditAccountBirthHeightPrefix = collections.NewPrefix("ca_birth_")
+
+// CreditAccountRepaidPrefix 按地址存储累计已偿还的负债
+var CreditAccountRepaidPrefix = collections.NewPrefix("ca_repaid_")
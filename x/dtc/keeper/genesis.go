@@ -11,6 +11,26 @@ import (
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	for _, grant := range genState.RoleGrants {
+		if err := k.RoleGrant.Set(ctx, roleGrantKey(grant.Role, grant.Address, grant.Scope), grant); err != nil {
+			return err
+		}
+	}
+
+	// 审计日志 id 从导入记录的最大 id 之后继续分配
+	var nextID uint64
+	for _, entry := range genState.RoleAuditLog {
+		if err := k.RoleAuditLog.Set(ctx, entry.Id, entry); err != nil {
+			return err
+		}
+		if entry.Id >= nextID {
+			nextID = entry.Id + 1
+		}
+	}
+	if err := k.RoleAuditSeq.Set(ctx, nextID); err != nil {
+		return err
+	}
+
 	return k.Params.Set(ctx, genState.Params)
 }
 
@@ -27,5 +47,19 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		genesis.Params = params
 	}
 
+	if err := k.RoleGrant.Walk(ctx, nil, func(_ collections.Triple[int32, string, string], grant types.RoleGrant) (bool, error) {
+		genesis.RoleGrants = append(genesis.RoleGrants, grant)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.RoleAuditLog.Walk(ctx, nil, func(_ uint64, entry types.RoleAuditEntry) (bool, error) {
+		genesis.RoleAuditLog = append(genesis.RoleAuditLog, entry)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
 	return genesis, nil
 }
@@ -11,6 +11,14 @@ import (
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		RoleGrants: []types.RoleGrant{
+			{Role: types.ROLE_ORACLE, Address: "oracle", Scope: "task/1", ExpiryHeight: 100},
+			{Role: types.ROLE_TREASURER, Address: "treasurer"},
+		},
+		RoleAuditLog: []types.RoleAuditEntry{
+			{Id: 0, Action: types.ROLE_ACTION_GRANT, Role: types.ROLE_ORACLE, Address: "oracle", Scope: "task/1", ExpiryHeight: 100},
+			{Id: 1, Action: types.ROLE_ACTION_GRANT, Role: types.ROLE_TREASURER, Address: "treasurer"},
+		},
 	}
 
 	f := initFixture(t)
@@ -21,4 +29,11 @@ func TestGenesis(t *testing.T) {
 	require.NotNil(t, got)
 
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
+	require.ElementsMatch(t, genesisState.RoleGrants, got.RoleGrants)
+	require.Equal(t, genesisState.RoleAuditLog, got.RoleAuditLog)
+
+	// 审计日志 id 在导入记录之后继续分配
+	next, err := f.keeper.RoleAuditSeq.Peek(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), next)
 }
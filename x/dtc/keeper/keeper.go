@@ -21,6 +21,10 @@ type Keeper struct {
 
 	Schema collections.Schema
 	Params collections.Item[types.Params]
+
+	RoleGrant    collections.Map[collections.Triple[int32, string, string], types.RoleGrant] // (role, address, scope) -> grant
+	RoleAuditLog collections.Map[uint64, types.RoleAuditEntry]                               // id -> audit entry
+	RoleAuditSeq collections.Sequence
 }
 
 func NewKeeper(
@@ -28,7 +32,6 @@ func NewKeeper(
 	cdc codec.Codec,
 	addressCodec address.Codec,
 	authority []byte,
-
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -43,6 +46,10 @@ func NewKeeper(
 		authority:    authority,
 
 		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+
+		RoleGrant:    collections.NewMap(sb, types.RoleGrantKey, "roleGrant", collections.TripleKeyCodec(collections.Int32Key, collections.StringKey, collections.StringKey), codec.CollValue[types.RoleGrant](cdc)),
+		RoleAuditLog: collections.NewMap(sb, types.RoleAuditLogKey, "roleAuditLog", collections.Uint64Key, codec.CollValue[types.RoleAuditEntry](cdc)),
+		RoleAuditSeq: collections.NewSequence(sb, types.RoleAuditSeqKey, "roleAuditSeq"),
 	}
 
 	schema, err := sb.Build()
@@ -0,0 +1,140 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dtc/x/dtc/types"
+)
+
+// checkAuthority 校验消息签名者为模块 authority（默认为 x/gov 模块账户）
+func (k msgServer) checkAuthority(authority string) error {
+	bz, err := k.addressCodec.StringToBytes(authority)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+	if !bytes.Equal(k.GetAuthority(), bz) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, authority)
+	}
+	return nil
+}
+
+// validateRoleTarget 校验角色、地址与范围
+func (k msgServer) validateRoleTarget(role types.Role, address, scope string) error {
+	if err := role.Validate(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidRole, err.Error())
+	}
+	if _, err := k.addressCodec.StringToBytes(address); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid role address: %s", err))
+	}
+	if err := types.ValidateRoleScope(scope); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidScope, err.Error())
+	}
+	return nil
+}
+
+// GrantRole 授予角色；同一地址、角色与范围已有授权时更新过期高度，每次变更都写入审计日志
+func (k msgServer) GrantRole(ctx context.Context, msg *types.MsgGrantRole) (*types.MsgGrantRoleResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if err := k.validateRoleTarget(msg.Role, msg.Address, msg.Scope); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if msg.ExpiryHeight != 0 && msg.ExpiryHeight <= sdkCtx.BlockHeight() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidRole, "expiry height %d is not in the future", msg.ExpiryHeight)
+	}
+
+	grant := types.RoleGrant{
+		Role:          msg.Role,
+		Address:       msg.Address,
+		Scope:         msg.Scope,
+		GrantedHeight: sdkCtx.BlockHeight(),
+		ExpiryHeight:  msg.ExpiryHeight,
+	}
+	if err := k.RoleGrant.Set(ctx, roleGrantKey(msg.Role, msg.Address, msg.Scope), grant); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	id, err := k.appendRoleAudit(ctx, types.RoleAuditEntry{
+		Action:       types.ROLE_ACTION_GRANT,
+		Role:         msg.Role,
+		Address:      msg.Address,
+		Scope:        msg.Scope,
+		ExpiryHeight: msg.ExpiryHeight,
+		Reason:       msg.Reason,
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRoleGranted,
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role.String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyScope, msg.Scope),
+			sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatInt(msg.ExpiryHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeyAuditID, strconv.FormatUint(id, 10)),
+		),
+	)
+
+	return &types.MsgGrantRoleResponse{}, nil
+}
+
+// RevokeRole 撤销角色，只删除完全匹配地址、角色与范围的授权
+func (k msgServer) RevokeRole(ctx context.Context, msg *types.MsgRevokeRole) (*types.MsgRevokeRoleResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+	if err := k.validateRoleTarget(msg.Role, msg.Address, msg.Scope); err != nil {
+		return nil, err
+	}
+
+	key := roleGrantKey(msg.Role, msg.Address, msg.Scope)
+	if _, err := k.RoleGrant.Get(ctx, key); err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrRoleNotFound, "%s %s %q", msg.Role, msg.Address, msg.Scope)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.RoleGrant.Remove(ctx, key); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	id, err := k.appendRoleAudit(ctx, types.RoleAuditEntry{
+		Action:  types.ROLE_ACTION_REVOKE,
+		Role:    msg.Role,
+		Address: msg.Address,
+		Scope:   msg.Scope,
+		Reason:  msg.Reason,
+	})
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRoleRevoked,
+			sdk.NewAttribute(types.AttributeKeyRole, msg.Role.String()),
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Address),
+			sdk.NewAttribute(types.AttributeKeyScope, msg.Scope),
+			sdk.NewAttribute(types.AttributeKeyReason, msg.Reason),
+			sdk.NewAttribute(types.AttributeKeyAuditID, strconv.FormatUint(id, 10)),
+		),
+	)
+
+	return &types.MsgRevokeRoleResponse{}, nil
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/dtc/keeper"
+	"dtc/x/dtc/types"
+)
+
+func TestGrantRevokeRole(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString([]byte("aliceAddr___________________"))
+	require.NoError(t, err)
+
+	grants := []struct {
+		desc string
+		msg  *types.MsgGrantRole
+		err  error
+	}{
+		{desc: "invalid authority", msg: &types.MsgGrantRole{Authority: alice, Role: types.ROLE_ORACLE, Address: alice}, err: types.ErrInvalidSigner},
+		{desc: "unspecified role", msg: &types.MsgGrantRole{Authority: authority, Address: alice}, err: types.ErrInvalidRole},
+		{desc: "unknown role", msg: &types.MsgGrantRole{Authority: authority, Role: types.Role(99), Address: alice}, err: types.ErrInvalidRole},
+		{desc: "invalid address", msg: &types.MsgGrantRole{Authority: authority, Role: types.ROLE_ORACLE, Address: "invalid"}, err: sdkerrors.ErrInvalidAddress},
+		{desc: "invalid scope", msg: &types.MsgGrantRole{Authority: authority, Role: types.ROLE_ORACLE, Address: alice, Scope: "task 1"}, err: types.ErrInvalidScope},
+		{desc: "expiry in the past", msg: &types.MsgGrantRole{Authority: authority, Role: types.ROLE_ORACLE, Address: alice, ExpiryHeight: 10}, err: types.ErrInvalidRole},
+		{desc: "scoped grant", msg: &types.MsgGrantRole{Authority: authority, Role: types.ROLE_ORACLE, Address: alice, Scope: "task/1", ExpiryHeight: 20, Reason: "task 1 oracle"}},
+		{desc: "global grant", msg: &types.MsgGrantRole{Authority: authority, Role: types.ROLE_PAUSER, Address: alice}},
+	}
+	for _, tc := range grants {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := ms.GrantRole(ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// 范围授权只在该范围内有效，并在过期高度失效
+	require.True(t, f.keeper.HasRole(ctx, types.ROLE_ORACLE, alice, "task/1"))
+	require.False(t, f.keeper.HasRole(ctx, types.ROLE_ORACLE, alice, "task/2"))
+	require.False(t, f.keeper.HasRole(ctx, types.ROLE_ORACLE, alice, ""))
+	require.False(t, f.keeper.HasRole(ctx.WithBlockHeight(20), types.ROLE_ORACLE, alice, "task/1"))
+
+	// 全局授权覆盖任意范围
+	require.True(t, f.keeper.HasRole(ctx, types.ROLE_PAUSER, alice, ""))
+	require.True(t, f.keeper.HasRole(ctx, types.ROLE_PAUSER, alice, "task/1"))
+	require.False(t, f.keeper.HasRole(ctx, types.ROLE_TREASURER, alice, ""))
+
+	_, err = ms.RevokeRole(ctx, &types.MsgRevokeRole{Authority: authority, Role: types.ROLE_PAUSER, Address: alice, Scope: "task/1"})
+	require.ErrorIs(t, err, types.ErrRoleNotFound)
+	_, err = ms.RevokeRole(ctx, &types.MsgRevokeRole{Authority: alice, Role: types.ROLE_PAUSER, Address: alice})
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+	_, err = ms.RevokeRole(ctx, &types.MsgRevokeRole{Authority: authority, Role: types.ROLE_PAUSER, Address: alice, Reason: "rotated"})
+	require.NoError(t, err)
+	require.False(t, f.keeper.HasRole(ctx, types.ROLE_PAUSER, alice, ""))
+
+	// 每次变更都按顺序写入审计日志
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	res, err := qs.RoleAuditLog(ctx, &types.QueryRoleAuditLogRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 3)
+	require.Equal(t, types.RoleAuditEntry{Id: 0, Action: types.ROLE_ACTION_GRANT, Role: types.ROLE_ORACLE, Address: alice, Scope: "task/1", ExpiryHeight: 20, Reason: "task 1 oracle", Height: 10}, res.Entries[0])
+	require.Equal(t, types.RoleAuditEntry{Id: 2, Action: types.ROLE_ACTION_REVOKE, Role: types.ROLE_PAUSER, Address: alice, Reason: "rotated", Height: 10}, res.Entries[2])
+}
+
+func TestQueryRoles(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	authority, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString([]byte("aliceAddr___________________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString([]byte("bobAddr_____________________"))
+	require.NoError(t, err)
+
+	for _, msg := range []*types.MsgGrantRole{
+		{Authority: authority, Role: types.ROLE_ATTESTOR, Address: alice},
+		{Authority: authority, Role: types.ROLE_ATTESTOR, Address: bob, Scope: "identity"},
+		{Authority: authority, Role: types.ROLE_DEATH_REGISTRAR, Address: bob},
+	} {
+		_, err := ms.GrantRole(ctx, msg)
+		require.NoError(t, err)
+	}
+
+	res, err := qs.HasRole(ctx, &types.QueryHasRoleRequest{Role: types.ROLE_ATTESTOR, Address: bob, Scope: "identity"})
+	require.NoError(t, err)
+	require.True(t, res.HasRole)
+	require.Equal(t, "identity", res.Grant.Scope)
+
+	res, err = qs.HasRole(ctx, &types.QueryHasRoleRequest{Role: types.ROLE_DEATH_REGISTRAR, Address: alice})
+	require.NoError(t, err)
+	require.False(t, res.HasRole)
+	require.Nil(t, res.Grant)
+
+	_, err = qs.HasRole(ctx, &types.QueryHasRoleRequest{Address: alice})
+	require.Error(t, err)
+
+	list, err := qs.ListRoleGrants(ctx, &types.QueryListRoleGrantsRequest{Role: types.ROLE_ATTESTOR})
+	require.NoError(t, err)
+	require.Len(t, list.Grants, 2)
+
+	list, err = qs.ListRoleGrants(ctx, &types.QueryListRoleGrantsRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.Grants, 3)
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dtc/x/dtc/types"
+)
+
+func (q queryServer) HasRole(ctx context.Context, req *types.QueryHasRoleRequest) (*types.QueryHasRoleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if err := req.Role.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	grant, found := q.k.GetActiveRoleGrant(ctx, req.Role, req.Address, req.Scope)
+	if !found {
+		return &types.QueryHasRoleResponse{}, nil
+	}
+
+	return &types.QueryHasRoleResponse{HasRole: true, Grant: &grant}, nil
+}
+
+func (q queryServer) ListRoleGrants(ctx context.Context, req *types.QueryListRoleGrantsRequest) (*types.QueryListRoleGrantsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	grants, pageRes, err := query.CollectionFilteredPaginate(
+		ctx,
+		q.k.RoleGrant,
+		req.Pagination,
+		func(_ collections.Triple[int32, string, string], grant types.RoleGrant) (bool, error) {
+			return req.Role == types.ROLE_UNSPECIFIED || grant.Role == req.Role, nil
+		},
+		func(_ collections.Triple[int32, string, string], grant types.RoleGrant) (types.RoleGrant, error) {
+			return grant, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListRoleGrantsResponse{Grants: grants, Pagination: pageRes}, nil
+}
+
+func (q queryServer) RoleAuditLog(ctx context.Context, req *types.QueryRoleAuditLogRequest) (*types.QueryRoleAuditLogResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	entries, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.RoleAuditLog,
+		req.Pagination,
+		func(_ uint64, entry types.RoleAuditEntry) (types.RoleAuditEntry, error) {
+			return entry, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRoleAuditLogResponse{Entries: entries, Pagination: pageRes}, nil
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dtc/x/dtc/types"
+)
+
+// roleGrantKey 返回角色授权的存储键
+func roleGrantKey(role types.Role, address, scope string) collections.Triple[int32, string, string] {
+	return collections.Join3(int32(role), address, scope)
+}
+
+// GetActiveRoleGrant 返回使 address 在 scope 内持有 role 的有效授权。
+// 先查找该范围的授权，再查找协议全局（空范围）授权；已过期的授权视为不存在
+func (k Keeper) GetActiveRoleGrant(ctx context.Context, role types.Role, address, scope string) (types.RoleGrant, bool) {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	scopes := []string{scope}
+	if scope != "" {
+		scopes = append(scopes, "")
+	}
+	for _, s := range scopes {
+		grant, err := k.RoleGrant.Get(ctx, roleGrantKey(role, address, s))
+		if err == nil && grant.IsActive(height) {
+			return grant, true
+		}
+	}
+	return types.RoleGrant{}, false
+}
+
+// HasRole 判断 address 当前是否在 scope 内持有 role，供其他模块通过 expected keeper 接口调用
+func (k Keeper) HasRole(ctx context.Context, role types.Role, address, scope string) bool {
+	_, found := k.GetActiveRoleGrant(ctx, role, address, scope)
+	return found
+}
+
+// appendRoleAudit 追加一条角色变更审计记录并返回其 id
+func (k Keeper) appendRoleAudit(ctx context.Context, entry types.RoleAuditEntry) (uint64, error) {
+	id, err := k.RoleAuditSeq.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	entry.Id = id
+	entry.Height = sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if err := k.RoleAuditLog.Set(ctx, id, entry); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
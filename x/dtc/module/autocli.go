@@ -17,6 +17,22 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod:      "HasRole",
+					Use:            "has-role [role] [address] [scope]",
+					Short:          "Query whether an address currently holds a role within a scope",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "role"}, {ProtoField: "address"}, {ProtoField: "scope", Optional: true}},
+				},
+				{
+					RpcMethod: "ListRoleGrants",
+					Use:       "list-role-grants",
+					Short:     "List role grants, optionally filtered with --role",
+				},
+				{
+					RpcMethod: "RoleAuditLog",
+					Use:       "role-audit-log",
+					Short:     "List the audit log of role grants and revocations",
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -28,6 +44,14 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "GrantRole",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RevokeRole",
+					Skip:      true, // skipped because authority gated
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
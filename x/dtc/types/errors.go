@@ -9,4 +9,7 @@ import (
 // x/dtc module sentinel errors
 var (
 	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidRole   = errors.Register(ModuleName, 1101, "invalid role")
+	ErrInvalidScope  = errors.Register(ModuleName, 1102, "invalid role scope")
+	ErrRoleNotFound  = errors.Register(ModuleName, 1103, "role grant not found")
 )
@@ -0,0 +1,14 @@
+package types
+
+// dtc module events
+const (
+	EventTypeRoleGranted = "role_granted"
+	EventTypeRoleRevoked = "role_revoked"
+
+	AttributeKeyRole         = "role"
+	AttributeKeyAddress      = "address"
+	AttributeKeyScope        = "scope"
+	AttributeKeyExpiryHeight = "expiry_height"
+	AttributeKeyReason       = "reason"
+	AttributeKeyAuditID      = "audit_id"
+)
@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,5 +12,28 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	grants := make(map[string]struct{}, len(gs.RoleGrants))
+	for _, grant := range gs.RoleGrants {
+		if err := grant.Role.Validate(); err != nil {
+			return err
+		}
+		if err := ValidateRoleScope(grant.Scope); err != nil {
+			return err
+		}
+		key := fmt.Sprintf("%d/%s/%s", grant.Role, grant.Address, grant.Scope)
+		if _, ok := grants[key]; ok {
+			return fmt.Errorf("duplicated role grant %s", key)
+		}
+		grants[key] = struct{}{}
+	}
+
+	ids := make(map[uint64]struct{}, len(gs.RoleAuditLog))
+	for _, entry := range gs.RoleAuditLog {
+		if _, ok := ids[entry.Id]; ok {
+			return fmt.Errorf("duplicated role audit entry id %d", entry.Id)
+		}
+		ids[entry.Id] = struct{}{}
+	}
+
 	return gs.Params.Validate()
 }
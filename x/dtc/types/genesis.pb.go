@@ -27,7 +27,9 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the dtc module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	Params       Params           `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	RoleGrants   []RoleGrant      `protobuf:"bytes,2,rep,name=role_grants,json=roleGrants,proto3" json:"role_grants"`
+	RoleAuditLog []RoleAuditEntry `protobuf:"bytes,3,rep,name=role_audit_log,json=roleAuditLog,proto3" json:"role_audit_log"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +72,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetRoleGrants() []RoleGrant {
+	if m != nil {
+		return m.RoleGrants
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoleAuditLog() []RoleAuditEntry {
+	if m != nil {
+		return m.RoleAuditLog
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dtc.dtc.v1.GenesisState")
 }
@@ -77,19 +93,24 @@ func init() {
 func init() { proto.RegisterFile("dtc/dtc/v1/genesis.proto", fileDescriptor_1bbca91c1873f3c1) }
 
 var fileDescriptor_1bbca91c1873f3c1 = []byte{
-	// 185 bytes of a gzipped FileDescriptorProto
+	// 272 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x48, 0x29, 0x49, 0xd6,
 	0x07, 0xe1, 0x32, 0x43, 0xfd, 0xf4, 0xd4, 0xbc, 0xd4, 0xe2, 0xcc, 0x62, 0xbd, 0x82, 0xa2, 0xfc,
 	0x92, 0x7c, 0x21, 0xae, 0x94, 0x92, 0x64, 0x3d, 0x10, 0x2e, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd,
 	0xcc, 0xcb, 0xd7, 0x07, 0x93, 0x10, 0x69, 0x29, 0x71, 0x24, 0x8d, 0x05, 0x89, 0x45, 0x89, 0xb9,
-	0x50, 0x7d, 0x52, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x11, 0x55, 0x72,
-	0xe5, 0xe2, 0x71, 0x87, 0x18, 0x1f, 0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xca, 0xc5, 0x06, 0xd1,
-	0x25, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x6d, 0x24, 0xa4, 0x87, 0xb0, 0x4e, 0x2f, 0x00, 0x2c, 0xe3,
-	0xc4, 0x79, 0xe2, 0x9e, 0x3c, 0xc3, 0x8a, 0xe7, 0x1b, 0xb4, 0x18, 0x83, 0xa0, 0x8a, 0x9d, 0x34,
-	0x4f, 0x3c, 0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5,
-	0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x8a, 0x1f, 0xe4, 0x96, 0x0a, 0xb0,
-	0x8b, 0x4a, 0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0x16, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xf7, 0x5a, 0x58, 0x47, 0xe2, 0x00, 0x00, 0x00,
+	0x50, 0x7d, 0x52, 0xa2, 0x48, 0x12, 0x45, 0xf9, 0x39, 0xa9, 0x50, 0x61, 0x91, 0xf4, 0xfc, 0xf4,
+	0x7c, 0x30, 0x53, 0x1f, 0xc4, 0x82, 0x88, 0x2a, 0x9d, 0x66, 0xe4, 0xe2, 0x71, 0x87, 0x58, 0x1b,
+	0x5c, 0x92, 0x58, 0x92, 0x2a, 0x64, 0xca, 0xc5, 0x06, 0x31, 0x4d, 0x82, 0x51, 0x81, 0x51, 0x83,
+	0xdb, 0x48, 0x48, 0x0f, 0xe1, 0x0c, 0xbd, 0x00, 0xb0, 0x8c, 0x13, 0xe7, 0x89, 0x7b, 0xf2, 0x0c,
+	0x2b, 0x9e, 0x6f, 0xd0, 0x62, 0x0c, 0x82, 0x2a, 0x16, 0xb2, 0xe1, 0xe2, 0x06, 0xd9, 0x15, 0x9f,
+	0x5e, 0x94, 0x98, 0x57, 0x52, 0x2c, 0xc1, 0xa4, 0xc0, 0xac, 0xc1, 0x6d, 0x24, 0x8a, 0xac, 0x37,
+	0x28, 0x3f, 0x27, 0xd5, 0x1d, 0x24, 0xeb, 0xc4, 0x02, 0xd2, 0x1e, 0xc4, 0x55, 0x04, 0x13, 0x28,
+	0x16, 0x72, 0xe3, 0xe2, 0x03, 0xeb, 0x4e, 0x2c, 0x4d, 0xc9, 0x2c, 0x89, 0xcf, 0xc9, 0x4f, 0x97,
+	0x60, 0x06, 0x1b, 0x20, 0x85, 0x6e, 0x80, 0x23, 0x48, 0x81, 0x6b, 0x5e, 0x49, 0x51, 0x25, 0xd4,
+	0x14, 0x9e, 0x22, 0x98, 0xa8, 0x4f, 0x7e, 0xba, 0x93, 0xe6, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e,
+	0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37,
+	0x1e, 0xcb, 0x31, 0x44, 0xf1, 0x83, 0x02, 0xa4, 0x02, 0x1c, 0x2c, 0x25, 0x95, 0x05, 0xa9, 0xc5,
+	0x49, 0x6c, 0x60, 0xff, 0x1b, 0x03, 0x06, 0x00, 0x94, 0xd9, 0x55, 0x38, 0x80, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -112,6 +133,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RoleAuditLog) > 0 {
+		for iNdEx := len(m.RoleAuditLog) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleAuditLog[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.RoleGrants) > 0 {
+		for iNdEx := len(m.RoleGrants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleGrants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -144,6 +193,18 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.RoleGrants) > 0 {
+		for _, e := range m.RoleGrants {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RoleAuditLog) > 0 {
+		for _, e := range m.RoleAuditLog {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -215,6 +276,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleGrants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleGrants = append(m.RoleGrants, RoleGrant{})
+			if err := m.RoleGrants[len(m.RoleGrants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleAuditLog", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleAuditLog = append(m.RoleAuditLog, RoleAuditEntry{})
+			if err := m.RoleAuditLog[len(m.RoleAuditLog)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -24,6 +24,41 @@ func TestGenesisState_Validate(t *testing.T) {
 			genState: &types.GenesisState{},
 			valid:    true,
 		},
+		{
+			desc: "valid role grants",
+			genState: &types.GenesisState{
+				RoleGrants: []types.RoleGrant{
+					{Role: types.ROLE_ORACLE, Address: "a", Scope: "task/1"},
+					{Role: types.ROLE_ORACLE, Address: "a"},
+				},
+				RoleAuditLog: []types.RoleAuditEntry{{Id: 0}, {Id: 1}},
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicated role grant",
+			genState: &types.GenesisState{
+				RoleGrants: []types.RoleGrant{
+					{Role: types.ROLE_ORACLE, Address: "a"},
+					{Role: types.ROLE_ORACLE, Address: "a"},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unspecified role",
+			genState: &types.GenesisState{
+				RoleGrants: []types.RoleGrant{{Address: "a"}},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicated audit entry id",
+			genState: &types.GenesisState{
+				RoleAuditLog: []types.RoleAuditEntry{{Id: 3}, {Id: 3}},
+			},
+			valid: false,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
@@ -17,3 +17,12 @@ const (
 
 // ParamsKey is the prefix to retrieve all Params
 var ParamsKey = collections.NewPrefix("p_dtc")
+
+// RoleGrantKey is the prefix to retrieve role grants by (role, address, scope)
+var RoleGrantKey = collections.NewPrefix("roleGrant/value/")
+
+// RoleAuditLogKey is the prefix to retrieve role audit entries by id
+var RoleAuditLogKey = collections.NewPrefix("roleAuditLog/value/")
+
+// RoleAuditSeqKey is the key of the next role audit entry id
+var RoleAuditSeqKey = collections.NewPrefix("roleAuditLog/seq/")
@@ -6,7 +6,7 @@ package types
 import (
 	context "context"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
@@ -114,34 +114,382 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// QueryHasRoleRequest defines the QueryHasRoleRequest message.
+type QueryHasRoleRequest struct {
+	Role    Role   `protobuf:"varint,1,opt,name=role,proto3,enum=dtc.dtc.v1.Role" json:"role,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Scope   string `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+}
+
+func (m *QueryHasRoleRequest) Reset()         { *m = QueryHasRoleRequest{} }
+func (m *QueryHasRoleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryHasRoleRequest) ProtoMessage()    {}
+func (*QueryHasRoleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a40eaea7692f4c, []int{2}
+}
+func (m *QueryHasRoleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHasRoleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHasRoleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHasRoleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHasRoleRequest.Merge(m, src)
+}
+func (m *QueryHasRoleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHasRoleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHasRoleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHasRoleRequest proto.InternalMessageInfo
+
+func (m *QueryHasRoleRequest) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return ROLE_UNSPECIFIED
+}
+
+func (m *QueryHasRoleRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *QueryHasRoleRequest) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
+// QueryHasRoleResponse defines the QueryHasRoleResponse message.
+type QueryHasRoleResponse struct {
+	HasRole bool `protobuf:"varint,1,opt,name=has_role,json=hasRole,proto3" json:"has_role,omitempty"`
+	// grant is the active grant that confers the role, scoped or protocol-wide.
+	Grant *RoleGrant `protobuf:"bytes,2,opt,name=grant,proto3" json:"grant,omitempty"`
+}
+
+func (m *QueryHasRoleResponse) Reset()         { *m = QueryHasRoleResponse{} }
+func (m *QueryHasRoleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryHasRoleResponse) ProtoMessage()    {}
+func (*QueryHasRoleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a40eaea7692f4c, []int{3}
+}
+func (m *QueryHasRoleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryHasRoleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryHasRoleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryHasRoleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryHasRoleResponse.Merge(m, src)
+}
+func (m *QueryHasRoleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryHasRoleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryHasRoleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryHasRoleResponse proto.InternalMessageInfo
+
+func (m *QueryHasRoleResponse) GetHasRole() bool {
+	if m != nil {
+		return m.HasRole
+	}
+	return false
+}
+
+func (m *QueryHasRoleResponse) GetGrant() *RoleGrant {
+	if m != nil {
+		return m.Grant
+	}
+	return nil
+}
+
+// QueryListRoleGrantsRequest defines the QueryListRoleGrantsRequest message.
+type QueryListRoleGrantsRequest struct {
+	// role filters the grants; ROLE_UNSPECIFIED lists all roles.
+	Role       Role               `protobuf:"varint,1,opt,name=role,proto3,enum=dtc.dtc.v1.Role" json:"role,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListRoleGrantsRequest) Reset()         { *m = QueryListRoleGrantsRequest{} }
+func (m *QueryListRoleGrantsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListRoleGrantsRequest) ProtoMessage()    {}
+func (*QueryListRoleGrantsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a40eaea7692f4c, []int{4}
+}
+func (m *QueryListRoleGrantsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListRoleGrantsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListRoleGrantsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListRoleGrantsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListRoleGrantsRequest.Merge(m, src)
+}
+func (m *QueryListRoleGrantsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListRoleGrantsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListRoleGrantsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListRoleGrantsRequest proto.InternalMessageInfo
+
+func (m *QueryListRoleGrantsRequest) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return ROLE_UNSPECIFIED
+}
+
+func (m *QueryListRoleGrantsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryListRoleGrantsResponse defines the QueryListRoleGrantsResponse message.
+type QueryListRoleGrantsResponse struct {
+	Grants     []RoleGrant         `protobuf:"bytes,1,rep,name=grants,proto3" json:"grants"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListRoleGrantsResponse) Reset()         { *m = QueryListRoleGrantsResponse{} }
+func (m *QueryListRoleGrantsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListRoleGrantsResponse) ProtoMessage()    {}
+func (*QueryListRoleGrantsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a40eaea7692f4c, []int{5}
+}
+func (m *QueryListRoleGrantsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListRoleGrantsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListRoleGrantsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListRoleGrantsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListRoleGrantsResponse.Merge(m, src)
+}
+func (m *QueryListRoleGrantsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListRoleGrantsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListRoleGrantsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListRoleGrantsResponse proto.InternalMessageInfo
+
+func (m *QueryListRoleGrantsResponse) GetGrants() []RoleGrant {
+	if m != nil {
+		return m.Grants
+	}
+	return nil
+}
+
+func (m *QueryListRoleGrantsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRoleAuditLogRequest defines the QueryRoleAuditLogRequest message.
+type QueryRoleAuditLogRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRoleAuditLogRequest) Reset()         { *m = QueryRoleAuditLogRequest{} }
+func (m *QueryRoleAuditLogRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRoleAuditLogRequest) ProtoMessage()    {}
+func (*QueryRoleAuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a40eaea7692f4c, []int{6}
+}
+func (m *QueryRoleAuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoleAuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoleAuditLogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoleAuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoleAuditLogRequest.Merge(m, src)
+}
+func (m *QueryRoleAuditLogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoleAuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoleAuditLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoleAuditLogRequest proto.InternalMessageInfo
+
+func (m *QueryRoleAuditLogRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryRoleAuditLogResponse defines the QueryRoleAuditLogResponse message.
+type QueryRoleAuditLogResponse struct {
+	Entries    []RoleAuditEntry    `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRoleAuditLogResponse) Reset()         { *m = QueryRoleAuditLogResponse{} }
+func (m *QueryRoleAuditLogResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRoleAuditLogResponse) ProtoMessage()    {}
+func (*QueryRoleAuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f1a40eaea7692f4c, []int{7}
+}
+func (m *QueryRoleAuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRoleAuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRoleAuditLogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRoleAuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRoleAuditLogResponse.Merge(m, src)
+}
+func (m *QueryRoleAuditLogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRoleAuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRoleAuditLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRoleAuditLogResponse proto.InternalMessageInfo
+
+func (m *QueryRoleAuditLogResponse) GetEntries() []RoleAuditEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *QueryRoleAuditLogResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "dtc.dtc.v1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "dtc.dtc.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryHasRoleRequest)(nil), "dtc.dtc.v1.QueryHasRoleRequest")
+	proto.RegisterType((*QueryHasRoleResponse)(nil), "dtc.dtc.v1.QueryHasRoleResponse")
+	proto.RegisterType((*QueryListRoleGrantsRequest)(nil), "dtc.dtc.v1.QueryListRoleGrantsRequest")
+	proto.RegisterType((*QueryListRoleGrantsResponse)(nil), "dtc.dtc.v1.QueryListRoleGrantsResponse")
+	proto.RegisterType((*QueryRoleAuditLogRequest)(nil), "dtc.dtc.v1.QueryRoleAuditLogRequest")
+	proto.RegisterType((*QueryRoleAuditLogResponse)(nil), "dtc.dtc.v1.QueryRoleAuditLogResponse")
 }
 
 func init() { proto.RegisterFile("dtc/dtc/v1/query.proto", fileDescriptor_f1a40eaea7692f4c) }
 
 var fileDescriptor_f1a40eaea7692f4c = []byte{
-	// 294 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x12, 0x4b, 0x29, 0x49, 0xd6,
-	0x07, 0xe1, 0x32, 0x43, 0xfd, 0xc2, 0xd2, 0xd4, 0xa2, 0x4a, 0xbd, 0x82, 0xa2, 0xfc, 0x92, 0x7c,
-	0x21, 0xae, 0x94, 0x92, 0x64, 0x3d, 0x10, 0x2e, 0x33, 0x94, 0x12, 0x4c, 0xcc, 0xcd, 0xcc, 0xcb,
-	0xd7, 0x07, 0x93, 0x10, 0x69, 0x29, 0xad, 0xe4, 0xfc, 0xe2, 0xdc, 0xfc, 0x62, 0xfd, 0xa4, 0xc4,
-	0xe2, 0x54, 0x88, 0x3e, 0xfd, 0x32, 0xc3, 0xa4, 0xd4, 0x92, 0x44, 0x43, 0xfd, 0x82, 0xc4, 0xf4,
-	0xcc, 0xbc, 0xc4, 0x92, 0xcc, 0xfc, 0x3c, 0xa8, 0x5a, 0x71, 0x24, 0x2b, 0x0a, 0x12, 0x8b, 0x12,
-	0x73, 0x8b, 0xa1, 0x12, 0x22, 0xe9, 0xf9, 0xe9, 0xf9, 0x60, 0xa6, 0x3e, 0x88, 0x05, 0x15, 0x95,
-	0x49, 0xcf, 0xcf, 0x4f, 0xcf, 0x49, 0xd5, 0x4f, 0x2c, 0xc8, 0xd4, 0x4f, 0xcc, 0xcb, 0xcb, 0x2f,
-	0x01, 0x9b, 0x05, 0xd5, 0xa3, 0x24, 0xc2, 0x25, 0x14, 0x08, 0xb2, 0x2e, 0x00, 0x6c, 0x50, 0x50,
-	0x6a, 0x61, 0x69, 0x6a, 0x71, 0x89, 0x92, 0x0f, 0x97, 0x30, 0x8a, 0x68, 0x71, 0x41, 0x7e, 0x5e,
-	0x71, 0xaa, 0x90, 0x29, 0x17, 0x1b, 0xc4, 0x42, 0x09, 0x46, 0x05, 0x46, 0x0d, 0x6e, 0x23, 0x21,
-	0x3d, 0x84, 0xaf, 0xf4, 0x20, 0x6a, 0x9d, 0x38, 0x4f, 0xdc, 0x93, 0x67, 0x58, 0xf1, 0x7c, 0x83,
-	0x16, 0x63, 0x10, 0x54, 0xb1, 0x51, 0x1e, 0x17, 0x2b, 0xd8, 0x34, 0xa1, 0x54, 0x2e, 0x36, 0x88,
-	0x2a, 0x21, 0x39, 0x64, 0x9d, 0x98, 0x0e, 0x90, 0x92, 0xc7, 0x29, 0x0f, 0x71, 0x8a, 0x92, 0x54,
-	0xd3, 0xe5, 0x27, 0x93, 0x99, 0x44, 0x84, 0x84, 0xf4, 0x31, 0x42, 0xc3, 0x49, 0xf3, 0xc4, 0x23,
-	0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e, 0xe1, 0xc2,
-	0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xf8, 0x41, 0x0a, 0x2b, 0xc0, 0xca, 0x4b, 0x2a,
-	0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xa1, 0x60, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x4d, 0x36,
-	0xf3, 0x60, 0xb7, 0x01, 0x00, 0x00,
+	// 643 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x54, 0xcf, 0x4f, 0xd4, 0x40,
+	0x14, 0xde, 0xe1, 0xc7, 0x2e, 0x3c, 0x0c, 0xea, 0xb8, 0xc8, 0x52, 0x49, 0x21, 0x0d, 0xbf, 0xc4,
+	0xd8, 0xc9, 0x2e, 0xf1, 0xe2, 0x4d, 0x12, 0xc5, 0x03, 0x07, 0xec, 0xd1, 0x83, 0x64, 0x76, 0x77,
+	0x52, 0x1a, 0x97, 0x4e, 0xe9, 0x0c, 0x44, 0x42, 0x88, 0x09, 0x47, 0x4f, 0x26, 0x1e, 0xbd, 0x78,
+	0xf4, 0xe8, 0x9f, 0xc1, 0x91, 0xc4, 0x8b, 0x27, 0x63, 0xc0, 0xc4, 0xb3, 0xff, 0x81, 0x99, 0x1f,
+	0x85, 0x96, 0x2e, 0x21, 0x1a, 0x0f, 0x6d, 0xda, 0x79, 0xdf, 0x7b, 0xdf, 0x37, 0x6f, 0xbe, 0x37,
+	0x70, 0xb7, 0x2b, 0x3b, 0x44, 0x3d, 0x7b, 0x4d, 0xb2, 0xb3, 0xcb, 0xd2, 0x7d, 0x3f, 0x49, 0xb9,
+	0xe4, 0x18, 0xba, 0xb2, 0xe3, 0xab, 0x67, 0xaf, 0xe9, 0xdc, 0xa6, 0xdb, 0x51, 0xcc, 0x89, 0x7e,
+	0x9b, 0xb0, 0xb3, 0xdc, 0xe1, 0x62, 0x9b, 0x0b, 0xd2, 0xa6, 0x82, 0x99, 0x3c, 0xb2, 0xd7, 0x6c,
+	0x33, 0x49, 0x9b, 0x24, 0xa1, 0x61, 0x14, 0x53, 0x19, 0xf1, 0xd8, 0x62, 0x27, 0x73, 0x14, 0x09,
+	0x4d, 0xe9, 0xb6, 0xb0, 0x81, 0x89, 0x5c, 0x20, 0xe5, 0x3d, 0x66, 0x97, 0xeb, 0x21, 0x0f, 0xb9,
+	0xfe, 0x24, 0xea, 0xcb, 0xae, 0x4e, 0x87, 0x9c, 0x87, 0x3d, 0x46, 0x68, 0x12, 0x11, 0x1a, 0xc7,
+	0x5c, 0x6a, 0x0a, 0x5b, 0xca, 0xab, 0x03, 0x7e, 0xa1, 0x54, 0x6c, 0xe8, 0xfa, 0x01, 0xdb, 0xd9,
+	0x65, 0x42, 0x7a, 0xeb, 0x70, 0xa7, 0xb0, 0x2a, 0x12, 0x1e, 0x0b, 0x86, 0x1f, 0x41, 0xd5, 0xe8,
+	0x68, 0xa0, 0x59, 0xb4, 0x34, 0xd6, 0xc2, 0xfe, 0xc5, 0x66, 0x7d, 0x83, 0x5d, 0x1d, 0x3d, 0xfe,
+	0x3e, 0x53, 0xf9, 0xfc, 0xeb, 0xcb, 0x32, 0x0a, 0x2c, 0xd8, 0x7b, 0x6d, 0xab, 0x3d, 0xa7, 0x22,
+	0xe0, 0x3d, 0x66, 0x49, 0xf0, 0x1c, 0x0c, 0x29, 0xf1, 0xba, 0xd6, 0x78, 0xeb, 0x56, 0xbe, 0x96,
+	0x86, 0xe9, 0x28, 0x6e, 0x40, 0x8d, 0x76, 0xbb, 0x29, 0x13, 0xa2, 0x31, 0x30, 0x8b, 0x96, 0x46,
+	0x83, 0xec, 0x17, 0xd7, 0x61, 0x58, 0x74, 0x78, 0xc2, 0x1a, 0x83, 0x7a, 0xdd, 0xfc, 0x78, 0xaf,
+	0xa0, 0x5e, 0x24, 0xb3, 0xda, 0xa7, 0x60, 0x64, 0x8b, 0x8a, 0xcd, 0x73, 0xc6, 0x91, 0xa0, 0xb6,
+	0x65, 0x20, 0xf8, 0x01, 0x0c, 0x87, 0x29, 0x8d, 0xa5, 0x26, 0x18, 0x6b, 0x4d, 0x5c, 0x56, 0xb2,
+	0xa6, 0x82, 0x81, 0xc1, 0x78, 0xef, 0x10, 0x38, 0x9a, 0x60, 0x3d, 0x12, 0xf2, 0x3c, 0x2a, 0xfe,
+	0x6e, 0x53, 0xcf, 0x00, 0x2e, 0x4e, 0xdb, 0xd2, 0x2e, 0xf8, 0xc6, 0x1a, 0xbe, 0xb2, 0x86, 0x6f,
+	0x2c, 0x65, 0xad, 0xe1, 0x6f, 0xd0, 0x30, 0x6b, 0x5b, 0x90, 0xcb, 0xf4, 0x3e, 0x22, 0xb8, 0xd7,
+	0x57, 0x8c, 0xdd, 0xf4, 0x0a, 0x54, 0xb5, 0x6a, 0x75, 0x60, 0x83, 0x57, 0x6e, 0x6d, 0x75, 0x48,
+	0x9d, 0x59, 0x60, 0xa1, 0x78, 0xad, 0x8f, 0xb8, 0xc5, 0x6b, 0xc5, 0x19, 0xc6, 0x82, 0xba, 0x36,
+	0x34, 0xb4, 0x38, 0x45, 0xf4, 0x64, 0xb7, 0x1b, 0xc9, 0x75, 0x1e, 0x66, 0x7d, 0x2a, 0x76, 0x00,
+	0xfd, 0x73, 0x07, 0x3e, 0x21, 0x98, 0xea, 0x43, 0x62, 0xf7, 0xff, 0x18, 0x6a, 0x2c, 0x96, 0x69,
+	0xc4, 0xb2, 0x06, 0x38, 0x97, 0x1b, 0xa0, 0x53, 0x9e, 0xc6, 0x32, 0xdd, 0xb7, 0x5d, 0xc8, 0x12,
+	0xfe, 0x5b, 0x1b, 0x5a, 0xbf, 0x07, 0x61, 0x58, 0x4b, 0xc4, 0x0c, 0xaa, 0x66, 0x4a, 0xb0, 0x9b,
+	0xd7, 0x51, 0x1e, 0x40, 0x67, 0xe6, 0xca, 0xb8, 0x21, 0xf0, 0x9c, 0xa3, 0xaf, 0x3f, 0x3f, 0x0c,
+	0xd4, 0x31, 0x26, 0xa5, 0x4b, 0x02, 0x1f, 0x40, 0xcd, 0xba, 0x1f, 0x97, 0xeb, 0x14, 0x87, 0xd0,
+	0x99, 0xbd, 0x1a, 0x60, 0x99, 0x1e, 0x6a, 0xa6, 0x45, 0x3c, 0x9f, 0x67, 0xca, 0x46, 0x89, 0x1c,
+	0xa8, 0xf7, 0x21, 0x39, 0xb0, 0x43, 0x79, 0x88, 0x8f, 0x10, 0x8c, 0x17, 0xdd, 0x88, 0x17, 0x4a,
+	0x1c, 0x7d, 0x67, 0xc7, 0x59, 0xbc, 0x16, 0x67, 0x25, 0xcd, 0x68, 0x49, 0x53, 0x78, 0x92, 0x5c,
+	0xba, 0x08, 0x37, 0xad, 0x85, 0xdf, 0xc2, 0x8d, 0xbc, 0x1f, 0xf0, 0x5c, 0xa9, 0x72, 0x1f, 0x4f,
+	0x3a, 0xf3, 0xd7, 0xa0, 0x2c, 0xbb, 0xa7, 0xd9, 0xa7, 0xb1, 0x53, 0x62, 0xa7, 0x0a, 0xba, 0xd9,
+	0xe3, 0xe1, 0xea, 0xfd, 0xe3, 0x53, 0x17, 0x9d, 0x9c, 0xba, 0xe8, 0xc7, 0xa9, 0x8b, 0xde, 0x9f,
+	0xb9, 0x95, 0x93, 0x33, 0xb7, 0xf2, 0xed, 0xcc, 0xad, 0xbc, 0xbc, 0xa9, 0x12, 0xde, 0xe8, 0x34,
+	0xb9, 0x9f, 0x30, 0xd1, 0xae, 0xea, 0x8b, 0x78, 0xe5, 0xcf, 0x00, 0x86, 0x43, 0xf2, 0xb5, 0x51,
+	0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -158,6 +506,12 @@ const _ = grpc.SupportPackageIsVersion4
 type QueryClient interface {
 	// Parameters queries the parameters of the module.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// HasRole queries whether an address currently holds a role within a scope.
+	HasRole(ctx context.Context, in *QueryHasRoleRequest, opts ...grpc.CallOption) (*QueryHasRoleResponse, error)
+	// ListRoleGrants queries role grants, optionally filtered by role.
+	ListRoleGrants(ctx context.Context, in *QueryListRoleGrantsRequest, opts ...grpc.CallOption) (*QueryListRoleGrantsResponse, error)
+	// RoleAuditLog queries the append-only log of role changes.
+	RoleAuditLog(ctx context.Context, in *QueryRoleAuditLogRequest, opts ...grpc.CallOption) (*QueryRoleAuditLogResponse, error)
 }
 
 type queryClient struct {
@@ -177,10 +531,43 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) HasRole(ctx context.Context, in *QueryHasRoleRequest, opts ...grpc.CallOption) (*QueryHasRoleResponse, error) {
+	out := new(QueryHasRoleResponse)
+	err := c.cc.Invoke(ctx, "/dtc.dtc.v1.Query/HasRole", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListRoleGrants(ctx context.Context, in *QueryListRoleGrantsRequest, opts ...grpc.CallOption) (*QueryListRoleGrantsResponse, error) {
+	out := new(QueryListRoleGrantsResponse)
+	err := c.cc.Invoke(ctx, "/dtc.dtc.v1.Query/ListRoleGrants", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RoleAuditLog(ctx context.Context, in *QueryRoleAuditLogRequest, opts ...grpc.CallOption) (*QueryRoleAuditLogResponse, error) {
+	out := new(QueryRoleAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/dtc.dtc.v1.Query/RoleAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Parameters queries the parameters of the module.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// HasRole queries whether an address currently holds a role within a scope.
+	HasRole(context.Context, *QueryHasRoleRequest) (*QueryHasRoleResponse, error)
+	// ListRoleGrants queries role grants, optionally filtered by role.
+	ListRoleGrants(context.Context, *QueryListRoleGrantsRequest) (*QueryListRoleGrantsResponse, error)
+	// RoleAuditLog queries the append-only log of role changes.
+	RoleAuditLog(context.Context, *QueryRoleAuditLogRequest) (*QueryRoleAuditLogResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -190,6 +577,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) HasRole(ctx context.Context, req *QueryHasRoleRequest) (*QueryHasRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method HasRole not implemented")
+}
+func (*UnimplementedQueryServer) ListRoleGrants(ctx context.Context, req *QueryListRoleGrantsRequest) (*QueryListRoleGrantsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRoleGrants not implemented")
+}
+func (*UnimplementedQueryServer) RoleAuditLog(ctx context.Context, req *QueryRoleAuditLogRequest) (*QueryRoleAuditLogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RoleAuditLog not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -213,6 +609,60 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_HasRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryHasRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).HasRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.dtc.v1.Query/HasRole",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).HasRole(ctx, req.(*QueryHasRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListRoleGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListRoleGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListRoleGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.dtc.v1.Query/ListRoleGrants",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListRoleGrants(ctx, req.(*QueryListRoleGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RoleAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRoleAuditLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RoleAuditLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.dtc.v1.Query/RoleAuditLog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RoleAuditLog(ctx, req.(*QueryRoleAuditLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var Query_serviceDesc = _Query_serviceDesc
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "dtc.dtc.v1.Query",
@@ -222,6 +672,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "HasRole",
+			Handler:    _Query_HasRole_Handler,
+		},
+		{
+			MethodName: "ListRoleGrants",
+			Handler:    _Query_ListRoleGrants_Handler,
+		},
+		{
+			MethodName: "RoleAuditLog",
+			Handler:    _Query_RoleAuditLog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dtc/dtc/v1/query.proto",
@@ -283,15 +745,275 @@ func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
+func (m *QueryHasRoleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHasRoleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHasRoleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Scope) > 0 {
+		i -= len(m.Scope)
+		copy(dAtA[i:], m.Scope)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Scope)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryHasRoleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryHasRoleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryHasRoleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Grant != nil {
+		{
+			size, err := m.Grant.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.HasRole {
+		i--
+		if m.HasRole {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListRoleGrantsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListRoleGrantsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListRoleGrantsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListRoleGrantsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListRoleGrantsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListRoleGrantsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Grants) > 0 {
+		for iNdEx := len(m.Grants) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Grants[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoleAuditLogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoleAuditLogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoleAuditLogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRoleAuditLogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRoleAuditLogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRoleAuditLogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
 	return base
 }
 func (m *QueryParamsRequest) Size() (n int) {
@@ -314,6 +1036,109 @@ func (m *QueryParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryHasRoleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovQuery(uint64(m.Role))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Scope)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryHasRoleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.HasRole {
+		n += 2
+	}
+	if m.Grant != nil {
+		l = m.Grant.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListRoleGrantsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovQuery(uint64(m.Role))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListRoleGrantsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Grants) > 0 {
+		for _, e := range m.Grants {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoleAuditLogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRoleAuditLogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -453,6 +1278,676 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryHasRoleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHasRoleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHasRoleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryHasRoleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryHasRoleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryHasRoleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HasRole", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.HasRole = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grant", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Grant == nil {
+				m.Grant = &RoleGrant{}
+			}
+			if err := m.Grant.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListRoleGrantsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListRoleGrantsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListRoleGrantsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListRoleGrantsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListRoleGrantsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListRoleGrantsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Grants", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Grants = append(m.Grants, RoleGrant{})
+			if err := m.Grants[len(m.Grants)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoleAuditLogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoleAuditLogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoleAuditLogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRoleAuditLogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRoleAuditLogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRoleAuditLogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, RoleAuditEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,37 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// MaxRoleScopeLength 是角色范围字符串的最大长度
+const MaxRoleScopeLength = 128
+
+// Validate 校验角色是已定义的非空角色
+func (r Role) Validate() error {
+	if r == ROLE_UNSPECIFIED {
+		return fmt.Errorf("role must be specified")
+	}
+	if _, ok := Role_name[int32(r)]; !ok {
+		return fmt.Errorf("unknown role %d", r)
+	}
+	return nil
+}
+
+// ValidateRoleScope 校验角色范围，空字符串表示协议全局
+func ValidateRoleScope(scope string) error {
+	if len(scope) > MaxRoleScopeLength {
+		return fmt.Errorf("scope exceeds %d characters", MaxRoleScopeLength)
+	}
+	if strings.IndexFunc(scope, unicode.IsSpace) >= 0 {
+		return fmt.Errorf("scope must not contain whitespace")
+	}
+	return nil
+}
+
+// IsActive 判断授权在给定高度是否仍然有效
+func (g RoleGrant) IsActive(height int64) bool {
+	return g.ExpiryHeight == 0 || height < g.ExpiryHeight
+}
@@ -0,0 +1,1030 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dtc/dtc/v1/role.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Role 是协议级特权角色，由治理授予和撤销
+type Role int32
+
+const (
+	// ROLE_UNSPECIFIED 未定义
+	ROLE_UNSPECIFIED Role = 0
+	// ROLE_ATTESTOR 签发身份认证
+	ROLE_ATTESTOR Role = 1
+	// ROLE_ORACLE 为任务奖励提供链下证明
+	ROLE_ORACLE Role = 2
+	// ROLE_DEATH_REGISTRAR 登记死亡证明
+	ROLE_DEATH_REGISTRAR Role = 3
+	// ROLE_TREASURER 管理协议资金
+	ROLE_TREASURER Role = 4
+	// ROLE_PAUSER 暂停协议功能
+	ROLE_PAUSER Role = 5
+	// ROLE_CLAIM_REVOKER 撤销任务领取记录
+	ROLE_CLAIM_REVOKER Role = 6
+	// ROLE_TASK_ARBITRATOR 在争议期内对任务领取提出争议
+	ROLE_TASK_ARBITRATOR Role = 7
+)
+
+var Role_name = map[int32]string{
+	0: "ROLE_UNSPECIFIED",
+	1: "ROLE_ATTESTOR",
+	2: "ROLE_ORACLE",
+	3: "ROLE_DEATH_REGISTRAR",
+	4: "ROLE_TREASURER",
+	5: "ROLE_PAUSER",
+	6: "ROLE_CLAIM_REVOKER",
+	7: "ROLE_TASK_ARBITRATOR",
+}
+
+var Role_value = map[string]int32{
+	"ROLE_UNSPECIFIED":     0,
+	"ROLE_ATTESTOR":        1,
+	"ROLE_ORACLE":          2,
+	"ROLE_DEATH_REGISTRAR": 3,
+	"ROLE_TREASURER":       4,
+	"ROLE_PAUSER":          5,
+	"ROLE_CLAIM_REVOKER":   6,
+	"ROLE_TASK_ARBITRATOR": 7,
+}
+
+func (x Role) String() string {
+	return proto.EnumName(Role_name, int32(x))
+}
+
+func (Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a15c25f4c2b49f8a, []int{0}
+}
+
+// RoleAction 是审计日志中记录的角色变更类型
+type RoleAction int32
+
+const (
+	// ROLE_ACTION_UNSPECIFIED 未定义
+	ROLE_ACTION_UNSPECIFIED RoleAction = 0
+	// ROLE_ACTION_GRANT 授予或更新角色
+	ROLE_ACTION_GRANT RoleAction = 1
+	// ROLE_ACTION_REVOKE 撤销角色
+	ROLE_ACTION_REVOKE RoleAction = 2
+)
+
+var RoleAction_name = map[int32]string{
+	0: "ROLE_ACTION_UNSPECIFIED",
+	1: "ROLE_ACTION_GRANT",
+	2: "ROLE_ACTION_REVOKE",
+}
+
+var RoleAction_value = map[string]int32{
+	"ROLE_ACTION_UNSPECIFIED": 0,
+	"ROLE_ACTION_GRANT":       1,
+	"ROLE_ACTION_REVOKE":      2,
+}
+
+func (x RoleAction) String() string {
+	return proto.EnumName(RoleAction_name, int32(x))
+}
+
+func (RoleAction) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a15c25f4c2b49f8a, []int{1}
+}
+
+// RoleGrant 是某地址在某范围内持有的角色
+type RoleGrant struct {
+	Role    Role   `protobuf:"varint,1,opt,name=role,proto3,enum=dtc.dtc.v1.Role" json:"role,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// scope 限定角色的适用范围（如 "task/7"），为空表示协议全局
+	Scope         string `protobuf:"bytes,3,opt,name=scope,proto3" json:"scope,omitempty"`
+	GrantedHeight int64  `protobuf:"varint,4,opt,name=granted_height,json=grantedHeight,proto3" json:"granted_height,omitempty"`
+	// expiry_height 之后角色失效，0 表示永不过期
+	ExpiryHeight int64 `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *RoleGrant) Reset()         { *m = RoleGrant{} }
+func (m *RoleGrant) String() string { return proto.CompactTextString(m) }
+func (*RoleGrant) ProtoMessage()    {}
+func (*RoleGrant) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a15c25f4c2b49f8a, []int{0}
+}
+func (m *RoleGrant) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleGrant) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleGrant.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleGrant) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleGrant.Merge(m, src)
+}
+func (m *RoleGrant) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleGrant) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleGrant.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleGrant proto.InternalMessageInfo
+
+func (m *RoleGrant) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return ROLE_UNSPECIFIED
+}
+
+func (m *RoleGrant) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RoleGrant) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
+func (m *RoleGrant) GetGrantedHeight() int64 {
+	if m != nil {
+		return m.GrantedHeight
+	}
+	return 0
+}
+
+func (m *RoleGrant) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+// RoleAuditEntry 是一条角色变更记录，只追加不修改
+type RoleAuditEntry struct {
+	Id           uint64     `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Action       RoleAction `protobuf:"varint,2,opt,name=action,proto3,enum=dtc.dtc.v1.RoleAction" json:"action,omitempty"`
+	Role         Role       `protobuf:"varint,3,opt,name=role,proto3,enum=dtc.dtc.v1.Role" json:"role,omitempty"`
+	Address      string     `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	Scope        string     `protobuf:"bytes,5,opt,name=scope,proto3" json:"scope,omitempty"`
+	ExpiryHeight int64      `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	Reason       string     `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	Height       int64      `protobuf:"varint,8,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *RoleAuditEntry) Reset()         { *m = RoleAuditEntry{} }
+func (m *RoleAuditEntry) String() string { return proto.CompactTextString(m) }
+func (*RoleAuditEntry) ProtoMessage()    {}
+func (*RoleAuditEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a15c25f4c2b49f8a, []int{1}
+}
+func (m *RoleAuditEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleAuditEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleAuditEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleAuditEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleAuditEntry.Merge(m, src)
+}
+func (m *RoleAuditEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleAuditEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleAuditEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleAuditEntry proto.InternalMessageInfo
+
+func (m *RoleAuditEntry) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *RoleAuditEntry) GetAction() RoleAction {
+	if m != nil {
+		return m.Action
+	}
+	return ROLE_ACTION_UNSPECIFIED
+}
+
+func (m *RoleAuditEntry) GetRole() Role {
+	if m != nil {
+		return m.Role
+	}
+	return ROLE_UNSPECIFIED
+}
+
+func (m *RoleAuditEntry) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RoleAuditEntry) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
+func (m *RoleAuditEntry) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *RoleAuditEntry) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *RoleAuditEntry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("dtc.dtc.v1.Role", Role_name, Role_value)
+	proto.RegisterEnum("dtc.dtc.v1.RoleAction", RoleAction_name, RoleAction_value)
+	proto.RegisterType((*RoleGrant)(nil), "dtc.dtc.v1.RoleGrant")
+	proto.RegisterType((*RoleAuditEntry)(nil), "dtc.dtc.v1.RoleAuditEntry")
+}
+
+func init() { proto.RegisterFile("dtc/dtc/v1/role.proto", fileDescriptor_a15c25f4c2b49f8a) }
+
+var fileDescriptor_a15c25f4c2b49f8a = []byte{
+	// 500 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xc1, 0x6e, 0xda, 0x4e,
+	0x10, 0xc6, 0x59, 0x30, 0xf0, 0xcf, 0xfc, 0x8b, 0xb3, 0x59, 0x11, 0x6a, 0xa5, 0x92, 0x85, 0xd2,
+	0x56, 0xa2, 0x39, 0x80, 0x92, 0x3e, 0xc1, 0x86, 0x6c, 0x89, 0x15, 0x8a, 0xa3, 0xf1, 0xd2, 0x43,
+	0x0f, 0xb5, 0x28, 0xb6, 0x88, 0xa5, 0x08, 0x23, 0xdb, 0x8d, 0xc2, 0x1b, 0xf4, 0xd8, 0x77, 0xe8,
+	0xb9, 0xe7, 0xbe, 0x42, 0x8f, 0x39, 0xf6, 0x58, 0xc1, 0x63, 0xf4, 0x52, 0xed, 0xda, 0x21, 0x51,
+	0xa2, 0x4a, 0x3d, 0xac, 0xc4, 0xfc, 0x66, 0xbe, 0xd9, 0xef, 0x43, 0x5e, 0xd8, 0x0d, 0xb2, 0x69,
+	0x4f, 0x9d, 0xab, 0xc3, 0x5e, 0x12, 0x5f, 0x86, 0xdd, 0x45, 0x12, 0x67, 0x31, 0x83, 0x20, 0x9b,
+	0x76, 0xd5, 0xb9, 0x3a, 0xdc, 0x6b, 0xce, 0xe2, 0x59, 0xac, 0x71, 0x4f, 0xfd, 0xca, 0x27, 0xf6,
+	0xbf, 0x11, 0xd8, 0xc2, 0xf8, 0x32, 0x1c, 0x24, 0x93, 0x79, 0xc6, 0x5e, 0x80, 0xa1, 0xd4, 0x16,
+	0x69, 0x93, 0x8e, 0x79, 0x44, 0xbb, 0x77, 0xf2, 0xae, 0x1a, 0x42, 0xdd, 0x65, 0x16, 0xd4, 0x27,
+	0x41, 0x90, 0x84, 0x69, 0x6a, 0x95, 0xdb, 0xa4, 0xb3, 0x85, 0xb7, 0x25, 0x6b, 0x42, 0x35, 0x9d,
+	0xc6, 0x8b, 0xd0, 0xaa, 0x68, 0x9e, 0x17, 0xec, 0x25, 0x98, 0x33, 0xb5, 0x3e, 0x0c, 0xfc, 0x8b,
+	0x30, 0x9a, 0x5d, 0x64, 0x96, 0xd1, 0x26, 0x9d, 0x0a, 0x36, 0x0a, 0x7a, 0xaa, 0x21, 0x7b, 0x0e,
+	0x8d, 0xf0, 0x7a, 0x11, 0x25, 0xcb, 0xdb, 0xa9, 0xaa, 0x9e, 0x7a, 0x92, 0xc3, 0x7c, 0x68, 0xff,
+	0x37, 0x01, 0x53, 0x59, 0xe1, 0x9f, 0x82, 0x28, 0x13, 0xf3, 0x2c, 0x59, 0x32, 0x13, 0xca, 0x51,
+	0xa0, 0x2d, 0x1b, 0x58, 0x8e, 0x02, 0xd6, 0x85, 0xda, 0x64, 0x9a, 0x45, 0xf1, 0x5c, 0xbb, 0x33,
+	0x8f, 0x5a, 0x0f, 0x63, 0x70, 0xdd, 0xc5, 0x62, 0x6a, 0x13, 0xba, 0xf2, 0xaf, 0xa1, 0x8d, 0xbf,
+	0x84, 0xae, 0xde, 0x0f, 0xfd, 0x28, 0x4d, 0xed, 0x71, 0x1a, 0xd6, 0x82, 0x5a, 0x12, 0x4e, 0xd2,
+	0x78, 0x6e, 0xd5, 0xb5, 0xb6, 0xa8, 0x14, 0x2f, 0x54, 0xff, 0x69, 0x55, 0x51, 0x1d, 0x7c, 0x27,
+	0x60, 0x28, 0x4f, 0xac, 0x09, 0x14, 0xdd, 0xa1, 0xf0, 0xc7, 0x23, 0xef, 0x5c, 0xf4, 0x9d, 0x37,
+	0x8e, 0x38, 0xa1, 0x25, 0xb6, 0x03, 0x0d, 0x4d, 0xb9, 0x94, 0xc2, 0x93, 0x2e, 0x52, 0xc2, 0xb6,
+	0xe1, 0x7f, 0x8d, 0x5c, 0xe4, 0xfd, 0xa1, 0xa0, 0x65, 0x66, 0x41, 0x53, 0x83, 0x13, 0xc1, 0xe5,
+	0xa9, 0x8f, 0x62, 0xe0, 0x78, 0x12, 0x39, 0xd2, 0x0a, 0x63, 0x60, 0xea, 0x8e, 0x44, 0xc1, 0xbd,
+	0x31, 0x0a, 0xa4, 0xc6, 0x46, 0x7e, 0xce, 0xc7, 0x9e, 0x40, 0x5a, 0x65, 0x2d, 0x60, 0x1a, 0xf4,
+	0x87, 0xdc, 0x79, 0xeb, 0xa3, 0x78, 0xe7, 0x9e, 0x09, 0xa4, 0xb5, 0xcd, 0x5a, 0xc9, 0xbd, 0x33,
+	0x9f, 0xe3, 0xb1, 0x23, 0x91, 0x2b, 0x07, 0xf5, 0x3d, 0xe3, 0xf3, 0x57, 0xbb, 0x74, 0xf0, 0x01,
+	0xe0, 0xee, 0xaf, 0x67, 0xcf, 0xe0, 0x69, 0x6e, 0xb4, 0x2f, 0x1d, 0x77, 0xf4, 0x20, 0xc5, 0x2e,
+	0xec, 0xdc, 0x6f, 0x0e, 0x90, 0x8f, 0x24, 0x25, 0x9b, 0x9b, 0x0b, 0x9c, 0x5f, 0x4d, 0xcb, 0xf9,
+	0xfe, 0xe3, 0x57, 0x3f, 0x56, 0x36, 0xb9, 0x59, 0xd9, 0xe4, 0xd7, 0xca, 0x26, 0x5f, 0xd6, 0x76,
+	0xe9, 0x66, 0x6d, 0x97, 0x7e, 0xae, 0xed, 0xd2, 0xfb, 0x6d, 0xf5, 0x2c, 0xae, 0xf5, 0xe3, 0xc8,
+	0x96, 0x8b, 0x30, 0xfd, 0x58, 0xd3, 0x5f, 0xfe, 0xeb, 0x3f, 0x03, 0x00, 0x2d, 0x6c, 0x3a, 0x90,
+	0x34, 0x03, 0x00, 0x00,
+}
+
+func (m *RoleGrant) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleGrant) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleGrant) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintRole(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.GrantedHeight != 0 {
+		i = encodeVarintRole(dAtA, i, uint64(m.GrantedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Scope) > 0 {
+		i -= len(m.Scope)
+		copy(dAtA[i:], m.Scope)
+		i = encodeVarintRole(dAtA, i, uint64(len(m.Scope)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRole(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Role != 0 {
+		i = encodeVarintRole(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleAuditEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleAuditEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleAuditEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintRole(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintRole(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintRole(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.Scope) > 0 {
+		i -= len(m.Scope)
+		copy(dAtA[i:], m.Scope)
+		i = encodeVarintRole(dAtA, i, uint64(len(m.Scope)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintRole(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Role != 0 {
+		i = encodeVarintRole(dAtA, i, uint64(m.Role))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Action != 0 {
+		i = encodeVarintRole(dAtA, i, uint64(m.Action))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Id != 0 {
+		i = encodeVarintRole(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRole(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRole(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RoleGrant) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Role != 0 {
+		n += 1 + sovRole(uint64(m.Role))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRole(uint64(l))
+	}
+	l = len(m.Scope)
+	if l > 0 {
+		n += 1 + l + sovRole(uint64(l))
+	}
+	if m.GrantedHeight != 0 {
+		n += 1 + sovRole(uint64(m.GrantedHeight))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovRole(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *RoleAuditEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRole(uint64(m.Id))
+	}
+	if m.Action != 0 {
+		n += 1 + sovRole(uint64(m.Action))
+	}
+	if m.Role != 0 {
+		n += 1 + sovRole(uint64(m.Role))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovRole(uint64(l))
+	}
+	l = len(m.Scope)
+	if l > 0 {
+		n += 1 + l + sovRole(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovRole(uint64(m.ExpiryHeight))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovRole(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovRole(uint64(m.Height))
+	}
+	return n
+}
+
+func sovRole(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRole(x uint64) (n int) {
+	return sovRole(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RoleGrant) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRole
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleGrant: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleGrant: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GrantedHeight", wireType)
+			}
+			m.GrantedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GrantedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRole(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRole
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleAuditEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRole
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleAuditEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleAuditEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= RoleAction(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			m.Role = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Role |= Role(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRole
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRole
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRole(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRole
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRole(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRole
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRole
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRole
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRole
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRole
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRole        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRole          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRole = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dtc/x/identity/types"
+)
+
+// GetAttestationStatus 返回地址控制的 DID 及其人格证明状态。
+// 只有 nullifier 索引指向该 DID 时才视为唯一认证；迁移遗留的重复人脸记录返回 DUPLICATE。
+func (k Keeper) GetAttestationStatus(ctx sdk.Context, address string) (types.AttestationStatus, types.DidDocument, error) {
+	doc, found := k.GetDidDocument(ctx, address)
+	if !found {
+		return types.ATTESTATION_STATUS_UNREGISTERED, types.DidDocument{}, nil
+	}
+	return k.attestationStatus(ctx, doc)
+}
+
+// GetDidAttestationStatus 按 DID 返回 DID 文档及其人格证明状态，用于没有 controller 地址的被监护 DID
+func (k Keeper) GetDidAttestationStatus(ctx sdk.Context, did string) (types.AttestationStatus, types.DidDocument, error) {
+	doc, err := k.DidDocument.Get(ctx, did)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.ATTESTATION_STATUS_UNREGISTERED, types.DidDocument{}, nil
+		}
+		return types.ATTESTATION_STATUS_UNSPECIFIED, types.DidDocument{}, err
+	}
+	if doc.IsDeactivated() {
+		return types.ATTESTATION_STATUS_UNREGISTERED, types.DidDocument{}, nil
+	}
+	return k.attestationStatus(ctx, doc)
+}
+
+// attestationStatus 根据人脸 nullifier 索引判断 DID 的人格证明状态
+func (k Keeper) attestationStatus(ctx sdk.Context, doc types.DidDocument) (types.AttestationStatus, types.DidDocument, error) {
+	if doc.FaceNullifier == "" {
+		return types.ATTESTATION_STATUS_UNATTESTED, doc, nil
+	}
+
+	indexed, err := k.FaceNullifierToIndex.Get(ctx, doc.FaceNullifier)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.ATTESTATION_STATUS_UNSPECIFIED, doc, err
+	}
+	if indexed != doc.Did {
+		return types.ATTESTATION_STATUS_DUPLICATE, doc, nil
+	}
+
+	return types.ATTESTATION_STATUS_ATTESTED, doc, nil
+}
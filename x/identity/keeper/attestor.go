@@ -0,0 +1,215 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	secp256k1lib "github.com/ethereum/go-ethereum/crypto/secp256k1"
+
+	dtctypes "dtc/x/dtc/types"
+	"dtc/x/identity/types"
+)
+
+// validateAttestorPubkey 校验认证方签名公钥为 33 字节压缩 secp256k1 公钥
+func validateAttestorPubkey(pubkey string) error {
+	bz, err := hex.DecodeString(pubkey)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidAttestor, "invalid pubkey hex: %s", err)
+	}
+	if len(bz) != 33 {
+		return errorsmod.Wrap(types.ErrInvalidAttestor, "pubkey must be 33 bytes compressed secp256k1 key")
+	}
+	if x, _ := secp256k1lib.DecompressPubkey(bz); x == nil {
+		return errorsmod.Wrap(types.ErrInvalidAttestor, "pubkey is not on the secp256k1 curve")
+	}
+	return nil
+}
+
+// isActiveAttestor 判断认证方是否处于质押状态且保证金不低于当前下限
+func isActiveAttestor(attestor types.Attestor, params types.Params) bool {
+	return attestor.Status == types.ATTESTOR_STATUS_BONDED &&
+		attestor.Bond.Amount.GTE(math.NewIntFromUint64(params.AttestorMinBond))
+}
+
+// requireAttestorRole 校验 address 在角色注册表中持有认证方角色
+func (k Keeper) requireAttestorRole(ctx context.Context, address string) error {
+	if !k.roleKeeper.HasRole(ctx, dtctypes.ROLE_ATTESTOR, address, "") {
+		return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s does not hold the attestor role", address)
+	}
+	return nil
+}
+
+// verifyAttestorSignature 验证 data 的签名来自 pubkey 对应的已质押认证方，返回签名者公钥（hex）。
+// 认证方须持有认证方角色，解除质押中或保证金被罚没至下限以下的认证方签名不再被接受；
+// 不再接受管理员公钥签名，保证每个签发的 DID 都有可罚没的保证金
+func (k Keeper) verifyAttestorSignature(ctx context.Context, pubkey string, data []byte, signature []byte) (string, error) {
+	if pubkey == "" {
+		return "", errorsmod.Wrap(sdkerrors.ErrUnauthorized, "attestor pubkey is required")
+	}
+
+	address, err := k.AttestorByPubkey.Get(ctx, pubkey)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return "", errorsmod.Wrapf(types.ErrAttestorNotFound, "pubkey %s", pubkey)
+		}
+		return "", errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	attestor, err := k.Attestor.Get(ctx, address)
+	if err != nil {
+		return "", errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return "", errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load params: %s", err))
+	}
+	if !isActiveAttestor(attestor, params) {
+		return "", errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "attestor %s is not active", address)
+	}
+	if err := k.requireAttestorRole(ctx, address); err != nil {
+		return "", err
+	}
+	if err := verifySecp256k1Signature(attestor.Pubkey, data, signature); err != nil {
+		return "", err
+	}
+	return attestor.Pubkey, nil
+}
+
+// slashAttestor 按比例罚没认证方保证金并转入 GBDP 资金池，解除质押中的认证方同样会被罚没
+func (k Keeper) slashAttestor(ctx context.Context, address string, fraction math.LegacyDec, reason string) (sdk.Coin, error) {
+	attestor, err := k.Attestor.Get(ctx, address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.Coin{}, errorsmod.Wrap(types.ErrAttestorNotFound, address)
+		}
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	slashed := sdk.NewCoin(attestor.Bond.Denom, fraction.MulInt(attestor.Bond.Amount).TruncateInt())
+	if slashed.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, types.GBDPPoolModuleName, sdk.NewCoins(slashed)); err != nil {
+			return sdk.Coin{}, err
+		}
+		attestor.Bond = attestor.Bond.Sub(slashed)
+		if attestor.Slashed.Denom == "" {
+			attestor.Slashed = sdk.NewCoin(slashed.Denom, math.ZeroInt())
+		}
+		attestor.Slashed = attestor.Slashed.Add(slashed)
+		if err := k.Attestor.Set(ctx, address, attestor); err != nil {
+			return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+	}
+
+	// 保证金被罚没至下限以下的认证方强制进入解除质押期，剩余保证金在期满前仍可被罚没
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return sdk.Coin{}, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load params: %s", err))
+	}
+	if attestor.Status == types.ATTESTOR_STATUS_BONDED && !isActiveAttestor(attestor, params) {
+		if err := k.beginAttestorUnbonding(ctx, attestor, params); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAttestorSlashed,
+			sdk.NewAttribute(types.AttributeKeyAddress, address),
+			sdk.NewAttribute(types.AttributeKeyAttestor, attestor.Pubkey),
+			sdk.NewAttribute(types.AttributeKeyAmount, slashed.String()),
+			sdk.NewAttribute(types.AttributeKeyBond, attestor.Bond.String()),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+
+	return slashed, nil
+}
+
+// beginAttestorUnbonding 使认证方进入解除质押期并加入到期队列，期满后由 EndBlocker 退还剩余保证金
+func (k Keeper) beginAttestorUnbonding(ctx context.Context, attestor types.Attestor, params types.Params) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	attestor.Status = types.ATTESTOR_STATUS_UNBONDING
+	attestor.UnbondingCompletionHeight = sdkCtx.BlockHeight() + params.AttestorUnbondingPeriod
+	if err := k.Attestor.Set(ctx, attestor.Address, attestor); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.AttestorUnbondingQueue.Set(ctx, collections.Join(attestor.UnbondingCompletionHeight, attestor.Address)); err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAttestorUnbonding,
+			sdk.NewAttribute(types.AttributeKeyAddress, attestor.Address),
+			sdk.NewAttribute(types.AttributeKeyAttestor, attestor.Pubkey),
+			sdk.NewAttribute(types.AttributeKeyBond, attestor.Bond.String()),
+			sdk.NewAttribute(types.AttributeKeyCompletionHeight, strconv.FormatInt(attestor.UnbondingCompletionHeight, 10)),
+		),
+	)
+	return nil
+}
+
+// EndBlocker 退还解除质押期已满的认证方保证金，并将其移出注册表
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var matured []collections.Pair[int64, string]
+	// 队列按完成高度排序，截止到下一高度即包含当前高度的全部条目
+	rng := new(collections.Range[collections.Pair[int64, string]]).
+		EndExclusive(collections.Join(sdkCtx.BlockHeight()+1, ""))
+	if err := k.AttestorUnbondingQueue.Walk(ctx, rng, func(key collections.Pair[int64, string]) (bool, error) {
+		matured = append(matured, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range matured {
+		address := key.K2()
+		if err := k.AttestorUnbondingQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		attestor, err := k.Attestor.Get(ctx, address)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+		if attestor.Bond.IsPositive() {
+			addr, err := k.addressCodec.StringToBytes(address)
+			if err != nil {
+				return err
+			}
+			if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sdk.NewCoins(attestor.Bond)); err != nil {
+				return err
+			}
+		}
+		if err := k.Attestor.Remove(ctx, address); err != nil {
+			return err
+		}
+		if err := k.AttestorByPubkey.Remove(ctx, attestor.Pubkey); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeAttestorUnbonded,
+				sdk.NewAttribute(types.AttributeKeyAddress, address),
+				sdk.NewAttribute(types.AttributeKeyAttestor, attestor.Pubkey),
+				sdk.NewAttribute(types.AttributeKeyAmount, attestor.Bond.String()),
+				sdk.NewAttribute(types.AttributeKeyCompletionHeight, strconv.FormatInt(key.K1(), 10)),
+			),
+		)
+	}
+
+	return nil
+}
@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// HasActiveCredential 判断 DID 在当前高度是否持有指定类型的有效凭证。
+// 签发方已解除质押或保证金低于下限时，其签发的凭证不再被承认。
+// 供 task 模块的 IdentityKeeper 接口使用
+func (k Keeper) HasActiveCredential(ctx context.Context, did, credentialType string) bool {
+	credential, err := k.Credential.Get(ctx, collections.Join(did, credentialType))
+	if err != nil || !credential.IsActive(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+		return false
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return false
+	}
+	issuer, err := k.Attestor.Get(ctx, credential.Issuer)
+	if err != nil {
+		return false
+	}
+	return isActiveAttestor(issuer, params)
+}
@@ -0,0 +1,162 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/x/evidence/exported"
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dtc/x/identity/types"
+)
+
+// NewDuplicateIdentityEvidenceHandler 返回处理重复身份证据的 x/evidence Handler。
+// credit keeper 在 app 中注册路由时传入，避免 identity 与 credit 模块之间的循环依赖
+func NewDuplicateIdentityEvidenceHandler(k Keeper, creditKeeper types.CreditKeeper) evidencetypes.Handler {
+	return func(ctx context.Context, e exported.Evidence) error {
+		evidence, ok := e.(*types.DuplicateIdentityEvidence)
+		if !ok {
+			return errorsmod.Wrapf(types.ErrInvalidEvidence, "unexpected evidence type %T", e)
+		}
+		return k.HandleDuplicateIdentity(ctx, evidence, creditKeeper)
+	}
+}
+
+// HandleDuplicateIdentity 处理重复身份证据：停用两个 DID 中较新的一个并解除其以太坊地址绑定，
+// 将其信用负债合并到原 DID 的 controller（原 DID 没有 controller 时注销该负债），
+// 对签发较新 DID 的认证方记录一次违规，该认证方已质押时按参数比例罚没其保证金
+func (k Keeper) HandleDuplicateIdentity(ctx context.Context, evidence *types.DuplicateIdentityEvidence, creditKeeper types.CreditKeeper) error {
+	if err := evidence.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidEvidence, err.Error())
+	}
+	// 证据必须由已质押的认证方签名，避免任意账户停用他人 DID 并罚没认证方保证金
+	signBytes := types.DuplicateIdentitySignBytes(evidence.FirstDid, evidence.SecondDid, evidence.Height)
+	if _, err := k.verifyAttestorSignature(ctx, evidence.AttestorPubkey, signBytes, evidence.Signature); err != nil {
+		return err
+	}
+
+	first, err := k.getActivePersonDid(ctx, evidence.FirstDid)
+	if err != nil {
+		return err
+	}
+	second, err := k.getActivePersonDid(ctx, evidence.SecondDid)
+	if err != nil {
+		return err
+	}
+
+	// 较晚注册的 DID 视为重复；注册高度相同（如旧数据）时以证据中的第二个 DID 为重复
+	original, duplicate := first, second
+	if first.CreatedHeight > second.CreatedHeight {
+		original, duplicate = second, first
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	duplicate.DeactivatedHeight = sdkCtx.BlockHeight()
+	duplicate.DuplicateOf = original.Did
+	if err := k.DidDocument.Set(ctx, duplicate.Did, duplicate); err != nil {
+		return err
+	}
+	if err := k.removeControllerDid(ctx, duplicate.Controller, duplicate.Did); err != nil {
+		return err
+	}
+	if err := k.removeDidExternalAccounts(ctx, duplicate.Did); err != nil {
+		return err
+	}
+	if err := k.PendingControllerTransfer.Remove(ctx, duplicate.Did); err != nil {
+		return err
+	}
+	if _, err := k.releaseDidHandle(ctx, duplicate.Did); err != nil && !errors.Is(err, types.ErrHandleNotFound) {
+		return err
+	}
+
+	// 信用负债跟随自然人：合并到原 DID 的 controller；原 DID 为被监护 DID（无 controller）时注销
+	var (
+		liability uint64
+		action    string
+	)
+	switch {
+	case duplicate.Controller == "":
+	case original.Controller != "":
+		liability, err = creditKeeper.MergeLiability(ctx, duplicate.Controller, original.Controller)
+		action = types.AttributeValueLiabilityMerged
+	default:
+		liability, err = creditKeeper.ForfeitLiability(ctx, duplicate.Controller)
+		action = types.AttributeValueLiabilityForfeited
+	}
+	if err != nil {
+		return err
+	}
+
+	// 旧数据没有记录认证方，均由当前认证方签发
+	attestor := duplicate.Attestor
+	if attestor == "" {
+		if attestor, err = k.adminPubKeyHex(ctx); err != nil {
+			return err
+		}
+	}
+	strikes, err := k.AttestorStrikes.Get(ctx, attestor)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	strikes++
+	if err := k.AttestorStrikes.Set(ctx, attestor, strikes); err != nil {
+		return err
+	}
+
+	// 解除质押中的认证方仍在注册表中，同样会被罚没
+	address, err := k.AttestorByPubkey.Get(ctx, attestor)
+	switch {
+	case err == nil:
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return err
+		}
+		if !params.AttestorSlashFraction.IsNil() && params.AttestorSlashFraction.IsPositive() {
+			if _, err := k.slashAttestor(ctx, address, params.AttestorSlashFraction, types.AttributeValueReasonDuplicateIdentity); err != nil {
+				return err
+			}
+		}
+	case !errors.Is(err, collections.ErrNotFound):
+		return err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDuplicateIdentity,
+			sdk.NewAttribute(types.AttributeKeyDid, original.Did),
+			sdk.NewAttribute(types.AttributeKeyDuplicateDid, duplicate.Did),
+			sdk.NewAttribute(types.AttributeKeyAttestor, attestor),
+			sdk.NewAttribute(types.AttributeKeyStrikes, strconv.FormatUint(strikes, 10)),
+			sdk.NewAttribute(types.AttributeKeyLiability, strconv.FormatUint(liability, 10)),
+			sdk.NewAttribute(types.AttributeKeyLiabilityAction, action),
+		),
+	)
+
+	return nil
+}
+
+// getActivePersonDid 读取重复身份证据中引用的 DID，必须是未停用的自然人 DID
+func (k Keeper) getActivePersonDid(ctx context.Context, rawDid string) (types.DidDocument, error) {
+	did, err := k.ResolveDid(ctx, rawDid)
+	if err != nil {
+		return types.DidDocument{}, err
+	}
+	doc, err := k.DidDocument.Get(ctx, did)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.DidDocument{}, errorsmod.Wrapf(types.ErrInvalidEvidence, "did %s not found", did)
+		}
+		return types.DidDocument{}, err
+	}
+	if doc.IsOrganisation() {
+		return types.DidDocument{}, errorsmod.Wrapf(types.ErrInvalidEvidence, "did %s is an organisation", did)
+	}
+	if doc.IsDeactivated() {
+		return types.DidDocument{}, errorsmod.Wrap(types.ErrDidDeactivated, did)
+	}
+	return doc, nil
+}
@@ -0,0 +1,164 @@
+package keeper_test
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	evidencetypes "cosmossdk.io/x/evidence/types"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/identity/keeper"
+	"dtc/x/identity/types"
+)
+
+// mockCreditKeeper 记录负债的合并与注销
+type mockCreditKeeper struct {
+	liabilities map[string]uint64
+}
+
+func (m *mockCreditKeeper) MergeLiability(_ context.Context, from, to string) (uint64, error) {
+	liability := m.liabilities[from]
+	m.liabilities[to] += liability
+	delete(m.liabilities, from)
+	return liability, nil
+}
+
+func (m *mockCreditKeeper) ForfeitLiability(_ context.Context, address string) (uint64, error) {
+	liability := m.liabilities[address]
+	delete(m.liabilities, address)
+	return liability, nil
+}
+
+func TestDuplicateIdentityEvidence(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	issuer := f.bondTestAttestor(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	creditKeeper := &mockCreditKeeper{liabilities: map[string]uint64{}}
+	handler := keeper.NewDuplicateIdentityEvidenceHandler(f.keeper, creditKeeper)
+
+	alice, err := f.addressCodec.BytesToString([]byte("aliceAddr___________________"))
+	require.NoError(t, err)
+	aliceAgain, err := f.addressCodec.BytesToString([]byte("aliceAgainAddr______________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString([]byte("bobAddr_____________________"))
+	require.NoError(t, err)
+
+	nullifier, commitment := faceBlinding("alice")
+	original, err := srv.CreateDidDocument(ctx.WithBlockHeight(10), issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: alice, FaceNullifier: nullifier, FaceCommitment: commitment}))
+	require.NoError(t, err)
+	// 认证方失误：同一个人以不同的 nullifier 再次注册
+	nullifier, commitment = faceBlinding("alice-again")
+	duplicate, err := srv.CreateDidDocument(ctx.WithBlockHeight(20), issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: aliceAgain, FaceNullifier: nullifier, FaceCommitment: commitment}))
+	require.NoError(t, err)
+	_, err = srv.ClaimHandle(ctx, &types.MsgClaimHandle{Creator: aliceAgain, Did: duplicate.Did, Handle: "alice"})
+	require.NoError(t, err)
+	bobDid, err := srv.CreateDidDocument(ctx, issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: bob}))
+	require.NoError(t, err)
+
+	doc, err := f.keeper.DidDocument.Get(ctx, duplicate.Did)
+	require.NoError(t, err)
+	require.Equal(t, int64(20), doc.CreatedHeight)
+	attestor := doc.Attestor
+	require.NotEmpty(t, attestor)
+
+	// 证据由另一个已质押认证方签名；未质押的密钥签名不被接受
+	reporter := f.bondTestAttestor(t)
+	unbonded := secp256k1.GenPrivKey()
+	evidence := func(first, second string, height int64) *types.DuplicateIdentityEvidence {
+		return &types.DuplicateIdentityEvidence{FirstDid: first, SecondDid: second, Height: height, Signature: reporter.sign(t, string(types.DuplicateIdentitySignBytes(first, second, height))), AttestorPubkey: reporter.pubkey}
+	}
+	unbondedSig, err := unbonded.Sign(types.DuplicateIdentitySignBytes(original.Did, duplicate.Did, 30))
+	require.NoError(t, err)
+
+	// 重复 DID 绑定的以太坊地址在停用时被解除
+	const ethKey = "b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291"
+	key, err := crypto.HexToECDSA(ethKey)
+	require.NoError(t, err)
+	ethAddress := strings.ToLower(crypto.PubkeyToAddress(key.PublicKey).Hex())
+	_, err = srv.LinkExternalAccount(ctx, &types.MsgLinkExternalAccount{Creator: aliceAgain, Did: duplicate.Did, EthAddress: ethAddress,
+		Signature: personalSign(t, ethKey, types.ExternalAccountChallenge(ctx.ChainID(), duplicate.Did, ethAddress, 0))})
+	require.NoError(t, err)
+
+	creditKeeper.liabilities[alice] = 100
+	creditKeeper.liabilities[aliceAgain] = 40
+
+	tests := []struct {
+		desc     string
+		evidence *types.DuplicateIdentityEvidence
+		err      error
+	}{
+		{desc: "same did", evidence: evidence(original.Did, original.Did, 30), err: types.ErrInvalidEvidence},
+		{desc: "missing signature", evidence: &types.DuplicateIdentityEvidence{FirstDid: original.Did, SecondDid: duplicate.Did, Height: 30}, err: types.ErrInvalidEvidence},
+		{desc: "missing attestor pubkey", evidence: &types.DuplicateIdentityEvidence{FirstDid: original.Did, SecondDid: duplicate.Did, Height: 30, Signature: make([]byte, 64)}, err: types.ErrInvalidEvidence},
+		{desc: "invalid signature", evidence: &types.DuplicateIdentityEvidence{FirstDid: original.Did, SecondDid: duplicate.Did, Height: 30, Signature: make([]byte, 64), AttestorPubkey: reporter.pubkey}, err: sdkerrors.ErrUnauthorized},
+		{desc: "unbonded attestor", evidence: &types.DuplicateIdentityEvidence{FirstDid: original.Did, SecondDid: duplicate.Did, Height: 30, Signature: unbondedSig, AttestorPubkey: hex.EncodeToString(unbonded.PubKey().Bytes())}, err: types.ErrAttestorNotFound},
+		{desc: "unknown did", evidence: evidence(original.Did, types.GenerateDid(bob, "unknown"), 30), err: types.ErrInvalidEvidence},
+		// 证据中 DID 的顺序不影响结果：较新注册的 DID 被停用
+		{desc: "completed", evidence: evidence(duplicate.Did, original.Did, 30)},
+		{desc: "already deactivated", evidence: evidence(original.Did, duplicate.Did, 31), err: types.ErrDidDeactivated},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			err := handler(ctx.WithBlockHeight(30), tc.evidence)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// 较新的 DID 被停用并指向原 DID，句柄被释放
+	doc, err = f.keeper.DidDocument.Get(ctx, duplicate.Did)
+	require.NoError(t, err)
+	require.True(t, doc.IsDeactivated())
+	require.Equal(t, original.Did, doc.DuplicateOf)
+	_, err = f.keeper.DidHandle.Get(ctx, duplicate.Did)
+	require.Error(t, err)
+	_, found := f.keeper.GetDidDocument(ctx, aliceAgain)
+	require.False(t, found)
+	has, err := f.keeper.ControllerDid.Has(ctx, aliceAgain)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.ExternalAccount.Has(ctx, ethAddress)
+	require.NoError(t, err)
+	require.False(t, has)
+	_, err = srv.UpdateDidDocument(ctx, &types.MsgUpdateDidDocument{Creator: aliceAgain, Did: duplicate.Did, Pubkeys: "x"})
+	require.ErrorIs(t, err, types.ErrDidDeactivated)
+	_, err = srv.DeleteDidDocument(ctx, &types.MsgDeleteDidDocument{Creator: aliceAgain, Did: duplicate.Did})
+	require.ErrorIs(t, err, types.ErrDidDeactivated)
+
+	// 原 DID 不受影响
+	doc, err = f.keeper.DidDocument.Get(ctx, original.Did)
+	require.NoError(t, err)
+	require.False(t, doc.IsDeactivated())
+
+	// 负债合并到原 DID 的 controller
+	require.Equal(t, map[string]uint64{alice: 140}, creditKeeper.liabilities)
+
+	// 认证方被记录一次违规
+	strikes, err := qs.AttestorStrikes(ctx, &types.QueryAttestorStrikesRequest{Attestor: attestor})
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), strikes.Strikes)
+
+	// 组织 DID 不能作为重复身份证据的对象
+	policy, err := f.addressCodec.BytesToString([]byte("groupPolicy_________________"))
+	require.NoError(t, err)
+	f.groupKeeper.policies[policy] = true
+	org, err := srv.CreateOrganisationDid(ctx, &types.MsgCreateOrganisationDid{Creator: policy})
+	require.NoError(t, err)
+	err = handler(ctx, evidence(bobDid.Did, org.Did, 30))
+	require.ErrorIs(t, err, types.ErrInvalidEvidence)
+
+	// 非本模块的证据类型被拒绝
+	err = handler(ctx, &evidencetypes.Equivocation{})
+	require.ErrorIs(t, err, types.ErrInvalidEvidence)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"dtc/x/identity/types"
+)
+
+// nextExternalAccountNonce 返回以太坊地址下一次绑定挑战使用的 nonce
+func (k Keeper) nextExternalAccountNonce(ctx context.Context, ethAddress string) (uint64, error) {
+	nonce, err := k.ExternalAccountNonce.Get(ctx, ethAddress)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, err
+	}
+	return nonce, nil
+}
+
+// removeExternalAccountLink 删除绑定记录及 DID 索引，nonce 保留以防旧签名重放
+func (k Keeper) removeExternalAccountLink(ctx context.Context, link types.ExternalAccountLink) error {
+	if err := k.ExternalAccount.Remove(ctx, link.EthAddress); err != nil {
+		return err
+	}
+	return k.DidExternalAccounts.Remove(ctx, collections.Join(link.Did, link.EthAddress))
+}
+
+// removeDidExternalAccounts 删除 DID 绑定的全部以太坊地址，在 DID 被删除或停用时调用
+func (k Keeper) removeDidExternalAccounts(ctx context.Context, did string) error {
+	var links []types.ExternalAccountLink
+	rng := collections.NewPrefixedPairRange[string, string](did)
+	if err := k.DidExternalAccounts.Walk(ctx, rng, func(key collections.Pair[string, string]) (bool, error) {
+		link, err := k.ExternalAccount.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		links = append(links, link)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, link := range links {
+		if err := k.removeExternalAccountLink(ctx, link); err != nil {
+			return err
+		}
+	}
+	return nil
+}
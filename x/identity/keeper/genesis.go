@@ -15,6 +15,15 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		if err := k.DidDocument.Set(ctx, elem.Did, elem); err != nil {
 			return err
 		}
+		// 重建 nullifier 索引，保证导入后的链仍能去重
+		if elem.FaceNullifier != "" {
+			if err := k.FaceNullifierToIndex.Set(ctx, elem.FaceNullifier, elem.Did); err != nil {
+				return err
+			}
+		}
+	}
+	if err := k.rebuildControllerDidIndex(ctx); err != nil {
+		return err
 	}
 
 	return k.Params.Set(ctx, genState.Params)
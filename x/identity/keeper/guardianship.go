@@ -0,0 +1,77 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dtc/x/identity/types"
+)
+
+// GetGuardianship 返回被监护 DID 的监护关系，供 credit、task 等模块判断 DID 是否仍处于监护期
+func (k Keeper) GetGuardianship(ctx context.Context, did string) (types.Guardianship, bool) {
+	guardianship, err := k.Guardianship.Get(ctx, did)
+	if err != nil {
+		return types.Guardianship{}, false
+	}
+	return guardianship, true
+}
+
+// GetDependents 返回监护人 DID 名下的全部被监护 DID
+func (k Keeper) GetDependents(ctx context.Context, guardianDid string) ([]types.Guardianship, error) {
+	var dependents []types.Guardianship
+	err := k.GuardianDependents.Walk(ctx, collections.NewPrefixedPairRange[string, string](guardianDid), func(key collections.Pair[string, string]) (bool, error) {
+		guardianship, err := k.Guardianship.Get(ctx, key.K2())
+		if err != nil {
+			return true, err
+		}
+		dependents = append(dependents, guardianship)
+		return false, nil
+	})
+	return dependents, err
+}
+
+// hasDependents 判断 DID 是否仍是某个被监护 DID 的监护人
+func (k Keeper) hasDependents(ctx context.Context, guardianDid string) (bool, error) {
+	iter, err := k.GuardianDependents.Iterate(ctx, collections.NewPrefixedPairRange[string, string](guardianDid))
+	if err != nil {
+		return false, err
+	}
+	defer iter.Close()
+	return iter.Valid(), nil
+}
+
+// getManagedDidDocument 解析 DID 并校验 creator 是否可以管理该 DID：
+// 即 DID 的 controller，或被监护 DID 的监护人 DID 的 controller。
+// 监护人只能执行受限操作（更新公钥、认领/释放句柄），删除、转移等操作仍须使用 getControlledDidDocument
+func (k msgServer) getManagedDidDocument(ctx context.Context, creator, rawDid string) (types.DidDocument, error) {
+	did, err := k.ResolveDid(ctx, rawDid)
+	if err != nil {
+		return types.DidDocument{}, err
+	}
+
+	val, err := k.DidDocument.Get(ctx, did)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.DidDocument{}, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
+		}
+		return types.DidDocument{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if val.IsDeactivated() {
+		return types.DidDocument{}, errorsmod.Wrap(types.ErrDidDeactivated, did)
+	}
+	if creator == val.Controller {
+		return val, nil
+	}
+	if val.Guardian != "" {
+		guardian, err := k.DidDocument.Get(ctx, val.Guardian)
+		if err == nil && creator == guardian.Controller {
+			return val, nil
+		}
+	}
+	return types.DidDocument{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect controller")
+}
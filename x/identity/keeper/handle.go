@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dtc/x/identity/types"
+)
+
+// releaseDidHandle 释放 DID 的句柄：记录进入冷却期，DID 到句柄的索引立即删除
+func (k Keeper) releaseDidHandle(ctx context.Context, did string) (types.Handle, error) {
+	name, err := k.DidHandle.Get(ctx, did)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Handle{}, types.ErrHandleNotFound
+		}
+		return types.Handle{}, err
+	}
+
+	handle, err := k.Handle.Get(ctx, name)
+	if err != nil {
+		return types.Handle{}, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return types.Handle{}, err
+	}
+
+	// 冷却期为 0 时也至少标记为已释放（ReleaseHeight 非 0）
+	handle.ReleaseHeight = max(sdk.UnwrapSDKContext(ctx).BlockHeight()+params.HandleReleaseCooldown, 1)
+	if err := k.Handle.Set(ctx, name, handle); err != nil {
+		return types.Handle{}, err
+	}
+	if err := k.DidHandle.Remove(ctx, did); err != nil {
+		return types.Handle{}, err
+	}
+
+	return handle, nil
+}
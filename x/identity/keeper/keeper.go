@@ -1,13 +1,17 @@
 package keeper
 
 import (
+	"context"
+	"errors"
 	"fmt"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibckeeper "github.com/cosmos/ibc-go/v10/modules/core/keeper"
 
 	"dtc/x/identity/types"
 )
@@ -20,10 +24,42 @@ type Keeper struct {
 	// Typically, this should be the x/gov module account.
 	authority []byte
 
-	Schema          collections.Schema
-	Params          collections.Item[types.Params]
-	DidDocument     collections.Map[string, types.DidDocument]
-	FaceHashToIndex collections.Map[string, string] // faceHash -> did
+	bankKeeper  types.BankKeeper
+	ibcKeeperFn func() *ibckeeper.Keeper
+	groupKeeper types.GroupKeeper
+	roleKeeper  types.RoleKeeper
+
+	Schema      collections.Schema
+	Params      collections.Item[types.Params]
+	DidDocument collections.Map[string, types.DidDocument]
+	DidAlias    collections.Map[string, string] // legacy did -> canonical did
+
+	ControllerDid collections.Map[string, string] // controller address -> active did
+
+	FaceNullifierToIndex  collections.Map[string, string] // faceNullifier -> did
+	LegacyFaceHashToIndex collections.Map[string, string] // faceHash -> did，仅供存储迁移使用
+
+	RemoteAttestation collections.Map[collections.Pair[string, string], types.RemoteAttestation] // (channelID, address) -> attestation
+
+	PendingControllerTransfer collections.Map[string, types.PendingControllerTransfer] // did -> pending transfer
+
+	Handle    collections.Map[string, types.Handle] // handle -> record
+	DidHandle collections.Map[string, string]       // did -> active handle
+
+	Guardianship       collections.Map[string, types.Guardianship]          // dependent did -> guardianship
+	GuardianDependents collections.KeySet[collections.Pair[string, string]] // (guardian did, dependent did)
+
+	AttestorStrikes collections.Map[string, uint64] // attestor pubkey -> duplicate-identity strikes
+
+	Attestor               collections.Map[string, types.Attestor]             // address -> attestor
+	AttestorByPubkey       collections.Map[string, string]                     // pubkey -> attestor address
+	AttestorUnbondingQueue collections.KeySet[collections.Pair[int64, string]] // (completion height, address)
+
+	ExternalAccount      collections.Map[string, types.ExternalAccountLink]   // eth address -> link
+	DidExternalAccounts  collections.KeySet[collections.Pair[string, string]] // (did, eth address)
+	ExternalAccountNonce collections.Map[string, uint64]                      // eth address -> next challenge nonce
+
+	Credential collections.Map[collections.Pair[string, string], types.Credential] // (did, credential type) -> credential
 }
 
 func NewKeeper(
@@ -31,7 +67,10 @@ func NewKeeper(
 	cdc codec.Codec,
 	addressCodec address.Codec,
 	authority []byte,
-
+	bankKeeper types.BankKeeper,
+	ibcKeeperFn func() *ibckeeper.Keeper,
+	groupKeeper types.GroupKeeper,
+	roleKeeper types.RoleKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -44,10 +83,41 @@ func NewKeeper(
 		cdc:          cdc,
 		addressCodec: addressCodec,
 		authority:    authority,
+		bankKeeper:   bankKeeper,
+		ibcKeeperFn:  ibcKeeperFn,
+		groupKeeper:  groupKeeper,
+		roleKeeper:   roleKeeper,
 
-		Params:          collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		DidDocument:     collections.NewMap(sb, types.DidDocumentKey, "didDocument", collections.StringKey, codec.CollValue[types.DidDocument](cdc)),
-		FaceHashToIndex: collections.NewMap(sb, types.FaceHashToIndexKey, "faceHashToIndex", collections.StringKey, collections.StringValue),
+		Params:      collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		DidDocument: collections.NewMap(sb, types.DidDocumentKey, "didDocument", collections.StringKey, codec.CollValue[types.DidDocument](cdc)),
+		DidAlias:    collections.NewMap(sb, types.DidAliasKey, "didAlias", collections.StringKey, collections.StringValue),
+
+		ControllerDid: collections.NewMap(sb, types.ControllerDidKey, "controllerDid", collections.StringKey, collections.StringValue),
+
+		FaceNullifierToIndex:  collections.NewMap(sb, types.FaceNullifierToIndexKey, "faceNullifierToIndex", collections.StringKey, collections.StringValue),
+		LegacyFaceHashToIndex: collections.NewMap(sb, types.LegacyFaceHashToIndexKey, "faceHashToIndex", collections.StringKey, collections.StringValue),
+
+		RemoteAttestation: collections.NewMap(sb, types.RemoteAttestationKey, "remoteAttestation", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.RemoteAttestation](cdc)),
+
+		PendingControllerTransfer: collections.NewMap(sb, types.PendingControllerTransferKey, "pendingControllerTransfer", collections.StringKey, codec.CollValue[types.PendingControllerTransfer](cdc)),
+
+		Handle:    collections.NewMap(sb, types.HandleKey, "handle", collections.StringKey, codec.CollValue[types.Handle](cdc)),
+		DidHandle: collections.NewMap(sb, types.DidHandleKey, "didHandle", collections.StringKey, collections.StringValue),
+
+		Guardianship:       collections.NewMap(sb, types.GuardianshipKey, "guardianship", collections.StringKey, codec.CollValue[types.Guardianship](cdc)),
+		GuardianDependents: collections.NewKeySet(sb, types.GuardianDependentsKey, "guardianDependents", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+
+		AttestorStrikes: collections.NewMap(sb, types.AttestorStrikesKey, "attestorStrikes", collections.StringKey, collections.Uint64Value),
+
+		Attestor:               collections.NewMap(sb, types.AttestorKey, "attestor", collections.StringKey, codec.CollValue[types.Attestor](cdc)),
+		AttestorByPubkey:       collections.NewMap(sb, types.AttestorByPubkeyKey, "attestorByPubkey", collections.StringKey, collections.StringValue),
+		AttestorUnbondingQueue: collections.NewKeySet(sb, types.AttestorUnbondingQueueKey, "attestorUnbondingQueue", collections.PairKeyCodec(collections.Int64Key, collections.StringKey)),
+
+		ExternalAccount:      collections.NewMap(sb, types.ExternalAccountKey, "externalAccount", collections.StringKey, codec.CollValue[types.ExternalAccountLink](cdc)),
+		DidExternalAccounts:  collections.NewKeySet(sb, types.DidExternalAccountsKey, "didExternalAccounts", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		ExternalAccountNonce: collections.NewMap(sb, types.ExternalAccountNonceKey, "externalAccountNonce", collections.StringKey, collections.Uint64Value),
+
+		Credential: collections.NewMap(sb, types.CredentialKey, "credential", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.Credential](cdc)),
 	}
 
 	schema, err := sb.Build()
@@ -64,19 +134,71 @@ func (k Keeper) GetAuthority() []byte {
 	return k.authority
 }
 
-// GetDidDocument returns the DidDocument whose Controller equals the given address.
-// It is used by the credit module's IdentityKeeper interface.
+// GetDidDocument returns the active (not deactivated) DidDocument whose Controller equals the given address.
+// 通过 controller 索引查找，避免遍历全部 DID
 func (k Keeper) GetDidDocument(ctx sdk.Context, address string) (val types.DidDocument, found bool) {
-	var foundDoc *types.DidDocument
-	err := k.DidDocument.Walk(ctx.Context(), nil, func(key string, value types.DidDocument) (stop bool, err error) {
-		if value.Controller == address {
-			foundDoc = &value
-			return true, nil
+	did, err := k.ControllerDid.Get(ctx, address)
+	if err != nil {
+		return types.DidDocument{}, false
+	}
+	doc, err := k.DidDocument.Get(ctx, did)
+	if err != nil || doc.IsDeactivated() {
+		return types.DidDocument{}, false
+	}
+	return doc, true
+}
+
+// removeControllerDid 删除 controller 索引，索引已指向其他 DID 时保持不变
+func (k Keeper) removeControllerDid(ctx context.Context, controller, did string) error {
+	if controller == "" {
+		return nil
+	}
+	indexed, err := k.ControllerDid.Get(ctx, controller)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	if indexed != did {
+		return nil
+	}
+	return k.ControllerDid.Remove(ctx, controller)
+}
+
+// rebuildControllerDidIndex 按全部未停用的 DID 重建 controller 索引。
+// 旧数据中同一 controller 控制多个 DID 时保留注册高度最早的一个，高度相同时保留先遍历到的
+func (k Keeper) rebuildControllerDidIndex(ctx context.Context) error {
+	if err := k.ControllerDid.Clear(ctx, nil); err != nil {
+		return err
+	}
+	created := make(map[string]int64)
+	return k.DidDocument.Walk(ctx, nil, func(did string, doc types.DidDocument) (bool, error) {
+		if doc.Controller == "" || doc.IsDeactivated() {
+			return false, nil
 		}
-		return false, nil
+		if height, ok := created[doc.Controller]; ok && height <= doc.CreatedHeight {
+			return false, nil
+		}
+		created[doc.Controller] = doc.CreatedHeight
+		return false, k.ControllerDid.Set(ctx, doc.Controller, did)
 	})
-	if err != nil || foundDoc == nil {
-		return types.DidDocument{}, false
+}
+
+// ResolveDid 将任意输入的 DID 解析为链上的规范 DID：先做语法规范化，再查找旧格式 DID 的别名。
+// 旧格式 DID 本身不满足 did:dtc 语法，因此先按原样查找别名。
+func (k Keeper) ResolveDid(ctx context.Context, did string) (string, error) {
+	canonical, err := k.DidAlias.Get(ctx, did)
+	if err == nil {
+		return canonical, nil
+	}
+	if !errors.Is(err, collections.ErrNotFound) {
+		return "", err
+	}
+
+	normalized, err := types.NormalizeDid(did)
+	if err != nil {
+		return "", errorsmod.Wrap(types.ErrInvalidDid, err.Error())
 	}
-	return *foundDoc, true
+	return normalized, nil
 }
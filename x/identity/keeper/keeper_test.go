@@ -2,17 +2,24 @@ package keeper_test
 
 import (
 	"context"
+	"encoding/hex"
+	"fmt"
 	"testing"
 
 	"cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/store/types"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
 	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/stretchr/testify/require"
 
+	dtctypes "dtc/x/dtc/types"
 	"dtc/x/identity/keeper"
 	module "dtc/x/identity/module"
 	"dtc/x/identity/types"
@@ -22,6 +29,125 @@ type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	groupKeeper  *mockGroupKeeper
+	bankKeeper   *mockBankKeeper
+	roleKeeper   *mockRoleKeeper
+}
+
+// mockRoleKeeper 按 "role/address/scope" 记录持有的角色
+type mockRoleKeeper struct {
+	roles map[string]bool
+}
+
+func (m *mockRoleKeeper) HasRole(_ context.Context, role dtctypes.Role, address, scope string) bool {
+	return m.roles[fmt.Sprintf("%s/%s/%s", role, address, scope)]
+}
+
+// grant 授予 address 全局角色
+func (m *mockRoleKeeper) grant(role dtctypes.Role, address string) {
+	m.roles[fmt.Sprintf("%s/%s/", role, address)] = true
+}
+
+// grantAttestorKey 授予公钥地址 addr 对应账户认证方角色
+func (f *fixture) grantAttestorKey(t *testing.T, addr []byte) {
+	t.Helper()
+	address, err := f.addressCodec.BytesToString(addr)
+	require.NoError(t, err)
+	f.roleKeeper.grant(dtctypes.ROLE_ATTESTOR, address)
+}
+
+// testAttestor 是测试用的已质押且持有认证方角色的认证方
+type testAttestor struct {
+	privKey secp256k1.PrivKey
+	pubkey  string
+	address string
+}
+
+// bondTestAttestor 质押一个持有认证方角色的认证方，供测试签发 DID
+func (f *fixture) bondTestAttestor(t *testing.T) testAttestor {
+	t.Helper()
+	privKey := secp256k1.GenPrivKey()
+	address, err := f.addressCodec.BytesToString(privKey.PubKey().Address())
+	require.NoError(t, err)
+	attestor := testAttestor{privKey: privKey, pubkey: hex.EncodeToString(privKey.PubKey().Bytes()), address: address}
+
+	f.bankKeeper.accounts[address] = f.bankKeeper.accounts[address].Add(bondCoin(types.DefaultAttestorMinBond))
+	_, err = keeper.NewMsgServerImpl(f.keeper).BondAttestor(f.ctx, &types.MsgBondAttestor{Creator: address, Pubkey: attestor.pubkey, Amount: bondCoin(types.DefaultAttestorMinBond)})
+	require.NoError(t, err)
+	f.roleKeeper.grant(dtctypes.ROLE_ATTESTOR, address)
+	return attestor
+}
+
+// sign 返回认证方对 data 的签名
+func (a testAttestor) sign(t *testing.T, data string) []byte {
+	t.Helper()
+	sig, err := a.privKey.Sign([]byte(data))
+	require.NoError(t, err)
+	return sig
+}
+
+// signDidDocument 以认证方签名填充 DID 注册消息
+func (a testAttestor) signDidDocument(t *testing.T, msg *types.MsgCreateDidDocument) *types.MsgCreateDidDocument {
+	t.Helper()
+	controller := msg.Controller
+	if controller == "" {
+		controller = msg.Creator
+	}
+	msg.Signature = a.sign(t, types.GenerateDid(controller, msg.FaceNullifier)+controller+msg.FaceNullifier+msg.FaceCommitment)
+	msg.AttestorPubkey = a.pubkey
+	return msg
+}
+
+// mockBankKeeper 按地址与模块名记录余额
+type mockBankKeeper struct {
+	accounts map[string]sdk.Coins
+	modules  map[string]sdk.Coins
+}
+
+func (m *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.accounts[addr.String()]
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	balance, hasNeg := m.accounts[senderAddr.String()].SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.accounts[senderAddr.String()] = balance
+	m.modules[recipientModule] = m.modules[recipientModule].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	balance, hasNeg := m.modules[senderModule].SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.modules[senderModule] = balance
+	m.accounts[recipientAddr.String()] = m.accounts[recipientAddr.String()].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToModule(_ context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	balance, hasNeg := m.modules[senderModule].SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.modules[senderModule] = balance
+	m.modules[recipientModule] = m.modules[recipientModule].Add(amt...)
+	return nil
+}
+
+// mockGroupKeeper 只记录哪些地址是 x/group 策略账户
+type mockGroupKeeper struct {
+	policies map[string]bool
+}
+
+func (m *mockGroupKeeper) GroupPolicyInfo(_ context.Context, req *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error) {
+	if !m.policies[req.Address] {
+		return nil, sdkerrors.ErrNotFound
+	}
+	return &group.QueryGroupPolicyInfoResponse{Info: &group.GroupPolicyInfo{Address: req.Address}}, nil
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -52,12 +178,19 @@ func initFixture(t *testing.T) *fixture {
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	groupKeeper := &mockGroupKeeper{policies: map[string]bool{}}
+	bankKeeper := &mockBankKeeper{accounts: map[string]sdk.Coins{}, modules: map[string]sdk.Coins{}}
+	roleKeeper := &mockRoleKeeper{roles: map[string]bool{}}
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
+		bankKeeper,
+		nil,
+		groupKeeper,
+		roleKeeper,
 	)
 
 	// Initialize params
@@ -69,5 +202,8 @@ func initFixture(t *testing.T) *fixture {
 		ctx:          ctx,
 		keeper:       k,
 		addressCodec: addressCodec,
+		groupKeeper:  groupKeeper,
+		bankKeeper:   bankKeeper,
+		roleKeeper:   roleKeeper,
 	}
 }
@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dtc/x/identity/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 将不符合 did:dtc 语法的旧 DID 迁移为规范 DID。
+// 语法合法但大小写不规范的 DID 直接小写化；其余旧 DID 按 controller 与 faceHash 重新派生。
+// 旧 DID 会作为别名保留，指向新的规范 DID，faceHash 索引同步更新。
+// 规范 DID 已被其他记录占用时保留旧记录且不写别名，记录日志并发出冲突事件以便人工处理。
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	var legacy []types.DidDocument
+	if err := m.keeper.DidDocument.Walk(ctx, nil, func(did string, doc types.DidDocument) (bool, error) {
+		if !types.IsCanonicalDid(did) {
+			legacy = append(legacy, doc)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, doc := range legacy {
+		legacyDid := doc.Did
+		canonical, err := types.NormalizeDid(legacyDid)
+		if err != nil {
+			canonical = types.GenerateDid(doc.Controller, doc.LegacyFaceHash) //nolint:staticcheck // legacy field is read during migration
+		}
+
+		// 目标 DID 已被占用时保留旧记录，避免覆盖已有身份；
+		// 两条记录可能属于不同的人，因此不写别名
+		taken, err := m.keeper.DidDocument.Has(ctx, canonical)
+		if err != nil {
+			return err
+		}
+		if taken {
+			ctx.Logger().Error("legacy did conflicts with an existing canonical did", "module", types.ModuleName, "did", legacyDid, "canonical_did", canonical)
+			ctx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeDidMigrationConflict,
+					sdk.NewAttribute(types.AttributeKeyDid, legacyDid),
+					sdk.NewAttribute(types.AttributeKeyCanonicalDid, canonical),
+					sdk.NewAttribute(types.AttributeKeyController, doc.Controller),
+				),
+			)
+			continue
+		}
+
+		doc.Did = canonical
+		if err := m.keeper.DidDocument.Set(ctx, canonical, doc); err != nil {
+			return err
+		}
+		if err := m.keeper.DidDocument.Remove(ctx, legacyDid); err != nil {
+			return err
+		}
+		if err := m.keeper.DidAlias.Set(ctx, legacyDid, canonical); err != nil {
+			return err
+		}
+		if doc.LegacyFaceHash != "" { //nolint:staticcheck // legacy field is read during migration
+			if err := m.keeper.LegacyFaceHashToIndex.Set(ctx, doc.LegacyFaceHash, canonical); err != nil { //nolint:staticcheck // legacy field is read during migration
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// Migrate2to3 移除链上明文存储的 faceHash。
+// 链上无法获得认证方的盐值，旧记录的 faceHash 通过 types.LegacyFaceNullifier 转换为 nullifier，
+// commitment 留空；旧的 faceHash 索引被删除，并以 nullifier 为键重建去重索引。
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	var docs []types.DidDocument
+	if err := m.keeper.DidDocument.Walk(ctx, nil, func(_ string, doc types.DidDocument) (bool, error) {
+		if doc.LegacyFaceHash != "" { //nolint:staticcheck // legacy field is read during migration
+			docs = append(docs, doc)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, doc := range docs {
+		nullifier := types.LegacyFaceNullifier(doc.LegacyFaceHash) //nolint:staticcheck // legacy field is read during migration
+		doc.LegacyFaceHash = ""                                    //nolint:staticcheck // legacy field is cleared during migration
+		if doc.FaceNullifier == "" {
+			doc.FaceNullifier = nullifier
+		}
+		if err := m.keeper.DidDocument.Set(ctx, doc.Did, doc); err != nil {
+			return err
+		}
+
+		// 同一人脸对应多个 DID 时只保留先建立的索引
+		taken, err := m.keeper.FaceNullifierToIndex.Has(ctx, doc.FaceNullifier)
+		if err != nil {
+			return err
+		}
+		if !taken {
+			if err := m.keeper.FaceNullifierToIndex.Set(ctx, doc.FaceNullifier, doc.Did); err != nil {
+				return err
+			}
+		}
+	}
+
+	return m.keeper.LegacyFaceHashToIndex.Clear(ctx, nil)
+}
+
+// Migrate3to4 为新增的 MaxBatchSize 参数写入默认值
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.MaxBatchSize == 0 {
+		params.MaxBatchSize = types.DefaultMaxBatchSize
+	}
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate4to5 为新增的 ControllerTransferPeriod 参数写入默认值
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.ControllerTransferPeriod == 0 {
+		params.ControllerTransferPeriod = types.DefaultControllerTransferPeriod
+	}
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate5to6 为新增的句柄规则参数写入默认值
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.HandleMaxLength == 0 {
+		params.HandleMinLength = types.DefaultHandleMinLength
+		params.HandleMaxLength = types.DefaultHandleMaxLength
+	}
+	if params.HandlePattern == "" {
+		params.HandlePattern = types.DefaultHandlePattern
+	}
+	if len(params.ReservedHandles) == 0 {
+		params.ReservedHandles = types.DefaultReservedHandles
+	}
+	if params.HandleReleaseCooldown == 0 {
+		params.HandleReleaseCooldown = types.DefaultHandleReleaseCooldown
+	}
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate6to7 为新增的认证方质押参数写入默认值
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.AttestorMinBond == 0 {
+		params.AttestorMinBond = types.DefaultAttestorMinBond
+	}
+	if params.AttestorUnbondingPeriod == 0 {
+		params.AttestorUnbondingPeriod = types.DefaultAttestorUnbondingPeriod
+	}
+	if params.AttestorSlashFraction.IsNil() || params.AttestorSlashFraction.IsZero() {
+		params.AttestorSlashFraction = types.DefaultAttestorSlashFraction
+	}
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate7to8 为已有 DID 建立 controller 索引，按地址查找 DID 不再遍历全部文档
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	return m.keeper.rebuildControllerDidIndex(ctx)
+}
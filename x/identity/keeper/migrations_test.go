@@ -0,0 +1,219 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/identity/keeper"
+	"dtc/x/identity/types"
+)
+
+//nolint:staticcheck // legacy face hash fields are exercised by store migrations
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+
+	canonical := types.DidDocument{Did: types.GenerateDid("controller-a", "face-a"), Controller: "controller-a", LegacyFaceHash: "face-a"}
+	upper := types.DidDocument{Did: strings.ToUpper(types.GenerateDid("controller-b", "face-b")), Controller: "controller-b", LegacyFaceHash: "face-b"}
+	legacy := types.DidDocument{Did: "0", Controller: "controller-c", LegacyFaceHash: "face-c"}
+	// 规范化后与已有 DID 冲突的旧 DID
+	collision := types.DidDocument{Did: strings.ToUpper(canonical.Did), Controller: "controller-d", LegacyFaceHash: "face-d"}
+	for _, doc := range []types.DidDocument{canonical, upper, legacy, collision} {
+		require.NoError(t, f.keeper.DidDocument.Set(f.ctx, doc.Did, doc))
+		require.NoError(t, f.keeper.LegacyFaceHashToIndex.Set(f.ctx, doc.LegacyFaceHash, doc.Did))
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(sdk.UnwrapSDKContext(f.ctx)))
+
+	// 规范 DID 保持不变
+	got, err := f.keeper.DidDocument.Get(f.ctx, canonical.Did)
+	require.NoError(t, err)
+	require.Equal(t, canonical, got)
+
+	// 大小写不规范的 DID 被小写化，旧 DID 保留为别名
+	expected := []struct {
+		legacy    string
+		canonical string
+		faceHash  string
+	}{
+		{legacy: upper.Did, canonical: strings.ToLower(upper.Did), faceHash: upper.LegacyFaceHash},
+		{legacy: legacy.Did, canonical: types.GenerateDid(legacy.Controller, legacy.LegacyFaceHash), faceHash: legacy.LegacyFaceHash},
+	}
+	for _, e := range expected {
+		has, err := f.keeper.DidDocument.Has(f.ctx, e.legacy)
+		require.NoError(t, err)
+		require.False(t, has)
+
+		doc, err := f.keeper.DidDocument.Get(f.ctx, e.canonical)
+		require.NoError(t, err)
+		require.Equal(t, e.canonical, doc.Did)
+
+		alias, err := f.keeper.DidAlias.Get(f.ctx, e.legacy)
+		require.NoError(t, err)
+		require.Equal(t, e.canonical, alias)
+
+		indexed, err := f.keeper.LegacyFaceHashToIndex.Get(f.ctx, e.faceHash)
+		require.NoError(t, err)
+		require.Equal(t, e.canonical, indexed)
+
+		resolved, err := f.keeper.ResolveDid(f.ctx, e.legacy)
+		require.NoError(t, err)
+		require.Equal(t, e.canonical, resolved)
+	}
+
+	// 冲突的旧记录原样保留，不写别名，并发出冲突事件
+	got, err = f.keeper.DidDocument.Get(f.ctx, collision.Did)
+	require.NoError(t, err)
+	require.Equal(t, collision, got)
+	has, err := f.keeper.DidAlias.Has(f.ctx, collision.Did)
+	require.NoError(t, err)
+	require.False(t, has)
+	got, err = f.keeper.DidDocument.Get(f.ctx, canonical.Did)
+	require.NoError(t, err)
+	require.Equal(t, canonical, got)
+
+	var conflicts []sdk.Event
+	for _, event := range sdk.UnwrapSDKContext(f.ctx).EventManager().Events() {
+		if event.Type == types.EventTypeDidMigrationConflict {
+			conflicts = append(conflicts, event)
+		}
+	}
+	require.Len(t, conflicts, 1)
+	did, ok := conflicts[0].GetAttribute(types.AttributeKeyDid)
+	require.True(t, ok)
+	require.Equal(t, collision.Did, did.Value)
+	canonicalDid, ok := conflicts[0].GetAttribute(types.AttributeKeyCanonicalDid)
+	require.True(t, ok)
+	require.Equal(t, canonical.Did, canonicalDid.Value)
+}
+
+//nolint:staticcheck // legacy face hash fields are exercised by store migrations
+func TestMigrate2to3(t *testing.T) {
+	f := initFixture(t)
+
+	first := types.DidDocument{Did: types.GenerateDid("controller-a", "face-a"), Controller: "controller-a", LegacyFaceHash: "face-a"}
+	duplicate := types.DidDocument{Did: types.GenerateDid("controller-b", "face-a"), Controller: "controller-b", LegacyFaceHash: "face-a"}
+	blinded := types.DidDocument{Did: types.GenerateDid("controller-c", "nullifier-c"), Controller: "controller-c", FaceNullifier: "nullifier-c", FaceCommitment: "commitment-c"}
+	for _, doc := range []types.DidDocument{first, duplicate, blinded} {
+		require.NoError(t, f.keeper.DidDocument.Set(f.ctx, doc.Did, doc))
+	}
+	require.NoError(t, f.keeper.LegacyFaceHashToIndex.Set(f.ctx, "face-a", first.Did))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate2to3(sdk.UnwrapSDKContext(f.ctx)))
+
+	nullifier := types.LegacyFaceNullifier("face-a")
+	for _, did := range []string{first.Did, duplicate.Did} {
+		doc, err := f.keeper.DidDocument.Get(f.ctx, did)
+		require.NoError(t, err)
+		require.Empty(t, doc.LegacyFaceHash)
+		require.Equal(t, nullifier, doc.FaceNullifier)
+		require.Empty(t, doc.FaceCommitment)
+	}
+
+	// 已盲化的记录保持不变
+	got, err := f.keeper.DidDocument.Get(f.ctx, blinded.Did)
+	require.NoError(t, err)
+	require.Equal(t, blinded, got)
+
+	// 重复人脸只保留先遍历到的 DID 作为索引
+	indexed, err := f.keeper.FaceNullifierToIndex.Get(f.ctx, nullifier)
+	require.NoError(t, err)
+	require.Contains(t, []string{first.Did, duplicate.Did}, indexed)
+
+	has, err := f.keeper.LegacyFaceHashToIndex.Has(f.ctx, "face-a")
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestMigrate3to4(t *testing.T) {
+	f := initFixture(t)
+
+	params := types.DefaultParams()
+	params.MaxBatchSize = 0
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate3to4(sdk.UnwrapSDKContext(f.ctx)))
+
+	got, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultMaxBatchSize, got.MaxBatchSize)
+}
+
+func TestMigrate4to5(t *testing.T) {
+	f := initFixture(t)
+
+	params := types.DefaultParams()
+	params.ControllerTransferPeriod = 0
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate4to5(sdk.UnwrapSDKContext(f.ctx)))
+
+	got, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultControllerTransferPeriod, got.ControllerTransferPeriod)
+}
+
+func TestMigrate5to6(t *testing.T) {
+	f := initFixture(t)
+
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.Params{AdminPubkey: types.DefaultParams().AdminPubkey}))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate5to6(sdk.UnwrapSDKContext(f.ctx)))
+
+	got, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultHandleMinLength, got.HandleMinLength)
+	require.Equal(t, types.DefaultHandleMaxLength, got.HandleMaxLength)
+	require.Equal(t, types.DefaultHandlePattern, got.HandlePattern)
+	require.Equal(t, types.DefaultReservedHandles, got.ReservedHandles)
+	require.Equal(t, types.DefaultHandleReleaseCooldown, got.HandleReleaseCooldown)
+}
+
+func TestMigrate6to7(t *testing.T) {
+	f := initFixture(t)
+
+	params := types.DefaultParams()
+	params.AttestorMinBond = 0
+	params.AttestorUnbondingPeriod = 0
+	params.AttestorSlashFraction = math.LegacyDec{}
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate6to7(sdk.UnwrapSDKContext(f.ctx)))
+
+	got, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultAttestorMinBond, got.AttestorMinBond)
+	require.Equal(t, types.DefaultAttestorUnbondingPeriod, got.AttestorUnbondingPeriod)
+	require.True(t, types.DefaultAttestorSlashFraction.Equal(got.AttestorSlashFraction))
+}
+
+func TestMigrate7to8(t *testing.T) {
+	f := initFixture(t)
+
+	// 旧数据中 controller-a 控制两个 DID，保留注册最早的一个；停用与被监护 DID 不建立索引
+	older := types.DidDocument{Did: types.GenerateDid("controller-a", "nullifier-old"), Controller: "controller-a", CreatedHeight: 5}
+	newer := types.DidDocument{Did: types.GenerateDid("controller-a", "nullifier-new"), Controller: "controller-a", CreatedHeight: 10}
+	other := types.DidDocument{Did: types.GenerateDid("controller-b", "nullifier-b"), Controller: "controller-b", CreatedHeight: 7}
+	deactivated := types.DidDocument{Did: types.GenerateDid("controller-c", "nullifier-c"), Controller: "controller-c", DeactivatedHeight: 8}
+	dependent := types.DidDocument{Did: types.GenerateDid(other.Did, "nullifier-d"), Guardian: other.Did}
+	for _, doc := range []types.DidDocument{older, newer, other, deactivated, dependent} {
+		require.NoError(t, f.keeper.DidDocument.Set(f.ctx, doc.Did, doc))
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate7to8(sdk.UnwrapSDKContext(f.ctx)))
+
+	for controller, did := range map[string]string{"controller-a": older.Did, "controller-b": other.Did} {
+		indexed, err := f.keeper.ControllerDid.Get(f.ctx, controller)
+		require.NoError(t, err)
+		require.Equal(t, did, indexed)
+	}
+	has, err := f.keeper.ControllerDid.Has(f.ctx, "controller-c")
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.ControllerDid.Has(f.ctx, "")
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dtc/x/identity/types"
+)
+
+// BondAttestor 将保证金转入模块账户。首次加入时登记签名公钥，之后可追加保证金；
+// 质押后的总保证金必须不低于参数规定的下限
+func (k msgServer) BondAttestor(ctx context.Context, msg *types.MsgBondAttestor) (*types.MsgBondAttestorResponse, error) {
+	creator, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid bond amount %s", msg.Amount)
+	}
+	if msg.Amount.Denom != sdk.DefaultBondDenom {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "bond denom must be %s", sdk.DefaultBondDenom)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load params: %s", err))
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	attestor, err := k.Attestor.Get(ctx, msg.Creator)
+	switch {
+	case err == nil:
+		if attestor.Status == types.ATTESTOR_STATUS_UNBONDING {
+			return nil, errorsmod.Wrap(types.ErrAttestorUnbonding, msg.Creator)
+		}
+		if msg.Pubkey != "" && msg.Pubkey != attestor.Pubkey {
+			return nil, errorsmod.Wrap(types.ErrInvalidAttestor, "pubkey cannot be changed while bonded")
+		}
+	case errors.Is(err, collections.ErrNotFound):
+		if err := validateAttestorPubkey(msg.Pubkey); err != nil {
+			return nil, err
+		}
+		taken, err := k.AttestorByPubkey.Has(ctx, msg.Pubkey)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		if taken {
+			return nil, errorsmod.Wrap(types.ErrInvalidAttestor, "pubkey already registered")
+		}
+		attestor = types.Attestor{
+			Address:      msg.Creator,
+			Pubkey:       msg.Pubkey,
+			Bond:         sdk.NewCoin(msg.Amount.Denom, math.ZeroInt()),
+			Status:       types.ATTESTOR_STATUS_BONDED,
+			BondedHeight: sdkCtx.BlockHeight(),
+			Slashed:      sdk.NewCoin(msg.Amount.Denom, math.ZeroInt()),
+		}
+	default:
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	bond := attestor.Bond.Add(msg.Amount)
+	if bond.Amount.LT(math.NewIntFromUint64(params.AttestorMinBond)) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientBond, "bond %s, min %d%s", bond, params.AttestorMinBond, sdk.DefaultBondDenom)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+
+	attestor.Bond = bond
+	if err := k.Attestor.Set(ctx, msg.Creator, attestor); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.AttestorByPubkey.Set(ctx, attestor.Pubkey, msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAttestorBonded,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyAttestor, attestor.Pubkey),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyBond, attestor.Bond.String()),
+		),
+	)
+
+	return &types.MsgBondAttestorResponse{}, nil
+}
+
+// UnbondAttestor 使认证方进入解除质押期，期间其签名不再被接受但保证金仍可被罚没，
+// 到期后由 EndBlocker 退还剩余保证金
+func (k msgServer) UnbondAttestor(ctx context.Context, msg *types.MsgUnbondAttestor) (*types.MsgUnbondAttestorResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	attestor, err := k.Attestor.Get(ctx, msg.Creator)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(types.ErrAttestorNotFound, msg.Creator)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if attestor.Status == types.ATTESTOR_STATUS_UNBONDING {
+		return nil, errorsmod.Wrap(types.ErrAttestorUnbonding, msg.Creator)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load params: %s", err))
+	}
+
+	if err := k.beginAttestorUnbonding(ctx, attestor, params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnbondAttestorResponse{CompletionHeight: sdk.UnwrapSDKContext(ctx).BlockHeight() + params.AttestorUnbondingPeriod}, nil
+}
+
+// SlashAttestor 由治理按比例罚没认证方保证金，用于处理证据之外查实的虚假认证
+func (k msgServer) SlashAttestor(ctx context.Context, msg *types.MsgSlashAttestor) (*types.MsgSlashAttestorResponse, error) {
+	authority, err := k.addressCodec.StringToBytes(msg.Authority)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid authority address")
+	}
+	if !bytes.Equal(k.GetAuthority(), authority) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
+		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, msg.Authority)
+	}
+	if msg.Fraction.IsNil() || !msg.Fraction.IsPositive() || msg.Fraction.GT(math.LegacyOneDec()) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAttestor, "slash fraction must be in (0, 1], got %s", msg.Fraction)
+	}
+
+	slashed, err := k.slashAttestor(ctx, msg.Attestor, msg.Fraction, msg.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSlashAttestorResponse{Slashed: slashed}, nil
+}
@@ -0,0 +1,265 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	dtctypes "dtc/x/dtc/types"
+	"dtc/x/identity/keeper"
+	"dtc/x/identity/types"
+)
+
+func bondCoin(amount uint64) sdk.Coin {
+	return sdk.NewCoin(sdk.DefaultBondDenom, math.NewIntFromUint64(amount))
+}
+
+func TestBondAttestor(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(5)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	alice, err := f.addressCodec.BytesToString([]byte("aliceAddr___________________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString([]byte("bobAddr_____________________"))
+	require.NoError(t, err)
+	f.bankKeeper.accounts[alice] = sdk.NewCoins(bondCoin(3 * types.DefaultAttestorMinBond))
+	f.bankKeeper.accounts[bob] = sdk.NewCoins(bondCoin(3 * types.DefaultAttestorMinBond))
+
+	pubkey := hex.EncodeToString(secp256k1.GenPrivKey().PubKey().Bytes())
+
+	tests := []struct {
+		desc string
+		msg  *types.MsgBondAttestor
+		err  error
+	}{
+		{desc: "invalid pubkey", msg: &types.MsgBondAttestor{Creator: alice, Pubkey: "zz", Amount: bondCoin(types.DefaultAttestorMinBond)}, err: types.ErrInvalidAttestor},
+		{desc: "wrong denom", msg: &types.MsgBondAttestor{Creator: alice, Pubkey: pubkey, Amount: sdk.NewInt64Coin("uatom", 1)}, err: sdkerrors.ErrInvalidCoins},
+		{desc: "below minimum", msg: &types.MsgBondAttestor{Creator: alice, Pubkey: pubkey, Amount: bondCoin(types.DefaultAttestorMinBond - 1)}, err: types.ErrInsufficientBond},
+		{desc: "bond", msg: &types.MsgBondAttestor{Creator: alice, Pubkey: pubkey, Amount: bondCoin(types.DefaultAttestorMinBond)}},
+		{desc: "top up", msg: &types.MsgBondAttestor{Creator: alice, Amount: bondCoin(1)}},
+		{desc: "pubkey taken", msg: &types.MsgBondAttestor{Creator: bob, Pubkey: pubkey, Amount: bondCoin(types.DefaultAttestorMinBond)}, err: types.ErrInvalidAttestor},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.BondAttestor(ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	attestor, err := f.keeper.Attestor.Get(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, types.ATTESTOR_STATUS_BONDED, attestor.Status)
+	require.Equal(t, bondCoin(types.DefaultAttestorMinBond+1), attestor.Bond)
+	require.Equal(t, int64(5), attestor.BondedHeight)
+	require.Equal(t, sdk.NewCoins(bondCoin(types.DefaultAttestorMinBond+1)), f.bankKeeper.modules[types.ModuleName])
+
+	address, err := f.keeper.AttestorByPubkey.Get(ctx, pubkey)
+	require.NoError(t, err)
+	require.Equal(t, alice, address)
+}
+
+func TestUnbondAttestor(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	alice, err := f.addressCodec.BytesToString([]byte("aliceAddr___________________"))
+	require.NoError(t, err)
+	f.bankKeeper.accounts[alice] = sdk.NewCoins(bondCoin(types.DefaultAttestorMinBond))
+	pubkey := hex.EncodeToString(secp256k1.GenPrivKey().PubKey().Bytes())
+
+	_, err = srv.UnbondAttestor(ctx, &types.MsgUnbondAttestor{Creator: alice})
+	require.ErrorIs(t, err, types.ErrAttestorNotFound)
+
+	_, err = srv.BondAttestor(ctx, &types.MsgBondAttestor{Creator: alice, Pubkey: pubkey, Amount: bondCoin(types.DefaultAttestorMinBond)})
+	require.NoError(t, err)
+
+	res, err := srv.UnbondAttestor(ctx, &types.MsgUnbondAttestor{Creator: alice})
+	require.NoError(t, err)
+	require.Equal(t, 10+types.DefaultAttestorUnbondingPeriod, res.CompletionHeight)
+
+	_, err = srv.UnbondAttestor(ctx, &types.MsgUnbondAttestor{Creator: alice})
+	require.ErrorIs(t, err, types.ErrAttestorUnbonding)
+	_, err = srv.BondAttestor(ctx, &types.MsgBondAttestor{Creator: alice, Amount: bondCoin(1)})
+	require.ErrorIs(t, err, types.ErrAttestorUnbonding)
+
+	// 解除质押期间仍可被罚没
+	slashRes, err := srv.SlashAttestor(ctx, &types.MsgSlashAttestor{
+		Authority: authtypes.NewModuleAddress(types.GovModuleName).String(),
+		Attestor:  alice,
+		Fraction:  math.LegacyNewDecWithPrec(5, 1),
+		Reason:    "false attestation",
+	})
+	require.NoError(t, err)
+	require.Equal(t, bondCoin(types.DefaultAttestorMinBond/2), slashRes.Slashed)
+
+	// 到期前保证金不退还
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(res.CompletionHeight-1)))
+	_, err = f.keeper.Attestor.Get(ctx, alice)
+	require.NoError(t, err)
+	require.True(t, f.bankKeeper.accounts[alice].IsZero())
+
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(res.CompletionHeight)))
+	has, err := f.keeper.Attestor.Has(ctx, alice)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.AttestorByPubkey.Has(ctx, pubkey)
+	require.NoError(t, err)
+	require.False(t, has)
+	require.Equal(t, sdk.NewCoins(bondCoin(types.DefaultAttestorMinBond/2)), f.bankKeeper.accounts[alice])
+	require.True(t, f.bankKeeper.modules[types.ModuleName].IsZero())
+	require.Equal(t, sdk.NewCoins(bondCoin(types.DefaultAttestorMinBond/2)), f.bankKeeper.modules[types.GBDPPoolModuleName])
+}
+
+func TestSlashAttestor(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	authority := authtypes.NewModuleAddress(types.GovModuleName).String()
+
+	alice, err := f.addressCodec.BytesToString([]byte("aliceAddr___________________"))
+	require.NoError(t, err)
+	f.bankKeeper.accounts[alice] = sdk.NewCoins(bondCoin(types.DefaultAttestorMinBond))
+	_, err = srv.BondAttestor(ctx, &types.MsgBondAttestor{Creator: alice, Pubkey: hex.EncodeToString(secp256k1.GenPrivKey().PubKey().Bytes()), Amount: bondCoin(types.DefaultAttestorMinBond)})
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc string
+		msg  *types.MsgSlashAttestor
+		err  error
+	}{
+		{desc: "invalid authority", msg: &types.MsgSlashAttestor{Authority: alice, Attestor: alice, Fraction: math.LegacyNewDecWithPrec(1, 1)}, err: types.ErrInvalidSigner},
+		{desc: "zero fraction", msg: &types.MsgSlashAttestor{Authority: authority, Attestor: alice, Fraction: math.LegacyZeroDec()}, err: types.ErrInvalidAttestor},
+		{desc: "fraction above one", msg: &types.MsgSlashAttestor{Authority: authority, Attestor: alice, Fraction: math.LegacyNewDec(2)}, err: types.ErrInvalidAttestor},
+		{desc: "unknown attestor", msg: &types.MsgSlashAttestor{Authority: authority, Attestor: authority, Fraction: math.LegacyNewDecWithPrec(1, 1)}, err: types.ErrAttestorNotFound},
+		{desc: "slash", msg: &types.MsgSlashAttestor{Authority: authority, Attestor: alice, Fraction: math.LegacyNewDecWithPrec(1, 1), Reason: "false attestation"}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SlashAttestor(ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	attestor, err := f.keeper.Attestor.Get(ctx, alice)
+	require.NoError(t, err)
+	require.Equal(t, bondCoin(types.DefaultAttestorMinBond/10*9), attestor.Bond)
+	require.Equal(t, bondCoin(types.DefaultAttestorMinBond/10), attestor.Slashed)
+	require.Equal(t, sdk.NewCoins(bondCoin(types.DefaultAttestorMinBond/10)), f.bankKeeper.modules[types.GBDPPoolModuleName])
+}
+
+func TestAttestorSignature(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	authority := authtypes.NewModuleAddress(types.GovModuleName).String()
+
+	attestorAddr, err := f.addressCodec.BytesToString([]byte("attestorAddr________________"))
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString([]byte("aliceAddr___________________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString([]byte("bobAddr_____________________"))
+	require.NoError(t, err)
+
+	privKey := secp256k1.GenPrivKey()
+	pubkey := hex.EncodeToString(privKey.PubKey().Bytes())
+	sign := func(controller, name string) *types.MsgCreateDidDocument {
+		nullifier, commitment := faceBlinding(name)
+		sig, err := privKey.Sign([]byte(types.GenerateDid(controller, nullifier) + controller + nullifier + commitment))
+		require.NoError(t, err)
+		return &types.MsgCreateDidDocument{Creator: controller, FaceNullifier: nullifier, FaceCommitment: commitment, Signature: sig, AttestorPubkey: pubkey}
+	}
+
+	// 未质押的认证方签名不被接受
+	_, err = srv.CreateDidDocument(ctx, sign(alice, "alice"))
+	require.ErrorIs(t, err, types.ErrAttestorNotFound)
+
+	f.bankKeeper.accounts[attestorAddr] = sdk.NewCoins(bondCoin(types.DefaultAttestorMinBond))
+	_, err = srv.BondAttestor(ctx, &types.MsgBondAttestor{Creator: attestorAddr, Pubkey: pubkey, Amount: bondCoin(types.DefaultAttestorMinBond)})
+	require.NoError(t, err)
+
+	// 未持有认证方角色的质押方签名不被接受
+	_, err = srv.CreateDidDocument(ctx, sign(alice, "alice"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	f.roleKeeper.grant(dtctypes.ROLE_ATTESTOR, attestorAddr)
+	res, err := srv.CreateDidDocument(ctx, sign(alice, "alice"))
+	require.NoError(t, err)
+	doc, err := f.keeper.DidDocument.Get(ctx, res.Did)
+	require.NoError(t, err)
+	require.Equal(t, pubkey, doc.Attestor)
+
+	// 签名须与声明的认证方公钥匹配
+	forged := sign(bob, "bob")
+	forged.Signature = make([]byte, 64)
+	_, err = srv.CreateDidDocument(ctx, forged)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// 保证金被罚没至下限以下后强制进入解除质押期，签名不再被接受
+	_, err = srv.SlashAttestor(ctx, &types.MsgSlashAttestor{Authority: authority, Attestor: attestorAddr, Fraction: math.LegacyNewDecWithPrec(1, 1)})
+	require.NoError(t, err)
+	_, err = srv.CreateDidDocument(ctx, sign(bob, "bob"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	attestor, err := f.keeper.Attestor.Get(ctx, attestorAddr)
+	require.NoError(t, err)
+	require.Equal(t, types.ATTESTOR_STATUS_UNBONDING, attestor.Status)
+	require.Equal(t, ctx.BlockHeight()+types.DefaultAttestorUnbondingPeriod, attestor.UnbondingCompletionHeight)
+	queued, err := f.keeper.AttestorUnbondingQueue.Has(ctx, collections.Join(attestor.UnbondingCompletionHeight, attestorAddr))
+	require.NoError(t, err)
+	require.True(t, queued)
+}
+
+func TestDuplicateIdentityEvidence_SlashesAttestor(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	issuer := f.bondTestAttestor(t)
+	handler := keeper.NewDuplicateIdentityEvidenceHandler(f.keeper, &mockCreditKeeper{liabilities: map[string]uint64{}})
+
+	attestorAddr, err := f.addressCodec.BytesToString([]byte("attestorAddr________________"))
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString([]byte("aliceAddr___________________"))
+	require.NoError(t, err)
+	aliceAgain, err := f.addressCodec.BytesToString([]byte("aliceAgainAddr______________"))
+	require.NoError(t, err)
+
+	privKey := secp256k1.GenPrivKey()
+	f.bankKeeper.accounts[attestorAddr] = sdk.NewCoins(bondCoin(types.DefaultAttestorMinBond))
+	f.roleKeeper.grant(dtctypes.ROLE_ATTESTOR, attestorAddr)
+	_, err = srv.BondAttestor(ctx, &types.MsgBondAttestor{Creator: attestorAddr, Pubkey: hex.EncodeToString(privKey.PubKey().Bytes()), Amount: bondCoin(types.DefaultAttestorMinBond)})
+	require.NoError(t, err)
+
+	nullifier, commitment := faceBlinding("alice")
+	original, err := srv.CreateDidDocument(ctx.WithBlockHeight(10), issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: alice, FaceNullifier: nullifier, FaceCommitment: commitment}))
+	require.NoError(t, err)
+	nullifier, commitment = faceBlinding("alice-again")
+	sig, err := privKey.Sign([]byte(types.GenerateDid(aliceAgain, nullifier) + aliceAgain + nullifier + commitment))
+	require.NoError(t, err)
+	duplicate, err := srv.CreateDidDocument(ctx.WithBlockHeight(20), &types.MsgCreateDidDocument{Creator: aliceAgain, FaceNullifier: nullifier, FaceCommitment: commitment, Signature: sig, AttestorPubkey: hex.EncodeToString(privKey.PubKey().Bytes())})
+	require.NoError(t, err)
+
+	evidenceSig := issuer.sign(t, string(types.DuplicateIdentitySignBytes(original.Did, duplicate.Did, 30)))
+	require.NoError(t, handler(ctx, &types.DuplicateIdentityEvidence{FirstDid: original.Did, SecondDid: duplicate.Did, Height: 30, Signature: evidenceSig, AttestorPubkey: issuer.pubkey}))
+
+	attestor, err := f.keeper.Attestor.Get(ctx, attestorAddr)
+	require.NoError(t, err)
+	slashed := types.DefaultAttestorSlashFraction.MulInt(math.NewIntFromUint64(types.DefaultAttestorMinBond)).TruncateInt()
+	require.Equal(t, slashed, attestor.Slashed.Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, slashed)), f.bankKeeper.modules[types.GBDPPoolModuleName])
+}
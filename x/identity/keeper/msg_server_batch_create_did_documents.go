@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+
+	"dtc/x/identity/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// BatchCreateDidDocuments 批量注册 DID。
+// 认证方只对全部条目的 Merkle 根签名一次；签名通过后逐条注册，
+// 单个条目失败（格式错误、DID 或 nullifier 已存在）不影响其他条目，结果按条目返回。
+func (k msgServer) BatchCreateDidDocuments(ctx context.Context, msg *types.MsgBatchCreateDidDocuments) (*types.MsgBatchCreateDidDocumentsResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load params: %s", err))
+	}
+	if len(msg.Entries) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidBatch, "batch has no entries")
+	}
+	if uint64(len(msg.Entries)) > params.MaxBatchSize {
+		return nil, errorsmod.Wrapf(types.ErrBatchTooLarge, "batch has %d entries, max %d", len(msg.Entries), params.MaxBatchSize)
+	}
+
+	// 叶子按条目顺序计算，DID 统一使用链上派生的规范形式，
+	// 因此条目自身的格式错误只会在注册阶段体现为单条失败
+	docs := make([]types.DidDocument, len(msg.Entries))
+	entryErrs := make([]error, len(msg.Entries))
+	leaves := make([][]byte, len(msg.Entries))
+	for i, entry := range msg.Entries {
+		docs[i], entryErrs[i] = newDidDocument(msg.Creator, entry)
+
+		controller := entry.Controller
+		if controller == "" {
+			controller = msg.Creator
+		}
+		did := types.GenerateDid(controller, entry.FaceNullifier)
+		leaves[i] = types.DidDocumentEntryLeaf(did, controller, entry.FaceNullifier, entry.FaceCommitment)
+	}
+
+	root := types.MerkleRoot(leaves)
+	attestor, err := k.verifyAttestorSignature(ctx, msg.AttestorPubkey, root, msg.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	results := make([]types.BatchCreateDidResult, len(msg.Entries))
+	for i := range msg.Entries {
+		results[i] = types.BatchCreateDidResult{Index: uint32(i), Did: docs[i].Did}
+
+		err := entryErrs[i]
+		if err == nil {
+			// 每个条目在独立的缓存上下文中写入，失败时不会留下部分状态
+			cacheCtx, write := sdkCtx.CacheContext()
+			if err = k.storeDidDocument(cacheCtx, docs[i], attestor); err == nil {
+				write()
+			}
+		}
+
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		results[i].Success = true
+	}
+
+	return &types.MsgBatchCreateDidDocumentsResponse{
+		MerkleRoot: hex.EncodeToString(root),
+		Results:    results,
+	}, nil
+}
@@ -0,0 +1,169 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"strconv"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/identity/keeper"
+	"dtc/x/identity/types"
+)
+
+// batchRoot 按链上规则计算批量条目的 Merkle 根
+func batchRoot(creator string, entries []types.DidDocumentEntry) []byte {
+	leaves := make([][]byte, len(entries))
+	for i, e := range entries {
+		controller := e.Controller
+		if controller == "" {
+			controller = creator
+		}
+		leaves[i] = types.DidDocumentEntryLeaf(types.GenerateDid(controller, e.FaceNullifier), controller, e.FaceNullifier, e.FaceCommitment)
+	}
+	return types.MerkleRoot(leaves)
+}
+
+func TestBatchCreateDidDocuments(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+
+	issuer := f.bondTestAttestor(t)
+
+	existing, existingCommitment := faceBlinding("existing")
+	_, err = srv.CreateDidDocument(f.ctx, issuer.signDidDocument(t, &types.MsgCreateDidDocument{
+		Creator:        creator,
+		FaceNullifier:  existing,
+		FaceCommitment: existingCommitment,
+	}))
+	require.NoError(t, err)
+
+	var entries []types.DidDocumentEntry
+	for i := 0; i < 3; i++ {
+		nullifier, commitment := faceBlinding(strconv.Itoa(i))
+		controller, err := f.addressCodec.BytesToString([]byte("controller" + strconv.Itoa(i) + "_________________"))
+		require.NoError(t, err)
+		entries = append(entries, types.DidDocumentEntry{Controller: controller, FaceNullifier: nullifier, FaceCommitment: commitment})
+	}
+	// 与已注册身份重复的 nullifier
+	entries = append(entries, types.DidDocumentEntry{Controller: entries[0].Controller + "x", FaceNullifier: existing, FaceCommitment: existingCommitment})
+	// 与同批次前一条目重复的 nullifier
+	entries = append(entries, types.DidDocumentEntry{Controller: entries[1].Controller + "x", FaceNullifier: entries[1].FaceNullifier, FaceCommitment: entries[1].FaceCommitment})
+	// 未盲化的 faceHash
+	entries = append(entries, types.DidDocumentEntry{FaceNullifier: "face", FaceCommitment: "face"})
+
+	root := batchRoot(creator, entries)
+	resp, err := srv.BatchCreateDidDocuments(f.ctx, &types.MsgBatchCreateDidDocuments{
+		Creator:        creator,
+		Entries:        entries,
+		Signature:      issuer.sign(t, string(root)),
+		AttestorPubkey: issuer.pubkey,
+	})
+	require.NoError(t, err)
+	require.Equal(t, hex.EncodeToString(root), resp.MerkleRoot)
+	require.Len(t, resp.Results, len(entries))
+
+	for i, res := range resp.Results {
+		require.Equal(t, uint32(i), res.Index)
+		if i < 3 {
+			require.True(t, res.Success, res.Error)
+			doc, err := f.keeper.DidDocument.Get(f.ctx, res.Did)
+			require.NoError(t, err)
+			require.Equal(t, entries[i].Controller, doc.Controller)
+			indexed, err := f.keeper.FaceNullifierToIndex.Get(f.ctx, entries[i].FaceNullifier)
+			require.NoError(t, err)
+			require.Equal(t, res.Did, indexed)
+			continue
+		}
+		require.False(t, res.Success)
+		require.NotEmpty(t, res.Error)
+	}
+	require.Contains(t, resp.Results[3].Error, types.ErrDuplicateFaceHash.Error())
+	require.Contains(t, resp.Results[4].Error, types.ErrDuplicateFaceHash.Error())
+	require.Contains(t, resp.Results[5].Error, types.ErrInvalidFaceBlind.Error())
+
+	// 失败条目不会写入任何状态
+	has, err := f.keeper.DidDocument.Has(f.ctx, types.GenerateDid(entries[4].Controller, entries[4].FaceNullifier))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestBatchCreateDidDocumentsRejected(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	testSig := make([]byte, 64)
+	issuer := f.bondTestAttestor(t)
+
+	// 持有认证方角色但未质押的签名方，包括参数中的管理员公钥
+	adminPrivKeyBytes, err := hex.DecodeString("da22b1840dbce304ed6b3e46da143e1f15d9e3012dd31446b0277af6c409cd57")
+	require.NoError(t, err)
+	adminPrivKey := secp256k1.PrivKey(adminPrivKeyBytes)
+	f.grantAttestorKey(t, adminPrivKey.PubKey().Address())
+
+	params := types.DefaultParams()
+	params.MaxBatchSize = 2
+	params.AdminPubkey = hex.EncodeToString(adminPrivKey.PubKey().Bytes())
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	entry := func(seed string) types.DidDocumentEntry {
+		nullifier, commitment := faceBlinding(seed)
+		return types.DidDocumentEntry{FaceNullifier: nullifier, FaceCommitment: commitment}
+	}
+	unbondedEntries := []types.DidDocumentEntry{entry("a")}
+	unbondedSig, err := adminPrivKey.Sign(batchRoot(creator, unbondedEntries))
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc string
+		msg  *types.MsgBatchCreateDidDocuments
+		err  error
+	}{
+		{
+			desc: "invalid address",
+			msg:  &types.MsgBatchCreateDidDocuments{Creator: "invalid", Entries: []types.DidDocumentEntry{entry("a")}, Signature: testSig},
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc: "empty batch",
+			msg:  &types.MsgBatchCreateDidDocuments{Creator: creator, Signature: testSig},
+			err:  types.ErrInvalidBatch,
+		},
+		{
+			desc: "exceeds max batch size",
+			msg:  &types.MsgBatchCreateDidDocuments{Creator: creator, Entries: []types.DidDocumentEntry{entry("a"), entry("b"), entry("c")}, Signature: testSig},
+			err:  types.ErrBatchTooLarge,
+		},
+		{
+			desc: "legacy test signature",
+			msg:  &types.MsgBatchCreateDidDocuments{Creator: creator, Entries: []types.DidDocumentEntry{entry("a")}, Signature: []byte("7369676e6174757265")},
+			err:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc: "missing attestor pubkey",
+			msg:  &types.MsgBatchCreateDidDocuments{Creator: creator, Entries: unbondedEntries, Signature: unbondedSig},
+			err:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc: "unbonded attestor",
+			msg:  &types.MsgBatchCreateDidDocuments{Creator: creator, Entries: unbondedEntries, Signature: unbondedSig, AttestorPubkey: params.AdminPubkey},
+			err:  types.ErrAttestorNotFound,
+		},
+		{
+			desc: "invalid signature",
+			msg:  &types.MsgBatchCreateDidDocuments{Creator: creator, Entries: []types.DidDocumentEntry{entry("a")}, Signature: make([]byte, 64), AttestorPubkey: issuer.pubkey},
+			err:  sdkerrors.ErrUnauthorized,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.BatchCreateDidDocuments(f.ctx, tc.msg)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dtc/x/identity/types"
+)
+
+// getControlledDidDocument 解析 DID 并校验 creator 是否为其当前 controller
+func (k msgServer) getControlledDidDocument(ctx context.Context, creator, rawDid string) (types.DidDocument, error) {
+	did, err := k.ResolveDid(ctx, rawDid)
+	if err != nil {
+		return types.DidDocument{}, err
+	}
+
+	val, err := k.DidDocument.Get(ctx, did)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.DidDocument{}, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
+		}
+		return types.DidDocument{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if creator != val.Controller {
+		return types.DidDocument{}, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect controller")
+	}
+	if val.IsDeactivated() {
+		return types.DidDocument{}, errorsmod.Wrap(types.ErrDidDeactivated, did)
+	}
+	return val, nil
+}
+
+// ProposeControllerTransfer 由当前 controller 提议转移控制权，新的提议会覆盖尚未接受的旧提议
+func (k msgServer) ProposeControllerTransfer(ctx context.Context, msg *types.MsgProposeControllerTransfer) (*types.MsgProposeControllerTransferResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.NewController); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid new controller address: %s", err))
+	}
+
+	val, err := k.getControlledDidDocument(ctx, msg.Creator, msg.Did)
+	if err != nil {
+		return nil, err
+	}
+	if msg.NewController == val.Controller {
+		return nil, errorsmod.Wrap(types.ErrInvalidControllerTransfer, "new controller is already the controller")
+	}
+	// 组织 DID 只能转移给另一个 x/group 策略账户
+	if val.IsOrganisation() && !k.isGroupPolicy(ctx, msg.NewController) {
+		return nil, errorsmod.Wrap(types.ErrNotGroupPolicy, msg.NewController)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load params: %s", err))
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	transfer := types.PendingControllerTransfer{
+		Did:                val.Did,
+		CurrentController:  val.Controller,
+		ProposedController: msg.NewController,
+		ProposedHeight:     sdkCtx.BlockHeight(),
+		ExpiryHeight:       sdkCtx.BlockHeight() + params.ControllerTransferPeriod,
+	}
+	if err := k.PendingControllerTransfer.Set(ctx, val.Did, transfer); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeControllerTransferProposed,
+			sdk.NewAttribute(types.AttributeKeyDid, val.Did),
+			sdk.NewAttribute(types.AttributeKeyCurrentController, val.Controller),
+			sdk.NewAttribute(types.AttributeKeyProposedController, msg.NewController),
+			sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatInt(transfer.ExpiryHeight, 10)),
+		),
+	)
+
+	return &types.MsgProposeControllerTransferResponse{ExpiryHeight: transfer.ExpiryHeight}, nil
+}
+
+// AcceptControllerTransfer 由被提议的新 controller 在到期前接受控制权
+func (k msgServer) AcceptControllerTransfer(ctx context.Context, msg *types.MsgAcceptControllerTransfer) (*types.MsgAcceptControllerTransferResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	did, err := k.ResolveDid(ctx, msg.Did)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := k.PendingControllerTransfer.Get(ctx, did)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(types.ErrTransferNotFound, did)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if msg.Creator != transfer.ProposedController {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "signer is not the proposed controller")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.BlockHeight() > transfer.ExpiryHeight {
+		return nil, errorsmod.Wrapf(types.ErrTransferExpired, "expired at height %d", transfer.ExpiryHeight)
+	}
+
+	val, err := k.DidDocument.Get(ctx, did)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if val.Controller != transfer.CurrentController {
+		return nil, errorsmod.Wrap(types.ErrInvalidControllerTransfer, "controller changed since the transfer was proposed")
+	}
+
+	// 一个地址只能控制一个 DID，按地址查找 DID 的逻辑依赖这一点
+	if _, found := k.GetDidDocument(sdkCtx, msg.Creator); found {
+		return nil, errorsmod.Wrap(types.ErrInvalidControllerTransfer, "proposed controller already controls a did")
+	}
+
+	val.Controller = transfer.ProposedController
+	if err := k.DidDocument.Set(ctx, did, val); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update didDocument")
+	}
+	if err := k.removeControllerDid(ctx, transfer.CurrentController, did); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.ControllerDid.Set(ctx, val.Controller, did); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.PendingControllerTransfer.Remove(ctx, did); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeControllerTransferAccepted,
+			sdk.NewAttribute(types.AttributeKeyDid, did),
+			sdk.NewAttribute(types.AttributeKeyCurrentController, transfer.CurrentController),
+			sdk.NewAttribute(types.AttributeKeyProposedController, transfer.ProposedController),
+		),
+	)
+
+	return &types.MsgAcceptControllerTransferResponse{}, nil
+}
+
+// CancelControllerTransfer 由当前 controller 取消待处理的转移
+func (k msgServer) CancelControllerTransfer(ctx context.Context, msg *types.MsgCancelControllerTransfer) (*types.MsgCancelControllerTransferResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	val, err := k.getControlledDidDocument(ctx, msg.Creator, msg.Did)
+	if err != nil {
+		return nil, err
+	}
+
+	transfer, err := k.PendingControllerTransfer.Get(ctx, val.Did)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(types.ErrTransferNotFound, val.Did)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.PendingControllerTransfer.Remove(ctx, val.Did); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeControllerTransferCancelled,
+			sdk.NewAttribute(types.AttributeKeyDid, val.Did),
+			sdk.NewAttribute(types.AttributeKeyCurrentController, transfer.CurrentController),
+			sdk.NewAttribute(types.AttributeKeyProposedController, transfer.ProposedController),
+		),
+	)
+
+	return &types.MsgCancelControllerTransferResponse{}, nil
+}
@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/identity/keeper"
+	"dtc/x/identity/types"
+)
+
+func TestControllerTransfer(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(100)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	issuer := f.bondTestAttestor(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.ControllerTransferPeriod = 10
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	controller, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	newController, err := f.addressCodec.BytesToString([]byte("newController_______________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr___________________"))
+	require.NoError(t, err)
+	created, err := srv.CreateDidDocument(ctx, issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: controller}))
+	require.NoError(t, err)
+
+	// UpdateDidDocument 不能直接修改 controller
+	_, err = srv.UpdateDidDocument(ctx, &types.MsgUpdateDidDocument{Creator: controller, Did: created.Did, Controller: newController})
+	require.ErrorIs(t, err, types.ErrControllerChange)
+
+	proposeTests := []struct {
+		desc string
+		msg  *types.MsgProposeControllerTransfer
+		err  error
+	}{
+		{desc: "invalid new controller", msg: &types.MsgProposeControllerTransfer{Creator: controller, Did: created.Did, NewController: ""}, err: sdkerrors.ErrInvalidAddress},
+		{desc: "not controller", msg: &types.MsgProposeControllerTransfer{Creator: other, Did: created.Did, NewController: newController}, err: sdkerrors.ErrUnauthorized},
+		{desc: "same controller", msg: &types.MsgProposeControllerTransfer{Creator: controller, Did: created.Did, NewController: controller}, err: types.ErrInvalidControllerTransfer},
+		{desc: "completed", msg: &types.MsgProposeControllerTransfer{Creator: controller, Did: created.Did, NewController: newController}},
+	}
+	for _, tc := range proposeTests {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := srv.ProposeControllerTransfer(ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, int64(110), resp.ExpiryHeight)
+		})
+	}
+
+	pending, err := qs.GetPendingControllerTransfer(ctx, &types.QueryGetPendingControllerTransferRequest{Did: created.Did})
+	require.NoError(t, err)
+	require.Equal(t, types.PendingControllerTransfer{
+		Did:                created.Did,
+		CurrentController:  controller,
+		ProposedController: newController,
+		ProposedHeight:     100,
+		ExpiryHeight:       110,
+	}, pending.PendingControllerTransfer)
+
+	// 提议期间 controller 不变
+	doc, err := f.keeper.DidDocument.Get(ctx, created.Did)
+	require.NoError(t, err)
+	require.Equal(t, controller, doc.Controller)
+
+	// 只有被提议的地址可以接受，且必须在到期前
+	_, err = srv.AcceptControllerTransfer(ctx, &types.MsgAcceptControllerTransfer{Creator: other, Did: created.Did})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.AcceptControllerTransfer(ctx.WithBlockHeight(111), &types.MsgAcceptControllerTransfer{Creator: newController, Did: created.Did})
+	require.ErrorIs(t, err, types.ErrTransferExpired)
+
+	_, err = srv.AcceptControllerTransfer(ctx.WithBlockHeight(110), &types.MsgAcceptControllerTransfer{Creator: newController, Did: created.Did})
+	require.NoError(t, err)
+	doc, err = f.keeper.DidDocument.Get(ctx, created.Did)
+	require.NoError(t, err)
+	require.Equal(t, newController, doc.Controller)
+
+	// controller 索引随控制权转移
+	_, found := f.keeper.GetDidDocument(ctx, controller)
+	require.False(t, found)
+	doc, found = f.keeper.GetDidDocument(ctx, newController)
+	require.True(t, found)
+	require.Equal(t, created.Did, doc.Did)
+
+	_, err = qs.GetPendingControllerTransfer(ctx, &types.QueryGetPendingControllerTransferRequest{Did: created.Did})
+	require.Error(t, err)
+	_, err = srv.AcceptControllerTransfer(ctx, &types.MsgAcceptControllerTransfer{Creator: newController, Did: created.Did})
+	require.ErrorIs(t, err, types.ErrTransferNotFound)
+}
+
+func TestCancelControllerTransfer(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	issuer := f.bondTestAttestor(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	controller, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	newController, err := f.addressCodec.BytesToString([]byte("newController_______________"))
+	require.NoError(t, err)
+	created, err := srv.CreateDidDocument(f.ctx, issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: controller}))
+	require.NoError(t, err)
+
+	_, err = srv.CancelControllerTransfer(f.ctx, &types.MsgCancelControllerTransfer{Creator: controller, Did: created.Did})
+	require.ErrorIs(t, err, types.ErrTransferNotFound)
+
+	_, err = srv.ProposeControllerTransfer(f.ctx, &types.MsgProposeControllerTransfer{Creator: controller, Did: created.Did, NewController: newController})
+	require.NoError(t, err)
+	list, err := qs.ListPendingControllerTransfer(f.ctx, &types.QueryAllPendingControllerTransferRequest{})
+	require.NoError(t, err)
+	require.Len(t, list.PendingControllerTransfer, 1)
+
+	// 被提议方不能取消
+	_, err = srv.CancelControllerTransfer(f.ctx, &types.MsgCancelControllerTransfer{Creator: newController, Did: created.Did})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.CancelControllerTransfer(f.ctx, &types.MsgCancelControllerTransfer{Creator: controller, Did: created.Did})
+	require.NoError(t, err)
+	_, err = srv.AcceptControllerTransfer(f.ctx, &types.MsgAcceptControllerTransfer{Creator: newController, Did: created.Did})
+	require.ErrorIs(t, err, types.ErrTransferNotFound)
+}
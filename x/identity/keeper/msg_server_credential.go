@@ -0,0 +1,116 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dtc/x/identity/types"
+)
+
+// IssueCredential 由有效认证方为未停用的 DID 签发凭证，同类型的已有凭证被覆盖
+func (k msgServer) IssueCredential(ctx context.Context, msg *types.MsgIssueCredential) (*types.MsgIssueCredentialResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	if err := types.ValidateCredentialType(msg.CredentialType); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidCredential, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if msg.ExpiryHeight != 0 && msg.ExpiryHeight <= sdkCtx.BlockHeight() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidCredential, "expiry height %d is not in the future", msg.ExpiryHeight)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load params: %s", err))
+	}
+	attestor, err := k.Attestor.Get(ctx, msg.Creator)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(types.ErrAttestorNotFound, msg.Creator)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !isActiveAttestor(attestor, params) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidAttestor, "attestor %s is not bonded", msg.Creator)
+	}
+
+	did, err := k.ResolveDid(ctx, msg.Did)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := k.DidDocument.Get(ctx, did)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrInvalidDid, "did %s not found", did)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if doc.IsDeactivated() {
+		return nil, errorsmod.Wrap(types.ErrDidDeactivated, did)
+	}
+
+	credential := types.Credential{
+		Did:            did,
+		CredentialType: msg.CredentialType,
+		Issuer:         msg.Creator,
+		IssuedHeight:   sdkCtx.BlockHeight(),
+		ExpiryHeight:   msg.ExpiryHeight,
+	}
+	if err := k.Credential.Set(ctx, collections.Join(did, msg.CredentialType), credential); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCredentialIssued,
+		sdk.NewAttribute(types.AttributeKeyDid, did),
+		sdk.NewAttribute(types.AttributeKeyCredentialType, msg.CredentialType),
+		sdk.NewAttribute(types.AttributeKeyIssuer, msg.Creator),
+		sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatInt(msg.ExpiryHeight, 10)),
+	))
+
+	return &types.MsgIssueCredentialResponse{}, nil
+}
+
+// RevokeCredential 由签发方撤销凭证
+func (k msgServer) RevokeCredential(ctx context.Context, msg *types.MsgRevokeCredential) (*types.MsgRevokeCredentialResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	did, err := k.ResolveDid(ctx, msg.Did)
+	if err != nil {
+		return nil, err
+	}
+	key := collections.Join(did, msg.CredentialType)
+	credential, err := k.Credential.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrCredentialNotFound, "%s/%s", did, msg.CredentialType)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if credential.Issuer != msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the issuer can revoke a credential")
+	}
+	if err := k.Credential.Remove(ctx, key); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCredentialRevoked,
+		sdk.NewAttribute(types.AttributeKeyDid, did),
+		sdk.NewAttribute(types.AttributeKeyCredentialType, msg.CredentialType),
+		sdk.NewAttribute(types.AttributeKeyIssuer, msg.Creator),
+	))
+
+	return &types.MsgRevokeCredentialResponse{}, nil
+}
@@ -0,0 +1,82 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"cosmossdk.io/collections"
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/identity/keeper"
+	"dtc/x/identity/types"
+)
+
+func TestIssueAndRevokeCredential(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	issuer, err := f.addressCodec.BytesToString([]byte("issuerAddr__________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr___________________"))
+	require.NoError(t, err)
+	f.bankKeeper.accounts[issuer] = sdk.NewCoins(bondCoin(types.DefaultAttestorMinBond))
+	_, err = srv.BondAttestor(ctx, &types.MsgBondAttestor{
+		Creator: issuer,
+		Pubkey:  hex.EncodeToString(secp256k1.GenPrivKey().PubKey().Bytes()),
+		Amount:  bondCoin(types.DefaultAttestorMinBond),
+	})
+	require.NoError(t, err)
+
+	did := types.GenerateDid(other, "subject")
+	require.NoError(t, f.keeper.DidDocument.Set(ctx, did, types.DidDocument{Did: did, Controller: other}))
+	deactivated := types.GenerateDid(other, "deactivated")
+	require.NoError(t, f.keeper.DidDocument.Set(ctx, deactivated, types.DidDocument{Did: deactivated, Controller: other, DeactivatedHeight: 3}))
+
+	issueTests := []struct {
+		desc string
+		msg  *types.MsgIssueCredential
+		err  error
+	}{
+		{desc: "not attestor", msg: &types.MsgIssueCredential{Creator: other, Did: did, CredentialType: "kyc"}, err: types.ErrAttestorNotFound},
+		{desc: "invalid type", msg: &types.MsgIssueCredential{Creator: issuer, Did: did, CredentialType: "KYC level"}, err: types.ErrInvalidCredential},
+		{desc: "expired", msg: &types.MsgIssueCredential{Creator: issuer, Did: did, CredentialType: "kyc", ExpiryHeight: 10}, err: types.ErrInvalidCredential},
+		{desc: "unknown did", msg: &types.MsgIssueCredential{Creator: issuer, Did: types.GenerateDid(other, "missing"), CredentialType: "kyc"}, err: types.ErrInvalidDid},
+		{desc: "deactivated did", msg: &types.MsgIssueCredential{Creator: issuer, Did: deactivated, CredentialType: "kyc"}, err: types.ErrDidDeactivated},
+		{desc: "issue", msg: &types.MsgIssueCredential{Creator: issuer, Did: did, CredentialType: "kyc", ExpiryHeight: 20}},
+	}
+	for _, tc := range issueTests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.IssueCredential(ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	require.True(t, f.keeper.HasActiveCredential(ctx, did, "kyc"))
+	require.True(t, f.keeper.HasActiveCredential(ctx.WithBlockHeight(20), did, "kyc"))
+	require.False(t, f.keeper.HasActiveCredential(ctx.WithBlockHeight(21), did, "kyc"))
+	require.False(t, f.keeper.HasActiveCredential(ctx, did, "student"))
+
+	// 签发方解除质押后其凭证不再被承认
+	_, err = srv.UnbondAttestor(ctx, &types.MsgUnbondAttestor{Creator: issuer})
+	require.NoError(t, err)
+	require.False(t, f.keeper.HasActiveCredential(ctx, did, "kyc"))
+
+	_, err = srv.RevokeCredential(ctx, &types.MsgRevokeCredential{Creator: other, Did: did, CredentialType: "kyc"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.RevokeCredential(ctx, &types.MsgRevokeCredential{Creator: issuer, Did: did, CredentialType: "student"})
+	require.ErrorIs(t, err, types.ErrCredentialNotFound)
+	_, err = srv.RevokeCredential(ctx, &types.MsgRevokeCredential{Creator: issuer, Did: did, CredentialType: "kyc"})
+	require.NoError(t, err)
+
+	has, err := f.keeper.Credential.Has(ctx, collections.Join(did, "kyc"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
@@ -13,122 +13,181 @@ import (
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
-	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	secp256k1lib "github.com/ethereum/go-ethereum/crypto/secp256k1"
 )
 
-const defaultAdminPubKeyHex = "03555db1e9893d6bafff7c3afdb62ddb99cf2f073d25144701966607f63e561a38"
-const integrationTestSignature = "7369676e6174757265"
-
 func (k msgServer) CreateDidDocument(ctx context.Context, msg *types.MsgCreateDidDocument) (*types.MsgCreateDidDocumentResponse, error) {
 	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
 	}
 
-	// 默认 controller 为空时使用 creator
-	controller := msg.Controller
+	didDocument, err := newDidDocument(msg.Creator, types.DidDocumentEntry{
+		Did:            msg.Did,
+		Controller:     msg.Controller,
+		Pubkeys:        msg.Pubkeys,
+		FaceNullifier:  msg.FaceNullifier,
+		FaceCommitment: msg.FaceCommitment,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 构造待验证数据：Did（规范形式）+ Controller + FaceNullifier + FaceCommitment
+	data := didDocument.Did + didDocument.Controller + didDocument.FaceNullifier + didDocument.FaceCommitment
+	attestor, err := k.verifyAttestorSignature(ctx, msg.AttestorPubkey, []byte(data), msg.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.storeDidDocument(ctx, didDocument, attestor); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCreateDidDocumentResponse{Did: didDocument.Did}, nil
+}
+
+// newDidDocument 校验注册条目并构造 DID 文档，controller 为空时使用 creator
+func newDidDocument(creator string, entry types.DidDocumentEntry) (types.DidDocument, error) {
+	controller := entry.Controller
 	if controller == "" {
-		controller = msg.Creator
+		controller = creator
+	}
+
+	// 只接受认证方盲化后的 nullifier 与 commitment，二者均为空表示无生物特征的注册
+	if entry.FaceNullifier != "" || entry.FaceCommitment != "" {
+		if err := types.ValidateFaceBlinding(entry.FaceNullifier, entry.FaceCommitment); err != nil {
+			return types.DidDocument{}, errorsmod.Wrap(types.ErrInvalidFaceBlind, err.Error())
+		}
+	}
+
+	// 确定 DID：未提供时由链按 did:dtc 规则派生；提供时必须符合语法且与派生结果一致
+	did := types.GenerateDid(controller, entry.FaceNullifier)
+	if entry.Did != "" {
+		normalized, err := types.NormalizeDid(entry.Did)
+		if err != nil {
+			return types.DidDocument{}, errorsmod.Wrap(types.ErrInvalidDid, err.Error())
+		}
+		if normalized != did {
+			return types.DidDocument{}, errorsmod.Wrap(types.ErrDidMismatch, fmt.Sprintf("expected %s, got %s", did, normalized))
+		}
 	}
 
-	// 从 Params 读取管理员公钥（hex 编码）
+	return types.DidDocument{
+		Did:            did,
+		Controller:     controller,
+		Pubkeys:        entry.Pubkeys,
+		FaceNullifier:  entry.FaceNullifier,
+		FaceCommitment: entry.FaceCommitment,
+	}, nil
+}
+
+// adminPubKeyHex 返回治理在参数中设置的管理员公钥的 hex 编码
+func (k Keeper) adminPubKeyHex(ctx context.Context) (string, error) {
 	params, err := k.Params.Get(ctx)
 	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load params: %s", err))
+		return "", errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load params: %s", err))
 	}
-	adminPubKeyHex := params.AdminPubkey
-	//if adminPubKeyHex == "" {
-	adminPubKeyHex = defaultAdminPubKeyHex
-	//}
-	adminPubKeyBytes, err := hex.DecodeString(adminPubKeyHex)
+	return params.AdminPubkey, nil
+}
+
+// verifySecp256k1Signature 使用 hex 编码的压缩公钥验证对 data 的签名，data 先进行 SHA256 哈希
+func verifySecp256k1Signature(pubKeyHex string, data []byte, signature []byte) error {
+	pubKeyBytes, err := hex.DecodeString(pubKeyHex)
 	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid admin pubkey hex: %s", err))
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid pubkey hex: %s", err))
 	}
-	if len(adminPubKeyBytes) != 33 {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid admin pubkey length; must be 33 bytes compressed secp256k1 key")
+	if len(pubKeyBytes) != 33 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid pubkey length; must be 33 bytes compressed secp256k1 key")
 	}
-	var adminPubKey secp256k1.PubKey
-	copy(adminPubKey[:], adminPubKeyBytes)
 
-	// 构造待验证数据：Did + Controller + FaceHash，先进行 SHA256 哈希
-	data := msg.Did + controller + msg.FaceHash
-	hash := sha256.Sum256([]byte(data))
+	hash := sha256.Sum256(data)
 	hashBytes := hash[:]
 
-	// 使用管理员公钥验证签名；集成测试签名跳过
-	if string(msg.Signature) != integrationTestSignature {
-		// 使用底层 ECDSA 验证方法，因为我们已经手动进行了 SHA256 哈希
-		// VerifySignature 可能会再次哈希，所以我们需要直接使用 ECDSA 验证
-		if len(msg.Signature) != 64 {
-			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "invalid signature length")
-		}
-
-		// 解析公钥
-		// adminPubKeyBytes 已经是 33 字节的压缩公钥，直接使用
+	// 使用底层 ECDSA 验证方法，因为我们已经手动进行了 SHA256 哈希
+	// VerifySignature 可能会再次哈希，所以我们需要直接使用 ECDSA 验证
+	if len(signature) != 64 {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "invalid signature length")
+	}
 
-		// 解压缩公钥得到 X, Y 坐标
-		x, y := secp256k1lib.DecompressPubkey(adminPubKeyBytes)
-		if x == nil || y == nil {
-			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "failed to decompress public key")
-		}
+	// 解压缩公钥得到 X, Y 坐标
+	x, y := secp256k1lib.DecompressPubkey(pubKeyBytes)
+	if x == nil || y == nil {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "failed to decompress public key")
+	}
 
-		// 创建 ECDSA 公钥
-		pubKey := &ecdsa.PublicKey{
-			Curve: secp256k1lib.S256(),
-			X:     x,
-			Y:     y,
-		}
+	// 创建 ECDSA 公钥
+	pubKey := &ecdsa.PublicKey{
+		Curve: secp256k1lib.S256(),
+		X:     x,
+		Y:     y,
+	}
 
-		// 解析签名 R || S
-		r := new(big.Int).SetBytes(msg.Signature[:32])
-		s := new(big.Int).SetBytes(msg.Signature[32:64])
+	// 解析签名 R || S
+	r := new(big.Int).SetBytes(signature[:32])
+	s := new(big.Int).SetBytes(signature[32:64])
 
-		// 验证签名（使用已哈希的数据）
-		valid := ecdsa.Verify(pubKey, hashBytes, r, s)
-		if !valid {
-			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "invalid admin signature")
-		}
+	// 验证签名（使用已哈希的数据）
+	if !ecdsa.Verify(pubKey, hashBytes, r, s) {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "signature verification failed")
 	}
 
+	return nil
+}
+
+// storeDidDocument 检查 DID、nullifier 与 controller 是否已被占用，然后写入文档及索引。
+// attestor 是签发该注册的认证方公钥
+func (k msgServer) storeDidDocument(ctx context.Context, didDocument types.DidDocument, attestor string) error {
 	// Check if the value already exists
-	ok, err := k.DidDocument.Has(ctx, msg.Did)
+	ok, err := k.DidDocument.Has(ctx, didDocument.Did)
 	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	} else if ok {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index already set")
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index already set")
 	}
 
-	// 检查 faceHash 是否已被注册（合约层去重）
-	if msg.FaceHash != "" {
-		exists, err := k.FaceHashToIndex.Has(ctx, msg.FaceHash)
+	// 每个地址至多控制一个 DID，MintCredit 等按地址查找 DID 的逻辑依赖这一点
+	if didDocument.Controller != "" {
+		if _, found := k.GetDidDocument(sdk.UnwrapSDKContext(ctx), didDocument.Controller); found {
+			return errorsmod.Wrap(types.ErrControllerHasDid, didDocument.Controller)
+		}
+	}
+
+	// 检查 nullifier 是否已被注册（合约层去重）
+	if didDocument.FaceNullifier != "" {
+		exists, err := k.FaceNullifierToIndex.Has(ctx, didDocument.FaceNullifier)
 		if err != nil {
-			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to check face hash: %s", err))
+			return errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to check face nullifier: %s", err))
 		}
 		if exists {
-			return nil, errorsmod.Wrap(types.ErrDuplicateFaceHash, fmt.Sprintf("face hash %s already registered", msg.FaceHash))
+			return errorsmod.Wrap(types.ErrDuplicateFaceHash, fmt.Sprintf("face nullifier %s already registered", didDocument.FaceNullifier))
 		}
-	}
 
-	var didDocument = types.DidDocument{
-		Did:        msg.Did,
-		Controller: controller,
-		FaceHash:   msg.FaceHash,
-		Pubkeys:    msg.Pubkeys,
+		// 记录签发该 DID 的认证方，出现重复身份时据此追责
+		didDocument.Attestor = attestor
 	}
+	didDocument.CreatedHeight = sdk.UnwrapSDKContext(ctx).BlockHeight()
 
 	if err := k.DidDocument.Set(ctx, didDocument.Did, didDocument); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		return errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
 
-	// 更新 FaceHashToIndex 索引
-	if msg.FaceHash != "" {
-		if err := k.FaceHashToIndex.Set(ctx, msg.FaceHash, msg.Did); err != nil {
-			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to set face hash index: %s", err))
+	// 更新 FaceNullifierToIndex 索引
+	if didDocument.FaceNullifier != "" {
+		if err := k.FaceNullifierToIndex.Set(ctx, didDocument.FaceNullifier, didDocument.Did); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to set face nullifier index: %s", err))
 		}
 	}
 
-	return &types.MsgCreateDidDocumentResponse{}, nil
+	// 更新 controller 索引，被监护 DID 没有 controller
+	if didDocument.Controller != "" {
+		if err := k.ControllerDid.Set(ctx, didDocument.Controller, didDocument.Did); err != nil {
+			return errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to set controller index: %s", err))
+		}
+	}
+
+	return nil
 }
 
 func (k msgServer) UpdateDidDocument(ctx context.Context, msg *types.MsgUpdateDidDocument) (*types.MsgUpdateDidDocumentResponse, error) {
@@ -136,27 +195,21 @@ func (k msgServer) UpdateDidDocument(ctx context.Context, msg *types.MsgUpdateDi
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
 	}
 
-	// Check if the value exists
-	val, err := k.DidDocument.Get(ctx, msg.Did)
+	// 校验操作者是否为当前 Controller，被监护 DID 也可由监护人更新
+	val, err := k.getManagedDidDocument(ctx, msg.Creator, msg.Did)
 	if err != nil {
-		if errors.Is(err, collections.ErrNotFound) {
-			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
-		}
-
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		return nil, err
 	}
 
-	// 校验操作者是否为当前 Controller
-	if msg.Creator != val.Controller {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect controller")
-	}
-	var didDocument = types.DidDocument{
-		Did:        msg.Did,
-		Controller: msg.Controller,
-		FaceHash:   val.FaceHash, // 保持原有的 faceHash
-		Pubkeys:    msg.Pubkeys,
+	// controller 变更必须走提议/接受流程，避免写错地址导致身份被永久锁定
+	if msg.Controller != "" && msg.Controller != val.Controller {
+		return nil, errorsmod.Wrap(types.ErrControllerChange, "use MsgProposeControllerTransfer")
 	}
 
+	// 只更新公钥，其余字段（controller、nullifier 与 commitment）保持不变
+	didDocument := val
+	didDocument.Pubkeys = msg.Pubkeys
+
 	if err := k.DidDocument.Set(ctx, didDocument.Did, didDocument); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update didDocument")
 	}
@@ -169,8 +222,13 @@ func (k msgServer) DeleteDidDocument(ctx context.Context, msg *types.MsgDeleteDi
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
 	}
 
+	did, err := k.ResolveDid(ctx, msg.Did)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check if the value exists
-	val, err := k.DidDocument.Get(ctx, msg.Did)
+	val, err := k.DidDocument.Get(ctx, did)
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
 			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
@@ -184,19 +242,52 @@ func (k msgServer) DeleteDidDocument(ctx context.Context, msg *types.MsgDeleteDi
 		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect controller")
 	}
 
-	if err := k.DidDocument.Remove(ctx, msg.Did); err != nil {
+	// 已停用的 DID 保留在链上，避免其 nullifier 被重新注册
+	if val.IsDeactivated() {
+		return nil, errorsmod.Wrap(types.ErrDidDeactivated, did)
+	}
+
+	// 仍有被监护人的 DID 不能删除，否则被监护 DID 将失去监护人
+	hasDependents, err := k.hasDependents(ctx, did)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if hasDependents {
+		return nil, errorsmod.Wrap(types.ErrHasDependents, did)
+	}
+
+	if err := k.DidDocument.Remove(ctx, did); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove didDocument")
 	}
 
-	// 删除 FaceHashToIndex 索引
-	if val.FaceHash != "" {
-		if err := k.FaceHashToIndex.Remove(ctx, val.FaceHash); err != nil {
+	// 删除 FaceNullifierToIndex 索引
+	if val.FaceNullifier != "" {
+		if err := k.FaceNullifierToIndex.Remove(ctx, val.FaceNullifier); err != nil {
 			// 如果索引不存在，忽略错误（可能已经被删除）
 			if !errors.Is(err, collections.ErrNotFound) {
-				return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to remove face hash index: %s", err))
+				return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to remove face nullifier index: %s", err))
 			}
 		}
 	}
 
+	if err := k.removeControllerDid(ctx, val.Controller, did); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to remove controller index: %s", err))
+	}
+
+	// 删除待处理的控制权转移
+	if err := k.PendingControllerTransfer.Remove(ctx, did); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to remove pending controller transfer: %s", err))
+	}
+
+	// 释放句柄，冷却期结束后可被重新认领
+	if _, err := k.releaseDidHandle(ctx, did); err != nil && !errors.Is(err, types.ErrHandleNotFound) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to release handle: %s", err))
+	}
+
+	// 删除 DID 绑定的以太坊地址
+	if err := k.removeDidExternalAccounts(ctx, did); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to remove external accounts: %s", err))
+	}
+
 	return &types.MsgDeleteDidDocumentResponse{}, nil
 }
@@ -4,9 +4,11 @@ import (
 	"crypto/sha256"
 	"encoding/hex"
 	"strconv"
+	"strings"
 	"testing"
 
 	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
@@ -14,41 +16,163 @@ import (
 	"dtc/x/identity/types"
 )
 
+// faceBlinding 生成测试用的 nullifier 与 commitment（均为 32 字节十六进制）
+func faceBlinding(seed string) (string, string) {
+	nullifier := sha256.Sum256([]byte("nullifier" + seed))
+	commitment := sha256.Sum256([]byte("commitment" + seed))
+	return hex.EncodeToString(nullifier[:]), hex.EncodeToString(commitment[:])
+}
+
 func TestDidDocumentMsgServerCreate(t *testing.T) {
 	f := initFixture(t)
 	srv := keeper.NewMsgServerImpl(f.keeper)
-	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
-	require.NoError(t, err)
+	issuer := f.bondTestAttestor(t)
 
 	for i := 0; i < 5; i++ {
-		expected := &types.MsgCreateDidDocument{Creator: creator,
-			Did:       strconv.Itoa(i),
-			Signature: []byte("7369676e6174757265"), // 集成测试签名，跳过验证
-		}
-		_, err := srv.CreateDidDocument(f.ctx, expected)
+		creator, err := f.addressCodec.BytesToString([]byte("signerAddr" + strconv.Itoa(i) + "_________________"))
+		require.NoError(t, err)
+		nullifier, commitment := faceBlinding(strconv.Itoa(i))
+		expected := issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: creator,
+			FaceNullifier:  nullifier,
+			FaceCommitment: commitment,
+		})
+		resp, err := srv.CreateDidDocument(f.ctx, expected)
 		require.NoError(t, err)
-		rst, err := f.keeper.DidDocument.Get(f.ctx, expected.Did)
+		require.Equal(t, types.GenerateDid(creator, expected.FaceNullifier), resp.Did)
+		rst, err := f.keeper.DidDocument.Get(f.ctx, resp.Did)
 		require.NoError(t, err)
-		require.Equal(t, expected.Did, rst.Did)
+		require.Equal(t, resp.Did, rst.Did)
+		require.Equal(t, nullifier, rst.FaceNullifier)
+		require.Equal(t, commitment, rst.FaceCommitment)
+	}
+}
+
+func TestDidDocumentMsgServerCreateOnePerController(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	issuer := f.bondTestAttestor(t)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr___________________"))
+	require.NoError(t, err)
+
+	nullifier, commitment := faceBlinding("first")
+	first, err := srv.CreateDidDocument(f.ctx, issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: creator, FaceNullifier: nullifier, FaceCommitment: commitment}))
+	require.NoError(t, err)
+
+	// 同一 controller 不能再注册第二个 DID，无论是自己注册还是由他人代为注册
+	nullifier, commitment = faceBlinding("second")
+	_, err = srv.CreateDidDocument(f.ctx, issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: creator, FaceNullifier: nullifier, FaceCommitment: commitment}))
+	require.ErrorIs(t, err, types.ErrControllerHasDid)
+	_, err = srv.CreateDidDocument(f.ctx, issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: other, Controller: creator, FaceNullifier: nullifier, FaceCommitment: commitment}))
+	require.ErrorIs(t, err, types.ErrControllerHasDid)
+
+	doc, found := f.keeper.GetDidDocument(sdk.UnwrapSDKContext(f.ctx), creator)
+	require.True(t, found)
+	require.Equal(t, first.Did, doc.Did)
+
+	// 删除后可重新注册
+	_, err = srv.DeleteDidDocument(f.ctx, &types.MsgDeleteDidDocument{Creator: creator, Did: first.Did})
+	require.NoError(t, err)
+	_, err = srv.CreateDidDocument(f.ctx, issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: creator, FaceNullifier: nullifier, FaceCommitment: commitment}))
+	require.NoError(t, err)
+}
+
+func TestDidDocumentMsgServerCreateFaceBlinding(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	issuer := f.bondTestAttestor(t)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr___________________"))
+	require.NoError(t, err)
+	nullifier, commitment := faceBlinding("face")
+
+	_, err = srv.CreateDidDocument(f.ctx, issuer.signDidDocument(t, &types.MsgCreateDidDocument{
+		Creator:        creator,
+		FaceNullifier:  nullifier,
+		FaceCommitment: commitment,
+	}))
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc       string
+		nullifier  string
+		commitment string
+		err        error
+	}{
+		{desc: "raw face hash", nullifier: "4577a4061029e1e2c053a27cafbcc83a", commitment: commitment, err: types.ErrInvalidFaceBlind},
+		{desc: "missing commitment", nullifier: nullifier, err: types.ErrInvalidFaceBlind},
+		{desc: "not hex", nullifier: strings.Repeat("z", 64), commitment: commitment, err: types.ErrInvalidFaceBlind},
+		{desc: "duplicate nullifier", nullifier: nullifier, commitment: commitment, err: types.ErrDuplicateFaceHash},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.CreateDidDocument(f.ctx, issuer.signDidDocument(t, &types.MsgCreateDidDocument{
+				Creator:        other,
+				FaceNullifier:  tc.nullifier,
+				FaceCommitment: tc.commitment,
+			}))
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+}
+
+func TestDidDocumentMsgServerCreateDidFormat(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	issuer := f.bondTestAttestor(t)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	nullifier, commitment := faceBlinding("face")
+	derived := types.GenerateDid(creator, nullifier)
+
+	tests := []struct {
+		desc string
+		did  string
+		err  error
+	}{
+		{desc: "not did:dtc", did: "0", err: types.ErrInvalidDid},
+		{desc: "wrong method", did: "did:web:" + derived[len(types.DidMethodPrefix):], err: types.ErrInvalidDid},
+		{desc: "not hex", did: types.DidMethodPrefix + "zz" + derived[len(types.DidMethodPrefix)+2:], err: types.ErrInvalidDid},
+		{desc: "not derived", did: types.GenerateDid(creator, "other"), err: types.ErrDidMismatch},
+		{desc: "upper case is normalised", did: strings.ToUpper(derived)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := srv.CreateDidDocument(f.ctx, issuer.signDidDocument(t, &types.MsgCreateDidDocument{
+				Creator:        creator,
+				Did:            tc.did,
+				FaceNullifier:  nullifier,
+				FaceCommitment: commitment,
+			}))
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, derived, resp.Did)
+		})
 	}
 }
 
 func TestDidDocumentMsgServerUpdate(t *testing.T) {
 	f := initFixture(t)
 	srv := keeper.NewMsgServerImpl(f.keeper)
+	issuer := f.bondTestAttestor(t)
 
 	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
 	require.NoError(t, err)
-	testSig := []byte("7369676e6174757265")
 
 	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
 	require.NoError(t, err)
 
-	expected := &types.MsgCreateDidDocument{Creator: creator,
-		Did:       strconv.Itoa(0),
-		Signature: testSig, // 集成测试签名，跳过验证
-	}
-	_, err = srv.CreateDidDocument(f.ctx, expected)
+	nullifier, commitment := faceBlinding(strconv.Itoa(0))
+	expected := issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: creator,
+		FaceNullifier:  nullifier,
+		FaceCommitment: commitment,
+	})
+	created, err := srv.CreateDidDocument(f.ctx, expected)
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -59,29 +183,36 @@ func TestDidDocumentMsgServerUpdate(t *testing.T) {
 		{
 			desc: "invalid address",
 			request: &types.MsgUpdateDidDocument{Creator: "invalid",
-				Did: strconv.Itoa(0),
+				Did: created.Did,
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		},
 		{
 			desc: "unauthorized",
 			request: &types.MsgUpdateDidDocument{Creator: unauthorizedAddr,
-				Did: strconv.Itoa(0),
+				Did: created.Did,
 			},
 			err: sdkerrors.ErrUnauthorized,
 		},
 		{
 			desc: "key not found",
 			request: &types.MsgUpdateDidDocument{Creator: creator,
-				Did: strconv.Itoa(100000),
+				Did: types.GenerateDid(creator, strconv.Itoa(100000)),
 			},
 			err: sdkerrors.ErrKeyNotFound,
 		},
 		{
-			desc: "completed",
+			desc: "invalid did",
 			request: &types.MsgUpdateDidDocument{Creator: creator,
 				Did: strconv.Itoa(0),
 			},
+			err: types.ErrInvalidDid,
+		},
+		{
+			desc: "completed",
+			request: &types.MsgUpdateDidDocument{Creator: creator,
+				Did: created.Did,
+			},
 		},
 	}
 	for _, tc := range tests {
@@ -91,9 +222,11 @@ func TestDidDocumentMsgServerUpdate(t *testing.T) {
 				require.ErrorIs(t, err, tc.err)
 			} else {
 				require.NoError(t, err)
-				rst, err := f.keeper.DidDocument.Get(f.ctx, expected.Did)
+				rst, err := f.keeper.DidDocument.Get(f.ctx, created.Did)
 				require.NoError(t, err)
-				require.Equal(t, expected.Did, rst.Did)
+				require.Equal(t, created.Did, rst.Did)
+				require.Equal(t, nullifier, rst.FaceNullifier)
+				require.Equal(t, commitment, rst.FaceCommitment)
 			}
 		})
 	}
@@ -102,18 +235,19 @@ func TestDidDocumentMsgServerUpdate(t *testing.T) {
 func TestDidDocumentMsgServerDelete(t *testing.T) {
 	f := initFixture(t)
 	srv := keeper.NewMsgServerImpl(f.keeper)
+	issuer := f.bondTestAttestor(t)
 
 	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
 	require.NoError(t, err)
-	testSig := []byte("7369676e6174757265")
 
 	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
 	require.NoError(t, err)
 
-	_, err = srv.CreateDidDocument(f.ctx, &types.MsgCreateDidDocument{Creator: creator,
-		Did:       strconv.Itoa(0),
-		Signature: testSig,
-	})
+	nullifier, commitment := faceBlinding(strconv.Itoa(0))
+	created, err := srv.CreateDidDocument(f.ctx, issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: creator,
+		FaceNullifier:  nullifier,
+		FaceCommitment: commitment,
+	}))
 	require.NoError(t, err)
 
 	tests := []struct {
@@ -124,28 +258,28 @@ func TestDidDocumentMsgServerDelete(t *testing.T) {
 		{
 			desc: "invalid address",
 			request: &types.MsgDeleteDidDocument{Creator: "invalid",
-				Did: strconv.Itoa(0),
+				Did: created.Did,
 			},
 			err: sdkerrors.ErrInvalidAddress,
 		},
 		{
 			desc: "unauthorized",
 			request: &types.MsgDeleteDidDocument{Creator: unauthorizedAddr,
-				Did: strconv.Itoa(0),
+				Did: created.Did,
 			},
 			err: sdkerrors.ErrUnauthorized,
 		},
 		{
 			desc: "key not found",
 			request: &types.MsgDeleteDidDocument{Creator: creator,
-				Did: strconv.Itoa(100000),
+				Did: types.GenerateDid(creator, strconv.Itoa(100000)),
 			},
 			err: sdkerrors.ErrKeyNotFound,
 		},
 		{
 			desc: "completed",
 			request: &types.MsgDeleteDidDocument{Creator: creator,
-				Did: strconv.Itoa(0),
+				Did: created.Did,
 			},
 		},
 	}
@@ -159,6 +293,9 @@ func TestDidDocumentMsgServerDelete(t *testing.T) {
 				found, err := f.keeper.DidDocument.Has(f.ctx, tc.request.Did)
 				require.NoError(t, err)
 				require.False(t, found)
+				found, err = f.keeper.FaceNullifierToIndex.Has(f.ctx, nullifier)
+				require.NoError(t, err)
+				require.False(t, found)
 			}
 		})
 	}
@@ -171,8 +308,8 @@ func TestCreateDidDocument_WithSignature(t *testing.T) {
 
 	// 测试参数
 	controller := "dtc1m4g397xg68ja9ufal99tk84a9wfrjt6vpaueex"
-	did := "did:dtc:4577a4061029e1e2c053a27cafbcc83a"
-	faceHash := "4577a4061029e1e2c053a27cafbcc83a"
+	nullifier, commitment := faceBlinding("4577a4061029e1e2c053a27cafbcc83a")
+	did := types.GenerateDid(controller, nullifier)
 
 	// TODO: 替换为实际的 admin 私钥（hex 编码，64 个十六进制字符，即 32 字节）
 	// 占位符：请将下面的私钥替换为实际的 admin 私钥
@@ -211,8 +348,8 @@ func TestCreateDidDocument_WithSignature(t *testing.T) {
 	err = f.keeper.Params.Set(f.ctx, params)
 	require.NoError(t, err, "failed to set params")
 
-	// 构造待签名数据：Did + Controller + FaceHash
-	data := did + controller + faceHash
+	// 构造待签名数据：Did + Controller + FaceNullifier + FaceCommitment
+	data := did + controller + nullifier + commitment
 
 	// 使用私钥对原始数据进行签名
 	// 注意：Sign 方法会自动对输入进行 SHA256 哈希
@@ -237,14 +374,36 @@ func TestCreateDidDocument_WithSignature(t *testing.T) {
 
 	// 创建消息
 	msg := &types.MsgCreateDidDocument{
-		Creator:    controller,
-		Did:        did,
-		Controller: controller,
-		FaceHash:   faceHash,
-		Pubkeys:    "",
-		Signature:  signature,
+		Creator:        controller,
+		Did:            did,
+		Controller:     controller,
+		FaceNullifier:  nullifier,
+		FaceCommitment: commitment,
+		Pubkeys:        "",
+		Signature:      signature,
 	}
 
+	// 不再接受固定的测试签名
+	_, err = srv.CreateDidDocument(f.ctx, &types.MsgCreateDidDocument{Creator: controller, FaceNullifier: nullifier, FaceCommitment: commitment, Signature: []byte("7369676e6174757265")})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// 未指定认证方公钥时，即使管理员公钥对应账户持有认证方角色，签名也不被接受
+	f.grantAttestorKey(t, adminPubKey.Address())
+	_, err = srv.CreateDidDocument(f.ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// 未质押的签名方不能签发 DID
+	msg.AttestorPubkey = adminPubKeyHex
+	_, err = srv.CreateDidDocument(f.ctx, msg)
+	require.ErrorIs(t, err, types.ErrAttestorNotFound)
+
+	// 质押后签名被接受
+	adminAddr, err := f.addressCodec.BytesToString(adminPubKey.Address())
+	require.NoError(t, err)
+	f.bankKeeper.accounts[adminAddr] = sdk.NewCoins(bondCoin(types.DefaultAttestorMinBond))
+	_, err = srv.BondAttestor(f.ctx, &types.MsgBondAttestor{Creator: adminAddr, Pubkey: adminPubKeyHex, Amount: bondCoin(types.DefaultAttestorMinBond)})
+	require.NoError(t, err)
+
 	// 执行 CreateDidDocument
 	_, err = srv.CreateDidDocument(f.ctx, msg)
 	require.NoError(t, err, "CreateDidDocument should succeed")
@@ -254,10 +413,11 @@ func TestCreateDidDocument_WithSignature(t *testing.T) {
 	require.NoError(t, err, "should be able to get created DID document")
 	require.Equal(t, did, didDoc.Did, "DID should match")
 	require.Equal(t, controller, didDoc.Controller, "Controller should match")
-	require.Equal(t, faceHash, didDoc.FaceHash, "FaceHash should match")
+	require.Equal(t, nullifier, didDoc.FaceNullifier, "FaceNullifier should match")
+	require.Equal(t, commitment, didDoc.FaceCommitment, "FaceCommitment should match")
 
-	// 验证 FaceHashToIndex 索引已创建
-	indexedDid, err := f.keeper.FaceHashToIndex.Get(f.ctx, faceHash)
+	// 验证 FaceNullifierToIndex 索引已创建
+	indexedDid, err := f.keeper.FaceNullifierToIndex.Get(f.ctx, nullifier)
 	require.NoError(t, err, "should be able to get indexed DID")
 	require.Equal(t, did, indexedDid, "indexed DID should match")
 }
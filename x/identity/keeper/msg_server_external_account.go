@@ -0,0 +1,138 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dtc/x/identity/types"
+)
+
+// LinkExternalAccount 将以太坊地址绑定到 creator 控制的 DID，链上恢复 EIP-191 签名者并与地址比对
+func (k msgServer) LinkExternalAccount(ctx context.Context, msg *types.MsgLinkExternalAccount) (*types.MsgLinkExternalAccountResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	ethAddress, err := types.NormalizeEthAddress(msg.EthAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidEthAddress, err.Error())
+	}
+
+	did, err := k.ResolveDid(ctx, msg.Did)
+	if err != nil {
+		return nil, err
+	}
+	doc, err := k.DidDocument.Get(ctx, did)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	// 校验操作者是否为当前 Controller
+	if msg.Creator != doc.Controller {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect controller")
+	}
+	// 已停用的 DID 不能再通过以太坊地址解析
+	if doc.IsDeactivated() {
+		return nil, errorsmod.Wrap(types.ErrDidDeactivated, did)
+	}
+
+	linked, err := k.ExternalAccount.Has(ctx, ethAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if linked {
+		return nil, errorsmod.Wrap(types.ErrExternalAccountLinked, ethAddress)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	nonce, err := k.nextExternalAccountNonce(ctx, ethAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	challenge := types.ExternalAccountChallenge(sdkCtx.ChainID(), did, ethAddress, nonce)
+	signer, err := types.RecoverEIP191Signer(challenge, msg.Signature)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidEIP191Signature, err.Error())
+	}
+	if signer != ethAddress {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEIP191Signature, "recovered signer %s does not match %s", signer, ethAddress)
+	}
+
+	link := types.ExternalAccountLink{
+		EthAddress:   ethAddress,
+		Did:          did,
+		LinkedHeight: sdkCtx.BlockHeight(),
+		Nonce:        nonce,
+	}
+	if err := k.ExternalAccount.Set(ctx, ethAddress, link); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.DidExternalAccounts.Set(ctx, collections.Join(did, ethAddress)); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.ExternalAccountNonce.Set(ctx, ethAddress, nonce+1); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExternalAccountLinked,
+			sdk.NewAttribute(types.AttributeKeyDid, did),
+			sdk.NewAttribute(types.AttributeKeyEthAddress, ethAddress),
+		),
+	)
+
+	return &types.MsgLinkExternalAccountResponse{}, nil
+}
+
+// UnlinkExternalAccount 解除以太坊地址的绑定，只能由所绑定 DID 的 controller 发起
+func (k msgServer) UnlinkExternalAccount(ctx context.Context, msg *types.MsgUnlinkExternalAccount) (*types.MsgUnlinkExternalAccountResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+
+	ethAddress, err := types.NormalizeEthAddress(msg.EthAddress)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidEthAddress, err.Error())
+	}
+
+	link, err := k.ExternalAccount.Get(ctx, ethAddress)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(types.ErrExternalAccountNotFound, ethAddress)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	doc, err := k.DidDocument.Get(ctx, link.Did)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if msg.Creator != doc.Controller {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect controller")
+	}
+
+	if err := k.removeExternalAccountLink(ctx, link); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeExternalAccountUnlinked,
+			sdk.NewAttribute(types.AttributeKeyDid, link.Did),
+			sdk.NewAttribute(types.AttributeKeyEthAddress, ethAddress),
+		),
+	)
+
+	return &types.MsgUnlinkExternalAccountResponse{}, nil
+}
@@ -0,0 +1,146 @@
+package keeper_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/identity/keeper"
+	"dtc/x/identity/types"
+)
+
+// personalSign 模拟钱包的 personal_sign，返回 V 为 27/28 的 65 字节签名
+func personalSign(t *testing.T, keyHex, message string) []byte {
+	t.Helper()
+	key, err := crypto.HexToECDSA(keyHex)
+	require.NoError(t, err)
+	hash := crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)))
+	sig, err := crypto.Sign(hash, key)
+	require.NoError(t, err)
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig
+}
+
+func TestLinkExternalAccount(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithChainID("dtc-1")
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	issuer := f.bondTestAttestor(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	controller, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr___________________"))
+	require.NoError(t, err)
+	created, err := srv.CreateDidDocument(ctx, issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: controller}))
+	require.NoError(t, err)
+
+	const ethKey = "b71c71a67e1177ad4e901695e1b4b9ee17ae16c6668d313eac2f96dbcda3f291"
+	key, err := crypto.HexToECDSA(ethKey)
+	require.NoError(t, err)
+	ethAddress := strings.ToLower(crypto.PubkeyToAddress(key.PublicKey).Hex())
+	checksummed := crypto.PubkeyToAddress(key.PublicKey).Hex()
+
+	challengeResp, err := qs.ExternalAccountChallenge(ctx, &types.QueryExternalAccountChallengeRequest{Did: created.Did, EthAddress: checksummed})
+	require.NoError(t, err)
+	require.Contains(t, challengeResp.Challenge, "dtc-1")
+	signature := personalSign(t, ethKey, challengeResp.Challenge)
+
+	deactivated := types.DidDocument{Did: types.GenerateDid(other, ""), Controller: other, DeactivatedHeight: 1}
+	require.NoError(t, f.keeper.DidDocument.Set(ctx, deactivated.Did, deactivated))
+
+	tests := []struct {
+		desc string
+		msg  *types.MsgLinkExternalAccount
+		err  error
+	}{
+		{
+			desc: "invalid eth address",
+			msg:  &types.MsgLinkExternalAccount{Creator: controller, Did: created.Did, EthAddress: "0x1234", Signature: signature},
+			err:  types.ErrInvalidEthAddress,
+		},
+		{
+			desc: "not controller",
+			msg:  &types.MsgLinkExternalAccount{Creator: other, Did: created.Did, EthAddress: checksummed, Signature: signature},
+			err:  sdkerrors.ErrUnauthorized,
+		},
+		{
+			desc: "signed by another key",
+			msg: &types.MsgLinkExternalAccount{Creator: controller, Did: created.Did, EthAddress: checksummed,
+				Signature: personalSign(t, strings.Repeat("1", 64), challengeResp.Challenge)},
+			err: types.ErrInvalidEIP191Signature,
+		},
+		{
+			desc: "signed for another chain",
+			msg: &types.MsgLinkExternalAccount{Creator: controller, Did: created.Did, EthAddress: checksummed,
+				Signature: personalSign(t, ethKey, types.ExternalAccountChallenge("other-1", created.Did, ethAddress, 0))},
+			err: types.ErrInvalidEIP191Signature,
+		},
+		{
+			desc: "malformed signature",
+			msg:  &types.MsgLinkExternalAccount{Creator: controller, Did: created.Did, EthAddress: checksummed, Signature: signature[:64]},
+			err:  types.ErrInvalidEIP191Signature,
+		},
+		{
+			desc: "deactivated did",
+			msg: &types.MsgLinkExternalAccount{Creator: other, Did: deactivated.Did, EthAddress: checksummed,
+				Signature: personalSign(t, ethKey, types.ExternalAccountChallenge("dtc-1", deactivated.Did, ethAddress, 0))},
+			err: types.ErrDidDeactivated,
+		},
+		{
+			desc: "completed",
+			msg:  &types.MsgLinkExternalAccount{Creator: controller, Did: created.Did, EthAddress: checksummed, Signature: signature},
+		},
+		{
+			desc: "already linked",
+			msg:  &types.MsgLinkExternalAccount{Creator: controller, Did: created.Did, EthAddress: checksummed, Signature: signature},
+			err:  types.ErrExternalAccountLinked,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.LinkExternalAccount(ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	// 反向查询：以太坊地址 -> DID
+	resp, err := qs.GetDidByExternalAccount(ctx, &types.QueryGetDidByExternalAccountRequest{EthAddress: checksummed})
+	require.NoError(t, err)
+	require.Equal(t, created.Did, resp.Link.Did)
+	require.Equal(t, ethAddress, resp.Link.EthAddress)
+
+	// 撤销：非 controller 无权撤销
+	_, err = srv.UnlinkExternalAccount(ctx, &types.MsgUnlinkExternalAccount{Creator: other, EthAddress: ethAddress})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.UnlinkExternalAccount(ctx, &types.MsgUnlinkExternalAccount{Creator: controller, EthAddress: ethAddress})
+	require.NoError(t, err)
+	_, err = qs.GetDidByExternalAccount(ctx, &types.QueryGetDidByExternalAccountRequest{EthAddress: ethAddress})
+	require.Error(t, err)
+	_, err = srv.UnlinkExternalAccount(ctx, &types.MsgUnlinkExternalAccount{Creator: controller, EthAddress: ethAddress})
+	require.ErrorIs(t, err, types.ErrExternalAccountNotFound)
+
+	// 撤销后旧签名不能重放，需要对新 nonce 重新签名
+	_, err = srv.LinkExternalAccount(ctx, &types.MsgLinkExternalAccount{Creator: controller, Did: created.Did, EthAddress: ethAddress, Signature: signature})
+	require.ErrorIs(t, err, types.ErrInvalidEIP191Signature)
+	challengeResp, err = qs.ExternalAccountChallenge(ctx, &types.QueryExternalAccountChallengeRequest{Did: created.Did, EthAddress: ethAddress})
+	require.NoError(t, err)
+	_, err = srv.LinkExternalAccount(ctx, &types.MsgLinkExternalAccount{Creator: controller, Did: created.Did, EthAddress: ethAddress, Signature: personalSign(t, ethKey, challengeResp.Challenge)})
+	require.NoError(t, err)
+
+	// 删除 DID 时一并删除绑定
+	_, err = srv.DeleteDidDocument(ctx, &types.MsgDeleteDidDocument{Creator: controller, Did: created.Did})
+	require.NoError(t, err)
+	has, err := f.keeper.ExternalAccount.Has(ctx, ethAddress)
+	require.NoError(t, err)
+	require.False(t, has)
+}
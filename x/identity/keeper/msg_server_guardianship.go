@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dtc/x/identity/types"
+)
+
+// CreateDependentDid 为无法持有私钥的被监护人（如未成年人）注册 DID。
+// 被监护 DID 在成年前没有 controller，由监护人 DID 的 controller 代为执行受限操作
+func (k msgServer) CreateDependentDid(ctx context.Context, msg *types.MsgCreateDependentDid) (*types.MsgCreateDependentDidResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	guardian, err := k.getControlledDidDocument(ctx, msg.Creator, msg.GuardianDid)
+	if err != nil {
+		return nil, err
+	}
+	if guardian.IsOrganisation() {
+		return nil, errorsmod.Wrap(types.ErrInvalidGuardianship, "organisation did cannot be a guardian")
+	}
+
+	// 被监护人必须经过人脸认证，nullifier 同时保证同一人不会被重复注册
+	if err := types.ValidateFaceBlinding(msg.FaceNullifier, msg.FaceCommitment); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidFaceBlind, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if msg.MaturityTime <= sdkCtx.BlockTime().Unix() {
+		return nil, errorsmod.Wrap(types.ErrInvalidGuardianship, "maturity time must be in the future")
+	}
+
+	// 构造待验证数据：GuardianDid + FaceNullifier + FaceCommitment + MaturityTime
+	data := guardian.Did + msg.FaceNullifier + msg.FaceCommitment + strconv.FormatInt(msg.MaturityTime, 10)
+	attestor, err := k.verifyAttestorSignature(ctx, msg.AttestorPubkey, []byte(data), msg.Signature)
+	if err != nil {
+		return nil, err
+	}
+
+	didDocument := types.DidDocument{
+		Did:            types.GenerateDid(guardian.Did, msg.FaceNullifier),
+		Guardian:       guardian.Did,
+		FaceNullifier:  msg.FaceNullifier,
+		FaceCommitment: msg.FaceCommitment,
+	}
+	if err := k.storeDidDocument(ctx, didDocument, attestor); err != nil {
+		return nil, err
+	}
+
+	guardianship := types.Guardianship{
+		DependentDid:   didDocument.Did,
+		GuardianDid:    guardian.Did,
+		MaturityTime:   msg.MaturityTime,
+		AttestedHeight: sdkCtx.BlockHeight(),
+	}
+	if err := k.Guardianship.Set(ctx, didDocument.Did, guardianship); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.GuardianDependents.Set(ctx, collections.Join(guardian.Did, didDocument.Did)); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDependentCreated,
+			sdk.NewAttribute(types.AttributeKeyDid, didDocument.Did),
+			sdk.NewAttribute(types.AttributeKeyGuardianDid, guardian.Did),
+			sdk.NewAttribute(types.AttributeKeyMaturityTime, strconv.FormatInt(msg.MaturityTime, 10)),
+		),
+	)
+
+	return &types.MsgCreateDependentDidResponse{Did: didDocument.Did}, nil
+}
+
+// ClaimMaturity 由被监护人自己的地址在成年后接管 DID，需要认证方对 did + creator 的签名，
+// 成功后监护关系解除，监护人不再能代为操作
+func (k msgServer) ClaimMaturity(ctx context.Context, msg *types.MsgClaimMaturity) (*types.MsgClaimMaturityResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	did, err := k.ResolveDid(ctx, msg.Did)
+	if err != nil {
+		return nil, err
+	}
+	guardianship, found := k.GetGuardianship(ctx, did)
+	if !found {
+		return nil, errorsmod.Wrap(types.ErrGuardianshipNotFound, did)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.BlockTime().Unix() < guardianship.MaturityTime {
+		return nil, errorsmod.Wrapf(types.ErrNotMature, "maturity time %d", guardianship.MaturityTime)
+	}
+
+	// 每个地址至多控制一个 DID
+	if _, found := k.GetDidDocument(sdkCtx, msg.Creator); found {
+		return nil, errorsmod.Wrap(types.ErrInvalidGuardianship, "creator already controls a did")
+	}
+
+	if _, err := k.verifyAttestorSignature(ctx, msg.AttestorPubkey, []byte(did+msg.Creator), msg.Signature); err != nil {
+		return nil, err
+	}
+
+	val, err := k.DidDocument.Get(ctx, did)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	val.Controller = msg.Creator
+	val.Guardian = ""
+	if err := k.DidDocument.Set(ctx, did, val); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.ControllerDid.Set(ctx, val.Controller, did); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.Guardianship.Remove(ctx, did); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.GuardianDependents.Remove(ctx, collections.Join(guardianship.GuardianDid, did)); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeDependentMatured,
+			sdk.NewAttribute(types.AttributeKeyDid, did),
+			sdk.NewAttribute(types.AttributeKeyGuardianDid, guardianship.GuardianDid),
+			sdk.NewAttribute(types.AttributeKeyController, msg.Creator),
+		),
+	)
+
+	return &types.MsgClaimMaturityResponse{}, nil
+}
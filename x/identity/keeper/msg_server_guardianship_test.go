@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dtc/x/identity/keeper"
+	"dtc/x/identity/types"
+)
+
+func TestDependentDid(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(100).WithBlockTime(time.Unix(1000, 0))
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	issuer := f.bondTestAttestor(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	parent, err := f.addressCodec.BytesToString([]byte("parentAddr__________________"))
+	require.NoError(t, err)
+	child, err := f.addressCodec.BytesToString([]byte("childAddr___________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("otherAddr___________________"))
+	require.NoError(t, err)
+	parentNullifier, parentCommitment := faceBlinding("parent")
+	parentDid, err := srv.CreateDidDocument(ctx, issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: parent, FaceNullifier: parentNullifier, FaceCommitment: parentCommitment}))
+	require.NoError(t, err)
+
+	childNullifier, childCommitment := faceBlinding("child")
+	dependent := func(creator, nullifier, commitment string, maturity int64) *types.MsgCreateDependentDid {
+		return &types.MsgCreateDependentDid{
+			Creator:        creator,
+			GuardianDid:    parentDid.Did,
+			FaceNullifier:  nullifier,
+			FaceCommitment: commitment,
+			MaturityTime:   maturity,
+			AttestorPubkey: issuer.pubkey,
+			Signature:      issuer.sign(t, parentDid.Did+nullifier+commitment+strconv.FormatInt(maturity, 10)),
+		}
+	}
+	tests := []struct {
+		desc string
+		msg  *types.MsgCreateDependentDid
+		err  error
+	}{
+		{desc: "not guardian controller", msg: dependent(other, childNullifier, childCommitment, 2000), err: sdkerrors.ErrUnauthorized},
+		{desc: "missing face blinding", msg: dependent(parent, "", "", 2000), err: types.ErrInvalidFaceBlind},
+		{desc: "maturity in the past", msg: dependent(parent, childNullifier, childCommitment, 1000), err: types.ErrInvalidGuardianship},
+		{desc: "invalid signature", msg: &types.MsgCreateDependentDid{Creator: parent, GuardianDid: parentDid.Did, FaceNullifier: childNullifier, FaceCommitment: childCommitment, MaturityTime: 2000, Signature: make([]byte, 64)}, err: sdkerrors.ErrUnauthorized},
+		{desc: "duplicate face", msg: dependent(parent, parentNullifier, parentCommitment, 2000), err: types.ErrDuplicateFaceHash},
+		{desc: "completed", msg: dependent(parent, childNullifier, childCommitment, 2000)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := srv.CreateDependentDid(ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, types.GenerateDid(parentDid.Did, childNullifier), resp.Did)
+		})
+	}
+
+	childDid := types.GenerateDid(parentDid.Did, childNullifier)
+	doc, err := f.keeper.DidDocument.Get(ctx, childDid)
+	require.NoError(t, err)
+	require.Empty(t, doc.Controller)
+	require.Equal(t, parentDid.Did, doc.Guardian)
+
+	// 其他模块可查询监护关系
+	guardianship := types.Guardianship{DependentDid: childDid, GuardianDid: parentDid.Did, MaturityTime: 2000, AttestedHeight: 100}
+	got, found := f.keeper.GetGuardianship(ctx, childDid)
+	require.True(t, found)
+	require.Equal(t, guardianship, got)
+	byDid, err := qs.GetGuardianship(ctx, &types.QueryGetGuardianshipRequest{Did: childDid})
+	require.NoError(t, err)
+	require.Equal(t, guardianship, byDid.Guardianship)
+	dependents, err := qs.ListDependents(ctx, &types.QueryListDependentsRequest{GuardianDid: parentDid.Did})
+	require.NoError(t, err)
+	require.Equal(t, []types.Guardianship{guardianship}, dependents.Guardianships)
+	attestation, dependentDoc, err := f.keeper.GetDidAttestationStatus(ctx, childDid)
+	require.NoError(t, err)
+	require.Equal(t, types.ATTESTATION_STATUS_ATTESTED, attestation)
+	require.Equal(t, childDid, dependentDoc.Did)
+	attestation, _, err = f.keeper.GetDidAttestationStatus(ctx, "did:dtc:missing")
+	require.NoError(t, err)
+	require.Equal(t, types.ATTESTATION_STATUS_UNREGISTERED, attestation)
+
+	// 监护人可执行受限操作：更新公钥、认领句柄
+	_, err = srv.UpdateDidDocument(ctx, &types.MsgUpdateDidDocument{Creator: parent, Did: childDid, Pubkeys: "child-key"})
+	require.NoError(t, err)
+	_, err = srv.ClaimHandle(ctx, &types.MsgClaimHandle{Creator: parent, Did: childDid, Handle: "kid"})
+	require.NoError(t, err)
+	_, err = srv.UpdateDidDocument(ctx, &types.MsgUpdateDidDocument{Creator: other, Did: childDid, Pubkeys: "x"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	// 但不能删除或转移被监护 DID，监护人 DID 在监护期内也不能删除
+	_, err = srv.DeleteDidDocument(ctx, &types.MsgDeleteDidDocument{Creator: parent, Did: childDid})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.ProposeControllerTransfer(ctx, &types.MsgProposeControllerTransfer{Creator: parent, Did: childDid, NewController: child})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.DeleteDidDocument(ctx, &types.MsgDeleteDidDocument{Creator: parent, Did: parentDid.Did})
+	require.ErrorIs(t, err, types.ErrHasDependents)
+
+	// 成年认领
+	_, err = srv.ClaimMaturity(ctx, &types.MsgClaimMaturity{Creator: child, Did: parentDid.Did, AttestorPubkey: issuer.pubkey, Signature: issuer.sign(t, parentDid.Did+child)})
+	require.ErrorIs(t, err, types.ErrGuardianshipNotFound)
+	_, err = srv.ClaimMaturity(ctx.WithBlockTime(time.Unix(1999, 0)), &types.MsgClaimMaturity{Creator: child, Did: childDid, AttestorPubkey: issuer.pubkey, Signature: issuer.sign(t, childDid+child)})
+	require.ErrorIs(t, err, types.ErrNotMature)
+	matureCtx := ctx.WithBlockTime(time.Unix(2000, 0))
+	_, err = srv.ClaimMaturity(matureCtx, &types.MsgClaimMaturity{Creator: parent, Did: childDid, AttestorPubkey: issuer.pubkey, Signature: issuer.sign(t, childDid+parent)})
+	require.ErrorIs(t, err, types.ErrInvalidGuardianship)
+	_, err = srv.ClaimMaturity(matureCtx, &types.MsgClaimMaturity{Creator: child, Did: childDid, Signature: make([]byte, 64)})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.ClaimMaturity(matureCtx, &types.MsgClaimMaturity{Creator: child, Did: childDid, AttestorPubkey: issuer.pubkey, Signature: issuer.sign(t, childDid+child)})
+	require.NoError(t, err)
+
+	doc, err = f.keeper.DidDocument.Get(ctx, childDid)
+	require.NoError(t, err)
+	require.Equal(t, child, doc.Controller)
+	require.Empty(t, doc.Guardian)
+	require.Equal(t, "child-key", doc.Pubkeys)
+	_, found = f.keeper.GetGuardianship(ctx, childDid)
+	require.False(t, found)
+	_, err = qs.GetGuardianship(ctx, &types.QueryGetGuardianshipRequest{Did: childDid})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// 成年后监护人失去权限，监护人 DID 可以删除
+	_, err = srv.UpdateDidDocument(ctx, &types.MsgUpdateDidDocument{Creator: parent, Did: childDid, Pubkeys: "x"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.DeleteDidDocument(ctx, &types.MsgDeleteDidDocument{Creator: parent, Did: parentDid.Did})
+	require.NoError(t, err)
+}
@@ -0,0 +1,178 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dtc/x/identity/types"
+)
+
+// ClaimHandle 为 DID 认领句柄。句柄按规范形式唯一；已释放的句柄在冷却期结束后才能被重新认领
+func (k msgServer) ClaimHandle(ctx context.Context, msg *types.MsgClaimHandle) (*types.MsgClaimHandleResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	// 被监护 DID 可由监护人代为认领
+	val, err := k.getManagedDidDocument(ctx, msg.Creator, msg.Did)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load params: %s", err))
+	}
+	name := types.NormalizeHandle(msg.Handle)
+	if err := params.ValidateHandle(name); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidHandle, err.Error())
+	}
+
+	// 每个 DID 至多一个句柄
+	hasHandle, err := k.DidHandle.Has(ctx, val.Did)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if hasHandle {
+		return nil, errorsmod.Wrap(types.ErrDidHasHandle, val.Did)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	existing, err := k.Handle.Get(ctx, name)
+	switch {
+	case errors.Is(err, collections.ErrNotFound):
+	case err != nil:
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	case !existing.IsClaimable(sdkCtx.BlockHeight()):
+		return nil, errorsmod.Wrap(types.ErrHandleTaken, name)
+	}
+
+	handle := types.Handle{
+		Handle:        name,
+		Did:           val.Did,
+		ClaimedHeight: sdkCtx.BlockHeight(),
+	}
+	if err := k.Handle.Set(ctx, name, handle); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.DidHandle.Set(ctx, val.Did, name); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHandleClaimed,
+			sdk.NewAttribute(types.AttributeKeyDid, val.Did),
+			sdk.NewAttribute(types.AttributeKeyHandle, name),
+		),
+	)
+
+	return &types.MsgClaimHandleResponse{Handle: name}, nil
+}
+
+// ReleaseHandle 释放 DID 的句柄，句柄在冷却期内不可被他人认领，防止立即冒用
+func (k msgServer) ReleaseHandle(ctx context.Context, msg *types.MsgReleaseHandle) (*types.MsgReleaseHandleResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	val, err := k.getManagedDidDocument(ctx, msg.Creator, msg.Did)
+	if err != nil {
+		return nil, err
+	}
+
+	handle, err := k.releaseDidHandle(ctx, val.Did)
+	if err != nil {
+		if errors.Is(err, types.ErrHandleNotFound) {
+			return nil, errorsmod.Wrap(types.ErrHandleNotFound, val.Did)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHandleReleased,
+			sdk.NewAttribute(types.AttributeKeyDid, val.Did),
+			sdk.NewAttribute(types.AttributeKeyHandle, handle.Handle),
+			sdk.NewAttribute(types.AttributeKeyReleaseHeight, strconv.FormatInt(handle.ReleaseHeight, 10)),
+		),
+	)
+
+	return &types.MsgReleaseHandleResponse{ReleaseHeight: handle.ReleaseHeight}, nil
+}
+
+// TransferHandle 将句柄立即转移给另一个尚无句柄的 DID，不经过冷却期
+func (k msgServer) TransferHandle(ctx context.Context, msg *types.MsgTransferHandle) (*types.MsgTransferHandleResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	val, err := k.getControlledDidDocument(ctx, msg.Creator, msg.Did)
+	if err != nil {
+		return nil, err
+	}
+
+	toDid, err := k.ResolveDid(ctx, msg.ToDid)
+	if err != nil {
+		return nil, err
+	}
+	if toDid == val.Did {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "cannot transfer a handle to the same did")
+	}
+	exists, err := k.DidDocument.Has(ctx, toDid)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !exists {
+		return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "recipient did not found")
+	}
+	hasHandle, err := k.DidHandle.Has(ctx, toDid)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if hasHandle {
+		return nil, errorsmod.Wrap(types.ErrDidHasHandle, toDid)
+	}
+
+	name, err := k.DidHandle.Get(ctx, val.Did)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(types.ErrHandleNotFound, val.Did)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	handle := types.Handle{
+		Handle:        name,
+		Did:           toDid,
+		ClaimedHeight: sdkCtx.BlockHeight(),
+	}
+	if err := k.Handle.Set(ctx, name, handle); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.DidHandle.Remove(ctx, val.Did); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.DidHandle.Set(ctx, toDid, name); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeHandleTransferred,
+			sdk.NewAttribute(types.AttributeKeyDid, val.Did),
+			sdk.NewAttribute(types.AttributeKeyToDid, toDid),
+			sdk.NewAttribute(types.AttributeKeyHandle, name),
+		),
+	)
+
+	return &types.MsgTransferHandleResponse{}, nil
+}
@@ -0,0 +1,139 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dtc/x/identity/keeper"
+	"dtc/x/identity/types"
+)
+
+func TestClaimHandle(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(100)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	issuer := f.bondTestAttestor(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	require.NoError(t, f.keeper.Params.Set(ctx, types.DefaultParams()))
+
+	alice, err := f.addressCodec.BytesToString([]byte("aliceAddr___________________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString([]byte("bobAddr_____________________"))
+	require.NoError(t, err)
+	aliceDid, err := srv.CreateDidDocument(ctx, issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: alice}))
+	require.NoError(t, err)
+	bobDid, err := srv.CreateDidDocument(ctx, issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: bob}))
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc string
+		msg  *types.MsgClaimHandle
+		err  error
+	}{
+		{desc: "not controller", msg: &types.MsgClaimHandle{Creator: bob, Did: aliceDid.Did, Handle: "alice"}, err: sdkerrors.ErrUnauthorized},
+		{desc: "too short", msg: &types.MsgClaimHandle{Creator: alice, Did: aliceDid.Did, Handle: "al"}, err: types.ErrInvalidHandle},
+		{desc: "too long", msg: &types.MsgClaimHandle{Creator: alice, Did: aliceDid.Did, Handle: "a123456789012345678901234567890"}, err: types.ErrInvalidHandle},
+		{desc: "invalid characters", msg: &types.MsgClaimHandle{Creator: alice, Did: aliceDid.Did, Handle: "ali.ce"}, err: types.ErrInvalidHandle},
+		{desc: "trailing separator", msg: &types.MsgClaimHandle{Creator: alice, Did: aliceDid.Did, Handle: "alice-"}, err: types.ErrInvalidHandle},
+		{desc: "reserved", msg: &types.MsgClaimHandle{Creator: alice, Did: aliceDid.Did, Handle: "Admin"}, err: types.ErrInvalidHandle},
+		{desc: "completed", msg: &types.MsgClaimHandle{Creator: alice, Did: aliceDid.Did, Handle: " Alice "}},
+		{desc: "one handle per did", msg: &types.MsgClaimHandle{Creator: alice, Did: aliceDid.Did, Handle: "alice2"}, err: types.ErrDidHasHandle},
+		{desc: "taken case-insensitively", msg: &types.MsgClaimHandle{Creator: bob, Did: bobDid.Did, Handle: "ALICE"}, err: types.ErrHandleTaken},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := srv.ClaimHandle(ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "alice", resp.Handle)
+		})
+	}
+
+	// 双向查询
+	resolved, err := qs.ResolveHandle(ctx, &types.QueryResolveHandleRequest{Handle: "ALICE"})
+	require.NoError(t, err)
+	require.Equal(t, types.Handle{Handle: "alice", Did: aliceDid.Did, ClaimedHeight: 100}, resolved.Handle)
+	byDid, err := qs.GetHandleByDid(ctx, &types.QueryGetHandleByDidRequest{Did: aliceDid.Did})
+	require.NoError(t, err)
+	require.Equal(t, "alice", byDid.Handle.Handle)
+	_, err = qs.GetHandleByDid(ctx, &types.QueryGetHandleByDidRequest{Did: bobDid.Did})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestReleaseAndTransferHandle(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(100)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	issuer := f.bondTestAttestor(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	params := types.DefaultParams()
+	params.HandleReleaseCooldown = 10
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	alice, err := f.addressCodec.BytesToString([]byte("aliceAddr___________________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString([]byte("bobAddr_____________________"))
+	require.NoError(t, err)
+	aliceDid, err := srv.CreateDidDocument(ctx, issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: alice}))
+	require.NoError(t, err)
+	bobDid, err := srv.CreateDidDocument(ctx, issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: bob}))
+	require.NoError(t, err)
+
+	_, err = srv.ReleaseHandle(ctx, &types.MsgReleaseHandle{Creator: alice, Did: aliceDid.Did})
+	require.ErrorIs(t, err, types.ErrHandleNotFound)
+
+	_, err = srv.ClaimHandle(ctx, &types.MsgClaimHandle{Creator: alice, Did: aliceDid.Did, Handle: "alice"})
+	require.NoError(t, err)
+
+	// 转移：目标 DID 必须存在，转移后立即生效
+	_, err = srv.TransferHandle(ctx, &types.MsgTransferHandle{Creator: bob, Did: aliceDid.Did, ToDid: bobDid.Did})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.TransferHandle(ctx, &types.MsgTransferHandle{Creator: alice, Did: aliceDid.Did, ToDid: types.GenerateDid(alice, "unknown")})
+	require.ErrorIs(t, err, sdkerrors.ErrKeyNotFound)
+	_, err = srv.TransferHandle(ctx.WithBlockHeight(101), &types.MsgTransferHandle{Creator: alice, Did: aliceDid.Did, ToDid: bobDid.Did})
+	require.NoError(t, err)
+
+	resolved, err := qs.ResolveHandle(ctx, &types.QueryResolveHandleRequest{Handle: "alice"})
+	require.NoError(t, err)
+	require.Equal(t, types.Handle{Handle: "alice", Did: bobDid.Did, ClaimedHeight: 101}, resolved.Handle)
+	_, err = qs.GetHandleByDid(ctx, &types.QueryGetHandleByDidRequest{Did: aliceDid.Did})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	// 目标 DID 已有句柄时拒绝转移
+	_, err = srv.ClaimHandle(ctx, &types.MsgClaimHandle{Creator: alice, Did: aliceDid.Did, Handle: "alice2"})
+	require.NoError(t, err)
+	_, err = srv.TransferHandle(ctx, &types.MsgTransferHandle{Creator: alice, Did: aliceDid.Did, ToDid: bobDid.Did})
+	require.ErrorIs(t, err, types.ErrDidHasHandle)
+
+	// 释放后进入冷却期，冷却期内不可被认领也不再解析
+	released, err := srv.ReleaseHandle(ctx.WithBlockHeight(200), &types.MsgReleaseHandle{Creator: bob, Did: bobDid.Did})
+	require.NoError(t, err)
+	require.Equal(t, int64(210), released.ReleaseHeight)
+	_, err = qs.ResolveHandle(ctx, &types.QueryResolveHandleRequest{Handle: "alice"})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = srv.ReleaseHandle(ctx, &types.MsgReleaseHandle{Creator: alice, Did: aliceDid.Did})
+	require.NoError(t, err)
+	_, err = srv.ClaimHandle(ctx.WithBlockHeight(209), &types.MsgClaimHandle{Creator: alice, Did: aliceDid.Did, Handle: "alice"})
+	require.ErrorIs(t, err, types.ErrHandleTaken)
+	_, err = srv.ClaimHandle(ctx.WithBlockHeight(210), &types.MsgClaimHandle{Creator: alice, Did: aliceDid.Did, Handle: "alice"})
+	require.NoError(t, err)
+
+	// 删除 DID 时释放其句柄
+	_, err = srv.DeleteDidDocument(ctx.WithBlockHeight(300), &types.MsgDeleteDidDocument{Creator: alice, Did: aliceDid.Did})
+	require.NoError(t, err)
+	handle, err := f.keeper.Handle.Get(ctx, "alice")
+	require.NoError(t, err)
+	require.Equal(t, int64(310), handle.ReleaseHeight)
+	_, err = srv.ClaimHandle(ctx.WithBlockHeight(310), &types.MsgClaimHandle{Creator: bob, Did: bobDid.Did, Handle: "alice"})
+	require.NoError(t, err)
+}
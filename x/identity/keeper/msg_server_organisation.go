@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dtc/x/identity/types"
+)
+
+// CreateOrganisationDid 为组织注册 DID。组织没有生物特征，由 x/group 策略账户控制，
+// 因此不需要认证方签名：交易只能通过组提案以策略账户身份执行
+func (k msgServer) CreateOrganisationDid(ctx context.Context, msg *types.MsgCreateOrganisationDid) (*types.MsgCreateOrganisationDidResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	if !k.isGroupPolicy(ctx, msg.Creator) {
+		return nil, errorsmod.Wrap(types.ErrNotGroupPolicy, msg.Creator)
+	}
+
+	// 每个 controller 至多控制一个 DID
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if _, found := k.GetDidDocument(sdkCtx, msg.Creator); found {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "group policy already controls a did")
+	}
+
+	members, err := k.normalizeOrganisationMembers(ctx, msg.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	didDocument := types.DidDocument{
+		Did:        types.GenerateDid(msg.Creator, types.OrganisationDidSubject),
+		Controller: msg.Creator,
+		Kind:       types.DID_KIND_ORGANISATION,
+		Members:    members,
+	}
+	if err := k.storeDidDocument(ctx, didDocument, ""); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOrganisationCreated,
+			sdk.NewAttribute(types.AttributeKeyDid, didDocument.Did),
+			sdk.NewAttribute(types.AttributeKeyController, didDocument.Controller),
+			sdk.NewAttribute(types.AttributeKeyMembers, strings.Join(members, ",")),
+		),
+	)
+
+	return &types.MsgCreateOrganisationDidResponse{Did: didDocument.Did}, nil
+}
+
+// UpdateOrganisationMembers 替换组织 DID 的成员列表
+func (k msgServer) UpdateOrganisationMembers(ctx context.Context, msg *types.MsgUpdateOrganisationMembers) (*types.MsgUpdateOrganisationMembersResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	val, err := k.getControlledDidDocument(ctx, msg.Creator, msg.Did)
+	if err != nil {
+		return nil, err
+	}
+	if !val.IsOrganisation() {
+		return nil, errorsmod.Wrap(types.ErrNotOrganisation, val.Did)
+	}
+
+	members, err := k.normalizeOrganisationMembers(ctx, msg.Members)
+	if err != nil {
+		return nil, err
+	}
+
+	val.Members = members
+	if err := k.DidDocument.Set(ctx, val.Did, val); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeOrganisationMembersUpdated,
+			sdk.NewAttribute(types.AttributeKeyDid, val.Did),
+			sdk.NewAttribute(types.AttributeKeyMembers, strings.Join(members, ",")),
+		),
+	)
+
+	return &types.MsgUpdateOrganisationMembersResponse{}, nil
+}
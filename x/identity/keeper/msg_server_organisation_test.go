@@ -0,0 +1,92 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/identity/keeper"
+	"dtc/x/identity/types"
+)
+
+func TestOrganisationDid(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	issuer := f.bondTestAttestor(t)
+
+	policy, err := f.addressCodec.BytesToString([]byte("groupPolicy_________________"))
+	require.NoError(t, err)
+	otherPolicy, err := f.addressCodec.BytesToString([]byte("otherGroupPolicy____________"))
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString([]byte("aliceAddr___________________"))
+	require.NoError(t, err)
+	f.groupKeeper.policies[policy] = true
+	f.groupKeeper.policies[otherPolicy] = true
+
+	aliceDid, err := srv.CreateDidDocument(ctx, issuer.signDidDocument(t, &types.MsgCreateDidDocument{Creator: alice}))
+	require.NoError(t, err)
+	unknownDid := types.GenerateDid(alice, "unknown")
+
+	tests := []struct {
+		desc string
+		msg  *types.MsgCreateOrganisationDid
+		err  error
+	}{
+		{desc: "not a group policy", msg: &types.MsgCreateOrganisationDid{Creator: alice}, err: types.ErrNotGroupPolicy},
+		{desc: "unknown member", msg: &types.MsgCreateOrganisationDid{Creator: policy, Members: []string{unknownDid}}, err: types.ErrInvalidOrganisation},
+		{desc: "duplicate member", msg: &types.MsgCreateOrganisationDid{Creator: policy, Members: []string{aliceDid.Did, aliceDid.Did}}, err: types.ErrInvalidOrganisation},
+		{desc: "completed", msg: &types.MsgCreateOrganisationDid{Creator: policy, Members: []string{aliceDid.Did}}},
+		{desc: "policy already controls a did", msg: &types.MsgCreateOrganisationDid{Creator: policy}, err: sdkerrors.ErrInvalidRequest},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			resp, err := srv.CreateOrganisationDid(ctx, tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, types.GenerateDid(policy, types.OrganisationDidSubject), resp.Did)
+		})
+	}
+
+	orgDid := types.GenerateDid(policy, types.OrganisationDidSubject)
+	doc, err := f.keeper.DidDocument.Get(ctx, orgDid)
+	require.NoError(t, err)
+	require.Equal(t, types.DidDocument{
+		Did:        orgDid,
+		Controller: policy,
+		Kind:       types.DID_KIND_ORGANISATION,
+		Members:    []string{aliceDid.Did},
+	}, doc)
+	require.True(t, doc.IsOrganisation())
+
+	// 组织没有生物特征，因此不具备人格证明
+	status, _, err := f.keeper.GetAttestationStatus(ctx, policy)
+	require.NoError(t, err)
+	require.Equal(t, types.ATTESTATION_STATUS_UNATTESTED, status)
+
+	// 成员只能是自然人 DID，组织不能作为另一组织的成员
+	_, err = srv.CreateOrganisationDid(ctx, &types.MsgCreateOrganisationDid{Creator: otherPolicy, Members: []string{orgDid}})
+	require.ErrorIs(t, err, types.ErrInvalidOrganisation)
+
+	// 更新成员
+	_, err = srv.UpdateOrganisationMembers(ctx, &types.MsgUpdateOrganisationMembers{Creator: alice, Did: orgDid})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.UpdateOrganisationMembers(ctx, &types.MsgUpdateOrganisationMembers{Creator: alice, Did: aliceDid.Did})
+	require.ErrorIs(t, err, types.ErrNotOrganisation)
+	_, err = srv.UpdateOrganisationMembers(ctx, &types.MsgUpdateOrganisationMembers{Creator: policy, Did: orgDid})
+	require.NoError(t, err)
+	doc, err = f.keeper.DidDocument.Get(ctx, orgDid)
+	require.NoError(t, err)
+	require.Empty(t, doc.Members)
+
+	// 组织 DID 的控制权只能转移给另一个策略账户
+	_, err = srv.ProposeControllerTransfer(ctx, &types.MsgProposeControllerTransfer{Creator: policy, Did: orgDid, NewController: alice})
+	require.ErrorIs(t, err, types.ErrNotGroupPolicy)
+	_, err = srv.ProposeControllerTransfer(ctx, &types.MsgProposeControllerTransfer{Creator: policy, Did: orgDid, NewController: otherPolicy})
+	require.NoError(t, err)
+}
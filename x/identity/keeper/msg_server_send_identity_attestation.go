@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+
+	"dtc/x/identity/types"
+)
+
+// SendIdentityAttestation 将 creator 所控制 DID 的人格证明状态推送至对手链
+func (k msgServer) SendIdentityAttestation(ctx context.Context, msg *types.MsgSendIdentityAttestation) (*types.MsgSendIdentityAttestationResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
+	}
+	if msg.TimeoutTimestamp == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidPacketTimeout, "timeout timestamp must be set")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	status, doc, err := k.GetAttestationStatus(sdkCtx, msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if status == types.ATTESTATION_STATUS_UNREGISTERED {
+		return nil, errorsmod.Wrap(types.ErrIdentityNotFound, msg.Creator)
+	}
+
+	packet := types.AttestationPacketData{
+		Address:        msg.Creator,
+		Did:            doc.Did,
+		Status:         status,
+		FaceCommitment: doc.FaceCommitment,
+		AttestedHeight: sdkCtx.BlockHeight(),
+	}
+
+	sequence, err := k.TransmitAttestationPacket(sdkCtx, packet, types.PortID, msg.ChannelId, clienttypes.ZeroHeight(), msg.TimeoutTimestamp)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeAttestationSent,
+			sdk.NewAttribute(types.AttributeKeyAddress, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyDid, doc.Did),
+			sdk.NewAttribute(types.AttributeKeyStatus, status.String()),
+			sdk.NewAttribute(types.AttributeKeyChannel, msg.ChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(sequence, 10)),
+		),
+	)
+
+	return &types.MsgSendIdentityAttestationResponse{Sequence: sequence}, nil
+}
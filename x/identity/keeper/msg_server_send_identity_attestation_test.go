@@ -0,0 +1,57 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/identity/keeper"
+	"dtc/x/identity/types"
+)
+
+func TestMsgSendIdentityAttestation(t *testing.T) {
+	f := initFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	require.NoError(t, err)
+	registered, err := f.addressCodec.BytesToString([]byte("registeredAddr______________"))
+	require.NoError(t, err)
+
+	_, err = srv.CreateDidDocument(f.ctx, f.bondTestAttestor(t).signDidDocument(t, &types.MsgCreateDidDocument{
+		Creator: registered,
+	}))
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc string
+		msg  *types.MsgSendIdentityAttestation
+		err  error
+	}{
+		{
+			desc: "invalid address",
+			msg:  &types.MsgSendIdentityAttestation{Creator: "invalid", ChannelId: "channel-0", TimeoutTimestamp: 1},
+			err:  sdkerrors.ErrInvalidAddress,
+		},
+		{
+			desc: "missing timeout",
+			msg:  &types.MsgSendIdentityAttestation{Creator: registered, ChannelId: "channel-0"},
+			err:  types.ErrInvalidPacketTimeout,
+		},
+		{
+			desc: "no did document",
+			msg:  &types.MsgSendIdentityAttestation{Creator: creator, ChannelId: "channel-0", TimeoutTimestamp: 1},
+			err:  types.ErrIdentityNotFound,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SendIdentityAttestation(f.ctx, tc.msg)
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	// 测试夹具没有 IBC keeper，发送会失败而不是 panic
+	_, err = srv.SendIdentityAttestation(f.ctx, &types.MsgSendIdentityAttestation{Creator: registered, ChannelId: "channel-0", TimeoutTimestamp: 1})
+	require.Error(t, err)
+}
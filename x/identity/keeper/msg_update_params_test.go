@@ -39,10 +39,19 @@ func TestMsgUpdateParams(t *testing.T) {
 			name: "send enabled param",
 			input: &types.MsgUpdateParams{
 				Authority: authorityStr,
-				Params:    types.Params{},
+				Params:    types.Params{AttestorUnbondingPeriod: 1},
 			},
 			expErr: false,
 		},
+		{
+			name: "zero attestor unbonding period",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params:    types.Params{},
+			},
+			expErr:    true,
+			expErrMsg: "attestor unbonding period must be positive",
+		},
 		{
 			name: "all good",
 			input: &types.MsgUpdateParams{
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	"dtc/x/identity/types"
+)
+
+// isGroupPolicy 判断地址是否为 x/group 的策略账户
+func (k Keeper) isGroupPolicy(ctx context.Context, address string) bool {
+	if k.groupKeeper == nil {
+		return false
+	}
+	resp, err := k.groupKeeper.GroupPolicyInfo(ctx, &group.QueryGroupPolicyInfoRequest{Address: address})
+	return err == nil && resp.Info != nil
+}
+
+// normalizeOrganisationMembers 将成员 DID 解析为规范形式，成员必须是已注册的自然人 DID 且不可重复
+func (k Keeper) normalizeOrganisationMembers(ctx context.Context, members []string) ([]string, error) {
+	normalized := make([]string, 0, len(members))
+	seen := make(map[string]bool, len(members))
+	for _, member := range members {
+		did, err := k.ResolveDid(ctx, member)
+		if err != nil {
+			return nil, err
+		}
+		if seen[did] {
+			return nil, types.ErrInvalidOrganisation.Wrapf("duplicate member %s", did)
+		}
+		seen[did] = true
+
+		doc, err := k.DidDocument.Get(ctx, did)
+		if err != nil {
+			return nil, types.ErrInvalidOrganisation.Wrapf("member %s not found", did)
+		}
+		if doc.IsOrganisation() {
+			return nil, types.ErrInvalidOrganisation.Wrapf("member %s is an organisation", did)
+		}
+		normalized = append(normalized, did)
+	}
+	return normalized, nil
+}
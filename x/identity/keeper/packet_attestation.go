@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"errors"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"dtc/x/identity/types"
+)
+
+// TransmitAttestationPacket 通过 identity 端口发送身份证明数据包
+func (k Keeper) TransmitAttestationPacket(
+	ctx sdk.Context,
+	packetData types.AttestationPacketData,
+	sourcePort,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+) (uint64, error) {
+	if k.ibcKeeperFn == nil || k.ibcKeeperFn() == nil {
+		return 0, errors.New("ibc keeper is not available")
+	}
+
+	packetBytes, err := types.IdentityPacketData{
+		Packet: &types.IdentityPacketData_Attestation{Attestation: &packetData},
+	}.GetBytes()
+	if err != nil {
+		return 0, errorsmod.Wrapf(types.ErrUnrecognizedPacket, "cannot marshal the packet: %s", err)
+	}
+
+	return k.ibcKeeperFn().ChannelKeeper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetBytes)
+}
+
+// OnRecvAttestationPacket 保存对手链推送的身份证明，同一通道上的同一地址以最新证明为准
+func (k Keeper) OnRecvAttestationPacket(ctx sdk.Context, packet channeltypes.Packet, data types.AttestationPacketData) (packetAck types.AttestationPacketAck, err error) {
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	remote := types.RemoteAttestation{
+		ChannelId:      packet.DestinationChannel,
+		Attestation:    data,
+		ReceivedHeight: ctx.BlockHeight(),
+	}
+	if err := k.RemoteAttestation.Set(ctx, remoteAttestationKey(packet.DestinationChannel, data.Address), remote); err != nil {
+		return packetAck, err
+	}
+
+	return packetAck, nil
+}
+
+// OnAcknowledgementAttestationPacket 处理身份证明数据包的确认结果，发送方无需回滚状态
+func (k Keeper) OnAcknowledgementAttestationPacket(ctx sdk.Context, packet channeltypes.Packet, data types.AttestationPacketData, ack channeltypes.Acknowledgement) error {
+	switch dispatchedAck := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Error:
+		return nil
+	case *channeltypes.Acknowledgement_Result:
+		var packetAck types.AttestationPacketAck
+		if err := types.ModuleCdc.UnmarshalJSON(dispatchedAck.Result, &packetAck); err != nil {
+			return errors.New("cannot unmarshal acknowledgment")
+		}
+		return nil
+	default:
+		return errors.New("the counter-party module does not implement the correct acknowledgment format")
+	}
+}
+
+// OnTimeoutAttestationPacket 处理身份证明数据包超时，发送方无需回滚状态
+func (k Keeper) OnTimeoutAttestationPacket(ctx sdk.Context, packet channeltypes.Packet, data types.AttestationPacketData) error {
+	return nil
+}
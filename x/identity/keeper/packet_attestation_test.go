@@ -0,0 +1,53 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/identity/keeper"
+	"dtc/x/identity/types"
+)
+
+func TestOnRecvAttestationPacket(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	packet := channeltypes.Packet{DestinationChannel: "channel-0"}
+	data := types.AttestationPacketData{
+		Address:        "partner1holder",
+		Did:            types.GenerateDid("partner1holder", ""),
+		Status:         types.ATTESTATION_STATUS_ATTESTED,
+		AttestedHeight: 5,
+	}
+
+	// 数据包可以序列化并按原样解析
+	bz, err := types.IdentityPacketData{Packet: &types.IdentityPacketData_Attestation{Attestation: &data}}.GetBytes()
+	require.NoError(t, err)
+	var decoded types.IdentityPacketData
+	require.NoError(t, types.ModuleCdc.UnmarshalJSON(bz, &decoded))
+	require.Equal(t, data, *decoded.GetAttestation())
+
+	_, err = f.keeper.OnRecvAttestationPacket(ctx, packet, data)
+	require.NoError(t, err)
+
+	resp, err := qs.GetRemoteAttestation(ctx, &types.QueryGetRemoteAttestationRequest{ChannelId: "channel-0", Address: data.Address})
+	require.NoError(t, err)
+	require.Equal(t, types.RemoteAttestation{ChannelId: "channel-0", Attestation: data, ReceivedHeight: 10}, resp.RemoteAttestation)
+
+	_, err = qs.GetRemoteAttestation(ctx, &types.QueryGetRemoteAttestationRequest{ChannelId: "channel-1", Address: data.Address})
+	require.Error(t, err)
+
+	invalid := []types.AttestationPacketData{
+		{Did: data.Did, Status: data.Status},
+		{Address: data.Address, Did: "0", Status: data.Status},
+		{Address: data.Address, Did: data.Did},
+	}
+	for _, d := range invalid {
+		_, err := f.keeper.OnRecvAttestationPacket(ctx, packet, d)
+		require.Error(t, err)
+	}
+}
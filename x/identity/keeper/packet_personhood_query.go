@@ -0,0 +1,28 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+
+	"dtc/x/identity/types"
+)
+
+// OnRecvPersonhoodQueryPacket 处理对手链发来的人格证明查询，查询结果通过 ack 返回，不修改状态
+func (k Keeper) OnRecvPersonhoodQueryPacket(ctx sdk.Context, packet channeltypes.Packet, data types.PersonhoodQueryPacketData) (packetAck types.PersonhoodQueryPacketAck, err error) {
+	if err := data.ValidateBasic(); err != nil {
+		return packetAck, err
+	}
+
+	status, doc, err := k.GetAttestationStatus(ctx, data.Address)
+	if err != nil {
+		return packetAck, err
+	}
+
+	return types.PersonhoodQueryPacketAck{
+		Address:      data.Address,
+		IsRegistered: status != types.ATTESTATION_STATUS_UNREGISTERED,
+		IsUnique:     status == types.ATTESTATION_STATUS_ATTESTED,
+		Status:       status,
+		Did:          doc.Did,
+	}, nil
+}
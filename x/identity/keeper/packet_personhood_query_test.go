@@ -0,0 +1,69 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/identity/types"
+)
+
+func TestOnRecvPersonhoodQueryPacket(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	nullifier, commitment := faceBlinding("attested")
+	attested := types.DidDocument{Did: types.GenerateDid("attested", nullifier), Controller: "attested", FaceNullifier: nullifier, FaceCommitment: commitment}
+	duplicate := types.DidDocument{Did: types.GenerateDid("duplicate", nullifier), Controller: "duplicate", FaceNullifier: nullifier, FaceCommitment: commitment}
+	unattested := types.DidDocument{Did: types.GenerateDid("unattested", ""), Controller: "unattested"}
+	for _, doc := range []types.DidDocument{attested, duplicate, unattested} {
+		require.NoError(t, f.keeper.DidDocument.Set(ctx, doc.Did, doc))
+		require.NoError(t, f.keeper.ControllerDid.Set(ctx, doc.Controller, doc.Did))
+	}
+	require.NoError(t, f.keeper.FaceNullifierToIndex.Set(ctx, nullifier, attested.Did))
+
+	tests := []struct {
+		desc    string
+		address string
+		exp     types.PersonhoodQueryPacketAck
+		err     bool
+	}{
+		{
+			desc:    "attested",
+			address: "attested",
+			exp:     types.PersonhoodQueryPacketAck{Address: "attested", IsRegistered: true, IsUnique: true, Status: types.ATTESTATION_STATUS_ATTESTED, Did: attested.Did},
+		},
+		{
+			desc:    "duplicate",
+			address: "duplicate",
+			exp:     types.PersonhoodQueryPacketAck{Address: "duplicate", IsRegistered: true, Status: types.ATTESTATION_STATUS_DUPLICATE, Did: duplicate.Did},
+		},
+		{
+			desc:    "unattested",
+			address: "unattested",
+			exp:     types.PersonhoodQueryPacketAck{Address: "unattested", IsRegistered: true, Status: types.ATTESTATION_STATUS_UNATTESTED, Did: unattested.Did},
+		},
+		{
+			desc:    "unregistered",
+			address: "nobody",
+			exp:     types.PersonhoodQueryPacketAck{Address: "nobody", Status: types.ATTESTATION_STATUS_UNREGISTERED},
+		},
+		{
+			desc: "empty address",
+			err:  true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			ack, err := f.keeper.OnRecvPersonhoodQueryPacket(ctx, channeltypes.Packet{}, types.PersonhoodQueryPacketData{Address: tc.address})
+			if tc.err {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.exp, ack)
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dtc/x/identity/types"
+)
+
+func (q queryServer) ListAttestor(ctx context.Context, req *types.QueryAllAttestorRequest) (*types.QueryAllAttestorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	attestors, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Attestor,
+		req.Pagination,
+		func(_ string, value types.Attestor) (types.Attestor, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllAttestorResponse{Attestor: attestors, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetAttestor(ctx context.Context, req *types.QueryGetAttestorRequest) (*types.QueryGetAttestorResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	attestor, err := q.k.Attestor.Get(ctx, req.Address)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetAttestorResponse{Attestor: attestor}, nil
+}
@@ -0,0 +1,25 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dtc/x/identity/types"
+)
+
+func (q queryServer) AttestorStrikes(ctx context.Context, req *types.QueryAttestorStrikesRequest) (*types.QueryAttestorStrikesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	strikes, err := q.k.AttestorStrikes.Get(ctx, req.Attestor)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryAttestorStrikesResponse{Strikes: strikes}, nil
+}
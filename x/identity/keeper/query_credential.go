@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dtc/x/identity/types"
+)
+
+func (q queryServer) GetCredential(ctx context.Context, req *types.QueryGetCredentialRequest) (*types.QueryGetCredentialResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	did, err := q.k.ResolveDid(ctx, req.Did)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	credential, err := q.k.Credential.Get(ctx, collections.Join(did, req.CredentialType))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetCredentialResponse{
+		Credential: credential,
+		Active:     q.k.HasActiveCredential(ctx, did, req.CredentialType),
+	}, nil
+}
@@ -37,7 +37,13 @@ func (q queryServer) GetDidDocument(ctx context.Context, req *types.QueryGetDidD
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	val, err := q.k.DidDocument.Get(ctx, req.Did)
+	// 兼容大小写不一致及迁移前的旧格式 DID
+	did := req.Did
+	if canonical, err := q.k.ResolveDid(ctx, req.Did); err == nil {
+		did = canonical
+	}
+
+	val, err := q.k.DidDocument.Get(ctx, did)
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
 			return nil, status.Error(codes.NotFound, "not found")
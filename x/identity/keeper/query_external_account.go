@@ -0,0 +1,58 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dtc/x/identity/types"
+)
+
+func (q queryServer) GetDidByExternalAccount(ctx context.Context, req *types.QueryGetDidByExternalAccountRequest) (*types.QueryGetDidByExternalAccountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ethAddress, err := types.NormalizeEthAddress(req.EthAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	link, err := q.k.ExternalAccount.Get(ctx, ethAddress)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetDidByExternalAccountResponse{Link: link}, nil
+}
+
+func (q queryServer) ExternalAccountChallenge(ctx context.Context, req *types.QueryExternalAccountChallengeRequest) (*types.QueryExternalAccountChallengeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	ethAddress, err := types.NormalizeEthAddress(req.EthAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+	did, err := q.k.ResolveDid(ctx, req.Did)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	nonce, err := q.k.nextExternalAccountNonce(ctx, ethAddress)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	challenge := types.ExternalAccountChallenge(sdk.UnwrapSDKContext(ctx).ChainID(), did, ethAddress, nonce)
+	return &types.QueryExternalAccountChallengeResponse{Challenge: challenge}, nil
+}
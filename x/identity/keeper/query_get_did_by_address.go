@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"dtc/x/identity/types"
 
 	"google.golang.org/grpc/codes"
@@ -14,33 +16,22 @@ func (q queryServer) GetDidByAddress(ctx context.Context, req *types.QueryGetDid
 		return nil, status.Error(codes.InvalidArgument, "invalid request")
 	}
 
-	// 遍历 DidDocument，查找 Controller 等于输入 address 的文档
-	var foundDidDocument *types.DidDocument
-	err := q.k.DidDocument.Walk(ctx, nil, func(key string, value types.DidDocument) (stop bool, err error) {
-		if value.Controller == req.Address {
-			foundDidDocument = &value
-			return true, nil // 找到后停止遍历
-		}
-		return false, nil
-	})
-
-	if err != nil {
-		return nil, status.Error(codes.Internal, err.Error())
-	}
+	// 通过 controller 索引查找未停用的 DID 文档
+	didDocument, found := q.k.GetDidDocument(sdk.UnwrapSDKContext(ctx), req.Address)
 
 	// 如果没找到，返回 isRegistered: false
-	if foundDidDocument == nil {
+	if !found {
 		return &types.QueryGetDidByAddressResponse{
-			IsRegistered: false,
-			Did:          "",
-			FaceHash:     "",
+			IsRegistered:   false,
+			Did:            "",
+			FaceCommitment: "",
 		}, nil
 	}
 
-	// 找到后返回 did 和 faceHash
+	// 找到后返回 did 和 faceCommitment（不返回可关联生物特征的 nullifier）
 	return &types.QueryGetDidByAddressResponse{
-		IsRegistered: true,
-		Did:          foundDidDocument.Did,
-		FaceHash:     foundDidDocument.FaceHash,
+		IsRegistered:   true,
+		Did:            didDocument.Did,
+		FaceCommitment: didDocument.FaceCommitment,
 	}, nil
 }
@@ -0,0 +1,46 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dtc/x/identity/types"
+)
+
+func (q queryServer) GetGuardianship(ctx context.Context, req *types.QueryGetGuardianshipRequest) (*types.QueryGetGuardianshipResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	did, err := q.k.ResolveDid(ctx, req.Did)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	guardianship, found := q.k.GetGuardianship(ctx, did)
+	if !found {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryGetGuardianshipResponse{Guardianship: guardianship}, nil
+}
+
+func (q queryServer) ListDependents(ctx context.Context, req *types.QueryListDependentsRequest) (*types.QueryListDependentsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	guardianDid, err := q.k.ResolveDid(ctx, req.GuardianDid)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	dependents, err := q.k.GetDependents(ctx, guardianDid)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListDependentsResponse{Guardianships: dependents}, nil
+}
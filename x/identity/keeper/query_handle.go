@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dtc/x/identity/types"
+)
+
+func (q queryServer) ResolveHandle(ctx context.Context, req *types.QueryResolveHandleRequest) (*types.QueryResolveHandleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	handle, err := q.k.Handle.Get(ctx, types.NormalizeHandle(req.Handle))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	// 已释放（含冷却期内）的句柄不再解析到 DID
+	if !handle.IsActive() {
+		return nil, status.Error(codes.NotFound, "not found")
+	}
+
+	return &types.QueryResolveHandleResponse{Handle: handle}, nil
+}
+
+func (q queryServer) GetHandleByDid(ctx context.Context, req *types.QueryGetHandleByDidRequest) (*types.QueryGetHandleByDidResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	did, err := q.k.ResolveDid(ctx, req.Did)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	name, err := q.k.DidHandle.Get(ctx, did)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	handle, err := q.k.Handle.Get(ctx, name)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetHandleByDidResponse{Handle: handle}, nil
+}
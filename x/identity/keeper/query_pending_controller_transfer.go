@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dtc/x/identity/types"
+)
+
+func (q queryServer) ListPendingControllerTransfer(ctx context.Context, req *types.QueryAllPendingControllerTransferRequest) (*types.QueryAllPendingControllerTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	transfers, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.PendingControllerTransfer,
+		req.Pagination,
+		func(_ string, value types.PendingControllerTransfer) (types.PendingControllerTransfer, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllPendingControllerTransferResponse{PendingControllerTransfer: transfers, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetPendingControllerTransfer(ctx context.Context, req *types.QueryGetPendingControllerTransferRequest) (*types.QueryGetPendingControllerTransferResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	did, err := q.k.ResolveDid(ctx, req.Did)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	val, err := q.k.PendingControllerTransfer.Get(ctx, did)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetPendingControllerTransferResponse{PendingControllerTransfer: val}, nil
+}
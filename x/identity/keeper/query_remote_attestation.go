@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dtc/x/identity/types"
+)
+
+// remoteAttestationKey 返回对手链身份证明的存储键
+func remoteAttestationKey(channelID, address string) collections.Pair[string, string] {
+	return collections.Join(channelID, address)
+}
+
+func (q queryServer) GetRemoteAttestation(ctx context.Context, req *types.QueryGetRemoteAttestationRequest) (*types.QueryGetRemoteAttestationResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.RemoteAttestation.Get(ctx, remoteAttestationKey(req.ChannelId, req.Address))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetRemoteAttestationResponse{RemoteAttestation: val}, nil
+}
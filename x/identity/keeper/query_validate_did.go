@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"dtc/x/identity/types"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ValidateDid(ctx context.Context, req *types.QueryValidateDidRequest) (*types.QueryValidateDidResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	// 旧格式 DID 迁移后通过别名指向规范 DID
+	canonical, err := q.k.DidAlias.Get(ctx, req.Did)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	normalized, err := types.NormalizeDid(req.Did)
+	if err != nil {
+		return &types.QueryValidateDidResponse{
+			Valid:        false,
+			CanonicalDid: canonical,
+			Reason:       err.Error(),
+		}, nil
+	}
+
+	if canonical == "" {
+		canonical = normalized
+	}
+
+	return &types.QueryValidateDidResponse{
+		Valid:         true,
+		NormalizedDid: normalized,
+		CanonicalDid:  canonical,
+	}, nil
+}
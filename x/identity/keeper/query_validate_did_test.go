@@ -0,0 +1,64 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"dtc/x/identity/keeper"
+	"dtc/x/identity/types"
+)
+
+func TestValidateDidQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	did := types.GenerateDid("controller", "face")
+	require.NoError(t, f.keeper.DidAlias.Set(f.ctx, "legacy-0", did))
+
+	tests := []struct {
+		desc     string
+		request  *types.QueryValidateDidRequest
+		response *types.QueryValidateDidResponse
+		err      error
+	}{
+		{
+			desc:     "canonical",
+			request:  &types.QueryValidateDidRequest{Did: did},
+			response: &types.QueryValidateDidResponse{Valid: true, NormalizedDid: did, CanonicalDid: did},
+		},
+		{
+			desc:     "normalised",
+			request:  &types.QueryValidateDidRequest{Did: strings.ToUpper(did)},
+			response: &types.QueryValidateDidResponse{Valid: true, NormalizedDid: did, CanonicalDid: did},
+		},
+		{
+			desc:     "legacy alias",
+			request:  &types.QueryValidateDidRequest{Did: "legacy-0"},
+			response: &types.QueryValidateDidResponse{Valid: false, CanonicalDid: did, Reason: `did must start with "did:dtc:"`},
+		},
+		{
+			desc:     "short id",
+			request:  &types.QueryValidateDidRequest{Did: "did:dtc:abc"},
+			response: &types.QueryValidateDidResponse{Valid: false, Reason: "method-specific id must be 32 hex characters, got 3"},
+		},
+		{
+			desc: "InvalidRequest",
+			err:  status.Error(codes.InvalidArgument, "invalid request"),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			response, err := qs.ValidateDid(f.ctx, tc.request)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+			} else {
+				require.NoError(t, err)
+				require.EqualExportedValues(t, tc.response, response)
+			}
+		})
+	}
+}
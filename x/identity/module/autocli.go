@@ -35,6 +35,94 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Query getDidByAddress",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
 				},
+				{
+					RpcMethod:      "ValidateDid",
+					Use:            "validate-did [did]",
+					Short:          "Validate and normalise a did:dtc identifier",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "did"}},
+				},
+
+				{
+					RpcMethod:      "GetRemoteAttestation",
+					Use:            "get-remote-attestation [channel-id] [address]",
+					Short:          "Query an identity attestation received over IBC",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}, {ProtoField: "address"}},
+				},
+
+				{
+					RpcMethod:      "GetDidByExternalAccount",
+					Use:            "get-did-by-external-account [eth-address]",
+					Short:          "Query the DID linked to an Ethereum address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "eth_address"}},
+				},
+				{
+					RpcMethod:      "ExternalAccountChallenge",
+					Use:            "external-account-challenge [did] [eth-address]",
+					Short:          "Query the message to personal_sign for linking an Ethereum address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "did"}, {ProtoField: "eth_address"}},
+				},
+
+				{
+					RpcMethod:      "GetPendingControllerTransfer",
+					Use:            "get-pending-controller-transfer [did]",
+					Short:          "Query the pending controller transfer of a DID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "did"}},
+				},
+				{
+					RpcMethod: "ListPendingControllerTransfer",
+					Use:       "list-pending-controller-transfer",
+					Short:     "List all pending controller transfers",
+				},
+
+				{
+					RpcMethod:      "ResolveHandle",
+					Use:            "resolve-handle [handle]",
+					Short:          "Resolve a handle to its DID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "handle"}},
+				},
+				{
+					RpcMethod:      "GetHandleByDid",
+					Use:            "get-handle-by-did [did]",
+					Short:          "Query the handle of a DID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "did"}},
+				},
+				{
+					RpcMethod:      "GetCredential",
+					Use:            "get-credential [did] [credential-type]",
+					Short:          "Query a credential of a DID by type",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "did"}, {ProtoField: "credential_type"}},
+				},
+
+				{
+					RpcMethod:      "GetGuardianship",
+					Use:            "get-guardianship [did]",
+					Short:          "Query the guardian of a dependent DID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "did"}},
+				},
+				{
+					RpcMethod:      "ListDependents",
+					Use:            "list-dependents [guardian-did]",
+					Short:          "List the dependent DIDs of a guardian DID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "guardian_did"}},
+				},
+
+				{
+					RpcMethod:      "AttestorStrikes",
+					Use:            "attestor-strikes [attestor]",
+					Short:          "Query the duplicate-identity strikes recorded against an attestor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "attestor"}},
+				},
+				{
+					RpcMethod: "ListAttestor",
+					Use:       "list-attestor",
+					Short:     "List all bonded and unbonding attestors",
+				},
+				{
+					RpcMethod:      "GetAttestor",
+					Use:            "get-attestor [address]",
+					Short:          "Query an attestor by address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
 
 				// this line is used by ignite scaffolding # autocli/query
 			},
@@ -65,6 +153,111 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Delete didDocument",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "did"}},
 				},
+				{
+					RpcMethod: "BatchCreateDidDocuments",
+					Skip:      true, // skipped because entries are submitted as a JSON tx by onboarding tooling
+				},
+				{
+					RpcMethod:      "SendIdentityAttestation",
+					Use:            "send-identity-attestation [channel-id] [timeout-timestamp]",
+					Short:          "Push the sender's identity attestation to a counterparty chain over IBC",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "channel_id"}, {ProtoField: "timeout_timestamp"}},
+				},
+				{
+					RpcMethod:      "LinkExternalAccount",
+					Use:            "link-external-account [did] [eth-address] [signature]",
+					Short:          "Link an Ethereum address to a DID with an EIP-191 signature",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "did"}, {ProtoField: "eth_address"}, {ProtoField: "signature"}},
+				},
+				{
+					RpcMethod:      "UnlinkExternalAccount",
+					Use:            "unlink-external-account [eth-address]",
+					Short:          "Unlink an Ethereum address from its DID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "eth_address"}},
+				},
+				{
+					RpcMethod:      "ProposeControllerTransfer",
+					Use:            "propose-controller-transfer [did] [new-controller]",
+					Short:          "Propose transferring control of a DID to a new address",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "did"}, {ProtoField: "new_controller"}},
+				},
+				{
+					RpcMethod:      "AcceptControllerTransfer",
+					Use:            "accept-controller-transfer [did]",
+					Short:          "Accept a pending controller transfer as the proposed controller",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "did"}},
+				},
+				{
+					RpcMethod:      "CancelControllerTransfer",
+					Use:            "cancel-controller-transfer [did]",
+					Short:          "Cancel a pending controller transfer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "did"}},
+				},
+				{
+					RpcMethod:      "ClaimHandle",
+					Use:            "claim-handle [did] [handle]",
+					Short:          "Claim a handle for a DID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "did"}, {ProtoField: "handle"}},
+				},
+				{
+					RpcMethod:      "ReleaseHandle",
+					Use:            "release-handle [did]",
+					Short:          "Release the handle of a DID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "did"}},
+				},
+				{
+					RpcMethod:      "TransferHandle",
+					Use:            "transfer-handle [did] [to-did]",
+					Short:          "Transfer the handle of a DID to another DID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "did"}, {ProtoField: "to_did"}},
+				},
+				{
+					RpcMethod:      "CreateOrganisationDid",
+					Use:            "create-organisation-did [members]...",
+					Short:          "Register an organisation DID controlled by the signing group policy",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "members", Varargs: true}},
+				},
+				{
+					RpcMethod:      "UpdateOrganisationMembers",
+					Use:            "update-organisation-members [did] [members]...",
+					Short:          "Replace the member DIDs of an organisation DID",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "did"}, {ProtoField: "members", Varargs: true}},
+				},
+				{
+					RpcMethod: "CreateDependentDid",
+					Skip:      true, // skipped because the attestor signature is produced by onboarding tooling
+				},
+				{
+					RpcMethod: "ClaimMaturity",
+					Skip:      true, // skipped because the attestor signature is produced by onboarding tooling
+				},
+				{
+					RpcMethod:      "BondAttestor",
+					Use:            "bond-attestor [amount] [pubkey]",
+					Short:          "Bond into the attestor registry or top up an existing bond",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}, {ProtoField: "pubkey", Optional: true}},
+				},
+				{
+					RpcMethod: "UnbondAttestor",
+					Use:       "unbond-attestor",
+					Short:     "Leave the attestor registry; the bond is returned after the unbonding period",
+				},
+				{
+					RpcMethod: "SlashAttestor",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "IssueCredential",
+					Use:            "issue-credential [did] [credential-type] [expiry-height]",
+					Short:          "Issue a credential to a DID as a bonded attestor",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "did"}, {ProtoField: "credential_type"}, {ProtoField: "expiry_height", Optional: true}},
+				},
+				{
+					RpcMethod:      "RevokeCredential",
+					Use:            "revoke-credential [did] [credential-type]",
+					Short:          "Revoke a credential issued by the signer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "did"}, {ProtoField: "credential_type"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -18,14 +18,15 @@ func (AppModule) GenerateGenesisState(simState *module.SimulationState) {
 	for i, acc := range simState.Accounts {
 		accs[i] = acc.Address.String()
 	}
+	controllers := []string{sample.AccAddress(), sample.AccAddress()}
 	identityGenesis := types.GenesisState{
 		Params: types.DefaultParams(),
 		DidDocumentMap: []types.DidDocument{{
-			Did:        "0",
-			Controller: sample.AccAddress(),
+			Did:        types.GenerateDid(controllers[0], ""),
+			Controller: controllers[0],
 		}, {
-			Did:        "1",
-			Controller: sample.AccAddress(),
+			Did:        types.GenerateDid(controllers[1], ""),
+			Controller: controllers[1],
 		}}}
 	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&identityGenesis)
 }
@@ -81,6 +82,288 @@ func (am AppModule) WeightedOperations(simState module.SimulationState) []simtyp
 		weightMsgDeleteDidDocument,
 		identitysimulation.SimulateMsgDeleteDidDocument(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
+	const (
+		opWeightMsgBatchCreateDidDocuments          = "op_weight_msg_identity"
+		defaultWeightMsgBatchCreateDidDocuments int = 100
+	)
+
+	var weightMsgBatchCreateDidDocuments int
+	simState.AppParams.GetOrGenerate(opWeightMsgBatchCreateDidDocuments, &weightMsgBatchCreateDidDocuments, nil,
+		func(_ *rand.Rand) {
+			weightMsgBatchCreateDidDocuments = defaultWeightMsgBatchCreateDidDocuments
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgBatchCreateDidDocuments,
+		identitysimulation.SimulateMsgBatchCreateDidDocuments(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgSendIdentityAttestation          = "op_weight_msg_identity"
+		defaultWeightMsgSendIdentityAttestation int = 100
+	)
+
+	var weightMsgSendIdentityAttestation int
+	simState.AppParams.GetOrGenerate(opWeightMsgSendIdentityAttestation, &weightMsgSendIdentityAttestation, nil,
+		func(_ *rand.Rand) {
+			weightMsgSendIdentityAttestation = defaultWeightMsgSendIdentityAttestation
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSendIdentityAttestation,
+		identitysimulation.SimulateMsgSendIdentityAttestation(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgLinkExternalAccount          = "op_weight_msg_identity"
+		defaultWeightMsgLinkExternalAccount int = 100
+	)
+
+	var weightMsgLinkExternalAccount int
+	simState.AppParams.GetOrGenerate(opWeightMsgLinkExternalAccount, &weightMsgLinkExternalAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgLinkExternalAccount = defaultWeightMsgLinkExternalAccount
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgLinkExternalAccount,
+		identitysimulation.SimulateMsgLinkExternalAccount(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgUnlinkExternalAccount          = "op_weight_msg_identity"
+		defaultWeightMsgUnlinkExternalAccount int = 100
+	)
+
+	var weightMsgUnlinkExternalAccount int
+	simState.AppParams.GetOrGenerate(opWeightMsgUnlinkExternalAccount, &weightMsgUnlinkExternalAccount, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnlinkExternalAccount = defaultWeightMsgUnlinkExternalAccount
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUnlinkExternalAccount,
+		identitysimulation.SimulateMsgUnlinkExternalAccount(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgProposeControllerTransfer          = "op_weight_msg_identity"
+		defaultWeightMsgProposeControllerTransfer int = 100
+	)
+
+	var weightMsgProposeControllerTransfer int
+	simState.AppParams.GetOrGenerate(opWeightMsgProposeControllerTransfer, &weightMsgProposeControllerTransfer, nil,
+		func(_ *rand.Rand) {
+			weightMsgProposeControllerTransfer = defaultWeightMsgProposeControllerTransfer
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgProposeControllerTransfer,
+		identitysimulation.SimulateMsgProposeControllerTransfer(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgAcceptControllerTransfer          = "op_weight_msg_identity"
+		defaultWeightMsgAcceptControllerTransfer int = 100
+	)
+
+	var weightMsgAcceptControllerTransfer int
+	simState.AppParams.GetOrGenerate(opWeightMsgAcceptControllerTransfer, &weightMsgAcceptControllerTransfer, nil,
+		func(_ *rand.Rand) {
+			weightMsgAcceptControllerTransfer = defaultWeightMsgAcceptControllerTransfer
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgAcceptControllerTransfer,
+		identitysimulation.SimulateMsgAcceptControllerTransfer(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgCancelControllerTransfer          = "op_weight_msg_identity"
+		defaultWeightMsgCancelControllerTransfer int = 100
+	)
+
+	var weightMsgCancelControllerTransfer int
+	simState.AppParams.GetOrGenerate(opWeightMsgCancelControllerTransfer, &weightMsgCancelControllerTransfer, nil,
+		func(_ *rand.Rand) {
+			weightMsgCancelControllerTransfer = defaultWeightMsgCancelControllerTransfer
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCancelControllerTransfer,
+		identitysimulation.SimulateMsgCancelControllerTransfer(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgClaimHandle          = "op_weight_msg_identity"
+		defaultWeightMsgClaimHandle int = 100
+	)
+
+	var weightMsgClaimHandle int
+	simState.AppParams.GetOrGenerate(opWeightMsgClaimHandle, &weightMsgClaimHandle, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaimHandle = defaultWeightMsgClaimHandle
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgClaimHandle,
+		identitysimulation.SimulateMsgClaimHandle(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgReleaseHandle          = "op_weight_msg_identity"
+		defaultWeightMsgReleaseHandle int = 100
+	)
+
+	var weightMsgReleaseHandle int
+	simState.AppParams.GetOrGenerate(opWeightMsgReleaseHandle, &weightMsgReleaseHandle, nil,
+		func(_ *rand.Rand) {
+			weightMsgReleaseHandle = defaultWeightMsgReleaseHandle
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgReleaseHandle,
+		identitysimulation.SimulateMsgReleaseHandle(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgTransferHandle          = "op_weight_msg_identity"
+		defaultWeightMsgTransferHandle int = 100
+	)
+
+	var weightMsgTransferHandle int
+	simState.AppParams.GetOrGenerate(opWeightMsgTransferHandle, &weightMsgTransferHandle, nil,
+		func(_ *rand.Rand) {
+			weightMsgTransferHandle = defaultWeightMsgTransferHandle
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgTransferHandle,
+		identitysimulation.SimulateMsgTransferHandle(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgCreateOrganisationDid          = "op_weight_msg_identity"
+		defaultWeightMsgCreateOrganisationDid int = 100
+	)
+
+	var weightMsgCreateOrganisationDid int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateOrganisationDid, &weightMsgCreateOrganisationDid, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateOrganisationDid = defaultWeightMsgCreateOrganisationDid
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateOrganisationDid,
+		identitysimulation.SimulateMsgCreateOrganisationDid(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgUpdateOrganisationMembers          = "op_weight_msg_identity"
+		defaultWeightMsgUpdateOrganisationMembers int = 100
+	)
+
+	var weightMsgUpdateOrganisationMembers int
+	simState.AppParams.GetOrGenerate(opWeightMsgUpdateOrganisationMembers, &weightMsgUpdateOrganisationMembers, nil,
+		func(_ *rand.Rand) {
+			weightMsgUpdateOrganisationMembers = defaultWeightMsgUpdateOrganisationMembers
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUpdateOrganisationMembers,
+		identitysimulation.SimulateMsgUpdateOrganisationMembers(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgCreateDependentDid          = "op_weight_msg_identity"
+		defaultWeightMsgCreateDependentDid int = 100
+	)
+
+	var weightMsgCreateDependentDid int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateDependentDid, &weightMsgCreateDependentDid, nil,
+		func(_ *rand.Rand) {
+			weightMsgCreateDependentDid = defaultWeightMsgCreateDependentDid
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgCreateDependentDid,
+		identitysimulation.SimulateMsgCreateDependentDid(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgClaimMaturity          = "op_weight_msg_identity"
+		defaultWeightMsgClaimMaturity int = 100
+	)
+
+	var weightMsgClaimMaturity int
+	simState.AppParams.GetOrGenerate(opWeightMsgClaimMaturity, &weightMsgClaimMaturity, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaimMaturity = defaultWeightMsgClaimMaturity
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgClaimMaturity,
+		identitysimulation.SimulateMsgClaimMaturity(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgBondAttestor          = "op_weight_msg_identity"
+		defaultWeightMsgBondAttestor int = 100
+	)
+
+	var weightMsgBondAttestor int
+	simState.AppParams.GetOrGenerate(opWeightMsgBondAttestor, &weightMsgBondAttestor, nil,
+		func(_ *rand.Rand) {
+			weightMsgBondAttestor = defaultWeightMsgBondAttestor
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgBondAttestor,
+		identitysimulation.SimulateMsgBondAttestor(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgUnbondAttestor          = "op_weight_msg_identity"
+		defaultWeightMsgUnbondAttestor int = 100
+	)
+
+	var weightMsgUnbondAttestor int
+	simState.AppParams.GetOrGenerate(opWeightMsgUnbondAttestor, &weightMsgUnbondAttestor, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnbondAttestor = defaultWeightMsgUnbondAttestor
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUnbondAttestor,
+		identitysimulation.SimulateMsgUnbondAttestor(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgIssueCredential          = "op_weight_msg_identity"
+		defaultWeightMsgIssueCredential int = 100
+	)
+
+	var weightMsgIssueCredential int
+	simState.AppParams.GetOrGenerate(opWeightMsgIssueCredential, &weightMsgIssueCredential, nil,
+		func(_ *rand.Rand) {
+			weightMsgIssueCredential = defaultWeightMsgIssueCredential
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgIssueCredential,
+		identitysimulation.SimulateMsgIssueCredential(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+
+	const (
+		opWeightMsgRevokeCredential          = "op_weight_msg_identity"
+		defaultWeightMsgRevokeCredential int = 100
+	)
+
+	var weightMsgRevokeCredential int
+	simState.AppParams.GetOrGenerate(opWeightMsgRevokeCredential, &weightMsgRevokeCredential, nil,
+		func(_ *rand.Rand) {
+			weightMsgRevokeCredential = defaultWeightMsgRevokeCredential
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRevokeCredential,
+		identitysimulation.SimulateMsgRevokeCredential(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
 
 	return operations
 }
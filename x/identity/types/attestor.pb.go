@@ -0,0 +1,633 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dtc/identity/v1/attestor.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AttestorStatus 是认证方在注册表中的状态
+type AttestorStatus int32
+
+const (
+	// ATTESTOR_STATUS_UNSPECIFIED 未定义
+	ATTESTOR_STATUS_UNSPECIFIED AttestorStatus = 0
+	// ATTESTOR_STATUS_BONDED 已质押，保证金不低于下限时其签名被接受
+	ATTESTOR_STATUS_BONDED AttestorStatus = 1
+	// ATTESTOR_STATUS_UNBONDING 正在解除质押，签名不再被接受，但保证金仍可被罚没
+	ATTESTOR_STATUS_UNBONDING AttestorStatus = 2
+)
+
+var AttestorStatus_name = map[int32]string{
+	0: "ATTESTOR_STATUS_UNSPECIFIED",
+	1: "ATTESTOR_STATUS_BONDED",
+	2: "ATTESTOR_STATUS_UNBONDING",
+}
+
+var AttestorStatus_value = map[string]int32{
+	"ATTESTOR_STATUS_UNSPECIFIED": 0,
+	"ATTESTOR_STATUS_BONDED":      1,
+	"ATTESTOR_STATUS_UNBONDING":   2,
+}
+
+func (x AttestorStatus) String() string {
+	return proto.EnumName(AttestorStatus_name, int32(x))
+}
+
+func (AttestorStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_304c65aeae4bb4ff, []int{0}
+}
+
+// Attestor 是质押保证金加入注册表的认证方
+type Attestor struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// pubkey 是认证方签名使用的 secp256k1 压缩公钥（hex）
+	Pubkey       string         `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Bond         types.Coin     `protobuf:"bytes,3,opt,name=bond,proto3" json:"bond"`
+	Status       AttestorStatus `protobuf:"varint,4,opt,name=status,proto3,enum=dtc.identity.v1.AttestorStatus" json:"status,omitempty"`
+	BondedHeight int64          `protobuf:"varint,5,opt,name=bonded_height,json=bondedHeight,proto3" json:"bonded_height,omitempty"`
+	// unbonding_completion_height 之后保证金退还给认证方
+	UnbondingCompletionHeight int64 `protobuf:"varint,6,opt,name=unbonding_completion_height,json=unbondingCompletionHeight,proto3" json:"unbonding_completion_height,omitempty"`
+	// slashed 是累计被罚没的保证金
+	Slashed types.Coin `protobuf:"bytes,7,opt,name=slashed,proto3" json:"slashed"`
+}
+
+func (m *Attestor) Reset()         { *m = Attestor{} }
+func (m *Attestor) String() string { return proto.CompactTextString(m) }
+func (*Attestor) ProtoMessage()    {}
+func (*Attestor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_304c65aeae4bb4ff, []int{0}
+}
+func (m *Attestor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Attestor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Attestor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Attestor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Attestor.Merge(m, src)
+}
+func (m *Attestor) XXX_Size() int {
+	return m.Size()
+}
+func (m *Attestor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Attestor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Attestor proto.InternalMessageInfo
+
+func (m *Attestor) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Attestor) GetPubkey() string {
+	if m != nil {
+		return m.Pubkey
+	}
+	return ""
+}
+
+func (m *Attestor) GetBond() types.Coin {
+	if m != nil {
+		return m.Bond
+	}
+	return types.Coin{}
+}
+
+func (m *Attestor) GetStatus() AttestorStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ATTESTOR_STATUS_UNSPECIFIED
+}
+
+func (m *Attestor) GetBondedHeight() int64 {
+	if m != nil {
+		return m.BondedHeight
+	}
+	return 0
+}
+
+func (m *Attestor) GetUnbondingCompletionHeight() int64 {
+	if m != nil {
+		return m.UnbondingCompletionHeight
+	}
+	return 0
+}
+
+func (m *Attestor) GetSlashed() types.Coin {
+	if m != nil {
+		return m.Slashed
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterEnum("dtc.identity.v1.AttestorStatus", AttestorStatus_name, AttestorStatus_value)
+	proto.RegisterType((*Attestor)(nil), "dtc.identity.v1.Attestor")
+}
+
+func init() { proto.RegisterFile("dtc/identity/v1/attestor.proto", fileDescriptor_304c65aeae4bb4ff) }
+
+var fileDescriptor_304c65aeae4bb4ff = []byte{
+	// 425 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3d, 0x8f, 0xd3, 0x30,
+	0x18, 0x8e, 0x7b, 0xa5, 0xe5, 0x0c, 0x1c, 0xc5, 0x3a, 0x9d, 0xd2, 0x9e, 0x70, 0x2b, 0x58, 0xaa,
+	0x1b, 0x6c, 0xf5, 0x18, 0x60, 0x42, 0xea, 0x17, 0xd0, 0xa5, 0x87, 0x92, 0xdc, 0xc2, 0x52, 0x25,
+	0xb1, 0xd5, 0x5a, 0x5c, 0xed, 0x28, 0x76, 0x2b, 0xfa, 0x0f, 0x18, 0xf9, 0x0f, 0x2c, 0x8c, 0xfc,
+	0x8c, 0x1b, 0x6f, 0x60, 0x60, 0x42, 0xa8, 0x1d, 0xf8, 0x1b, 0x28, 0x4e, 0x52, 0xc4, 0x31, 0xb1,
+	0x44, 0xef, 0xfb, 0x7c, 0xc4, 0xcf, 0x2b, 0x3d, 0x10, 0x33, 0x13, 0x53, 0xc1, 0xb8, 0x34, 0xc2,
+	0x6c, 0xe8, 0xba, 0x47, 0x43, 0x63, 0xb8, 0x36, 0x2a, 0x25, 0x49, 0xaa, 0x8c, 0x42, 0x0f, 0x99,
+	0x89, 0x49, 0xc9, 0x93, 0x75, 0xaf, 0xf5, 0x28, 0x5c, 0x0a, 0xa9, 0xa8, 0xfd, 0xe6, 0x9a, 0x16,
+	0x8e, 0x95, 0x5e, 0x2a, 0x4d, 0xa3, 0x50, 0x73, 0xba, 0xee, 0x45, 0xdc, 0x84, 0x3d, 0x1a, 0x2b,
+	0x21, 0x0b, 0xfe, 0x78, 0xae, 0xe6, 0xca, 0x8e, 0x34, 0x9b, 0x72, 0xf4, 0xc9, 0xb7, 0x0a, 0xbc,
+	0xdb, 0x2f, 0x1e, 0x43, 0x2e, 0xac, 0x87, 0x8c, 0xa5, 0x5c, 0x6b, 0x17, 0x74, 0x40, 0xf7, 0xd0,
+	0x2b, 0x57, 0x74, 0x02, 0x6b, 0xc9, 0x2a, 0x7a, 0xcf, 0x37, 0x6e, 0xc5, 0x12, 0xc5, 0x86, 0x5e,
+	0xc0, 0x6a, 0xa4, 0x24, 0x73, 0x0f, 0x3a, 0xa0, 0x7b, 0xef, 0xbc, 0x49, 0xf2, 0x0c, 0x24, 0xcb,
+	0x40, 0x8a, 0x0c, 0x64, 0xa8, 0x84, 0x1c, 0x1c, 0x5e, 0xff, 0x68, 0x3b, 0x5f, 0x7e, 0x7d, 0x3d,
+	0x03, 0x9e, 0x75, 0xa0, 0xe7, 0xb0, 0xa6, 0x4d, 0x68, 0x56, 0xda, 0xad, 0x76, 0x40, 0xf7, 0xe8,
+	0xbc, 0x4d, 0x6e, 0xdd, 0x48, 0xca, 0x58, 0xbe, 0x95, 0x79, 0x85, 0x1c, 0x3d, 0x85, 0x0f, 0xb2,
+	0x1f, 0x70, 0x36, 0x5b, 0x70, 0x31, 0x5f, 0x18, 0xf7, 0x4e, 0x07, 0x74, 0x0f, 0xbc, 0xfb, 0x39,
+	0xf8, 0xc6, 0x62, 0xe8, 0x25, 0x3c, 0x5d, 0xc9, 0x0c, 0x11, 0x72, 0x3e, 0x8b, 0xd5, 0x32, 0xb9,
+	0xe2, 0x46, 0x28, 0x59, 0x5a, 0x6a, 0xd6, 0xd2, 0xdc, 0x4b, 0x86, 0x7b, 0xc5, 0xde, 0x5f, 0xd7,
+	0x57, 0xa1, 0x5e, 0x70, 0xe6, 0xd6, 0xff, 0xe3, 0xb4, 0xd2, 0x74, 0x96, 0xc2, 0xa3, 0xbf, 0xe3,
+	0xa3, 0x36, 0x3c, 0xed, 0x07, 0xc1, 0xd8, 0x0f, 0x2e, 0xbc, 0x99, 0x1f, 0xf4, 0x83, 0x4b, 0x7f,
+	0x76, 0x39, 0xf5, 0xdf, 0x8e, 0x87, 0x93, 0x57, 0x93, 0xf1, 0xa8, 0xe1, 0xa0, 0x16, 0x3c, 0xb9,
+	0x2d, 0x18, 0x5c, 0x4c, 0x47, 0xe3, 0x51, 0x03, 0xa0, 0xc7, 0xb0, 0xf9, 0xaf, 0x39, 0x63, 0x27,
+	0xd3, 0xd7, 0x8d, 0x4a, 0xab, 0xfa, 0xf1, 0x33, 0x76, 0x06, 0xe4, 0x7a, 0x8b, 0xc1, 0xcd, 0x16,
+	0x83, 0x9f, 0x5b, 0x0c, 0x3e, 0xed, 0xb0, 0x73, 0xb3, 0xc3, 0xce, 0xf7, 0x1d, 0x76, 0xde, 0x1d,
+	0x67, 0xf5, 0xfa, 0xf0, 0xa7, 0x60, 0x66, 0x93, 0x70, 0x1d, 0xd5, 0x6c, 0x03, 0x9e, 0xfd, 0x1e,
+	0x00, 0xf4, 0x44, 0x03, 0xc7, 0x7d, 0x02, 0x00, 0x00,
+}
+
+func (m *Attestor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Attestor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Attestor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Slashed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAttestor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.UnbondingCompletionHeight != 0 {
+		i = encodeVarintAttestor(dAtA, i, uint64(m.UnbondingCompletionHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.BondedHeight != 0 {
+		i = encodeVarintAttestor(dAtA, i, uint64(m.BondedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Status != 0 {
+		i = encodeVarintAttestor(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size, err := m.Bond.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintAttestor(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Pubkey) > 0 {
+		i -= len(m.Pubkey)
+		copy(dAtA[i:], m.Pubkey)
+		i = encodeVarintAttestor(dAtA, i, uint64(len(m.Pubkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintAttestor(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAttestor(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAttestor(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Attestor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovAttestor(uint64(l))
+	}
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovAttestor(uint64(l))
+	}
+	l = m.Bond.Size()
+	n += 1 + l + sovAttestor(uint64(l))
+	if m.Status != 0 {
+		n += 1 + sovAttestor(uint64(m.Status))
+	}
+	if m.BondedHeight != 0 {
+		n += 1 + sovAttestor(uint64(m.BondedHeight))
+	}
+	if m.UnbondingCompletionHeight != 0 {
+		n += 1 + sovAttestor(uint64(m.UnbondingCompletionHeight))
+	}
+	l = m.Slashed.Size()
+	n += 1 + l + sovAttestor(uint64(l))
+	return n
+}
+
+func sovAttestor(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAttestor(x uint64) (n int) {
+	return sovAttestor(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Attestor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAttestor
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Attestor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Attestor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAttestor
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bond", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Bond.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= AttestorStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BondedHeight", wireType)
+			}
+			m.BondedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BondedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnbondingCompletionHeight", wireType)
+			}
+			m.UnbondingCompletionHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnbondingCompletionHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAttestor
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAttestor
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAttestor
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAttestor(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAttestor
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipAttestor(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowAttestor
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttestor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowAttestor
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthAttestor
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupAttestor
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthAttestor
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthAttestor        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowAttestor          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupAttestor = fmt.Errorf("proto: unexpected end of group")
+)
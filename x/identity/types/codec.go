@@ -1,20 +1,48 @@
 package types
 
 import (
+	"cosmossdk.io/x/evidence/exported"
+	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/msgservice"
 )
 
+// ModuleCdc 用于 IBC 数据包与确认结果的 JSON 编解码
+var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
 func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgCreateDidDocument{},
 		&MsgUpdateDidDocument{},
 		&MsgDeleteDidDocument{},
+		&MsgBatchCreateDidDocuments{},
+		&MsgSendIdentityAttestation{},
+		&MsgLinkExternalAccount{},
+		&MsgUnlinkExternalAccount{},
+		&MsgProposeControllerTransfer{},
+		&MsgAcceptControllerTransfer{},
+		&MsgCancelControllerTransfer{},
+		&MsgClaimHandle{},
+		&MsgReleaseHandle{},
+		&MsgTransferHandle{},
+		&MsgCreateOrganisationDid{},
+		&MsgUpdateOrganisationMembers{},
+		&MsgCreateDependentDid{},
+		&MsgClaimMaturity{},
+		&MsgBondAttestor{},
+		&MsgUnbondAttestor{},
+		&MsgIssueCredential{},
+		&MsgRevokeCredential{},
 	)
 
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgSlashAttestor{},
+	)
+
+	registrar.RegisterImplementations((*exported.Evidence)(nil),
+		&DuplicateIdentityEvidence{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
@@ -0,0 +1,496 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dtc/identity/v1/controller_transfer.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PendingControllerTransfer 是等待新 controller 接受的 DID 控制权转移
+type PendingControllerTransfer struct {
+	Did                string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	CurrentController  string `protobuf:"bytes,2,opt,name=current_controller,json=currentController,proto3" json:"current_controller,omitempty"`
+	ProposedController string `protobuf:"bytes,3,opt,name=proposed_controller,json=proposedController,proto3" json:"proposed_controller,omitempty"`
+	ProposedHeight     int64  `protobuf:"varint,4,opt,name=proposed_height,json=proposedHeight,proto3" json:"proposed_height,omitempty"`
+	// expiry_height 之后（不含）转移不能再被接受
+	ExpiryHeight int64 `protobuf:"varint,5,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *PendingControllerTransfer) Reset()         { *m = PendingControllerTransfer{} }
+func (m *PendingControllerTransfer) String() string { return proto.CompactTextString(m) }
+func (*PendingControllerTransfer) ProtoMessage()    {}
+func (*PendingControllerTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_98aeff8c2e4aae00, []int{0}
+}
+func (m *PendingControllerTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingControllerTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingControllerTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingControllerTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingControllerTransfer.Merge(m, src)
+}
+func (m *PendingControllerTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingControllerTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingControllerTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingControllerTransfer proto.InternalMessageInfo
+
+func (m *PendingControllerTransfer) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *PendingControllerTransfer) GetCurrentController() string {
+	if m != nil {
+		return m.CurrentController
+	}
+	return ""
+}
+
+func (m *PendingControllerTransfer) GetProposedController() string {
+	if m != nil {
+		return m.ProposedController
+	}
+	return ""
+}
+
+func (m *PendingControllerTransfer) GetProposedHeight() int64 {
+	if m != nil {
+		return m.ProposedHeight
+	}
+	return 0
+}
+
+func (m *PendingControllerTransfer) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*PendingControllerTransfer)(nil), "dtc.identity.v1.PendingControllerTransfer")
+}
+
+func init() {
+	proto.RegisterFile("dtc/identity/v1/controller_transfer.proto", fileDescriptor_98aeff8c2e4aae00)
+}
+
+var fileDescriptor_98aeff8c2e4aae00 = []byte{
+	// 243 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x4c, 0x29, 0x49, 0xd6,
+	0xcf, 0x4c, 0x49, 0xcd, 0x2b, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0xce, 0xcf, 0x2b,
+	0x29, 0xca, 0xcf, 0xc9, 0x49, 0x2d, 0x8a, 0x2f, 0x29, 0x4a, 0xcc, 0x2b, 0x4e, 0x4b, 0x2d, 0xd2,
+	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x4f, 0x29, 0x49, 0xd6, 0x83, 0x29, 0xd5, 0x2b, 0x33,
+	0x54, 0xba, 0xcd, 0xc8, 0x25, 0x19, 0x90, 0x9a, 0x97, 0x92, 0x99, 0x97, 0xee, 0x0c, 0xd7, 0x15,
+	0x02, 0xd5, 0x24, 0x24, 0xc0, 0xc5, 0x9c, 0x92, 0x99, 0x22, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19,
+	0x04, 0x62, 0x0a, 0xe9, 0x72, 0x09, 0x25, 0x97, 0x16, 0x15, 0xa5, 0xe6, 0x95, 0xc4, 0x23, 0x6c,
+	0x91, 0x60, 0x02, 0x2b, 0x10, 0x84, 0xca, 0x20, 0x0c, 0x12, 0xd2, 0xe7, 0x12, 0x2e, 0x28, 0xca,
+	0x2f, 0xc8, 0x2f, 0x4e, 0x4d, 0x41, 0x56, 0xcf, 0x0c, 0x56, 0x2f, 0x04, 0x93, 0x42, 0xd2, 0xa0,
+	0xce, 0xc5, 0x0f, 0xd7, 0x90, 0x91, 0x9a, 0x99, 0x9e, 0x51, 0x22, 0xc1, 0xa2, 0xc0, 0xa8, 0xc1,
+	0x1c, 0xc4, 0x07, 0x13, 0xf6, 0x00, 0x8b, 0x0a, 0x29, 0x73, 0xf1, 0xa6, 0x56, 0x14, 0x64, 0x16,
+	0x55, 0xc2, 0x94, 0xb1, 0x82, 0x95, 0xf1, 0x40, 0x04, 0x21, 0x8a, 0x9c, 0xf4, 0x4e, 0x3c, 0x92,
+	0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c,
+	0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x04, 0x14, 0x66, 0x15, 0x88, 0x50, 0x2b, 0xa9,
+	0x2c, 0x48, 0x2d, 0x4e, 0x62, 0x03, 0x87, 0x92, 0x31, 0x60, 0x00, 0x37, 0xb1, 0xc9, 0x63, 0x52,
+	0x01, 0x00, 0x00,
+}
+
+func (m *PendingControllerTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingControllerTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingControllerTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintControllerTransfer(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.ProposedHeight != 0 {
+		i = encodeVarintControllerTransfer(dAtA, i, uint64(m.ProposedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ProposedController) > 0 {
+		i -= len(m.ProposedController)
+		copy(dAtA[i:], m.ProposedController)
+		i = encodeVarintControllerTransfer(dAtA, i, uint64(len(m.ProposedController)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.CurrentController) > 0 {
+		i -= len(m.CurrentController)
+		copy(dAtA[i:], m.CurrentController)
+		i = encodeVarintControllerTransfer(dAtA, i, uint64(len(m.CurrentController)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintControllerTransfer(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintControllerTransfer(dAtA []byte, offset int, v uint64) int {
+	offset -= sovControllerTransfer(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PendingControllerTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovControllerTransfer(uint64(l))
+	}
+	l = len(m.CurrentController)
+	if l > 0 {
+		n += 1 + l + sovControllerTransfer(uint64(l))
+	}
+	l = len(m.ProposedController)
+	if l > 0 {
+		n += 1 + l + sovControllerTransfer(uint64(l))
+	}
+	if m.ProposedHeight != 0 {
+		n += 1 + sovControllerTransfer(uint64(m.ProposedHeight))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovControllerTransfer(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func sovControllerTransfer(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozControllerTransfer(x uint64) (n int) {
+	return sovControllerTransfer(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PendingControllerTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowControllerTransfer
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingControllerTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingControllerTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControllerTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControllerTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControllerTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentController", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControllerTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControllerTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControllerTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CurrentController = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedController", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControllerTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthControllerTransfer
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthControllerTransfer
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProposedController = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProposedHeight", wireType)
+			}
+			m.ProposedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControllerTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ProposedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowControllerTransfer
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipControllerTransfer(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthControllerTransfer
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipControllerTransfer(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowControllerTransfer
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowControllerTransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowControllerTransfer
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthControllerTransfer
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupControllerTransfer
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthControllerTransfer
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthControllerTransfer        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowControllerTransfer          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupControllerTransfer = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+)
+
+// credentialTypePattern 限定凭证类型为小写字母、数字、下划线与连字符
+var credentialTypePattern = regexp.MustCompile(`^[a-z0-9_-]{1,64}$`)
+
+// ValidateCredentialType 校验凭证类型标识
+func ValidateCredentialType(credentialType string) error {
+	if !credentialTypePattern.MatchString(credentialType) {
+		return fmt.Errorf("credential type %q must match %s", credentialType, credentialTypePattern)
+	}
+	return nil
+}
+
+// IsActive 判断凭证在给定高度是否仍然有效
+func (c Credential) IsActive(height int64) bool {
+	return c.ExpiryHeight == 0 || height <= c.ExpiryHeight
+}
@@ -0,0 +1,88 @@
+package types
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+const (
+	// DidMethodPrefix 是 did:dtc 方法的固定前缀
+	DidMethodPrefix = "did:dtc:"
+
+	// DidMethodSpecificIDLength 是方法特定标识（十六进制字符）的长度，即 16 字节
+	DidMethodSpecificIDLength = 32
+)
+
+// NormalizeDid 校验 DID 是否符合 did:dtc:<method-specific-id> 语法，并返回规范化（小写）后的 DID。
+// method-specific-id 必须是 32 个十六进制字符。
+func NormalizeDid(did string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(did))
+	if !strings.HasPrefix(normalized, DidMethodPrefix) {
+		return "", fmt.Errorf("did must start with %q", DidMethodPrefix)
+	}
+
+	id := strings.TrimPrefix(normalized, DidMethodPrefix)
+	if len(id) != DidMethodSpecificIDLength {
+		return "", fmt.Errorf("method-specific id must be %d hex characters, got %d", DidMethodSpecificIDLength, len(id))
+	}
+	if _, err := hex.DecodeString(id); err != nil {
+		return "", fmt.Errorf("method-specific id must be hex encoded: %s", err)
+	}
+
+	return normalized, nil
+}
+
+// IsCanonicalDid 判断 DID 是否已经是规范形式（语法合法且已小写）
+func IsCanonicalDid(did string) bool {
+	normalized, err := NormalizeDid(did)
+	return err == nil && normalized == did
+}
+
+// GenerateDid 根据 controller 地址与认证主体（face nullifier）确定性地派生 DID。
+// 派生规则：sha256(controller + subject) 的前 16 字节，十六进制编码后拼接 did:dtc: 前缀，
+// 客户端可以离线计算出相同的结果。
+func GenerateDid(controller, subject string) string {
+	hash := sha256.Sum256([]byte(controller + subject))
+	return DidMethodPrefix + hex.EncodeToString(hash[:DidMethodSpecificIDLength/2])
+}
+
+// OrganisationDidSubject 是派生组织 DID 时使用的认证主体，组织 DID 不含生物特征
+const OrganisationDidSubject = "dtc/identity/organisation"
+
+// IsOrganisation 判断 DID 文档是否为组织 DID
+func (d DidDocument) IsOrganisation() bool {
+	return d.Kind == DID_KIND_ORGANISATION
+}
+
+// IsDeactivated 判断 DID 是否已因重复身份被停用
+func (d DidDocument) IsDeactivated() bool {
+	return d.DeactivatedHeight != 0
+}
+
+// legacyNullifierDomain 是旧明文 faceHash 迁移为 nullifier 时使用的域分隔前缀
+const legacyNullifierDomain = "dtc/identity/legacy-face-nullifier/"
+
+// ValidateFaceBlinding 校验 nullifier 与 commitment 均为 32 字节的十六进制编码。
+// 链上只接受盲化后的值，明文 faceHash 不应再出现在交易或状态中。
+func ValidateFaceBlinding(nullifier, commitment string) error {
+	for name, value := range map[string]string{"face nullifier": nullifier, "face commitment": commitment} {
+		bz, err := hex.DecodeString(value)
+		if err != nil {
+			return fmt.Errorf("%s must be hex encoded: %s", name, err)
+		}
+		if len(bz) != sha256.Size {
+			return fmt.Errorf("%s must be %d bytes, got %d", name, sha256.Size, len(bz))
+		}
+	}
+	return nil
+}
+
+// LegacyFaceNullifier 将旧版本明文存储的 faceHash 转换为 nullifier。
+// 链上没有认证方的私有盐值，因此迁移只能使用带域分隔的 SHA256；
+// 认证方在为新用户签名前，应使用同一函数在链下检查旧记录是否已占用该人脸。
+func LegacyFaceNullifier(faceHash string) string {
+	hash := sha256.Sum256([]byte(legacyNullifierDomain + faceHash))
+	return hex.EncodeToString(hash[:])
+}
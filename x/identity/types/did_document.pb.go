@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -22,12 +23,57 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// DidKind 区分自然人 DID 与组织 DID
+type DidKind int32
+
+const (
+	// DID_KIND_PERSON 是自然人 DID，也是旧数据的默认类型
+	DID_KIND_PERSON DidKind = 0
+	// DID_KIND_ORGANISATION 是由 x/group 策略账户控制、不含生物特征的组织 DID
+	DID_KIND_ORGANISATION DidKind = 1
+)
+
+var DidKind_name = map[int32]string{
+	0: "DID_KIND_PERSON",
+	1: "DID_KIND_ORGANISATION",
+}
+
+var DidKind_value = map[string]int32{
+	"DID_KIND_PERSON":       0,
+	"DID_KIND_ORGANISATION": 1,
+}
+
+func (x DidKind) String() string {
+	return proto.EnumName(DidKind_name, int32(x))
+}
+
+func (DidKind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_43400030caae9f23, []int{0}
+}
+
 // DidDocument defines the DidDocument message.
 type DidDocument struct {
 	Did        string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
 	Controller string `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
-	FaceHash   string `protobuf:"bytes,3,opt,name=faceHash,proto3" json:"faceHash,omitempty"`
-	Pubkeys    string `protobuf:"bytes,4,opt,name=pubkeys,proto3" json:"pubkeys,omitempty"`
+	// legacy_face_hash 是旧版本明文存储的 faceHash，仅供存储迁移读取，迁移后始终为空
+	LegacyFaceHash string `protobuf:"bytes,3,opt,name=legacy_face_hash,json=legacyFaceHash,proto3" json:"legacy_face_hash,omitempty"` // Deprecated: Do not use.
+	Pubkeys        string `protobuf:"bytes,4,opt,name=pubkeys,proto3" json:"pubkeys,omitempty"`
+	// face_nullifier 是认证方使用私有盐值对人脸特征派生的盲化标识，用于唯一性校验
+	FaceNullifier string `protobuf:"bytes,5,opt,name=face_nullifier,json=faceNullifier,proto3" json:"face_nullifier,omitempty"`
+	// face_commitment 是对人脸特征的承诺值，用户可凭盲化因子自行证明
+	FaceCommitment string  `protobuf:"bytes,6,opt,name=face_commitment,json=faceCommitment,proto3" json:"face_commitment,omitempty"`
+	Kind           DidKind `protobuf:"varint,7,opt,name=kind,proto3,enum=dtc.identity.v1.DidKind" json:"kind,omitempty"`
+	// members 是组织 DID 引用的成员 DID，仅组织 DID 使用
+	Members []string `protobuf:"bytes,8,rep,name=members,proto3" json:"members,omitempty"`
+	// guardian 是被监护 DID 的监护人 DID，成年认领前 controller 为空，由监护人代为操作
+	Guardian      string `protobuf:"bytes,9,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	CreatedHeight int64  `protobuf:"varint,10,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	// attestor 是签发该 DID 的认证方公钥（hex），用于追究重复身份的责任
+	Attestor string `protobuf:"bytes,11,opt,name=attestor,proto3" json:"attestor,omitempty"`
+	// deactivated_height 非 0 表示 DID 已因重复身份被停用
+	DeactivatedHeight int64 `protobuf:"varint,12,opt,name=deactivated_height,json=deactivatedHeight,proto3" json:"deactivated_height,omitempty"`
+	// duplicate_of 是被停用 DID 所重复的原 DID
+	DuplicateOf string `protobuf:"bytes,13,opt,name=duplicate_of,json=duplicateOf,proto3" json:"duplicate_of,omitempty"`
 }
 
 func (m *DidDocument) Reset()         { *m = DidDocument{} }
@@ -77,9 +123,10 @@ func (m *DidDocument) GetController() string {
 	return ""
 }
 
-func (m *DidDocument) GetFaceHash() string {
+// Deprecated: Do not use.
+func (m *DidDocument) GetLegacyFaceHash() string {
 	if m != nil {
-		return m.FaceHash
+		return m.LegacyFaceHash
 	}
 	return ""
 }
@@ -91,7 +138,71 @@ func (m *DidDocument) GetPubkeys() string {
 	return ""
 }
 
+func (m *DidDocument) GetFaceNullifier() string {
+	if m != nil {
+		return m.FaceNullifier
+	}
+	return ""
+}
+
+func (m *DidDocument) GetFaceCommitment() string {
+	if m != nil {
+		return m.FaceCommitment
+	}
+	return ""
+}
+
+func (m *DidDocument) GetKind() DidKind {
+	if m != nil {
+		return m.Kind
+	}
+	return DID_KIND_PERSON
+}
+
+func (m *DidDocument) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+func (m *DidDocument) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *DidDocument) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *DidDocument) GetAttestor() string {
+	if m != nil {
+		return m.Attestor
+	}
+	return ""
+}
+
+func (m *DidDocument) GetDeactivatedHeight() int64 {
+	if m != nil {
+		return m.DeactivatedHeight
+	}
+	return 0
+}
+
+func (m *DidDocument) GetDuplicateOf() string {
+	if m != nil {
+		return m.DuplicateOf
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("dtc.identity.v1.DidKind", DidKind_name, DidKind_value)
 	proto.RegisterType((*DidDocument)(nil), "dtc.identity.v1.DidDocument")
 }
 
@@ -100,20 +211,36 @@ func init() {
 }
 
 var fileDescriptor_43400030caae9f23 = []byte{
-	// 196 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0x29, 0x49, 0xd6,
-	0xcf, 0x4c, 0x49, 0xcd, 0x2b, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0xc9, 0x4c, 0x89,
-	0x4f, 0xc9, 0x4f, 0x2e, 0xcd, 0x4d, 0xcd, 0x2b, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2,
-	0x4f, 0x29, 0x49, 0xd6, 0x83, 0xa9, 0xd1, 0x2b, 0x33, 0x54, 0x2a, 0xe5, 0xe2, 0x76, 0xc9, 0x4c,
-	0x71, 0x81, 0xaa, 0x12, 0x12, 0xe0, 0x62, 0x4e, 0xc9, 0x4c, 0x91, 0x60, 0x54, 0x60, 0xd4, 0xe0,
-	0x0c, 0x02, 0x31, 0x85, 0xe4, 0xb8, 0xb8, 0x92, 0xf3, 0xf3, 0x4a, 0x8a, 0xf2, 0x73, 0x72, 0x52,
-	0x8b, 0x24, 0x98, 0xc0, 0x12, 0x48, 0x22, 0x42, 0x52, 0x5c, 0x1c, 0x69, 0x89, 0xc9, 0xa9, 0x1e,
-	0x89, 0xc5, 0x19, 0x12, 0xcc, 0x60, 0x59, 0x38, 0x5f, 0x48, 0x82, 0x8b, 0xbd, 0xa0, 0x34, 0x29,
-	0x3b, 0xb5, 0xb2, 0x58, 0x82, 0x05, 0x2c, 0x05, 0xe3, 0x3a, 0xe9, 0x9d, 0x78, 0x24, 0xc7, 0x78,
-	0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72, 0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7,
-	0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x08, 0xc8, 0x17, 0x15, 0x08, 0x7f, 0x94, 0x54, 0x16, 0xa4,
-	0x16, 0x27, 0xb1, 0x81, 0x9d, 0x6f, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0xcc, 0x59, 0x07, 0x52,
-	0xe4, 0x00, 0x00, 0x00,
+	// 456 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xdf, 0x6e, 0xd3, 0x30,
+	0x14, 0x87, 0x93, 0xa5, 0xac, 0x9b, 0xbb, 0xfe, 0xc1, 0x0c, 0xc9, 0xf4, 0x22, 0x2a, 0x93, 0x10,
+	0x15, 0x1a, 0xa9, 0x06, 0x0f, 0x80, 0x36, 0x02, 0xac, 0x9a, 0x94, 0xa2, 0x8c, 0x2b, 0x6e, 0x22,
+	0xd7, 0x3e, 0x4d, 0xac, 0x25, 0x71, 0x95, 0x38, 0x15, 0x7d, 0x03, 0x2e, 0x79, 0x07, 0x5e, 0x86,
+	0xcb, 0x5e, 0x72, 0x89, 0xda, 0x17, 0x41, 0x76, 0xd3, 0x52, 0x71, 0xe7, 0xf3, 0x9d, 0x9f, 0xbf,
+	0x9c, 0x58, 0x07, 0x5d, 0x70, 0xc5, 0x46, 0x82, 0x43, 0xae, 0x84, 0x5a, 0x8e, 0x16, 0x57, 0x23,
+	0x2e, 0x78, 0xc4, 0x25, 0xab, 0x32, 0xc8, 0x95, 0x37, 0x2f, 0xa4, 0x92, 0xb8, 0xcb, 0x15, 0xf3,
+	0x76, 0x19, 0x6f, 0x71, 0xd5, 0x3f, 0x8f, 0x65, 0x2c, 0x4d, 0x6f, 0xa4, 0x4f, 0xdb, 0xd8, 0xc5,
+	0xca, 0x41, 0x2d, 0x5f, 0x70, 0xbf, 0xbe, 0x8c, 0x7b, 0xc8, 0xe1, 0x82, 0x13, 0x7b, 0x60, 0x0f,
+	0x4f, 0x43, 0x7d, 0xc4, 0x2e, 0x42, 0x4c, 0xe6, 0xaa, 0x90, 0x69, 0x0a, 0x05, 0x39, 0x32, 0x8d,
+	0x03, 0x82, 0x2f, 0x51, 0x2f, 0x85, 0x98, 0xb2, 0x65, 0x34, 0xa3, 0x0c, 0xa2, 0x84, 0x96, 0x09,
+	0x71, 0x74, 0xea, 0xe6, 0x88, 0xd8, 0x61, 0x67, 0xdb, 0xfb, 0x48, 0x19, 0xdc, 0xd2, 0x32, 0xc1,
+	0x04, 0x35, 0xe7, 0xd5, 0xf4, 0x01, 0x96, 0x25, 0x69, 0x18, 0xd5, 0xae, 0xc4, 0x2f, 0x50, 0xc7,
+	0x08, 0xf2, 0x2a, 0x4d, 0xc5, 0x4c, 0x40, 0x41, 0x1e, 0x99, 0x40, 0x5b, 0xd3, 0x60, 0x07, 0xf1,
+	0x4b, 0xd4, 0x35, 0x31, 0x26, 0xb3, 0x4c, 0x28, 0x3d, 0x33, 0x39, 0x36, 0x39, 0x73, 0xfb, 0xfd,
+	0x9e, 0xe2, 0x4b, 0xd4, 0x78, 0x10, 0x39, 0x27, 0xcd, 0x81, 0x3d, 0xec, 0xbc, 0x21, 0xde, 0x7f,
+	0xef, 0xe1, 0xf9, 0x82, 0xdf, 0x89, 0x9c, 0x87, 0x26, 0xa5, 0xe7, 0xca, 0x20, 0x9b, 0x42, 0x51,
+	0x92, 0x93, 0x81, 0xa3, 0xe7, 0xaa, 0x4b, 0xdc, 0x47, 0x27, 0x71, 0x45, 0x0b, 0x2e, 0x68, 0x4e,
+	0x4e, 0xcd, 0x97, 0xf6, 0xb5, 0x9e, 0x99, 0x15, 0x40, 0x15, 0xf0, 0x28, 0x01, 0x11, 0x27, 0x8a,
+	0xa0, 0x81, 0x3d, 0x74, 0xc2, 0x76, 0x4d, 0x6f, 0x0d, 0xd4, 0x0a, 0xaa, 0x14, 0x94, 0x4a, 0x16,
+	0xa4, 0xb5, 0x55, 0xec, 0x6a, 0xfc, 0x1a, 0x61, 0x0e, 0x94, 0x29, 0xb1, 0x38, 0xd4, 0x9c, 0x19,
+	0xcd, 0xe3, 0x83, 0x4e, 0xad, 0x7a, 0x8e, 0xce, 0x78, 0x35, 0x4f, 0x05, 0xa3, 0x0a, 0x22, 0x39,
+	0x23, 0x6d, 0xa3, 0x6b, 0xed, 0xd9, 0x64, 0xf6, 0xea, 0x1d, 0x6a, 0xd6, 0xff, 0x86, 0x9f, 0xa0,
+	0xae, 0x3f, 0xf6, 0xa3, 0xbb, 0x71, 0xe0, 0x47, 0x9f, 0x3f, 0x84, 0xf7, 0x93, 0xa0, 0x67, 0xe1,
+	0x67, 0xe8, 0xe9, 0x1e, 0x4e, 0xc2, 0x4f, 0xd7, 0xc1, 0xf8, 0xfe, 0xfa, 0xcb, 0x78, 0x12, 0xf4,
+	0xec, 0x7e, 0xe3, 0xfb, 0x4f, 0xd7, 0xba, 0xf1, 0x7e, 0xad, 0x5d, 0x7b, 0xb5, 0x76, 0xed, 0x3f,
+	0x6b, 0xd7, 0xfe, 0xb1, 0x71, 0xad, 0xd5, 0xc6, 0xb5, 0x7e, 0x6f, 0x5c, 0xeb, 0xeb, 0xb9, 0x5e,
+	0xbc, 0x6f, 0xff, 0x56, 0x4f, 0x2d, 0xe7, 0x50, 0x4e, 0x8f, 0xcd, 0x2a, 0xbd, 0xfd, 0x3b, 0x00,
+	0x6f, 0x0b, 0x3c, 0x66, 0x97, 0x02, 0x00, 0x00,
 }
 
 func (m *DidDocument) Marshal() (dAtA []byte, err error) {
@@ -136,6 +263,65 @@ func (m *DidDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DuplicateOf) > 0 {
+		i -= len(m.DuplicateOf)
+		copy(dAtA[i:], m.DuplicateOf)
+		i = encodeVarintDidDocument(dAtA, i, uint64(len(m.DuplicateOf)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.DeactivatedHeight != 0 {
+		i = encodeVarintDidDocument(dAtA, i, uint64(m.DeactivatedHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if len(m.Attestor) > 0 {
+		i -= len(m.Attestor)
+		copy(dAtA[i:], m.Attestor)
+		i = encodeVarintDidDocument(dAtA, i, uint64(len(m.Attestor)))
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintDidDocument(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintDidDocument(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintDidDocument(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if m.Kind != 0 {
+		i = encodeVarintDidDocument(dAtA, i, uint64(m.Kind))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.FaceCommitment) > 0 {
+		i -= len(m.FaceCommitment)
+		copy(dAtA[i:], m.FaceCommitment)
+		i = encodeVarintDidDocument(dAtA, i, uint64(len(m.FaceCommitment)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.FaceNullifier) > 0 {
+		i -= len(m.FaceNullifier)
+		copy(dAtA[i:], m.FaceNullifier)
+		i = encodeVarintDidDocument(dAtA, i, uint64(len(m.FaceNullifier)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Pubkeys) > 0 {
 		i -= len(m.Pubkeys)
 		copy(dAtA[i:], m.Pubkeys)
@@ -143,10 +329,10 @@ func (m *DidDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x22
 	}
-	if len(m.FaceHash) > 0 {
-		i -= len(m.FaceHash)
-		copy(dAtA[i:], m.FaceHash)
-		i = encodeVarintDidDocument(dAtA, i, uint64(len(m.FaceHash)))
+	if len(m.LegacyFaceHash) > 0 {
+		i -= len(m.LegacyFaceHash)
+		copy(dAtA[i:], m.LegacyFaceHash)
+		i = encodeVarintDidDocument(dAtA, i, uint64(len(m.LegacyFaceHash)))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -192,7 +378,7 @@ func (m *DidDocument) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDidDocument(uint64(l))
 	}
-	l = len(m.FaceHash)
+	l = len(m.LegacyFaceHash)
 	if l > 0 {
 		n += 1 + l + sovDidDocument(uint64(l))
 	}
@@ -200,6 +386,41 @@ func (m *DidDocument) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovDidDocument(uint64(l))
 	}
+	l = len(m.FaceNullifier)
+	if l > 0 {
+		n += 1 + l + sovDidDocument(uint64(l))
+	}
+	l = len(m.FaceCommitment)
+	if l > 0 {
+		n += 1 + l + sovDidDocument(uint64(l))
+	}
+	if m.Kind != 0 {
+		n += 1 + sovDidDocument(uint64(m.Kind))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovDidDocument(uint64(l))
+		}
+	}
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 1 + l + sovDidDocument(uint64(l))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovDidDocument(uint64(m.CreatedHeight))
+	}
+	l = len(m.Attestor)
+	if l > 0 {
+		n += 1 + l + sovDidDocument(uint64(l))
+	}
+	if m.DeactivatedHeight != 0 {
+		n += 1 + sovDidDocument(uint64(m.DeactivatedHeight))
+	}
+	l = len(m.DuplicateOf)
+	if l > 0 {
+		n += 1 + l + sovDidDocument(uint64(l))
+	}
 	return n
 }
 
@@ -304,7 +525,7 @@ func (m *DidDocument) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FaceHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyFaceHash", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -332,7 +553,7 @@ func (m *DidDocument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FaceHash = string(dAtA[iNdEx:postIndex])
+			m.LegacyFaceHash = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
@@ -366,6 +587,255 @@ func (m *DidDocument) Unmarshal(dAtA []byte) error {
 			}
 			m.Pubkeys = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FaceNullifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDocument
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDidDocument
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDocument
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FaceNullifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FaceCommitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDocument
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDidDocument
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDocument
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FaceCommitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Kind", wireType)
+			}
+			m.Kind = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDocument
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Kind |= DidKind(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDocument
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDidDocument
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDocument
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDocument
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDidDocument
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDocument
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDocument
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDocument
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDidDocument
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDocument
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Attestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeactivatedHeight", wireType)
+			}
+			m.DeactivatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDocument
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DeactivatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DuplicateOf", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowDidDocument
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthDidDocument
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthDidDocument
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DuplicateOf = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipDidDocument(dAtA[iNdEx:])
@@ -9,5 +9,53 @@ import (
 // x/identity module sentinel errors
 var (
 	ErrInvalidSigner     = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
-	ErrDuplicateFaceHash = errors.Register(ModuleName, 1101, "face hash already registered")
+	ErrDuplicateFaceHash = errors.Register(ModuleName, 1101, "face nullifier already registered")
+	ErrInvalidDid        = errors.Register(ModuleName, 1102, "invalid did:dtc identifier")
+	ErrDidMismatch       = errors.Register(ModuleName, 1103, "did does not match the identifier derived from controller and face nullifier")
+	ErrInvalidFaceBlind  = errors.Register(ModuleName, 1104, "invalid face nullifier or commitment")
+	ErrInvalidBatch      = errors.Register(ModuleName, 1105, "invalid did document batch")
+	ErrBatchTooLarge     = errors.Register(ModuleName, 1106, "did document batch exceeds max batch size")
+
+	ErrInvalidPacketTimeout = errors.Register(ModuleName, 1107, "invalid packet timeout")
+	ErrInvalidVersion       = errors.Register(ModuleName, 1108, "invalid version")
+	ErrInvalidChannelOrder  = errors.Register(ModuleName, 1109, "invalid channel ordering")
+	ErrUnrecognizedPacket   = errors.Register(ModuleName, 1110, "unrecognized identity packet")
+	ErrIdentityNotFound     = errors.Register(ModuleName, 1111, "no did document controlled by address")
+
+	ErrInvalidEthAddress       = errors.Register(ModuleName, 1112, "invalid eth address")
+	ErrInvalidEIP191Signature  = errors.Register(ModuleName, 1113, "invalid EIP-191 signature")
+	ErrExternalAccountLinked   = errors.Register(ModuleName, 1114, "external account already linked")
+	ErrExternalAccountNotFound = errors.Register(ModuleName, 1115, "external account not linked")
+
+	ErrControllerChange          = errors.Register(ModuleName, 1116, "controller can only be changed through a controller transfer")
+	ErrInvalidControllerTransfer = errors.Register(ModuleName, 1117, "invalid controller transfer")
+	ErrTransferNotFound          = errors.Register(ModuleName, 1118, "no pending controller transfer")
+	ErrTransferExpired           = errors.Register(ModuleName, 1119, "controller transfer expired")
+
+	ErrInvalidHandle  = errors.Register(ModuleName, 1120, "invalid handle")
+	ErrHandleTaken    = errors.Register(ModuleName, 1121, "handle already taken")
+	ErrHandleNotFound = errors.Register(ModuleName, 1122, "did has no handle")
+	ErrDidHasHandle   = errors.Register(ModuleName, 1123, "did already has a handle")
+
+	ErrNotGroupPolicy      = errors.Register(ModuleName, 1124, "controller is not a group policy account")
+	ErrNotOrganisation     = errors.Register(ModuleName, 1125, "did is not an organisation did")
+	ErrInvalidOrganisation = errors.Register(ModuleName, 1126, "invalid organisation members")
+
+	ErrInvalidGuardianship  = errors.Register(ModuleName, 1127, "invalid guardianship")
+	ErrGuardianshipNotFound = errors.Register(ModuleName, 1128, "did is not a dependent")
+	ErrNotMature            = errors.Register(ModuleName, 1129, "dependent has not reached maturity")
+	ErrHasDependents        = errors.Register(ModuleName, 1130, "guardian did still has dependents")
+
+	ErrInvalidEvidence = errors.Register(ModuleName, 1131, "invalid duplicate identity evidence")
+	ErrDidDeactivated  = errors.Register(ModuleName, 1132, "did is deactivated")
+
+	ErrInvalidAttestor   = errors.Register(ModuleName, 1133, "invalid attestor")
+	ErrAttestorNotFound  = errors.Register(ModuleName, 1134, "attestor not found")
+	ErrInsufficientBond  = errors.Register(ModuleName, 1135, "attestor bond below minimum")
+	ErrAttestorUnbonding = errors.Register(ModuleName, 1136, "attestor is unbonding")
+
+	ErrInvalidCredential  = errors.Register(ModuleName, 1137, "invalid credential")
+	ErrCredentialNotFound = errors.Register(ModuleName, 1138, "credential not found")
+
+	ErrControllerHasDid = errors.Register(ModuleName, 1139, "controller already controls a did")
 )
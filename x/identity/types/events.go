@@ -0,0 +1,69 @@
+package types
+
+// identity module events
+const (
+	EventTypeExternalAccountLinked   = "external_account_linked"
+	EventTypeExternalAccountUnlinked = "external_account_unlinked"
+
+	EventTypeControllerTransferProposed  = "controller_transfer_proposed"
+	EventTypeControllerTransferAccepted  = "controller_transfer_accepted"
+	EventTypeControllerTransferCancelled = "controller_transfer_cancelled"
+
+	EventTypeHandleClaimed     = "handle_claimed"
+	EventTypeHandleReleased    = "handle_released"
+	EventTypeHandleTransferred = "handle_transferred"
+
+	EventTypeOrganisationCreated        = "organisation_created"
+	EventTypeOrganisationMembersUpdated = "organisation_members_updated"
+
+	EventTypeDependentCreated = "dependent_created"
+	EventTypeDependentMatured = "dependent_matured"
+
+	AttributeKeyGuardianDid  = "guardian_did"
+	AttributeKeyMaturityTime = "maturity_time"
+
+	EventTypeDuplicateIdentity = "duplicate_identity"
+
+	AttributeKeyDuplicateDid    = "duplicate_did"
+	AttributeKeyAttestor        = "attestor"
+	AttributeKeyStrikes         = "strikes"
+	AttributeKeyLiability       = "liability"
+	AttributeKeyLiabilityAction = "liability_action"
+
+	AttributeValueLiabilityMerged    = "merged"
+	AttributeValueLiabilityForfeited = "forfeited"
+
+	EventTypeAttestorBonded    = "attestor_bonded"
+	EventTypeAttestorUnbonding = "attestor_unbonding"
+	EventTypeAttestorUnbonded  = "attestor_unbonded"
+	EventTypeAttestorSlashed   = "attestor_slashed"
+
+	AttributeKeyAmount           = "amount"
+	AttributeKeyBond             = "bond"
+	AttributeKeyCompletionHeight = "completion_height"
+	AttributeKeyReason           = "reason"
+
+	AttributeValueReasonDuplicateIdentity = "duplicate_identity"
+
+	AttributeKeyController = "controller"
+	AttributeKeyMembers    = "members"
+
+	AttributeKeyHandle        = "handle"
+	AttributeKeyToDid         = "to_did"
+	AttributeKeyReleaseHeight = "release_height"
+
+	AttributeKeyEthAddress         = "eth_address"
+	AttributeKeyCurrentController  = "current_controller"
+	AttributeKeyProposedController = "proposed_controller"
+	AttributeKeyExpiryHeight       = "expiry_height"
+
+	EventTypeDidMigrationConflict = "did_migration_conflict"
+
+	AttributeKeyCanonicalDid = "canonical_did"
+
+	EventTypeCredentialIssued  = "credential_issued"
+	EventTypeCredentialRevoked = "credential_revoked"
+
+	AttributeKeyCredentialType = "credential_type"
+	AttributeKeyIssuer         = "issuer"
+)
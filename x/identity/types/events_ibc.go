@@ -0,0 +1,18 @@
+package types
+
+// IBC events
+const (
+	EventTypeTimeout               = "timeout"
+	EventTypePersonhoodQueryPacket = "personhood_query_packet"
+	EventTypeAttestationPacket     = "attestation_packet"
+	EventTypeAttestationSent       = "identity_attestation_sent"
+
+	AttributeKeyAckSuccess = "success"
+	AttributeKeyAck        = "acknowledgement"
+	AttributeKeyAckError   = "error"
+	AttributeKeyAddress    = "address"
+	AttributeKeyDid        = "did"
+	AttributeKeyStatus     = "status"
+	AttributeKeyChannel    = "channel_id"
+	AttributeKeySequence   = "sequence"
+)
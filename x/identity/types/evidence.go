@@ -0,0 +1,62 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/x/evidence/exported"
+)
+
+// RouteDuplicateIdentity 是重复身份证据在 x/evidence 路由中的名称（只能包含字母与数字）
+const RouteDuplicateIdentity = "duplicateidentity"
+
+// duplicateIdentityDomain 是重复身份证据签名数据的域分隔前缀
+const duplicateIdentityDomain = "dtc/identity/duplicate/"
+
+var _ exported.Evidence = (*DuplicateIdentityEvidence)(nil)
+
+// DuplicateIdentitySignBytes 返回认证方需要签名的数据，两个 DID 之间用 "/" 分隔以避免拼接歧义
+func DuplicateIdentitySignBytes(firstDid, secondDid string, height int64) []byte {
+	return []byte(duplicateIdentityDomain + firstDid + "/" + secondDid + "/" + strconv.FormatInt(height, 10))
+}
+
+// Route 实现 exported.Evidence
+func (e *DuplicateIdentityEvidence) Route() string {
+	return RouteDuplicateIdentity
+}
+
+// Hash 返回证据内容的 SHA256，x/evidence 以此去重
+func (e *DuplicateIdentityEvidence) Hash() []byte {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	hash := sha256.Sum256(bz)
+	return hash[:]
+}
+
+// ValidateBasic 校验两个 DID 语法合法且不同，并且带有认证方公钥与签名
+func (e *DuplicateIdentityEvidence) ValidateBasic() error {
+	first, err := NormalizeDid(e.FirstDid)
+	if err != nil {
+		return fmt.Errorf("invalid first did: %w", err)
+	}
+	second, err := NormalizeDid(e.SecondDid)
+	if err != nil {
+		return fmt.Errorf("invalid second did: %w", err)
+	}
+	if first == second {
+		return fmt.Errorf("first and second did must differ")
+	}
+	if e.Height <= 0 {
+		return fmt.Errorf("height must be positive, got %d", e.Height)
+	}
+	if e.AttestorPubkey == "" {
+		return fmt.Errorf("missing attestor pubkey")
+	}
+	if len(e.Signature) == 0 {
+		return fmt.Errorf("missing attestor signature")
+	}
+	return nil
+}
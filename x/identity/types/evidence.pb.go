@@ -0,0 +1,515 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dtc/identity/v1/evidence.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// DuplicateIdentityEvidence 是通过 x/evidence 提交的重复身份证据：已质押认证方签名证明两个 DID 属于同一自然人。
+// identity 模块处理时停用较新的 DID，合并或注销其信用负债，并对签发该 DID 的认证方记录一次违规
+type DuplicateIdentityEvidence struct {
+	FirstDid  string `protobuf:"bytes,1,opt,name=first_did,json=firstDid,proto3" json:"first_did,omitempty"`
+	SecondDid string `protobuf:"bytes,2,opt,name=second_did,json=secondDid,proto3" json:"second_did,omitempty"`
+	// height 是发现重复的区块高度
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// signature 是认证方对 DuplicateIdentitySignBytes(first_did, second_did, height) 的签名
+	Signature []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// attestor_pubkey 是签名认证方的 hex 编码压缩 secp256k1 公钥，该认证方必须处于质押状态
+	AttestorPubkey string `protobuf:"bytes,5,opt,name=attestor_pubkey,json=attestorPubkey,proto3" json:"attestor_pubkey,omitempty"`
+}
+
+func (m *DuplicateIdentityEvidence) Reset()         { *m = DuplicateIdentityEvidence{} }
+func (m *DuplicateIdentityEvidence) String() string { return proto.CompactTextString(m) }
+func (*DuplicateIdentityEvidence) ProtoMessage()    {}
+func (*DuplicateIdentityEvidence) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba497c425dff0b62, []int{0}
+}
+func (m *DuplicateIdentityEvidence) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DuplicateIdentityEvidence) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DuplicateIdentityEvidence.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DuplicateIdentityEvidence) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicateIdentityEvidence.Merge(m, src)
+}
+func (m *DuplicateIdentityEvidence) XXX_Size() int {
+	return m.Size()
+}
+func (m *DuplicateIdentityEvidence) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicateIdentityEvidence.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DuplicateIdentityEvidence proto.InternalMessageInfo
+
+func (m *DuplicateIdentityEvidence) GetFirstDid() string {
+	if m != nil {
+		return m.FirstDid
+	}
+	return ""
+}
+
+func (m *DuplicateIdentityEvidence) GetSecondDid() string {
+	if m != nil {
+		return m.SecondDid
+	}
+	return ""
+}
+
+func (m *DuplicateIdentityEvidence) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *DuplicateIdentityEvidence) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *DuplicateIdentityEvidence) GetAttestorPubkey() string {
+	if m != nil {
+		return m.AttestorPubkey
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*DuplicateIdentityEvidence)(nil), "dtc.identity.v1.DuplicateIdentityEvidence")
+}
+
+func init() { proto.RegisterFile("dtc/identity/v1/evidence.proto", fileDescriptor_ba497c425dff0b62) }
+
+var fileDescriptor_ba497c425dff0b62 = []byte{
+	// 241 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x29, 0x49, 0xd6,
+	0xcf, 0x4c, 0x49, 0xcd, 0x2b, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0x2d, 0x03, 0xf1,
+	0x92, 0x53, 0xf5, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xf8, 0x53, 0x4a, 0x92, 0xf5, 0x60, 0xf2,
+	0x7a, 0x65, 0x86, 0x4a, 0xdb, 0x18, 0xb9, 0x24, 0x5d, 0x4a, 0x0b, 0x72, 0x32, 0x93, 0x13, 0x4b,
+	0x52, 0x3d, 0xa1, 0x12, 0xae, 0x50, 0x4d, 0x42, 0xd2, 0x5c, 0x9c, 0x69, 0x99, 0x45, 0xc5, 0x25,
+	0xf1, 0x29, 0x99, 0x29, 0x12, 0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x1c, 0x60, 0x01, 0x97, 0xcc,
+	0x14, 0x21, 0x59, 0x2e, 0xae, 0xe2, 0xd4, 0xe4, 0xfc, 0xbc, 0x14, 0xb0, 0x2c, 0x13, 0x58, 0x96,
+	0x13, 0x22, 0x02, 0x92, 0x16, 0xe3, 0x62, 0xcb, 0x48, 0xcd, 0x4c, 0xcf, 0x28, 0x91, 0x60, 0x56,
+	0x60, 0xd4, 0x60, 0x0e, 0x82, 0xf2, 0x84, 0x64, 0xb8, 0x38, 0x8b, 0x33, 0xd3, 0xf3, 0x12, 0x4b,
+	0x4a, 0x8b, 0x52, 0x25, 0x58, 0x14, 0x18, 0x35, 0x78, 0x82, 0x10, 0x02, 0x42, 0xea, 0x5c, 0xfc,
+	0x89, 0x25, 0x25, 0xa9, 0xc5, 0x25, 0xf9, 0x45, 0xf1, 0x05, 0xa5, 0x49, 0xd9, 0xa9, 0x95, 0x12,
+	0xac, 0x60, 0x93, 0xf9, 0x60, 0xc2, 0x01, 0x60, 0x51, 0x27, 0xbd, 0x13, 0x8f, 0xe4, 0x18, 0x2f,
+	0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b, 0x8f, 0xe5, 0x18,
+	0x6e, 0x3c, 0x96, 0x63, 0x88, 0x12, 0x01, 0x85, 0x41, 0x05, 0x22, 0x14, 0x4a, 0x2a, 0x0b, 0x52,
+	0x8b, 0x93, 0xd8, 0xc0, 0x01, 0x60, 0x0c, 0x18, 0x00, 0x79, 0xd2, 0x5a, 0x8e, 0x22, 0x01, 0x00,
+	0x00,
+}
+
+func (m *DuplicateIdentityEvidence) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DuplicateIdentityEvidence) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DuplicateIdentityEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AttestorPubkey) > 0 {
+		i -= len(m.AttestorPubkey)
+		copy(dAtA[i:], m.AttestorPubkey)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.AttestorPubkey)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SecondDid) > 0 {
+		i -= len(m.SecondDid)
+		copy(dAtA[i:], m.SecondDid)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.SecondDid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FirstDid) > 0 {
+		i -= len(m.FirstDid)
+		copy(dAtA[i:], m.FirstDid)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.FirstDid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvidence(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *DuplicateIdentityEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FirstDid)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.SecondDid)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.AttestorPubkey)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
+func sovEvidence(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvidence(x uint64) (n int) {
+	return sovEvidence(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *DuplicateIdentityEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DuplicateIdentityEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DuplicateIdentityEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FirstDid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FirstDid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SecondDid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SecondDid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestorPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestorPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvidence(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvidence
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvidence
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvidence
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvidence        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvidence          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvidence = fmt.Errorf("proto: unexpected end of group")
+)
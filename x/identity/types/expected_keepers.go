@@ -5,6 +5,9 @@ import (
 
 	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/group"
+
+	dtctypes "dtc/x/dtc/types"
 )
 
 // AuthKeeper defines the expected interface for the Auth module.
@@ -17,9 +20,28 @@ type AuthKeeper interface {
 // BankKeeper defines the expected interface for the Bank module.
 type BankKeeper interface {
 	SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
 	// Methods imported from bank should be defined here
 }
 
+// GroupKeeper defines the expected interface for the Group module.
+type GroupKeeper interface {
+	GroupPolicyInfo(context.Context, *group.QueryGroupPolicyInfoRequest) (*group.QueryGroupPolicyInfoResponse, error)
+}
+
+// RoleKeeper defines the expected interface for the x/dtc role registry.
+type RoleKeeper interface {
+	HasRole(ctx context.Context, role dtctypes.Role, address, scope string) bool
+}
+
+// CreditKeeper defines the expected interface for the Credit module.
+type CreditKeeper interface {
+	MergeLiability(ctx context.Context, from, to string) (uint64, error)
+	ForfeitLiability(ctx context.Context, address string) (uint64, error)
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
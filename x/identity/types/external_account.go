@@ -0,0 +1,49 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// NormalizeEthAddress 校验以太坊地址格式（0x + 40 个十六进制字符），返回小写形式
+func NormalizeEthAddress(address string) (string, error) {
+	normalized := strings.ToLower(strings.TrimSpace(address))
+	if !strings.HasPrefix(normalized, "0x") || len(normalized) != 42 {
+		return "", fmt.Errorf("eth address must be 0x followed by 40 hex characters")
+	}
+	if _, err := hex.DecodeString(normalized[2:]); err != nil {
+		return "", fmt.Errorf("eth address must be hex encoded: %s", err)
+	}
+	return normalized, nil
+}
+
+// ExternalAccountChallenge 返回以太坊私钥需要 personal_sign 的挑战文本。
+// 挑战包含链 ID 与 nonce，签名无法在其他链或撤销绑定后重放。
+func ExternalAccountChallenge(chainID, did, ethAddress string, nonce uint64) string {
+	return fmt.Sprintf("dtc identity: link %s to %s on chain %s (nonce %d)", ethAddress, did, chainID, nonce)
+}
+
+// RecoverEIP191Signer 按 EIP-191（version 0x45，personal_sign）恢复签名者地址，返回小写 0x 地址。
+// signature 为 65 字节 R || S || V，V 可以是 0/1 或 27/28。
+func RecoverEIP191Signer(message string, signature []byte) (string, error) {
+	if len(signature) != crypto.SignatureLength {
+		return "", fmt.Errorf("signature must be %d bytes, got %d", crypto.SignatureLength, len(signature))
+	}
+
+	sig := make([]byte, crypto.SignatureLength)
+	copy(sig, signature)
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	hash := crypto.Keccak256([]byte(fmt.Sprintf("\x19Ethereum Signed Message:\n%d%s", len(message), message)))
+	pubKey, err := crypto.SigToPub(hash, sig)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.ToLower(crypto.PubkeyToAddress(*pubKey).Hex()), nil
+}
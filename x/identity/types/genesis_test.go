@@ -21,7 +21,7 @@ func TestGenesisState_Validate(t *testing.T) {
 		},
 		{
 			desc:     "valid genesis state",
-			genState: &types.GenesisState{DidDocumentMap: []types.DidDocument{{Did: "0"}, {Did: "1"}}},
+			genState: &types.GenesisState{Params: types.DefaultParams(), DidDocumentMap: []types.DidDocument{{Did: "0"}, {Did: "1"}}},
 			valid:    true,
 		}, {
 			desc: "duplicated didDocument",
@@ -0,0 +1,443 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dtc/identity/v1/guardianship.proto
+
+package types
+
+import (
+	fmt "fmt"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Guardianship 记录被监护 DID 与监护人 DID 的关系，成年认领后删除
+type Guardianship struct {
+	DependentDid string `protobuf:"bytes,1,opt,name=dependent_did,json=dependentDid,proto3" json:"dependent_did,omitempty"`
+	GuardianDid  string `protobuf:"bytes,2,opt,name=guardian_did,json=guardianDid,proto3" json:"guardian_did,omitempty"`
+	// maturity_time 是认证时记录的成年时间（Unix 秒），到达后被监护人可认领控制权
+	MaturityTime   int64 `protobuf:"varint,3,opt,name=maturity_time,json=maturityTime,proto3" json:"maturity_time,omitempty"`
+	AttestedHeight int64 `protobuf:"varint,4,opt,name=attested_height,json=attestedHeight,proto3" json:"attested_height,omitempty"`
+}
+
+func (m *Guardianship) Reset()         { *m = Guardianship{} }
+func (m *Guardianship) String() string { return proto.CompactTextString(m) }
+func (*Guardianship) ProtoMessage()    {}
+func (*Guardianship) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c7a99b44e9b05de7, []int{0}
+}
+func (m *Guardianship) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Guardianship) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Guardianship.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Guardianship) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Guardianship.Merge(m, src)
+}
+func (m *Guardianship) XXX_Size() int {
+	return m.Size()
+}
+func (m *Guardianship) XXX_DiscardUnknown() {
+	xxx_messageInfo_Guardianship.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Guardianship proto.InternalMessageInfo
+
+func (m *Guardianship) GetDependentDid() string {
+	if m != nil {
+		return m.DependentDid
+	}
+	return ""
+}
+
+func (m *Guardianship) GetGuardianDid() string {
+	if m != nil {
+		return m.GuardianDid
+	}
+	return ""
+}
+
+func (m *Guardianship) GetMaturityTime() int64 {
+	if m != nil {
+		return m.MaturityTime
+	}
+	return 0
+}
+
+func (m *Guardianship) GetAttestedHeight() int64 {
+	if m != nil {
+		return m.AttestedHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Guardianship)(nil), "dtc.identity.v1.Guardianship")
+}
+
+func init() {
+	proto.RegisterFile("dtc/identity/v1/guardianship.proto", fileDescriptor_c7a99b44e9b05de7)
+}
+
+var fileDescriptor_c7a99b44e9b05de7 = []byte{
+	// 224 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x4a, 0x29, 0x49, 0xd6,
+	0xcf, 0x4c, 0x49, 0xcd, 0x2b, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4, 0x4f, 0x2f, 0x4d, 0x2c,
+	0x4a, 0xc9, 0x4c, 0xcc, 0x2b, 0xce, 0xc8, 0x2c, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2,
+	0x4f, 0x29, 0x49, 0xd6, 0x83, 0xa9, 0xd1, 0x2b, 0x33, 0x54, 0x5a, 0xc2, 0xc8, 0xc5, 0xe3, 0x8e,
+	0xa4, 0x4e, 0x48, 0x99, 0x8b, 0x37, 0x25, 0xb5, 0x20, 0x35, 0x0f, 0xa4, 0x26, 0x3e, 0x25, 0x33,
+	0x45, 0x82, 0x51, 0x81, 0x51, 0x83, 0x33, 0x88, 0x07, 0x2e, 0xe8, 0x92, 0x99, 0x22, 0xa4, 0xc8,
+	0xc5, 0x03, 0x33, 0x1c, 0xac, 0x86, 0x09, 0xac, 0x86, 0x1b, 0x26, 0x06, 0x52, 0xa2, 0xcc, 0xc5,
+	0x9b, 0x9b, 0x58, 0x52, 0x5a, 0x94, 0x59, 0x52, 0x19, 0x5f, 0x92, 0x99, 0x9b, 0x2a, 0xc1, 0xac,
+	0xc0, 0xa8, 0xc1, 0x1c, 0xc4, 0x03, 0x13, 0x0c, 0xc9, 0xcc, 0x4d, 0x15, 0x52, 0xe7, 0xe2, 0x4f,
+	0x2c, 0x29, 0x49, 0x2d, 0x2e, 0x49, 0x4d, 0x89, 0xcf, 0x48, 0xcd, 0x4c, 0xcf, 0x28, 0x91, 0x60,
+	0x01, 0x2b, 0xe3, 0x83, 0x09, 0x7b, 0x80, 0x45, 0x9d, 0xf4, 0x4e, 0x3c, 0x92, 0x63, 0xbc, 0xf0,
+	0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e, 0x3c, 0x96, 0x63, 0xb8,
+	0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x04, 0xe4, 0xeb, 0x0a, 0x84, 0xbf, 0x4b, 0x2a, 0x0b, 0x52, 0x8b,
+	0x93, 0xd8, 0xc0, 0xde, 0x35, 0x06, 0x0c, 0x00, 0x6f, 0x77, 0x30, 0x6d, 0x14, 0x01, 0x00, 0x00,
+}
+
+func (m *Guardianship) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Guardianship) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Guardianship) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AttestedHeight != 0 {
+		i = encodeVarintGuardianship(dAtA, i, uint64(m.AttestedHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MaturityTime != 0 {
+		i = encodeVarintGuardianship(dAtA, i, uint64(m.MaturityTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.GuardianDid) > 0 {
+		i -= len(m.GuardianDid)
+		copy(dAtA[i:], m.GuardianDid)
+		i = encodeVarintGuardianship(dAtA, i, uint64(len(m.GuardianDid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DependentDid) > 0 {
+		i -= len(m.DependentDid)
+		copy(dAtA[i:], m.DependentDid)
+		i = encodeVarintGuardianship(dAtA, i, uint64(len(m.DependentDid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGuardianship(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGuardianship(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Guardianship) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.DependentDid)
+	if l > 0 {
+		n += 1 + l + sovGuardianship(uint64(l))
+	}
+	l = len(m.GuardianDid)
+	if l > 0 {
+		n += 1 + l + sovGuardianship(uint64(l))
+	}
+	if m.MaturityTime != 0 {
+		n += 1 + sovGuardianship(uint64(m.MaturityTime))
+	}
+	if m.AttestedHeight != 0 {
+		n += 1 + sovGuardianship(uint64(m.AttestedHeight))
+	}
+	return n
+}
+
+func sovGuardianship(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGuardianship(x uint64) (n int) {
+	return sovGuardianship(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Guardianship) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGuardianship
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Guardianship: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Guardianship: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependentDid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardianship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardianship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardianship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependentDid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianDid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardianship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGuardianship
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGuardianship
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianDid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaturityTime", wireType)
+			}
+			m.MaturityTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardianship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaturityTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestedHeight", wireType)
+			}
+			m.AttestedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGuardianship
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGuardianship(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGuardianship
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGuardianship(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGuardianship
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGuardianship
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGuardianship
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGuardianship
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGuardianship
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGuardianship
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGuardianship        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGuardianship          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGuardianship = fmt.Errorf("proto: unexpected end of group")
+)
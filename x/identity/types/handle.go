@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+// NormalizeHandle 返回句柄的规范形式（去除首尾空白并小写），唯一性按规范形式判断
+func NormalizeHandle(handle string) string {
+	return strings.ToLower(strings.TrimSpace(handle))
+}
+
+// ValidateHandle 按治理参数校验规范化后的句柄：长度、字符规则与保留词
+func (p Params) ValidateHandle(handle string) error {
+	length := uint32(utf8.RuneCountInString(handle))
+	if length < p.HandleMinLength || length > p.HandleMaxLength {
+		return fmt.Errorf("handle length must be between %d and %d, got %d", p.HandleMinLength, p.HandleMaxLength, length)
+	}
+	if p.HandlePattern != "" {
+		re, err := regexp.Compile(p.HandlePattern)
+		if err != nil {
+			return fmt.Errorf("invalid handle pattern: %s", err)
+		}
+		if !re.MatchString(handle) {
+			return fmt.Errorf("handle %q does not match %s", handle, p.HandlePattern)
+		}
+	}
+	if slices.ContainsFunc(p.ReservedHandles, func(reserved string) bool { return NormalizeHandle(reserved) == handle }) {
+		return fmt.Errorf("handle %q is reserved", handle)
+	}
+	return nil
+}
+
+// IsActive 判断句柄是否仍绑定在 DID 上
+func (h Handle) IsActive() bool {
+	return h.ReleaseHeight == 0
+}
+
+// IsClaimable 判断句柄在给定高度是否可被重新认领
+func (h Handle) IsClaimable(height int64) bool {
+	return !h.IsActive() && height >= h.ReleaseHeight
+}
@@ -4,3 +4,51 @@ import "cosmossdk.io/collections"
 
 // DidDocumentKey is the prefix to retrieve all DidDocument
 var DidDocumentKey = collections.NewPrefix("didDocument/value/")
+
+// ControllerDidKey is the prefix to retrieve the active DID controlled by an address
+var ControllerDidKey = collections.NewPrefix("controllerDid/value/")
+
+// DidAliasKey is the prefix to retrieve the canonical DID of a migrated legacy DID
+var DidAliasKey = collections.NewPrefix("didAlias/value/")
+
+// RemoteAttestationKey is the prefix to retrieve identity attestations received over IBC
+var RemoteAttestationKey = collections.NewPrefix("remoteAttestation/value/")
+
+// PendingControllerTransferKey is the prefix to retrieve pending controller transfers by DID
+var PendingControllerTransferKey = collections.NewPrefix("pendingControllerTransfer/value/")
+
+// HandleKey is the prefix to retrieve handle records by handle
+var HandleKey = collections.NewPrefix("handle/value/")
+
+// DidHandleKey is the prefix to retrieve the active handle of a DID
+var DidHandleKey = collections.NewPrefix("didHandle/value/")
+
+// GuardianshipKey is the prefix to retrieve the guardianship of a dependent DID
+var GuardianshipKey = collections.NewPrefix("guardianship/value/")
+
+// GuardianDependentsKey is the prefix of the (guardian did, dependent did) index
+var GuardianDependentsKey = collections.NewPrefix("guardianDependents/value/")
+
+// AttestorStrikesKey is the prefix to retrieve the duplicate-identity strikes of an attestor
+var AttestorStrikesKey = collections.NewPrefix("attestorStrikes/value/")
+
+// AttestorKey is the prefix to retrieve bonded attestors by address
+var AttestorKey = collections.NewPrefix("attestor/value/")
+
+// AttestorByPubkeyKey is the prefix to retrieve the attestor address of a signing pubkey
+var AttestorByPubkeyKey = collections.NewPrefix("attestorByPubkey/value/")
+
+// AttestorUnbondingQueueKey is the prefix of the (completion height, attestor address) unbonding queue
+var AttestorUnbondingQueueKey = collections.NewPrefix("attestorUnbondingQueue/value/")
+
+// ExternalAccountKey is the prefix to retrieve the DID linked to an Ethereum address
+var ExternalAccountKey = collections.NewPrefix("externalAccount/value/")
+
+// DidExternalAccountsKey is the prefix of the (did, eth address) index of linked accounts
+var DidExternalAccountsKey = collections.NewPrefix("didExternalAccounts/value/")
+
+// ExternalAccountNonceKey is the prefix to retrieve the next link challenge nonce of an Ethereum address
+var ExternalAccountNonceKey = collections.NewPrefix("externalAccountNonce/value/")
+
+// CredentialKey is the prefix to retrieve credentials by (did, credential type)
+var CredentialKey = collections.NewPrefix("credential/value/")
@@ -13,10 +13,23 @@ const (
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/v0.52.0-beta.2/x/gov/types/keys.go#L9
 	GovModuleName = "gov"
+
+	// Version defines the current version the IBC module supports
+	Version = "dtc-identity-1"
+
+	// PortID is the default port id that module binds to
+	PortID = "identity"
+
+	// GBDPPoolModuleName 是接收罚没保证金的 GBDP 资金池模块账户，需与 x/credit 保持一致
+	GBDPPoolModuleName = "gbdp_pool"
 )
 
 // ParamsKey is the prefix to retrieve all Params
 var ParamsKey = collections.NewPrefix("p_identity")
 
-// FaceHashToIndexKey is the prefix to retrieve face hash to DID index
-var FaceHashToIndexKey = collections.NewPrefix("fh_identity")
+// LegacyFaceHashToIndexKey is the prefix of the legacy plaintext face hash to DID index.
+// It is only read by store migrations.
+var LegacyFaceHashToIndexKey = collections.NewPrefix("fh_identity")
+
+// FaceNullifierToIndexKey is the prefix to retrieve face nullifier to DID index
+var FaceNullifierToIndexKey = collections.NewPrefix("fn_identity")
@@ -0,0 +1,49 @@
+package types
+
+import (
+	"crypto/sha256"
+)
+
+// Merkle 树叶子与内部节点使用不同的前缀，防止第二原像攻击（与 RFC 6962 一致）
+const (
+	merkleLeafPrefix = byte(0x00)
+	merkleNodePrefix = byte(0x01)
+)
+
+// DidDocumentEntryLeaf 计算批量注册条目的叶子哈希。
+// 叶子内容与单条注册的签名数据一致：Did（规范形式）+ Controller + FaceNullifier + FaceCommitment。
+func DidDocumentEntryLeaf(did, controller, faceNullifier, faceCommitment string) []byte {
+	return merkleHash(merkleLeafPrefix, []byte(did+controller+faceNullifier+faceCommitment))
+}
+
+// MerkleRoot 按条目顺序计算二叉 Merkle 根。
+// 每层节点数为奇数时，最后一个节点直接提升到上一层；没有叶子时返回 nil。
+func MerkleRoot(leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		return nil
+	}
+
+	level := leaves
+	for len(level) > 1 {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 == len(level) {
+				next = append(next, level[i])
+				continue
+			}
+			next = append(next, merkleHash(merkleNodePrefix, level[i], level[i+1]))
+		}
+		level = next
+	}
+
+	return level[0]
+}
+
+func merkleHash(prefix byte, parts ...[]byte) []byte {
+	h := sha256.New()
+	h.Write([]byte{prefix})
+	for _, p := range parts {
+		h.Write(p)
+	}
+	return h.Sum(nil)
+}
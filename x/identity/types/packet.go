@@ -0,0 +1,38 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// GetBytes 将数据包序列化为按键排序的 JSON
+func (p IdentityPacketData) GetBytes() ([]byte, error) {
+	bz, err := ModuleCdc.MarshalJSON(&p)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.MustSortJSON(bz), nil
+}
+
+// ValidateBasic 校验数据包内容
+func (p PersonhoodQueryPacketData) ValidateBasic() error {
+	if p.Address == "" {
+		return fmt.Errorf("address cannot be empty")
+	}
+	return nil
+}
+
+// ValidateBasic 校验数据包内容
+func (p AttestationPacketData) ValidateBasic() error {
+	if p.Address == "" {
+		return fmt.Errorf("address cannot be empty")
+	}
+	if _, err := NormalizeDid(p.Did); err != nil {
+		return err
+	}
+	if _, ok := AttestationStatus_name[int32(p.Status)]; !ok || p.Status == ATTESTATION_STATUS_UNSPECIFIED {
+		return fmt.Errorf("invalid attestation status %d", p.Status)
+	}
+	return nil
+}
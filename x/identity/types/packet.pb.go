@@ -0,0 +1,1966 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dtc/identity/v1/packet.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AttestationStatus 描述地址在 dtc 上的人格证明状态
+type AttestationStatus int32
+
+const (
+	// ATTESTATION_STATUS_UNSPECIFIED 未指定
+	ATTESTATION_STATUS_UNSPECIFIED AttestationStatus = 0
+	// ATTESTATION_STATUS_UNREGISTERED 地址未注册 DID
+	ATTESTATION_STATUS_UNREGISTERED AttestationStatus = 1
+	// ATTESTATION_STATUS_UNATTESTED DID 未绑定认证方签发的人脸 nullifier
+	ATTESTATION_STATUS_UNATTESTED AttestationStatus = 2
+	// ATTESTATION_STATUS_ATTESTED DID 已通过认证，且 nullifier 唯一指向该 DID
+	ATTESTATION_STATUS_ATTESTED AttestationStatus = 3
+	// ATTESTATION_STATUS_DUPLICATE DID 的 nullifier 已被另一个 DID 占用
+	ATTESTATION_STATUS_DUPLICATE AttestationStatus = 4
+)
+
+var AttestationStatus_name = map[int32]string{
+	0: "ATTESTATION_STATUS_UNSPECIFIED",
+	1: "ATTESTATION_STATUS_UNREGISTERED",
+	2: "ATTESTATION_STATUS_UNATTESTED",
+	3: "ATTESTATION_STATUS_ATTESTED",
+	4: "ATTESTATION_STATUS_DUPLICATE",
+}
+
+var AttestationStatus_value = map[string]int32{
+	"ATTESTATION_STATUS_UNSPECIFIED":  0,
+	"ATTESTATION_STATUS_UNREGISTERED": 1,
+	"ATTESTATION_STATUS_UNATTESTED":   2,
+	"ATTESTATION_STATUS_ATTESTED":     3,
+	"ATTESTATION_STATUS_DUPLICATE":    4,
+}
+
+func (x AttestationStatus) String() string {
+	return proto.EnumName(AttestationStatus_name, int32(x))
+}
+
+func (AttestationStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_c9fd7bcde3d6b361, []int{0}
+}
+
+// IdentityPacketData 是 identity 端口上传输的数据包
+type IdentityPacketData struct {
+	// Types that are valid to be assigned to Packet:
+	//	*IdentityPacketData_NoData
+	//	*IdentityPacketData_PersonhoodQuery
+	//	*IdentityPacketData_Attestation
+	Packet isIdentityPacketData_Packet `protobuf_oneof:"packet"`
+}
+
+func (m *IdentityPacketData) Reset()         { *m = IdentityPacketData{} }
+func (m *IdentityPacketData) String() string { return proto.CompactTextString(m) }
+func (*IdentityPacketData) ProtoMessage()    {}
+func (*IdentityPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9fd7bcde3d6b361, []int{0}
+}
+func (m *IdentityPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IdentityPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IdentityPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IdentityPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IdentityPacketData.Merge(m, src)
+}
+func (m *IdentityPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *IdentityPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_IdentityPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IdentityPacketData proto.InternalMessageInfo
+
+type isIdentityPacketData_Packet interface {
+	isIdentityPacketData_Packet()
+	MarshalTo([]byte) (int, error)
+	Size() int
+}
+
+type IdentityPacketData_NoData struct {
+	NoData *NoData `protobuf:"bytes,1,opt,name=no_data,json=noData,proto3,oneof" json:"no_data,omitempty"`
+}
+type IdentityPacketData_PersonhoodQuery struct {
+	PersonhoodQuery *PersonhoodQueryPacketData `protobuf:"bytes,2,opt,name=personhood_query,json=personhoodQuery,proto3,oneof" json:"personhood_query,omitempty"`
+}
+type IdentityPacketData_Attestation struct {
+	Attestation *AttestationPacketData `protobuf:"bytes,3,opt,name=attestation,proto3,oneof" json:"attestation,omitempty"`
+}
+
+func (*IdentityPacketData_NoData) isIdentityPacketData_Packet()          {}
+func (*IdentityPacketData_PersonhoodQuery) isIdentityPacketData_Packet() {}
+func (*IdentityPacketData_Attestation) isIdentityPacketData_Packet()     {}
+
+func (m *IdentityPacketData) GetPacket() isIdentityPacketData_Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return nil
+}
+
+func (m *IdentityPacketData) GetNoData() *NoData {
+	if x, ok := m.GetPacket().(*IdentityPacketData_NoData); ok {
+		return x.NoData
+	}
+	return nil
+}
+
+func (m *IdentityPacketData) GetPersonhoodQuery() *PersonhoodQueryPacketData {
+	if x, ok := m.GetPacket().(*IdentityPacketData_PersonhoodQuery); ok {
+		return x.PersonhoodQuery
+	}
+	return nil
+}
+
+func (m *IdentityPacketData) GetAttestation() *AttestationPacketData {
+	if x, ok := m.GetPacket().(*IdentityPacketData_Attestation); ok {
+		return x.Attestation
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*IdentityPacketData) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*IdentityPacketData_NoData)(nil),
+		(*IdentityPacketData_PersonhoodQuery)(nil),
+		(*IdentityPacketData_Attestation)(nil),
+	}
+}
+
+// NoData 表示空数据包
+type NoData struct {
+}
+
+func (m *NoData) Reset()         { *m = NoData{} }
+func (m *NoData) String() string { return proto.CompactTextString(m) }
+func (*NoData) ProtoMessage()    {}
+func (*NoData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9fd7bcde3d6b361, []int{1}
+}
+func (m *NoData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NoData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NoData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NoData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NoData.Merge(m, src)
+}
+func (m *NoData) XXX_Size() int {
+	return m.Size()
+}
+func (m *NoData) XXX_DiscardUnknown() {
+	xxx_messageInfo_NoData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NoData proto.InternalMessageInfo
+
+// PersonhoodQueryPacketData 查询 dtc 上某地址是否由唯一的真人控制，结果通过 ack 返回
+type PersonhoodQueryPacketData struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *PersonhoodQueryPacketData) Reset()         { *m = PersonhoodQueryPacketData{} }
+func (m *PersonhoodQueryPacketData) String() string { return proto.CompactTextString(m) }
+func (*PersonhoodQueryPacketData) ProtoMessage()    {}
+func (*PersonhoodQueryPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9fd7bcde3d6b361, []int{2}
+}
+func (m *PersonhoodQueryPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PersonhoodQueryPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PersonhoodQueryPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PersonhoodQueryPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersonhoodQueryPacketData.Merge(m, src)
+}
+func (m *PersonhoodQueryPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *PersonhoodQueryPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersonhoodQueryPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersonhoodQueryPacketData proto.InternalMessageInfo
+
+func (m *PersonhoodQueryPacketData) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// PersonhoodQueryPacketAck 是 PersonhoodQueryPacketData 的确认结果
+type PersonhoodQueryPacketAck struct {
+	Address      string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	IsRegistered bool   `protobuf:"varint,2,opt,name=is_registered,json=isRegistered,proto3" json:"is_registered,omitempty"`
+	// is_unique 为 true 表示 DID 已认证且人脸 nullifier 唯一
+	IsUnique bool              `protobuf:"varint,3,opt,name=is_unique,json=isUnique,proto3" json:"is_unique,omitempty"`
+	Status   AttestationStatus `protobuf:"varint,4,opt,name=status,proto3,enum=dtc.identity.v1.AttestationStatus" json:"status,omitempty"`
+	Did      string            `protobuf:"bytes,5,opt,name=did,proto3" json:"did,omitempty"`
+}
+
+func (m *PersonhoodQueryPacketAck) Reset()         { *m = PersonhoodQueryPacketAck{} }
+func (m *PersonhoodQueryPacketAck) String() string { return proto.CompactTextString(m) }
+func (*PersonhoodQueryPacketAck) ProtoMessage()    {}
+func (*PersonhoodQueryPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9fd7bcde3d6b361, []int{3}
+}
+func (m *PersonhoodQueryPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PersonhoodQueryPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PersonhoodQueryPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PersonhoodQueryPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PersonhoodQueryPacketAck.Merge(m, src)
+}
+func (m *PersonhoodQueryPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *PersonhoodQueryPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_PersonhoodQueryPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PersonhoodQueryPacketAck proto.InternalMessageInfo
+
+func (m *PersonhoodQueryPacketAck) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *PersonhoodQueryPacketAck) GetIsRegistered() bool {
+	if m != nil {
+		return m.IsRegistered
+	}
+	return false
+}
+
+func (m *PersonhoodQueryPacketAck) GetIsUnique() bool {
+	if m != nil {
+		return m.IsUnique
+	}
+	return false
+}
+
+func (m *PersonhoodQueryPacketAck) GetStatus() AttestationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ATTESTATION_STATUS_UNSPECIFIED
+}
+
+func (m *PersonhoodQueryPacketAck) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+// AttestationPacketData 由 DID 持有者推送至对手链的身份证明
+type AttestationPacketData struct {
+	Address        string            `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Did            string            `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	Status         AttestationStatus `protobuf:"varint,3,opt,name=status,proto3,enum=dtc.identity.v1.AttestationStatus" json:"status,omitempty"`
+	FaceCommitment string            `protobuf:"bytes,4,opt,name=face_commitment,json=faceCommitment,proto3" json:"face_commitment,omitempty"`
+	// attested_height 是源链发送数据包时的区块高度
+	AttestedHeight int64 `protobuf:"varint,5,opt,name=attested_height,json=attestedHeight,proto3" json:"attested_height,omitempty"`
+}
+
+func (m *AttestationPacketData) Reset()         { *m = AttestationPacketData{} }
+func (m *AttestationPacketData) String() string { return proto.CompactTextString(m) }
+func (*AttestationPacketData) ProtoMessage()    {}
+func (*AttestationPacketData) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9fd7bcde3d6b361, []int{4}
+}
+func (m *AttestationPacketData) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationPacketData) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationPacketData.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationPacketData) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationPacketData.Merge(m, src)
+}
+func (m *AttestationPacketData) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationPacketData) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationPacketData.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationPacketData proto.InternalMessageInfo
+
+func (m *AttestationPacketData) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *AttestationPacketData) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *AttestationPacketData) GetStatus() AttestationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return ATTESTATION_STATUS_UNSPECIFIED
+}
+
+func (m *AttestationPacketData) GetFaceCommitment() string {
+	if m != nil {
+		return m.FaceCommitment
+	}
+	return ""
+}
+
+func (m *AttestationPacketData) GetAttestedHeight() int64 {
+	if m != nil {
+		return m.AttestedHeight
+	}
+	return 0
+}
+
+// AttestationPacketAck 是 AttestationPacketData 的确认结果
+type AttestationPacketAck struct {
+}
+
+func (m *AttestationPacketAck) Reset()         { *m = AttestationPacketAck{} }
+func (m *AttestationPacketAck) String() string { return proto.CompactTextString(m) }
+func (*AttestationPacketAck) ProtoMessage()    {}
+func (*AttestationPacketAck) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9fd7bcde3d6b361, []int{5}
+}
+func (m *AttestationPacketAck) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AttestationPacketAck) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AttestationPacketAck.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AttestationPacketAck) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AttestationPacketAck.Merge(m, src)
+}
+func (m *AttestationPacketAck) XXX_Size() int {
+	return m.Size()
+}
+func (m *AttestationPacketAck) XXX_DiscardUnknown() {
+	xxx_messageInfo_AttestationPacketAck.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AttestationPacketAck proto.InternalMessageInfo
+
+// RemoteAttestation 是从对手链接收并保存的身份证明
+type RemoteAttestation struct {
+	ChannelId      string                `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Attestation    AttestationPacketData `protobuf:"bytes,2,opt,name=attestation,proto3" json:"attestation"`
+	ReceivedHeight int64                 `protobuf:"varint,3,opt,name=received_height,json=receivedHeight,proto3" json:"received_height,omitempty"`
+}
+
+func (m *RemoteAttestation) Reset()         { *m = RemoteAttestation{} }
+func (m *RemoteAttestation) String() string { return proto.CompactTextString(m) }
+func (*RemoteAttestation) ProtoMessage()    {}
+func (*RemoteAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c9fd7bcde3d6b361, []int{6}
+}
+func (m *RemoteAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteAttestation.Merge(m, src)
+}
+func (m *RemoteAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteAttestation.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteAttestation proto.InternalMessageInfo
+
+func (m *RemoteAttestation) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *RemoteAttestation) GetAttestation() AttestationPacketData {
+	if m != nil {
+		return m.Attestation
+	}
+	return AttestationPacketData{}
+}
+
+func (m *RemoteAttestation) GetReceivedHeight() int64 {
+	if m != nil {
+		return m.ReceivedHeight
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("dtc.identity.v1.AttestationStatus", AttestationStatus_name, AttestationStatus_value)
+	proto.RegisterType((*IdentityPacketData)(nil), "dtc.identity.v1.IdentityPacketData")
+	proto.RegisterType((*NoData)(nil), "dtc.identity.v1.NoData")
+	proto.RegisterType((*PersonhoodQueryPacketData)(nil), "dtc.identity.v1.PersonhoodQueryPacketData")
+	proto.RegisterType((*PersonhoodQueryPacketAck)(nil), "dtc.identity.v1.PersonhoodQueryPacketAck")
+	proto.RegisterType((*AttestationPacketData)(nil), "dtc.identity.v1.AttestationPacketData")
+	proto.RegisterType((*AttestationPacketAck)(nil), "dtc.identity.v1.AttestationPacketAck")
+	proto.RegisterType((*RemoteAttestation)(nil), "dtc.identity.v1.RemoteAttestation")
+}
+
+func init() { proto.RegisterFile("dtc/identity/v1/packet.proto", fileDescriptor_c9fd7bcde3d6b361) }
+
+var fileDescriptor_c9fd7bcde3d6b361 = []byte{
+	// 605 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0xcd, 0x6e, 0xd3, 0x4e,
+	0x14, 0xc5, 0x3d, 0x49, 0xfe, 0x69, 0x72, 0xfb, 0xa7, 0x4d, 0x47, 0x05, 0x4c, 0x3f, 0xdc, 0xe2,
+	0x4a, 0x50, 0x75, 0x91, 0xa8, 0x45, 0x6c, 0xd8, 0xb9, 0x8d, 0xa1, 0x46, 0x28, 0x84, 0x89, 0x23,
+	0x24, 0x36, 0x96, 0xf1, 0x0c, 0xc9, 0xa8, 0xc4, 0x93, 0xda, 0x93, 0x8a, 0xbe, 0x01, 0x4b, 0xde,
+	0x81, 0x25, 0xaf, 0xc1, 0xa2, 0xcb, 0xb2, 0x63, 0x85, 0x50, 0xfb, 0x10, 0x6c, 0x91, 0xc7, 0xf9,
+	0x68, 0x88, 0x5b, 0xa9, 0xab, 0xcc, 0x9c, 0xf9, 0x9d, 0x9b, 0x7b, 0xae, 0x47, 0x03, 0x6b, 0x54,
+	0x06, 0x35, 0x4e, 0x59, 0x28, 0xb9, 0x3c, 0xad, 0x9d, 0xec, 0xd6, 0xfa, 0x7e, 0x70, 0xc4, 0x64,
+	0xb5, 0x1f, 0x09, 0x29, 0xf0, 0x22, 0x95, 0x41, 0x75, 0x74, 0x5a, 0x3d, 0xd9, 0x5d, 0x59, 0xee,
+	0x88, 0x8e, 0x50, 0x67, 0xb5, 0x64, 0x95, 0x62, 0xe6, 0x1f, 0x04, 0xd8, 0x19, 0x52, 0x4d, 0xe5,
+	0xaf, 0xfb, 0xd2, 0xc7, 0x7b, 0x30, 0x17, 0x0a, 0x8f, 0xfa, 0xd2, 0xd7, 0xd1, 0x26, 0xda, 0x9e,
+	0xdf, 0xbb, 0x5f, 0xfd, 0xa7, 0x5e, 0xb5, 0x21, 0x12, 0xf2, 0x50, 0x23, 0xc5, 0x50, 0xad, 0xf0,
+	0x5b, 0xa8, 0xf4, 0x59, 0x14, 0x8b, 0xb0, 0x2b, 0x04, 0xf5, 0x8e, 0x07, 0x2c, 0x3a, 0xd5, 0x73,
+	0xca, 0xbc, 0x33, 0x63, 0x6e, 0x8e, 0xc1, 0x37, 0x09, 0x37, 0xf9, 0xe7, 0x43, 0x8d, 0x2c, 0xf6,
+	0xa7, 0x0f, 0xf1, 0x4b, 0x98, 0xf7, 0xa5, 0x64, 0xb1, 0xf4, 0x25, 0x17, 0xa1, 0x9e, 0x57, 0x35,
+	0x1f, 0xcd, 0xd4, 0xb4, 0x26, 0xcc, 0x54, 0xbd, 0xab, 0xe6, 0xfd, 0x12, 0x14, 0xd3, 0x31, 0x99,
+	0x25, 0x28, 0xa6, 0x11, 0xcc, 0xa7, 0xf0, 0xe0, 0xda, 0x7e, 0xb0, 0x0e, 0x73, 0x3e, 0xa5, 0x11,
+	0x8b, 0x63, 0x35, 0x89, 0x32, 0x19, 0x6d, 0xcd, 0xef, 0x08, 0xf4, 0x4c, 0x9f, 0x15, 0x1c, 0x5d,
+	0x6f, 0xc3, 0x5b, 0x70, 0x87, 0xc7, 0x5e, 0xc4, 0x3a, 0x3c, 0x96, 0x2c, 0x62, 0x54, 0xcd, 0xa8,
+	0x44, 0xfe, 0xe7, 0x31, 0x19, 0x6b, 0x78, 0x15, 0xca, 0x3c, 0xf6, 0x06, 0x21, 0x3f, 0x1e, 0x30,
+	0x15, 0xb8, 0x44, 0x4a, 0x3c, 0x6e, 0xab, 0x3d, 0x7e, 0x06, 0xc5, 0x24, 0xce, 0x20, 0xd6, 0x0b,
+	0x9b, 0x68, 0x7b, 0x61, 0xcf, 0xbc, 0x69, 0x14, 0x2d, 0x45, 0x92, 0xa1, 0x03, 0x57, 0x20, 0x4f,
+	0x39, 0xd5, 0xff, 0x53, 0x3d, 0x25, 0x4b, 0xf3, 0x07, 0x82, 0xbb, 0x99, 0xa3, 0xbb, 0x21, 0xc3,
+	0xb0, 0x4a, 0x6e, 0x5c, 0xe5, 0x4a, 0x4f, 0xf9, 0x5b, 0xf7, 0xf4, 0x18, 0x16, 0x3f, 0xf8, 0x01,
+	0xf3, 0x02, 0xd1, 0xeb, 0x71, 0xd9, 0x63, 0xa1, 0x54, 0xc1, 0xca, 0x64, 0x21, 0x91, 0x0f, 0xc6,
+	0x6a, 0x02, 0xa6, 0xdf, 0x92, 0x51, 0xaf, 0xcb, 0x78, 0xa7, 0x2b, 0x55, 0x90, 0x3c, 0x59, 0x18,
+	0xc9, 0x87, 0x4a, 0x35, 0xef, 0xc1, 0xf2, 0x4c, 0x24, 0x2b, 0x38, 0x32, 0xbf, 0x21, 0x58, 0x22,
+	0xac, 0x27, 0x24, 0xbb, 0x72, 0x8c, 0xd7, 0x01, 0x82, 0xae, 0x1f, 0x86, 0xec, 0xa3, 0xc7, 0xe9,
+	0x30, 0x6a, 0x79, 0xa8, 0x38, 0x14, 0x37, 0xa6, 0xaf, 0x5f, 0xee, 0x36, 0xd7, 0x6f, 0xbf, 0x70,
+	0xf6, 0x6b, 0x63, 0xfa, 0x0a, 0x26, 0x29, 0x22, 0x16, 0x30, 0x7e, 0x32, 0x49, 0x91, 0x4f, 0x53,
+	0x8c, 0xe4, 0x34, 0xc5, 0xce, 0x19, 0x82, 0xa5, 0x99, 0xa9, 0x61, 0x13, 0x0c, 0xcb, 0x75, 0xed,
+	0x96, 0x6b, 0xb9, 0xce, 0xeb, 0x86, 0x97, 0xfc, 0xb6, 0x5b, 0x5e, 0xbb, 0xd1, 0x6a, 0xda, 0x07,
+	0xce, 0x73, 0xc7, 0xae, 0x57, 0x34, 0xbc, 0x05, 0x1b, 0x99, 0x0c, 0xb1, 0x5f, 0x38, 0x2d, 0xd7,
+	0x26, 0x76, 0xbd, 0x82, 0xf0, 0x43, 0x58, 0xcf, 0x84, 0x52, 0xd1, 0xae, 0x57, 0x72, 0x78, 0x03,
+	0x56, 0x33, 0x90, 0x31, 0x90, 0xc7, 0x9b, 0xb0, 0x96, 0x01, 0xd4, 0xdb, 0xcd, 0x57, 0xce, 0x81,
+	0xe5, 0xda, 0x95, 0xc2, 0x4a, 0xe1, 0xf3, 0x57, 0x43, 0xdb, 0xaf, 0x9e, 0x5d, 0x18, 0xe8, 0xfc,
+	0xc2, 0x40, 0xbf, 0x2f, 0x0c, 0xf4, 0xe5, 0xd2, 0xd0, 0xce, 0x2f, 0x0d, 0xed, 0xe7, 0xa5, 0xa1,
+	0xbd, 0x5b, 0x4e, 0x5e, 0xb1, 0x4f, 0x93, 0x77, 0x4c, 0x9e, 0xf6, 0x59, 0xfc, 0xbe, 0xa8, 0x5e,
+	0xa7, 0x27, 0x7f, 0x07, 0x00, 0x61, 0x6d, 0x98, 0x14, 0xe4, 0x04, 0x00, 0x00,
+}
+
+func (m *IdentityPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IdentityPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentityPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Packet != nil {
+		{
+			size := m.Packet.Size()
+			i -= size
+			if _, err := m.Packet.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IdentityPacketData_NoData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentityPacketData_NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.NoData != nil {
+		{
+			size, err := m.NoData.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+func (m *IdentityPacketData_PersonhoodQuery) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentityPacketData_PersonhoodQuery) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.PersonhoodQuery != nil {
+		{
+			size, err := m.PersonhoodQuery.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	return len(dAtA) - i, nil
+}
+func (m *IdentityPacketData_Attestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IdentityPacketData_Attestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	if m.Attestation != nil {
+		{
+			size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintPacket(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	return len(dAtA) - i, nil
+}
+func (m *NoData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NoData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NoData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *PersonhoodQueryPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PersonhoodQueryPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PersonhoodQueryPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PersonhoodQueryPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PersonhoodQueryPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PersonhoodQueryPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Status != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsUnique {
+		i--
+		if m.IsUnique {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IsRegistered {
+		i--
+		if m.IsRegistered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationPacketData) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationPacketData) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.AttestedHeight != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.AttestedHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FaceCommitment) > 0 {
+		i -= len(m.FaceCommitment)
+		copy(dAtA[i:], m.FaceCommitment)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.FaceCommitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Status != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AttestationPacketAck) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AttestationPacketAck) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AttestationPacketAck) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReceivedHeight != 0 {
+		i = encodeVarintPacket(dAtA, i, uint64(m.ReceivedHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Attestation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPacket(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPacket(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *IdentityPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Packet != nil {
+		n += m.Packet.Size()
+	}
+	return n
+}
+
+func (m *IdentityPacketData_NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NoData != nil {
+		l = m.NoData.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *IdentityPacketData_PersonhoodQuery) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PersonhoodQuery != nil {
+		l = m.PersonhoodQuery.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *IdentityPacketData_Attestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Attestation != nil {
+		l = m.Attestation.Size()
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+func (m *NoData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *PersonhoodQueryPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *PersonhoodQueryPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.IsRegistered {
+		n += 2
+	}
+	if m.IsUnique {
+		n += 2
+	}
+	if m.Status != 0 {
+		n += 1 + sovPacket(uint64(m.Status))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	return n
+}
+
+func (m *AttestationPacketData) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovPacket(uint64(m.Status))
+	}
+	l = len(m.FaceCommitment)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	if m.AttestedHeight != 0 {
+		n += 1 + sovPacket(uint64(m.AttestedHeight))
+	}
+	return n
+}
+
+func (m *AttestationPacketAck) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *RemoteAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = m.Attestation.Size()
+	n += 1 + l + sovPacket(uint64(l))
+	if m.ReceivedHeight != 0 {
+		n += 1 + sovPacket(uint64(m.ReceivedHeight))
+	}
+	return n
+}
+
+func sovPacket(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPacket(x uint64) (n int) {
+	return sovPacket(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *IdentityPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IdentityPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IdentityPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NoData", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &NoData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &IdentityPacketData_NoData{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PersonhoodQuery", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &PersonhoodQueryPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &IdentityPacketData_PersonhoodQuery{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &AttestationPacketData{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Packet = &IdentityPacketData_Attestation{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NoData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NoData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NoData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PersonhoodQueryPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PersonhoodQueryPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PersonhoodQueryPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PersonhoodQueryPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PersonhoodQueryPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PersonhoodQueryPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRegistered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRegistered = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsUnique", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsUnique = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= AttestationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationPacketData) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationPacketData: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationPacketData: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= AttestationStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FaceCommitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FaceCommitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestedHeight", wireType)
+			}
+			m.AttestedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AttestationPacketAck) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AttestationPacketAck: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AttestationPacketAck: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Attestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivedHeight", wireType)
+			}
+			m.ReceivedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceivedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPacket(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPacket
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPacket
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPacket
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPacket        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPacket          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPacket = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,13 +1,55 @@
 package types
 
-import "encoding/hex"
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+
+	"cosmossdk.io/math"
+)
 
 const defaultAdminPubKeyHex = "03555db1e9893d6bafff7c3afdb62ddb99cf2f073d25144701966607f63e561a38"
 
+// DefaultMaxBatchSize 是批量注册 DID 的默认单批次上限
+const DefaultMaxBatchSize uint64 = 200
+
+// DefaultControllerTransferPeriod 是控制权转移提议的默认有效期（约 7 天，按 6 秒出块计算）
+const DefaultControllerTransferPeriod int64 = 100800
+
+// 句柄规则的默认值
+const (
+	DefaultHandleMinLength       uint32 = 3
+	DefaultHandleMaxLength       uint32 = 30
+	DefaultHandlePattern                = "^[a-z0-9]([a-z0-9_-]*[a-z0-9])?$"
+	DefaultHandleReleaseCooldown int64  = 100800
+)
+
+// 认证方质押的默认值：最低保证金 1000 DTC，解除质押期约 21 天（按 6 秒出块计算），重复身份罚没 10%
+const (
+	DefaultAttestorMinBond         uint64 = 1_000_000_000
+	DefaultAttestorUnbondingPeriod int64  = 302400
+)
+
+// DefaultAttestorSlashFraction 是重复身份证据成立时的默认罚没比例
+var DefaultAttestorSlashFraction = math.LegacyNewDecWithPrec(1, 1)
+
+// DefaultReservedHandles 是默认的保留词列表
+var DefaultReservedHandles = []string{"admin", "administrator", "dtc", "gov", "identity", "moderator", "official", "root", "support", "system"}
+
 // NewParams creates a new Params instance.
 func NewParams() Params {
 	return Params{
-		AdminPubkey: defaultAdminPubKeyHex,
+		AdminPubkey:              defaultAdminPubKeyHex,
+		MaxBatchSize:             DefaultMaxBatchSize,
+		ControllerTransferPeriod: DefaultControllerTransferPeriod,
+		HandleMinLength:          DefaultHandleMinLength,
+		HandleMaxLength:          DefaultHandleMaxLength,
+		HandlePattern:            DefaultHandlePattern,
+		ReservedHandles:          DefaultReservedHandles,
+		HandleReleaseCooldown:    DefaultHandleReleaseCooldown,
+		AttestorMinBond:          DefaultAttestorMinBond,
+		AttestorUnbondingPeriod:  DefaultAttestorUnbondingPeriod,
+		AttestorSlashFraction:    DefaultAttestorSlashFraction,
 	}
 }
 
@@ -18,6 +60,24 @@ func DefaultParams() Params {
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
+	if p.ControllerTransferPeriod < 0 {
+		return fmt.Errorf("controller transfer period cannot be negative: %d", p.ControllerTransferPeriod)
+	}
+	if p.HandleMaxLength < p.HandleMinLength {
+		return fmt.Errorf("handle max length %d is less than min length %d", p.HandleMaxLength, p.HandleMinLength)
+	}
+	if _, err := regexp.Compile(p.HandlePattern); err != nil {
+		return fmt.Errorf("invalid handle pattern: %s", err)
+	}
+	if p.HandleReleaseCooldown < 0 {
+		return fmt.Errorf("handle release cooldown cannot be negative: %d", p.HandleReleaseCooldown)
+	}
+	if p.AttestorUnbondingPeriod <= 0 {
+		return fmt.Errorf("attestor unbonding period must be positive: %d", p.AttestorUnbondingPeriod)
+	}
+	if !p.AttestorSlashFraction.IsNil() && (p.AttestorSlashFraction.IsNegative() || p.AttestorSlashFraction.GT(math.LegacyOneDec())) {
+		return fmt.Errorf("attestor slash fraction must be between 0 and 1: %s", p.AttestorSlashFraction)
+	}
 	if p.AdminPubkey == "" {
 		return nil
 	}
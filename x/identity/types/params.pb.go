@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -27,7 +29,27 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the module.
 type Params struct {
 	// Admin oracle pubkey (hex-encoded secp256k1 compressed public key)
+	// 不再用于验证签名，仅用于归属未记录认证方的旧 DID
 	AdminPubkey string `protobuf:"bytes,1,opt,name=admin_pubkey,json=adminPubkey,proto3" json:"admin_pubkey,omitempty"`
+	// max_batch_size 是 MsgBatchCreateDidDocuments 单批次允许的最大条目数，0 表示禁用批量注册
+	MaxBatchSize uint64 `protobuf:"varint,2,opt,name=max_batch_size,json=maxBatchSize,proto3" json:"max_batch_size,omitempty"`
+	// controller_transfer_period 是控制权转移提议的有效区块数
+	ControllerTransferPeriod int64 `protobuf:"varint,3,opt,name=controller_transfer_period,json=controllerTransferPeriod,proto3" json:"controller_transfer_period,omitempty"`
+	// handle_min_length 与 handle_max_length 限定句柄长度（字符数）
+	HandleMinLength uint32 `protobuf:"varint,4,opt,name=handle_min_length,json=handleMinLength,proto3" json:"handle_min_length,omitempty"`
+	HandleMaxLength uint32 `protobuf:"varint,5,opt,name=handle_max_length,json=handleMaxLength,proto3" json:"handle_max_length,omitempty"`
+	// handle_pattern 是句柄必须匹配的正则表达式（RE2 语法），为空表示不限制字符
+	HandlePattern string `protobuf:"bytes,6,opt,name=handle_pattern,json=handlePattern,proto3" json:"handle_pattern,omitempty"`
+	// reserved_handles 是不允许认领的保留词
+	ReservedHandles []string `protobuf:"bytes,7,rep,name=reserved_handles,json=reservedHandles,proto3" json:"reserved_handles,omitempty"`
+	// handle_release_cooldown 是句柄释放后可被重新认领前的冷却区块数
+	HandleReleaseCooldown int64 `protobuf:"varint,8,opt,name=handle_release_cooldown,json=handleReleaseCooldown,proto3" json:"handle_release_cooldown,omitempty"`
+	// attestor_min_bond 是认证方加入注册表所需的最低保证金（链上基础币种的最小单位）
+	AttestorMinBond uint64 `protobuf:"varint,9,opt,name=attestor_min_bond,json=attestorMinBond,proto3" json:"attestor_min_bond,omitempty"`
+	// attestor_unbonding_period 是认证方解除质押到退还保证金之间的区块数，期间仍可被罚没
+	AttestorUnbondingPeriod int64 `protobuf:"varint,10,opt,name=attestor_unbonding_period,json=attestorUnbondingPeriod,proto3" json:"attestor_unbonding_period,omitempty"`
+	// attestor_slash_fraction 是重复身份证据成立时罚没认证方保证金的比例
+	AttestorSlashFraction cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=attestor_slash_fraction,json=attestorSlashFraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"attestor_slash_fraction"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,6 +92,69 @@ func (m *Params) GetAdminPubkey() string {
 	return ""
 }
 
+func (m *Params) GetMaxBatchSize() uint64 {
+	if m != nil {
+		return m.MaxBatchSize
+	}
+	return 0
+}
+
+func (m *Params) GetControllerTransferPeriod() int64 {
+	if m != nil {
+		return m.ControllerTransferPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetHandleMinLength() uint32 {
+	if m != nil {
+		return m.HandleMinLength
+	}
+	return 0
+}
+
+func (m *Params) GetHandleMaxLength() uint32 {
+	if m != nil {
+		return m.HandleMaxLength
+	}
+	return 0
+}
+
+func (m *Params) GetHandlePattern() string {
+	if m != nil {
+		return m.HandlePattern
+	}
+	return ""
+}
+
+func (m *Params) GetReservedHandles() []string {
+	if m != nil {
+		return m.ReservedHandles
+	}
+	return nil
+}
+
+func (m *Params) GetHandleReleaseCooldown() int64 {
+	if m != nil {
+		return m.HandleReleaseCooldown
+	}
+	return 0
+}
+
+func (m *Params) GetAttestorMinBond() uint64 {
+	if m != nil {
+		return m.AttestorMinBond
+	}
+	return 0
+}
+
+func (m *Params) GetAttestorUnbondingPeriod() int64 {
+	if m != nil {
+		return m.AttestorUnbondingPeriod
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "dtc.identity.v1.Params")
 }
@@ -77,19 +162,40 @@ func init() {
 func init() { proto.RegisterFile("dtc/identity/v1/params.proto", fileDescriptor_0c5dd8422ebd9baf) }
 
 var fileDescriptor_0c5dd8422ebd9baf = []byte{
-	// 186 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x49, 0x29, 0x49, 0xd6,
-	0xcf, 0x4c, 0x49, 0xcd, 0x2b, 0xc9, 0x2c, 0xa9, 0xd4, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a,
-	0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x4f, 0x29, 0x49, 0xd6, 0x83, 0xc9,
-	0xea, 0x95, 0x19, 0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0x1a, 0x29,
-	0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x30, 0x53, 0x1f, 0xc4, 0x82, 0x88, 0x2a, 0x79, 0x73, 0xb1, 0x05,
-	0x80, 0x4d, 0x12, 0x52, 0xe4, 0xe2, 0x49, 0x4c, 0xc9, 0xcd, 0xcc, 0x8b, 0x2f, 0x28, 0x4d, 0xca,
-	0x4e, 0xad, 0x94, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x0c, 0xe2, 0x06, 0x8b, 0x05, 0x80, 0x85, 0xac,
-	0xe4, 0x5e, 0x2c, 0x90, 0x67, 0xec, 0x7a, 0xbe, 0x41, 0x4b, 0x14, 0xe4, 0x9a, 0x0a, 0x84, 0x7b,
-	0x20, 0x46, 0x38, 0xe9, 0x9d, 0x78, 0x24, 0xc7, 0x78, 0xe1, 0x91, 0x1c, 0xe3, 0x83, 0x47, 0x72,
-	0x8c, 0x13, 0x1e, 0xcb, 0x31, 0x5c, 0x78, 0x2c, 0xc7, 0x70, 0xe3, 0xb1, 0x1c, 0x43, 0x94, 0x08,
-	0x9a, 0x86, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0x1b, 0x8c, 0x01, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xb6, 0x13, 0x02, 0x18, 0xdd, 0x00, 0x00, 0x00,
+	// 523 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0xcf, 0x6e, 0xd3, 0x4c,
+	0x14, 0xc5, 0xe3, 0x2f, 0xf9, 0x02, 0x99, 0xfe, 0x09, 0xb5, 0x1a, 0xd5, 0x0d, 0xc8, 0x09, 0x08,
+	0xa4, 0x10, 0x09, 0x5b, 0x15, 0x52, 0x17, 0x15, 0xab, 0x50, 0x21, 0x16, 0xad, 0x14, 0xb9, 0xb0,
+	0x61, 0x33, 0x9a, 0x78, 0x6e, 0x93, 0x51, 0xed, 0x19, 0x6b, 0x66, 0x1a, 0x92, 0x3e, 0x02, 0x2b,
+	0x1e, 0x81, 0x25, 0xcb, 0x2e, 0x78, 0x88, 0x2e, 0x2b, 0x56, 0x88, 0x45, 0x85, 0x92, 0x45, 0xe1,
+	0x2d, 0x90, 0x67, 0xec, 0x16, 0xd8, 0x58, 0x9e, 0x73, 0x7e, 0x73, 0xef, 0xdc, 0xab, 0x83, 0x1e,
+	0x50, 0x1d, 0x87, 0x8c, 0x02, 0xd7, 0x4c, 0xcf, 0xc3, 0xe9, 0x4e, 0x98, 0x11, 0x49, 0x52, 0x15,
+	0x64, 0x52, 0x68, 0xe1, 0x36, 0xa9, 0x8e, 0x83, 0xd2, 0x0d, 0xa6, 0x3b, 0xed, 0x0d, 0x92, 0x32,
+	0x2e, 0x42, 0xf3, 0xb5, 0x4c, 0x7b, 0x3b, 0x16, 0x2a, 0x15, 0x0a, 0x9b, 0x53, 0x68, 0x0f, 0x85,
+	0xb5, 0x39, 0x16, 0x63, 0x61, 0xf5, 0xfc, 0xcf, 0xaa, 0x8f, 0x7e, 0xd5, 0x50, 0x7d, 0x68, 0xba,
+	0xb8, 0x0f, 0xd1, 0x2a, 0xa1, 0x29, 0xe3, 0x38, 0x3b, 0x1d, 0x9d, 0xc0, 0xdc, 0x73, 0xba, 0x4e,
+	0xaf, 0x11, 0xad, 0x18, 0x6d, 0x68, 0x24, 0xf7, 0x31, 0x5a, 0x4f, 0xc9, 0x0c, 0x8f, 0x88, 0x8e,
+	0x27, 0x58, 0xb1, 0x33, 0xf0, 0xfe, 0xeb, 0x3a, 0xbd, 0x5a, 0xb4, 0x9a, 0x92, 0xd9, 0x20, 0x17,
+	0x8f, 0xd8, 0x19, 0xb8, 0x2f, 0x50, 0x3b, 0x16, 0x5c, 0x4b, 0x91, 0x24, 0x20, 0xb1, 0x96, 0x84,
+	0xab, 0x63, 0x90, 0x38, 0x03, 0xc9, 0x04, 0xf5, 0xaa, 0x5d, 0xa7, 0x57, 0x8d, 0xbc, 0x5b, 0xe2,
+	0x4d, 0x01, 0x0c, 0x8d, 0xef, 0xf6, 0xd1, 0xc6, 0x84, 0x70, 0x9a, 0x00, 0xce, 0xdf, 0x92, 0x00,
+	0x1f, 0xeb, 0x89, 0x57, 0xeb, 0x3a, 0xbd, 0xb5, 0xa8, 0x69, 0x8d, 0x43, 0xc6, 0x0f, 0x8c, 0xfc,
+	0x27, 0x4b, 0x66, 0x25, 0xfb, 0xff, 0x5f, 0x2c, 0x99, 0x15, 0xec, 0x13, 0xb4, 0x5e, 0xb0, 0x19,
+	0xd1, 0x1a, 0x24, 0xf7, 0xea, 0x66, 0xc0, 0x35, 0xab, 0x0e, 0xad, 0xe8, 0x3e, 0x45, 0xf7, 0x24,
+	0x28, 0x90, 0x53, 0xa0, 0xd8, 0x3a, 0xca, 0xbb, 0xd3, 0xad, 0xf6, 0x1a, 0x51, 0xb3, 0xd4, 0x5f,
+	0x5b, 0xd9, 0xdd, 0x45, 0x5b, 0x45, 0x45, 0x09, 0x09, 0x10, 0x05, 0x38, 0x16, 0x22, 0xa1, 0xe2,
+	0x3d, 0xf7, 0xee, 0x9a, 0x21, 0x5b, 0xd6, 0x8e, 0xac, 0xfb, 0xb2, 0x30, 0xf3, 0x57, 0xe7, 0xcd,
+	0x94, 0x16, 0xd2, 0xcc, 0x38, 0x12, 0x9c, 0x7a, 0x0d, 0xb3, 0xc8, 0x66, 0x69, 0x1c, 0x32, 0x3e,
+	0x10, 0x9c, 0xba, 0x7b, 0x68, 0xfb, 0x86, 0x3d, 0xe5, 0x39, 0xc9, 0xf8, 0xb8, 0x5c, 0x25, 0x32,
+	0x5d, 0xb6, 0x4a, 0xe0, 0x6d, 0xe9, 0x17, 0x9b, 0xe4, 0xe8, 0xc6, 0xc2, 0x2a, 0x21, 0x6a, 0x82,
+	0x8f, 0x25, 0x89, 0x35, 0x13, 0xdc, 0x5b, 0xc9, 0x47, 0x1f, 0xec, 0x5e, 0x5c, 0x75, 0x2a, 0xdf,
+	0xaf, 0x3a, 0xf7, 0x6d, 0x50, 0x14, 0x3d, 0x09, 0x98, 0x08, 0x53, 0xa2, 0x27, 0xc1, 0x01, 0x8c,
+	0x49, 0x3c, 0xdf, 0x87, 0xf8, 0xeb, 0x97, 0x67, 0xa8, 0xc8, 0xd1, 0x3e, 0xc4, 0x9f, 0xaf, 0xcf,
+	0xfb, 0x4e, 0xd4, 0x2a, 0xcb, 0x1e, 0xe5, 0x55, 0x5f, 0x15, 0x45, 0xf7, 0xfc, 0x9f, 0x9f, 0x3a,
+	0xce, 0x87, 0xeb, 0xf3, 0x7e, 0x2b, 0xcf, 0xf1, 0xec, 0x36, 0xc9, 0x36, 0x60, 0x83, 0xe0, 0x62,
+	0xe1, 0x3b, 0x97, 0x0b, 0xdf, 0xf9, 0xb1, 0xf0, 0x9d, 0x8f, 0x4b, 0xbf, 0x72, 0xb9, 0xf4, 0x2b,
+	0xdf, 0x96, 0x7e, 0xe5, 0xdd, 0xe6, 0x3f, 0x17, 0xf4, 0x3c, 0x03, 0x35, 0xaa, 0x9b, 0x88, 0x3e,
+	0xff, 0x3d, 0x00, 0xac, 0x9b, 0xfe, 0xe6, 0x17, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -114,6 +220,41 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.AdminPubkey != that1.AdminPubkey {
 		return false
 	}
+	if this.MaxBatchSize != that1.MaxBatchSize {
+		return false
+	}
+	if this.ControllerTransferPeriod != that1.ControllerTransferPeriod {
+		return false
+	}
+	if this.HandleMinLength != that1.HandleMinLength {
+		return false
+	}
+	if this.HandleMaxLength != that1.HandleMaxLength {
+		return false
+	}
+	if this.HandlePattern != that1.HandlePattern {
+		return false
+	}
+	if len(this.ReservedHandles) != len(that1.ReservedHandles) {
+		return false
+	}
+	for i := range this.ReservedHandles {
+		if this.ReservedHandles[i] != that1.ReservedHandles[i] {
+			return false
+		}
+	}
+	if this.HandleReleaseCooldown != that1.HandleReleaseCooldown {
+		return false
+	}
+	if this.AttestorMinBond != that1.AttestorMinBond {
+		return false
+	}
+	if this.AttestorUnbondingPeriod != that1.AttestorUnbondingPeriod {
+		return false
+	}
+	if !this.AttestorSlashFraction.Equal(that1.AttestorSlashFraction) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -136,6 +277,67 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.AttestorSlashFraction.Size()
+		i -= size
+		if _, err := m.AttestorSlashFraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.AttestorUnbondingPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AttestorUnbondingPeriod))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.AttestorMinBond != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AttestorMinBond))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.HandleReleaseCooldown != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HandleReleaseCooldown))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.ReservedHandles) > 0 {
+		for iNdEx := len(m.ReservedHandles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReservedHandles[iNdEx])
+			copy(dAtA[i:], m.ReservedHandles[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.ReservedHandles[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.HandlePattern) > 0 {
+		i -= len(m.HandlePattern)
+		copy(dAtA[i:], m.HandlePattern)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.HandlePattern)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.HandleMaxLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HandleMaxLength))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.HandleMinLength != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.HandleMinLength))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ControllerTransferPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.ControllerTransferPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MaxBatchSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBatchSize))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.AdminPubkey) > 0 {
 		i -= len(m.AdminPubkey)
 		copy(dAtA[i:], m.AdminPubkey)
@@ -167,6 +369,39 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if m.MaxBatchSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxBatchSize))
+	}
+	if m.ControllerTransferPeriod != 0 {
+		n += 1 + sovParams(uint64(m.ControllerTransferPeriod))
+	}
+	if m.HandleMinLength != 0 {
+		n += 1 + sovParams(uint64(m.HandleMinLength))
+	}
+	if m.HandleMaxLength != 0 {
+		n += 1 + sovParams(uint64(m.HandleMaxLength))
+	}
+	l = len(m.HandlePattern)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if len(m.ReservedHandles) > 0 {
+		for _, s := range m.ReservedHandles {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.HandleReleaseCooldown != 0 {
+		n += 1 + sovParams(uint64(m.HandleReleaseCooldown))
+	}
+	if m.AttestorMinBond != 0 {
+		n += 1 + sovParams(uint64(m.AttestorMinBond))
+	}
+	if m.AttestorUnbondingPeriod != 0 {
+		n += 1 + sovParams(uint64(m.AttestorUnbondingPeriod))
+	}
+	l = m.AttestorSlashFraction.Size()
+	n += 1 + l + sovParams(uint64(l))
 	return n
 }
 
@@ -237,6 +472,237 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.AdminPubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchSize", wireType)
+			}
+			m.MaxBatchSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ControllerTransferPeriod", wireType)
+			}
+			m.ControllerTransferPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ControllerTransferPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandleMinLength", wireType)
+			}
+			m.HandleMinLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HandleMinLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandleMaxLength", wireType)
+			}
+			m.HandleMaxLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HandleMaxLength |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandlePattern", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HandlePattern = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReservedHandles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReservedHandles = append(m.ReservedHandles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HandleReleaseCooldown", wireType)
+			}
+			m.HandleReleaseCooldown = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HandleReleaseCooldown |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestorMinBond", wireType)
+			}
+			m.AttestorMinBond = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestorMinBond |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestorUnbondingPeriod", wireType)
+			}
+			m.AttestorUnbondingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestorUnbondingPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestorSlashFraction", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AttestorSlashFraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -349,9 +349,9 @@ func (m *QueryGetDidByAddressRequest) GetAddress() string {
 
 // QueryGetDidByAddressResponse defines the QueryGetDidByAddressResponse message.
 type QueryGetDidByAddressResponse struct {
-	IsRegistered bool   `protobuf:"varint,1,opt,name=is_registered,json=isRegistered,proto3" json:"is_registered,omitempty"`
-	Did          string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
-	FaceHash     string `protobuf:"bytes,3,opt,name=face_hash,json=faceHash,proto3" json:"face_hash,omitempty"`
+	IsRegistered   bool   `protobuf:"varint,1,opt,name=is_registered,json=isRegistered,proto3" json:"is_registered,omitempty"`
+	Did            string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	FaceCommitment string `protobuf:"bytes,4,opt,name=face_commitment,json=faceCommitment,proto3" json:"face_commitment,omitempty"`
 }
 
 func (m *QueryGetDidByAddressResponse) Reset()         { *m = QueryGetDidByAddressResponse{} }
@@ -401,674 +401,6490 @@ func (m *QueryGetDidByAddressResponse) GetDid() string {
 	return ""
 }
 
-func (m *QueryGetDidByAddressResponse) GetFaceHash() string {
+func (m *QueryGetDidByAddressResponse) GetFaceCommitment() string {
 	if m != nil {
-		return m.FaceHash
+		return m.FaceCommitment
 	}
 	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "dtc.identity.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "dtc.identity.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetDidDocumentRequest)(nil), "dtc.identity.v1.QueryGetDidDocumentRequest")
-	proto.RegisterType((*QueryGetDidDocumentResponse)(nil), "dtc.identity.v1.QueryGetDidDocumentResponse")
-	proto.RegisterType((*QueryAllDidDocumentRequest)(nil), "dtc.identity.v1.QueryAllDidDocumentRequest")
-	proto.RegisterType((*QueryAllDidDocumentResponse)(nil), "dtc.identity.v1.QueryAllDidDocumentResponse")
-	proto.RegisterType((*QueryGetDidByAddressRequest)(nil), "dtc.identity.v1.QueryGetDidByAddressRequest")
-	proto.RegisterType((*QueryGetDidByAddressResponse)(nil), "dtc.identity.v1.QueryGetDidByAddressResponse")
+// QueryValidateDidRequest defines the QueryValidateDidRequest message.
+type QueryValidateDidRequest struct {
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
 }
 
-func init() { proto.RegisterFile("dtc/identity/v1/query.proto", fileDescriptor_d19faca8396e375a) }
-
-var fileDescriptor_d19faca8396e375a = []byte{
-	// 629 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x94, 0xc1, 0x6b, 0x13, 0x4f,
-	0x14, 0xc7, 0xb3, 0x6d, 0x7f, 0xfd, 0xb5, 0xd3, 0x6a, 0x74, 0x0c, 0x34, 0x6e, 0xe2, 0x46, 0x36,
-	0x56, 0xa5, 0xb5, 0x3b, 0xa4, 0x22, 0x82, 0xb7, 0x86, 0x6a, 0x3d, 0x78, 0x68, 0xf7, 0xe8, 0x25,
-	0x4c, 0x32, 0xe3, 0x66, 0x20, 0xd9, 0xd9, 0xee, 0x4c, 0x82, 0xa1, 0xf4, 0xe2, 0x5f, 0x20, 0xf4,
-	0xe2, 0x3f, 0x20, 0x78, 0x11, 0xfc, 0x33, 0x7a, 0x2c, 0x08, 0xe2, 0x49, 0x24, 0x11, 0xfc, 0x37,
-	0x64, 0x67, 0x27, 0x4d, 0xb2, 0xd9, 0xc6, 0xe0, 0x25, 0x4c, 0xde, 0xbe, 0xef, 0x7b, 0x9f, 0x79,
-	0xef, 0xbb, 0x0b, 0x0a, 0x44, 0x36, 0x10, 0x23, 0xd4, 0x97, 0x4c, 0xf6, 0x50, 0xb7, 0x82, 0x8e,
-	0x3b, 0x34, 0xec, 0x39, 0x41, 0xc8, 0x25, 0x87, 0x59, 0x22, 0x1b, 0xce, 0xf0, 0xa1, 0xd3, 0xad,
-	0x98, 0x37, 0x71, 0x9b, 0xf9, 0x1c, 0xa9, 0xdf, 0x38, 0xc7, 0xdc, 0x6a, 0x70, 0xd1, 0xe6, 0x02,
-	0xd5, 0xb1, 0xa0, 0xb1, 0x18, 0x75, 0x2b, 0x75, 0x2a, 0x71, 0x05, 0x05, 0xd8, 0x63, 0x3e, 0x96,
-	0x8c, 0xfb, 0x3a, 0xd7, 0x4e, 0x36, 0x23, 0x8c, 0xd4, 0x08, 0x6f, 0x74, 0xda, 0xd4, 0x97, 0x3a,
-	0xa7, 0x98, 0xcc, 0x09, 0x70, 0x88, 0xdb, 0x42, 0x3f, 0xcd, 0x79, 0xdc, 0xe3, 0xea, 0x88, 0xa2,
-	0xd3, 0x50, 0xe3, 0x71, 0xee, 0xb5, 0x28, 0xc2, 0x01, 0x43, 0xd8, 0xf7, 0xb9, 0x54, 0x4d, 0xb5,
-	0xc6, 0xce, 0x01, 0x78, 0x14, 0x71, 0x1d, 0xaa, 0x42, 0x2e, 0x3d, 0xee, 0x50, 0x21, 0xed, 0x23,
-	0x70, 0x6b, 0x22, 0x2a, 0x02, 0xee, 0x0b, 0x0a, 0x9f, 0x81, 0xe5, 0xb8, 0x61, 0xde, 0xb8, 0x6b,
-	0x3c, 0x5c, 0xdb, 0xdd, 0x70, 0x12, 0x33, 0x70, 0x62, 0x41, 0x75, 0xf5, 0xfc, 0x47, 0x29, 0xf3,
-	0xe9, 0xf7, 0x97, 0x2d, 0xc3, 0xd5, 0x0a, 0xdb, 0x01, 0xa6, 0x2a, 0x79, 0x40, 0xe5, 0x3e, 0x23,
-	0xfb, 0xfa, 0x5e, 0xba, 0x21, 0xbc, 0x01, 0x16, 0x09, 0x23, 0xaa, 0xec, 0xaa, 0x1b, 0x1d, 0x6d,
-	0x02, 0x0a, 0xa9, 0xf9, 0x1a, 0xe5, 0x39, 0x58, 0x1f, 0x9f, 0x8f, 0x06, 0x2a, 0x4e, 0x01, 0x8d,
-	0x69, 0xab, 0x4b, 0x11, 0x95, 0xbb, 0x46, 0x46, 0x21, 0x9b, 0x68, 0xaa, 0xbd, 0x56, 0x2b, 0x85,
-	0xea, 0x05, 0x00, 0xa3, 0x35, 0xe9, 0x16, 0xf7, 0x9d, 0x78, 0xa7, 0x4e, 0xb4, 0x53, 0x27, 0x36,
-	0x84, 0xde, 0xa9, 0x73, 0x88, 0x3d, 0xaa, 0xb5, 0xee, 0x98, 0xd2, 0xfe, 0x6c, 0xe8, 0xcb, 0x24,
-	0xdb, 0x5c, 0x79, 0x99, 0xc5, 0x7f, 0xb8, 0x0c, 0x3c, 0x98, 0xc0, 0x5d, 0x50, 0xb8, 0x0f, 0xfe,
-	0x8a, 0x1b, 0x33, 0x4c, 0xf0, 0x3e, 0x9d, 0x98, 0x7d, 0xb5, 0xb7, 0x47, 0x48, 0x48, 0xc5, 0xd0,
-	0x1d, 0x30, 0x0f, 0xfe, 0xc7, 0x71, 0x44, 0x2f, 0x6c, 0xf8, 0xd7, 0x0e, 0x41, 0x31, 0x5d, 0xa8,
-	0x2f, 0x5a, 0x06, 0xd7, 0x98, 0xa8, 0x85, 0xd4, 0x63, 0x42, 0xd2, 0x90, 0xc6, 0x0b, 0x5f, 0x71,
-	0xd7, 0x99, 0x70, 0x2f, 0x63, 0x43, 0x2f, 0x2c, 0x5c, 0x7a, 0x01, 0x16, 0xc0, 0xea, 0x1b, 0xdc,
-	0xa0, 0xb5, 0x26, 0x16, 0xcd, 0xfc, 0xa2, 0x8a, 0xaf, 0x44, 0x81, 0x97, 0x58, 0x34, 0x77, 0xbf,
-	0x2d, 0x81, 0xff, 0x54, 0x53, 0x28, 0xc1, 0x72, 0xec, 0x3f, 0x58, 0x9e, 0x1a, 0xdd, 0xb4, 0xc9,
-	0xcd, 0x7b, 0xb3, 0x93, 0x62, 0x64, 0xbb, 0xf4, 0xee, 0xeb, 0xaf, 0xb3, 0x85, 0xdb, 0x70, 0x03,
-	0xa5, 0xbf, 0x7b, 0xf0, 0x83, 0x01, 0xae, 0x4f, 0x9a, 0x14, 0x6e, 0xa7, 0x57, 0x4e, 0xb5, 0xbe,
-	0xf9, 0x68, 0xbe, 0x64, 0x8d, 0xb3, 0xad, 0x70, 0x36, 0x61, 0x19, 0xcd, 0xfa, 0x5c, 0xa0, 0x13,
-	0xc2, 0xc8, 0x29, 0x3c, 0x33, 0x40, 0xf6, 0x15, 0x13, 0xf3, 0xb0, 0xa5, 0xbe, 0x00, 0x57, 0xb1,
-	0xa5, 0xdb, 0xd8, 0xde, 0x54, 0x6c, 0x25, 0x78, 0x67, 0x26, 0x1b, 0xfc, 0x68, 0x80, 0x6c, 0xc2,
-	0x20, 0x70, 0xe6, 0x10, 0x92, 0x06, 0x34, 0x77, 0xe6, 0xcc, 0xd6, 0x5c, 0x4f, 0x14, 0x17, 0x82,
-	0x3b, 0x53, 0x5c, 0x1e, 0x95, 0xb5, 0x88, 0xad, 0xde, 0xab, 0x69, 0x0b, 0xa3, 0x13, 0x7d, 0x38,
-	0xad, 0x3a, 0xe7, 0x7d, 0xcb, 0xb8, 0xe8, 0x5b, 0xc6, 0xcf, 0xbe, 0x65, 0xbc, 0x1f, 0x58, 0x99,
-	0x8b, 0x81, 0x95, 0xf9, 0x3e, 0xb0, 0x32, 0xaf, 0x73, 0x51, 0x9d, 0xb7, 0xa3, 0x4a, 0xb2, 0x17,
-	0x50, 0x51, 0x5f, 0x56, 0x5f, 0xd4, 0xc7, 0x7f, 0x02, 0x00, 0x00, 0xff, 0xff, 0xe4, 0xa4, 0x53,
-	0x3c, 0x36, 0x06, 0x00, 0x00,
+func (m *QueryValidateDidRequest) Reset()         { *m = QueryValidateDidRequest{} }
+func (m *QueryValidateDidRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateDidRequest) ProtoMessage()    {}
+func (*QueryValidateDidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{8}
+}
+func (m *QueryValidateDidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateDidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateDidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryValidateDidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateDidRequest.Merge(m, src)
+}
+func (m *QueryValidateDidRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateDidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateDidRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryValidateDidRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ListDidDocument Queries a list of DidDocument items.
-	GetDidDocument(ctx context.Context, in *QueryGetDidDocumentRequest, opts ...grpc.CallOption) (*QueryGetDidDocumentResponse, error)
-	// ListDidDocument defines the ListDidDocument RPC.
-	ListDidDocument(ctx context.Context, in *QueryAllDidDocumentRequest, opts ...grpc.CallOption) (*QueryAllDidDocumentResponse, error)
-	// GetDidByAddress Queries a list of GetDidByAddress items.
-	GetDidByAddress(ctx context.Context, in *QueryGetDidByAddressRequest, opts ...grpc.CallOption) (*QueryGetDidByAddressResponse, error)
+func (m *QueryValidateDidRequest) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryValidateDidResponse defines the QueryValidateDidResponse message.
+type QueryValidateDidResponse struct {
+	// valid 表示输入是否符合 did:dtc 语法
+	Valid bool `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	// normalized_did 是规范化（小写）后的 DID，仅在 valid 为 true 时设置
+	NormalizedDid string `protobuf:"bytes,2,opt,name=normalized_did,json=normalizedDid,proto3" json:"normalized_did,omitempty"`
+	// canonical_did 是该 DID 在链上对应的规范 DID（旧格式 DID 迁移后的别名目标）
+	CanonicalDid string `protobuf:"bytes,3,opt,name=canonical_did,json=canonicalDid,proto3" json:"canonical_did,omitempty"`
+	// reason 是校验失败的原因
+	Reason string `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryValidateDidResponse) Reset()         { *m = QueryValidateDidResponse{} }
+func (m *QueryValidateDidResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryValidateDidResponse) ProtoMessage()    {}
+func (*QueryValidateDidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{9}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryValidateDidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryValidateDidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryValidateDidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryValidateDidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryValidateDidResponse.Merge(m, src)
+}
+func (m *QueryValidateDidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryValidateDidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryValidateDidResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) GetDidDocument(ctx context.Context, in *QueryGetDidDocumentRequest, opts ...grpc.CallOption) (*QueryGetDidDocumentResponse, error) {
-	out := new(QueryGetDidDocumentResponse)
-	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/GetDidDocument", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryValidateDidResponse proto.InternalMessageInfo
+
+func (m *QueryValidateDidResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return out, nil
+	return false
 }
 
-func (c *queryClient) ListDidDocument(ctx context.Context, in *QueryAllDidDocumentRequest, opts ...grpc.CallOption) (*QueryAllDidDocumentResponse, error) {
-	out := new(QueryAllDidDocumentResponse)
-	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/ListDidDocument", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryValidateDidResponse) GetNormalizedDid() string {
+	if m != nil {
+		return m.NormalizedDid
 	}
-	return out, nil
+	return ""
 }
 
-func (c *queryClient) GetDidByAddress(ctx context.Context, in *QueryGetDidByAddressRequest, opts ...grpc.CallOption) (*QueryGetDidByAddressResponse, error) {
-	out := new(QueryGetDidByAddressResponse)
-	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/GetDidByAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryValidateDidResponse) GetCanonicalDid() string {
+	if m != nil {
+		return m.CanonicalDid
 	}
-	return out, nil
+	return ""
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ListDidDocument Queries a list of DidDocument items.
-	GetDidDocument(context.Context, *QueryGetDidDocumentRequest) (*QueryGetDidDocumentResponse, error)
-	// ListDidDocument defines the ListDidDocument RPC.
-	ListDidDocument(context.Context, *QueryAllDidDocumentRequest) (*QueryAllDidDocumentResponse, error)
-	// GetDidByAddress Queries a list of GetDidByAddress items.
-	GetDidByAddress(context.Context, *QueryGetDidByAddressRequest) (*QueryGetDidByAddressResponse, error)
+func (m *QueryValidateDidResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+// QueryGetRemoteAttestationRequest defines the QueryGetRemoteAttestationRequest message.
+type QueryGetRemoteAttestationRequest struct {
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// address 是对手链上的持有者地址
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryGetRemoteAttestationRequest) Reset()         { *m = QueryGetRemoteAttestationRequest{} }
+func (m *QueryGetRemoteAttestationRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRemoteAttestationRequest) ProtoMessage()    {}
+func (*QueryGetRemoteAttestationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{10}
 }
-func (*UnimplementedQueryServer) GetDidDocument(ctx context.Context, req *QueryGetDidDocumentRequest) (*QueryGetDidDocumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDidDocument not implemented")
+func (m *QueryGetRemoteAttestationRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) ListDidDocument(ctx context.Context, req *QueryAllDidDocumentRequest) (*QueryAllDidDocumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListDidDocument not implemented")
+func (m *QueryGetRemoteAttestationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRemoteAttestationRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) GetDidByAddress(ctx context.Context, req *QueryGetDidByAddressRequest) (*QueryGetDidByAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDidByAddress not implemented")
+func (m *QueryGetRemoteAttestationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRemoteAttestationRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryGetRemoteAttestationRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRemoteAttestationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRemoteAttestationRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dtc.identity.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryGetRemoteAttestationRequest proto.InternalMessageInfo
+
+func (m *QueryGetRemoteAttestationRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_GetDidDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetDidDocumentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDidDocument(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dtc.identity.v1.Query/GetDidDocument",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDidDocument(ctx, req.(*QueryGetDidDocumentRequest))
+func (m *QueryGetRemoteAttestationRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Query_ListDidDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllDidDocumentRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListDidDocument(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dtc.identity.v1.Query/ListDidDocument",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListDidDocument(ctx, req.(*QueryAllDidDocumentRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryGetRemoteAttestationResponse defines the QueryGetRemoteAttestationResponse message.
+type QueryGetRemoteAttestationResponse struct {
+	RemoteAttestation RemoteAttestation `protobuf:"bytes,1,opt,name=remote_attestation,json=remoteAttestation,proto3" json:"remote_attestation"`
 }
 
-func _Query_GetDidByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetDidByAddressRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetDidByAddress(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dtc.identity.v1.Query/GetDidByAddress",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetDidByAddress(ctx, req.(*QueryGetDidByAddressRequest))
+func (m *QueryGetRemoteAttestationResponse) Reset()         { *m = QueryGetRemoteAttestationResponse{} }
+func (m *QueryGetRemoteAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetRemoteAttestationResponse) ProtoMessage()    {}
+func (*QueryGetRemoteAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{11}
+}
+func (m *QueryGetRemoteAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetRemoteAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetRemoteAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dtc.identity.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetDidDocument",
-			Handler:    _Query_GetDidDocument_Handler,
-		},
-		{
-			MethodName: "ListDidDocument",
-			Handler:    _Query_ListDidDocument_Handler,
-		},
-		{
-			MethodName: "GetDidByAddress",
-			Handler:    _Query_GetDidByAddress_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dtc/identity/v1/query.proto",
+func (m *QueryGetRemoteAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetRemoteAttestationResponse.Merge(m, src)
+}
+func (m *QueryGetRemoteAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetRemoteAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetRemoteAttestationResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetRemoteAttestationResponse proto.InternalMessageInfo
+
+func (m *QueryGetRemoteAttestationResponse) GetRemoteAttestation() RemoteAttestation {
+	if m != nil {
+		return m.RemoteAttestation
 	}
-	return dAtA[:n], nil
+	return RemoteAttestation{}
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetDidByExternalAccountRequest defines the QueryGetDidByExternalAccountRequest message.
+type QueryGetDidByExternalAccountRequest struct {
+	EthAddress string `protobuf:"bytes,1,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *QueryGetDidByExternalAccountRequest) Reset()         { *m = QueryGetDidByExternalAccountRequest{} }
+func (m *QueryGetDidByExternalAccountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDidByExternalAccountRequest) ProtoMessage()    {}
+func (*QueryGetDidByExternalAccountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{12}
+}
+func (m *QueryGetDidByExternalAccountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDidByExternalAccountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDidByExternalAccountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetDidByExternalAccountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDidByExternalAccountRequest.Merge(m, src)
+}
+func (m *QueryGetDidByExternalAccountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDidByExternalAccountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDidByExternalAccountRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetDidByExternalAccountRequest proto.InternalMessageInfo
+
+func (m *QueryGetDidByExternalAccountRequest) GetEthAddress() string {
+	if m != nil {
+		return m.EthAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetDidByExternalAccountResponse defines the QueryGetDidByExternalAccountResponse message.
+type QueryGetDidByExternalAccountResponse struct {
+	Link ExternalAccountLink `protobuf:"bytes,1,opt,name=link,proto3" json:"link"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryGetDidByExternalAccountResponse) Reset()         { *m = QueryGetDidByExternalAccountResponse{} }
+func (m *QueryGetDidByExternalAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetDidByExternalAccountResponse) ProtoMessage()    {}
+func (*QueryGetDidByExternalAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{13}
+}
+func (m *QueryGetDidByExternalAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetDidByExternalAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetDidByExternalAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetDidByExternalAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetDidByExternalAccountResponse.Merge(m, src)
+}
+func (m *QueryGetDidByExternalAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetDidByExternalAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetDidByExternalAccountResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetDidDocumentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetDidByExternalAccountResponse proto.InternalMessageInfo
+
+func (m *QueryGetDidByExternalAccountResponse) GetLink() ExternalAccountLink {
+	if m != nil {
+		return m.Link
 	}
-	return dAtA[:n], nil
+	return ExternalAccountLink{}
 }
 
-func (m *QueryGetDidDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryExternalAccountChallengeRequest defines the QueryExternalAccountChallengeRequest message.
+type QueryExternalAccountChallengeRequest struct {
+	Did        string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	EthAddress string `protobuf:"bytes,2,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
 }
 
-func (m *QueryGetDidDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryExternalAccountChallengeRequest) Reset()         { *m = QueryExternalAccountChallengeRequest{} }
+func (m *QueryExternalAccountChallengeRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryExternalAccountChallengeRequest) ProtoMessage()    {}
+func (*QueryExternalAccountChallengeRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{14}
+}
+func (m *QueryExternalAccountChallengeRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExternalAccountChallengeRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExternalAccountChallengeRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryExternalAccountChallengeRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExternalAccountChallengeRequest.Merge(m, src)
+}
+func (m *QueryExternalAccountChallengeRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExternalAccountChallengeRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExternalAccountChallengeRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetDidDocumentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryExternalAccountChallengeRequest proto.InternalMessageInfo
+
+func (m *QueryExternalAccountChallengeRequest) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryGetDidDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryExternalAccountChallengeRequest) GetEthAddress() string {
+	if m != nil {
+		return m.EthAddress
+	}
+	return ""
 }
 
-func (m *QueryGetDidDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
+// QueryExternalAccountChallengeResponse defines the QueryExternalAccountChallengeResponse message.
+type QueryExternalAccountChallengeResponse struct {
+	Challenge string `protobuf:"bytes,1,opt,name=challenge,proto3" json:"challenge,omitempty"`
+}
+
+func (m *QueryExternalAccountChallengeResponse) Reset()         { *m = QueryExternalAccountChallengeResponse{} }
+func (m *QueryExternalAccountChallengeResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryExternalAccountChallengeResponse) ProtoMessage()    {}
+func (*QueryExternalAccountChallengeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{15}
+}
+func (m *QueryExternalAccountChallengeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryExternalAccountChallengeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryExternalAccountChallengeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryExternalAccountChallengeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryExternalAccountChallengeResponse.Merge(m, src)
+}
+func (m *QueryExternalAccountChallengeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryExternalAccountChallengeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryExternalAccountChallengeResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllDidDocumentRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryExternalAccountChallengeResponse proto.InternalMessageInfo
+
+func (m *QueryExternalAccountChallengeResponse) GetChallenge() string {
+	if m != nil {
+		return m.Challenge
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllDidDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetPendingControllerTransferRequest defines the QueryGetPendingControllerTransferRequest message.
+type QueryGetPendingControllerTransferRequest struct {
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
 }
 
-func (m *QueryAllDidDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetPendingControllerTransferRequest) Reset() {
+	*m = QueryGetPendingControllerTransferRequest{}
+}
+func (m *QueryGetPendingControllerTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetPendingControllerTransferRequest) ProtoMessage()    {}
+func (*QueryGetPendingControllerTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{16}
+}
+func (m *QueryGetPendingControllerTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingControllerTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingControllerTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryGetPendingControllerTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingControllerTransferRequest.Merge(m, src)
+}
+func (m *QueryGetPendingControllerTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingControllerTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingControllerTransferRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllDidDocumentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetPendingControllerTransferRequest proto.InternalMessageInfo
+
+func (m *QueryGetPendingControllerTransferRequest) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllDidDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryGetPendingControllerTransferResponse defines the QueryGetPendingControllerTransferResponse message.
+type QueryGetPendingControllerTransferResponse struct {
+	PendingControllerTransfer PendingControllerTransfer `protobuf:"bytes,1,opt,name=pending_controller_transfer,json=pendingControllerTransfer,proto3" json:"pending_controller_transfer"`
 }
 
-func (m *QueryAllDidDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryGetPendingControllerTransferResponse) Reset() {
+	*m = QueryGetPendingControllerTransferResponse{}
+}
+func (m *QueryGetPendingControllerTransferResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryGetPendingControllerTransferResponse) ProtoMessage() {}
+func (*QueryGetPendingControllerTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{17}
+}
+func (m *QueryGetPendingControllerTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetPendingControllerTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetPendingControllerTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.DidDocument) > 0 {
-		for iNdEx := len(m.DidDocument) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.DidDocument[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryGetPendingControllerTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetPendingControllerTransferResponse.Merge(m, src)
+}
+func (m *QueryGetPendingControllerTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetPendingControllerTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetPendingControllerTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetPendingControllerTransferResponse proto.InternalMessageInfo
+
+func (m *QueryGetPendingControllerTransferResponse) GetPendingControllerTransfer() PendingControllerTransfer {
+	if m != nil {
+		return m.PendingControllerTransfer
 	}
-	return len(dAtA) - i, nil
+	return PendingControllerTransfer{}
 }
 
-func (m *QueryGetDidByAddressRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryAllPendingControllerTransferRequest defines the QueryAllPendingControllerTransferRequest message.
+type QueryAllPendingControllerTransferRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetDidByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllPendingControllerTransferRequest) Reset() {
+	*m = QueryAllPendingControllerTransferRequest{}
 }
-
-func (m *QueryGetDidByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryAllPendingControllerTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllPendingControllerTransferRequest) ProtoMessage()    {}
+func (*QueryAllPendingControllerTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{18}
+}
+func (m *QueryAllPendingControllerTransferRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPendingControllerTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPendingControllerTransferRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllPendingControllerTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPendingControllerTransferRequest.Merge(m, src)
+}
+func (m *QueryAllPendingControllerTransferRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPendingControllerTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPendingControllerTransferRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetDidByAddressResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllPendingControllerTransferRequest proto.InternalMessageInfo
+
+func (m *QueryAllPendingControllerTransferRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryGetDidByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryAllPendingControllerTransferResponse defines the QueryAllPendingControllerTransferResponse message.
+type QueryAllPendingControllerTransferResponse struct {
+	PendingControllerTransfer []PendingControllerTransfer `protobuf:"bytes,1,rep,name=pending_controller_transfer,json=pendingControllerTransfer,proto3" json:"pending_controller_transfer"`
+	Pagination                *query.PageResponse         `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryGetDidByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FaceHash) > 0 {
-		i -= len(m.FaceHash)
-		copy(dAtA[i:], m.FaceHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.FaceHash)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.IsRegistered {
-		i--
-		if m.IsRegistered {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
+func (m *QueryAllPendingControllerTransferResponse) Reset() {
+	*m = QueryAllPendingControllerTransferResponse{}
+}
+func (m *QueryAllPendingControllerTransferResponse) String() string {
+	return proto.CompactTextString(m)
+}
+func (*QueryAllPendingControllerTransferResponse) ProtoMessage() {}
+func (*QueryAllPendingControllerTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{19}
+}
+func (m *QueryAllPendingControllerTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllPendingControllerTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllPendingControllerTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x8
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryAllPendingControllerTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllPendingControllerTransferResponse.Merge(m, src)
+}
+func (m *QueryAllPendingControllerTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllPendingControllerTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllPendingControllerTransferResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryAllPendingControllerTransferResponse proto.InternalMessageInfo
+
+func (m *QueryAllPendingControllerTransferResponse) GetPendingControllerTransfer() []PendingControllerTransfer {
+	if m != nil {
+		return m.PendingControllerTransfer
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryAllPendingControllerTransferResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+// QueryResolveHandleRequest defines the QueryResolveHandleRequest message.
+type QueryResolveHandleRequest struct {
+	Handle string `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
 }
 
-func (m *QueryGetDidDocumentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryResolveHandleRequest) Reset()         { *m = QueryResolveHandleRequest{} }
+func (m *QueryResolveHandleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveHandleRequest) ProtoMessage()    {}
+func (*QueryResolveHandleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{20}
+}
+func (m *QueryResolveHandleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveHandleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveHandleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryResolveHandleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveHandleRequest.Merge(m, src)
+}
+func (m *QueryResolveHandleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveHandleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveHandleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryResolveHandleRequest proto.InternalMessageInfo
+
+func (m *QueryResolveHandleRequest) GetHandle() string {
+	if m != nil {
+		return m.Handle
 	}
-	return n
+	return ""
 }
 
-func (m *QueryGetDidDocumentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+// QueryResolveHandleResponse defines the QueryResolveHandleResponse message.
+type QueryResolveHandleResponse struct {
+	Handle Handle `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle"`
+}
+
+func (m *QueryResolveHandleResponse) Reset()         { *m = QueryResolveHandleResponse{} }
+func (m *QueryResolveHandleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryResolveHandleResponse) ProtoMessage()    {}
+func (*QueryResolveHandleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{21}
+}
+func (m *QueryResolveHandleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryResolveHandleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryResolveHandleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	l = m.DidDocument.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+}
+func (m *QueryResolveHandleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryResolveHandleResponse.Merge(m, src)
+}
+func (m *QueryResolveHandleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryResolveHandleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryResolveHandleResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllDidDocumentRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryResolveHandleResponse proto.InternalMessageInfo
+
+func (m *QueryResolveHandleResponse) GetHandle() Handle {
+	if m != nil {
+		return m.Handle
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	return Handle{}
+}
+
+// QueryGetHandleByDidRequest defines the QueryGetHandleByDidRequest message.
+type QueryGetHandleByDidRequest struct {
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+}
+
+func (m *QueryGetHandleByDidRequest) Reset()         { *m = QueryGetHandleByDidRequest{} }
+func (m *QueryGetHandleByDidRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetHandleByDidRequest) ProtoMessage()    {}
+func (*QueryGetHandleByDidRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{22}
+}
+func (m *QueryGetHandleByDidRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetHandleByDidRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetHandleByDidRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *QueryGetHandleByDidRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetHandleByDidRequest.Merge(m, src)
+}
+func (m *QueryGetHandleByDidRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetHandleByDidRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetHandleByDidRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllDidDocumentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryGetHandleByDidRequest proto.InternalMessageInfo
+
+func (m *QueryGetHandleByDidRequest) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	var l int
-	_ = l
-	if len(m.DidDocument) > 0 {
-		for _, e := range m.DidDocument {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+	return ""
+}
+
+// QueryGetHandleByDidResponse defines the QueryGetHandleByDidResponse message.
+type QueryGetHandleByDidResponse struct {
+	Handle Handle `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle"`
+}
+
+func (m *QueryGetHandleByDidResponse) Reset()         { *m = QueryGetHandleByDidResponse{} }
+func (m *QueryGetHandleByDidResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetHandleByDidResponse) ProtoMessage()    {}
+func (*QueryGetHandleByDidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{23}
+}
+func (m *QueryGetHandleByDidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetHandleByDidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetHandleByDidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+}
+func (m *QueryGetHandleByDidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetHandleByDidResponse.Merge(m, src)
+}
+func (m *QueryGetHandleByDidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetHandleByDidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetHandleByDidResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetDidByAddressRequest) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_QueryGetHandleByDidResponse proto.InternalMessageInfo
+
+func (m *QueryGetHandleByDidResponse) GetHandle() Handle {
+	if m != nil {
+		return m.Handle
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	return Handle{}
+}
+
+// QueryGetGuardianshipRequest defines the QueryGetGuardianshipRequest message.
+type QueryGetGuardianshipRequest struct {
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+}
+
+func (m *QueryGetGuardianshipRequest) Reset()         { *m = QueryGetGuardianshipRequest{} }
+func (m *QueryGetGuardianshipRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGuardianshipRequest) ProtoMessage()    {}
+func (*QueryGetGuardianshipRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{24}
+}
+func (m *QueryGetGuardianshipRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetGuardianshipRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetGuardianshipRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetGuardianshipRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGuardianshipRequest.Merge(m, src)
+}
+func (m *QueryGetGuardianshipRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetGuardianshipRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGuardianshipRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetGuardianshipRequest proto.InternalMessageInfo
+
+func (m *QueryGetGuardianshipRequest) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+// QueryGetGuardianshipResponse defines the QueryGetGuardianshipResponse message.
+type QueryGetGuardianshipResponse struct {
+	Guardianship Guardianship `protobuf:"bytes,1,opt,name=guardianship,proto3" json:"guardianship"`
+}
+
+func (m *QueryGetGuardianshipResponse) Reset()         { *m = QueryGetGuardianshipResponse{} }
+func (m *QueryGetGuardianshipResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetGuardianshipResponse) ProtoMessage()    {}
+func (*QueryGetGuardianshipResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{25}
+}
+func (m *QueryGetGuardianshipResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetGuardianshipResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetGuardianshipResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetGuardianshipResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetGuardianshipResponse.Merge(m, src)
+}
+func (m *QueryGetGuardianshipResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetGuardianshipResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetGuardianshipResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetGuardianshipResponse proto.InternalMessageInfo
+
+func (m *QueryGetGuardianshipResponse) GetGuardianship() Guardianship {
+	if m != nil {
+		return m.Guardianship
+	}
+	return Guardianship{}
+}
+
+// QueryListDependentsRequest defines the QueryListDependentsRequest message.
+type QueryListDependentsRequest struct {
+	GuardianDid string `protobuf:"bytes,1,opt,name=guardian_did,json=guardianDid,proto3" json:"guardian_did,omitempty"`
+}
+
+func (m *QueryListDependentsRequest) Reset()         { *m = QueryListDependentsRequest{} }
+func (m *QueryListDependentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListDependentsRequest) ProtoMessage()    {}
+func (*QueryListDependentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{26}
+}
+func (m *QueryListDependentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListDependentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListDependentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListDependentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListDependentsRequest.Merge(m, src)
+}
+func (m *QueryListDependentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListDependentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListDependentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListDependentsRequest proto.InternalMessageInfo
+
+func (m *QueryListDependentsRequest) GetGuardianDid() string {
+	if m != nil {
+		return m.GuardianDid
+	}
+	return ""
+}
+
+// QueryListDependentsResponse defines the QueryListDependentsResponse message.
+type QueryListDependentsResponse struct {
+	Guardianships []Guardianship `protobuf:"bytes,1,rep,name=guardianships,proto3" json:"guardianships"`
+}
+
+func (m *QueryListDependentsResponse) Reset()         { *m = QueryListDependentsResponse{} }
+func (m *QueryListDependentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListDependentsResponse) ProtoMessage()    {}
+func (*QueryListDependentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{27}
+}
+func (m *QueryListDependentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListDependentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListDependentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListDependentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListDependentsResponse.Merge(m, src)
+}
+func (m *QueryListDependentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListDependentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListDependentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListDependentsResponse proto.InternalMessageInfo
+
+func (m *QueryListDependentsResponse) GetGuardianships() []Guardianship {
+	if m != nil {
+		return m.Guardianships
+	}
+	return nil
+}
+
+// QueryAttestorStrikesRequest defines the QueryAttestorStrikesRequest message.
+type QueryAttestorStrikesRequest struct {
+	Attestor string `protobuf:"bytes,1,opt,name=attestor,proto3" json:"attestor,omitempty"`
+}
+
+func (m *QueryAttestorStrikesRequest) Reset()         { *m = QueryAttestorStrikesRequest{} }
+func (m *QueryAttestorStrikesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestorStrikesRequest) ProtoMessage()    {}
+func (*QueryAttestorStrikesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{28}
+}
+func (m *QueryAttestorStrikesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestorStrikesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestorStrikesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestorStrikesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestorStrikesRequest.Merge(m, src)
+}
+func (m *QueryAttestorStrikesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestorStrikesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestorStrikesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestorStrikesRequest proto.InternalMessageInfo
+
+func (m *QueryAttestorStrikesRequest) GetAttestor() string {
+	if m != nil {
+		return m.Attestor
+	}
+	return ""
+}
+
+// QueryAttestorStrikesResponse defines the QueryAttestorStrikesResponse message.
+type QueryAttestorStrikesResponse struct {
+	Strikes uint64 `protobuf:"varint,1,opt,name=strikes,proto3" json:"strikes,omitempty"`
+}
+
+func (m *QueryAttestorStrikesResponse) Reset()         { *m = QueryAttestorStrikesResponse{} }
+func (m *QueryAttestorStrikesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAttestorStrikesResponse) ProtoMessage()    {}
+func (*QueryAttestorStrikesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{29}
+}
+func (m *QueryAttestorStrikesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAttestorStrikesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAttestorStrikesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAttestorStrikesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAttestorStrikesResponse.Merge(m, src)
+}
+func (m *QueryAttestorStrikesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAttestorStrikesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAttestorStrikesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAttestorStrikesResponse proto.InternalMessageInfo
+
+func (m *QueryAttestorStrikesResponse) GetStrikes() uint64 {
+	if m != nil {
+		return m.Strikes
+	}
+	return 0
+}
+
+// QueryGetAttestorRequest defines the QueryGetAttestorRequest message.
+type QueryGetAttestorRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryGetAttestorRequest) Reset()         { *m = QueryGetAttestorRequest{} }
+func (m *QueryGetAttestorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAttestorRequest) ProtoMessage()    {}
+func (*QueryGetAttestorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{30}
+}
+func (m *QueryGetAttestorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAttestorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAttestorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAttestorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAttestorRequest.Merge(m, src)
+}
+func (m *QueryGetAttestorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAttestorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAttestorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAttestorRequest proto.InternalMessageInfo
+
+func (m *QueryGetAttestorRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryGetAttestorResponse defines the QueryGetAttestorResponse message.
+type QueryGetAttestorResponse struct {
+	Attestor Attestor `protobuf:"bytes,1,opt,name=attestor,proto3" json:"attestor"`
+}
+
+func (m *QueryGetAttestorResponse) Reset()         { *m = QueryGetAttestorResponse{} }
+func (m *QueryGetAttestorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetAttestorResponse) ProtoMessage()    {}
+func (*QueryGetAttestorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{31}
+}
+func (m *QueryGetAttestorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetAttestorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetAttestorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetAttestorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetAttestorResponse.Merge(m, src)
+}
+func (m *QueryGetAttestorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetAttestorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetAttestorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetAttestorResponse proto.InternalMessageInfo
+
+func (m *QueryGetAttestorResponse) GetAttestor() Attestor {
+	if m != nil {
+		return m.Attestor
+	}
+	return Attestor{}
+}
+
+// QueryAllAttestorRequest defines the QueryAllAttestorRequest message.
+type QueryAllAttestorRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAttestorRequest) Reset()         { *m = QueryAllAttestorRequest{} }
+func (m *QueryAllAttestorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAttestorRequest) ProtoMessage()    {}
+func (*QueryAllAttestorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{32}
+}
+func (m *QueryAllAttestorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAttestorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAttestorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAttestorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAttestorRequest.Merge(m, src)
+}
+func (m *QueryAllAttestorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAttestorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAttestorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAttestorRequest proto.InternalMessageInfo
+
+func (m *QueryAllAttestorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAllAttestorResponse defines the QueryAllAttestorResponse message.
+type QueryAllAttestorResponse struct {
+	Attestor   []Attestor          `protobuf:"bytes,1,rep,name=attestor,proto3" json:"attestor"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAllAttestorResponse) Reset()         { *m = QueryAllAttestorResponse{} }
+func (m *QueryAllAttestorResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllAttestorResponse) ProtoMessage()    {}
+func (*QueryAllAttestorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{33}
+}
+func (m *QueryAllAttestorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllAttestorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllAttestorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAllAttestorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllAttestorResponse.Merge(m, src)
+}
+func (m *QueryAllAttestorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllAttestorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllAttestorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAllAttestorResponse proto.InternalMessageInfo
+
+func (m *QueryAllAttestorResponse) GetAttestor() []Attestor {
+	if m != nil {
+		return m.Attestor
+	}
+	return nil
+}
+
+func (m *QueryAllAttestorResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryGetCredentialRequest defines the QueryGetCredentialRequest message.
+type QueryGetCredentialRequest struct {
+	Did            string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	CredentialType string `protobuf:"bytes,2,opt,name=credential_type,json=credentialType,proto3" json:"credential_type,omitempty"`
+}
+
+func (m *QueryGetCredentialRequest) Reset()         { *m = QueryGetCredentialRequest{} }
+func (m *QueryGetCredentialRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCredentialRequest) ProtoMessage()    {}
+func (*QueryGetCredentialRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{34}
+}
+func (m *QueryGetCredentialRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCredentialRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCredentialRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCredentialRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCredentialRequest.Merge(m, src)
+}
+func (m *QueryGetCredentialRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCredentialRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCredentialRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCredentialRequest proto.InternalMessageInfo
+
+func (m *QueryGetCredentialRequest) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *QueryGetCredentialRequest) GetCredentialType() string {
+	if m != nil {
+		return m.CredentialType
+	}
+	return ""
+}
+
+// QueryGetCredentialResponse defines the QueryGetCredentialResponse message.
+type QueryGetCredentialResponse struct {
+	Credential Credential `protobuf:"bytes,1,opt,name=credential,proto3" json:"credential"`
+	// active 表示凭证在当前高度仍然有效
+	Active bool `protobuf:"varint,2,opt,name=active,proto3" json:"active,omitempty"`
+}
+
+func (m *QueryGetCredentialResponse) Reset()         { *m = QueryGetCredentialResponse{} }
+func (m *QueryGetCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetCredentialResponse) ProtoMessage()    {}
+func (*QueryGetCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d19faca8396e375a, []int{35}
+}
+func (m *QueryGetCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetCredentialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetCredentialResponse.Merge(m, src)
+}
+func (m *QueryGetCredentialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetCredentialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryGetCredentialResponse proto.InternalMessageInfo
+
+func (m *QueryGetCredentialResponse) GetCredential() Credential {
+	if m != nil {
+		return m.Credential
+	}
+	return Credential{}
+}
+
+func (m *QueryGetCredentialResponse) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "dtc.identity.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "dtc.identity.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetDidDocumentRequest)(nil), "dtc.identity.v1.QueryGetDidDocumentRequest")
+	proto.RegisterType((*QueryGetDidDocumentResponse)(nil), "dtc.identity.v1.QueryGetDidDocumentResponse")
+	proto.RegisterType((*QueryAllDidDocumentRequest)(nil), "dtc.identity.v1.QueryAllDidDocumentRequest")
+	proto.RegisterType((*QueryAllDidDocumentResponse)(nil), "dtc.identity.v1.QueryAllDidDocumentResponse")
+	proto.RegisterType((*QueryGetDidByAddressRequest)(nil), "dtc.identity.v1.QueryGetDidByAddressRequest")
+	proto.RegisterType((*QueryGetDidByAddressResponse)(nil), "dtc.identity.v1.QueryGetDidByAddressResponse")
+	proto.RegisterType((*QueryValidateDidRequest)(nil), "dtc.identity.v1.QueryValidateDidRequest")
+	proto.RegisterType((*QueryValidateDidResponse)(nil), "dtc.identity.v1.QueryValidateDidResponse")
+	proto.RegisterType((*QueryGetRemoteAttestationRequest)(nil), "dtc.identity.v1.QueryGetRemoteAttestationRequest")
+	proto.RegisterType((*QueryGetRemoteAttestationResponse)(nil), "dtc.identity.v1.QueryGetRemoteAttestationResponse")
+	proto.RegisterType((*QueryGetDidByExternalAccountRequest)(nil), "dtc.identity.v1.QueryGetDidByExternalAccountRequest")
+	proto.RegisterType((*QueryGetDidByExternalAccountResponse)(nil), "dtc.identity.v1.QueryGetDidByExternalAccountResponse")
+	proto.RegisterType((*QueryExternalAccountChallengeRequest)(nil), "dtc.identity.v1.QueryExternalAccountChallengeRequest")
+	proto.RegisterType((*QueryExternalAccountChallengeResponse)(nil), "dtc.identity.v1.QueryExternalAccountChallengeResponse")
+	proto.RegisterType((*QueryGetPendingControllerTransferRequest)(nil), "dtc.identity.v1.QueryGetPendingControllerTransferRequest")
+	proto.RegisterType((*QueryGetPendingControllerTransferResponse)(nil), "dtc.identity.v1.QueryGetPendingControllerTransferResponse")
+	proto.RegisterType((*QueryAllPendingControllerTransferRequest)(nil), "dtc.identity.v1.QueryAllPendingControllerTransferRequest")
+	proto.RegisterType((*QueryAllPendingControllerTransferResponse)(nil), "dtc.identity.v1.QueryAllPendingControllerTransferResponse")
+	proto.RegisterType((*QueryResolveHandleRequest)(nil), "dtc.identity.v1.QueryResolveHandleRequest")
+	proto.RegisterType((*QueryResolveHandleResponse)(nil), "dtc.identity.v1.QueryResolveHandleResponse")
+	proto.RegisterType((*QueryGetHandleByDidRequest)(nil), "dtc.identity.v1.QueryGetHandleByDidRequest")
+	proto.RegisterType((*QueryGetHandleByDidResponse)(nil), "dtc.identity.v1.QueryGetHandleByDidResponse")
+	proto.RegisterType((*QueryGetGuardianshipRequest)(nil), "dtc.identity.v1.QueryGetGuardianshipRequest")
+	proto.RegisterType((*QueryGetGuardianshipResponse)(nil), "dtc.identity.v1.QueryGetGuardianshipResponse")
+	proto.RegisterType((*QueryListDependentsRequest)(nil), "dtc.identity.v1.QueryListDependentsRequest")
+	proto.RegisterType((*QueryListDependentsResponse)(nil), "dtc.identity.v1.QueryListDependentsResponse")
+	proto.RegisterType((*QueryAttestorStrikesRequest)(nil), "dtc.identity.v1.QueryAttestorStrikesRequest")
+	proto.RegisterType((*QueryAttestorStrikesResponse)(nil), "dtc.identity.v1.QueryAttestorStrikesResponse")
+	proto.RegisterType((*QueryGetAttestorRequest)(nil), "dtc.identity.v1.QueryGetAttestorRequest")
+	proto.RegisterType((*QueryGetAttestorResponse)(nil), "dtc.identity.v1.QueryGetAttestorResponse")
+	proto.RegisterType((*QueryAllAttestorRequest)(nil), "dtc.identity.v1.QueryAllAttestorRequest")
+	proto.RegisterType((*QueryAllAttestorResponse)(nil), "dtc.identity.v1.QueryAllAttestorResponse")
+	proto.RegisterType((*QueryGetCredentialRequest)(nil), "dtc.identity.v1.QueryGetCredentialRequest")
+	proto.RegisterType((*QueryGetCredentialResponse)(nil), "dtc.identity.v1.QueryGetCredentialResponse")
+}
+
+func init() { proto.RegisterFile("dtc/identity/v1/query.proto", fileDescriptor_d19faca8396e375a) }
+
+var fileDescriptor_d19faca8396e375a = []byte{
+	// 1770 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0x4b, 0x6f, 0x14, 0xcb,
+	0x15, 0x76, 0xdb, 0xc6, 0xd8, 0xc7, 0x2f, 0xa8, 0x58, 0xd8, 0xee, 0xf1, 0x8b, 0xb6, 0x0d, 0x7e,
+	0x4e, 0xcb, 0x36, 0x0e, 0xc1, 0xa0, 0xa0, 0xb1, 0x0d, 0x0e, 0x11, 0x0b, 0x18, 0x10, 0x28, 0xc9,
+	0x62, 0x54, 0x9e, 0x2e, 0x66, 0x5a, 0xee, 0xe9, 0x1e, 0xba, 0xcb, 0x0e, 0x8e, 0xe3, 0x4d, 0x16,
+	0xd9, 0x12, 0x85, 0x2c, 0x12, 0x45, 0x51, 0xb2, 0x08, 0x52, 0x36, 0x91, 0xf2, 0x0f, 0x22, 0x76,
+	0x2c, 0xb2, 0x20, 0xc9, 0xe6, 0xae, 0xae, 0xae, 0xe0, 0xea, 0xde, 0xbf, 0x71, 0xd5, 0xd5, 0xa7,
+	0xa7, 0x7b, 0xfa, 0x31, 0xd3, 0xe6, 0x7a, 0x03, 0x53, 0x55, 0xdf, 0xa9, 0xfa, 0xbe, 0x53, 0x67,
+	0x4e, 0x9d, 0xe3, 0x81, 0x9c, 0xc6, 0xcb, 0xaa, 0xae, 0x31, 0x93, 0xeb, 0xfc, 0x58, 0x3d, 0x5a,
+	0x53, 0x5f, 0x1e, 0x32, 0xfb, 0x38, 0x5f, 0xb7, 0x2d, 0x6e, 0x91, 0x61, 0x8d, 0x97, 0xf3, 0xfe,
+	0x62, 0xfe, 0x68, 0x4d, 0xbe, 0x4c, 0x6b, 0xba, 0x69, 0xa9, 0xe2, 0x5f, 0x0f, 0x23, 0x2f, 0x95,
+	0x2d, 0xa7, 0x66, 0x39, 0xea, 0x3e, 0x75, 0x98, 0x67, 0xac, 0x1e, 0xad, 0xed, 0x33, 0x4e, 0xd7,
+	0xd4, 0x3a, 0xad, 0xe8, 0x26, 0xe5, 0xba, 0x65, 0x22, 0x76, 0x2a, 0x7a, 0x18, 0xe5, 0x9c, 0x39,
+	0xdc, 0xb2, 0x71, 0x7d, 0x31, 0xba, 0x5e, 0xb6, 0x4c, 0x6e, 0x5b, 0x86, 0xc1, 0xec, 0x12, 0xb7,
+	0xa9, 0xe9, 0xbc, 0x60, 0x3e, 0x74, 0x26, 0x06, 0xb5, 0x99, 0x18, 0x51, 0x03, 0x11, 0x4a, 0x14,
+	0xa1, 0xe9, 0x5a, 0x49, 0xb3, 0xca, 0x87, 0x35, 0x66, 0x72, 0xc4, 0x5c, 0x8b, 0x62, 0xd8, 0x2b,
+	0xce, 0x6c, 0x93, 0x1a, 0x25, 0x5a, 0x2e, 0x5b, 0x87, 0x26, 0x4f, 0xdb, 0xab, 0x72, 0x48, 0x6d,
+	0x4d, 0xa7, 0xa6, 0x53, 0xd5, 0xeb, 0x88, 0x99, 0x88, 0x62, 0xaa, 0xd4, 0xd4, 0x0c, 0x96, 0xb6,
+	0x5a, 0xa7, 0xe5, 0x03, 0xc6, 0xd3, 0x57, 0x6d, 0x5a, 0x73, 0x70, 0x75, 0xa4, 0x62, 0x55, 0x2c,
+	0xf1, 0x51, 0x75, 0x3f, 0xf9, 0x36, 0x15, 0xcb, 0xaa, 0x18, 0x4c, 0xa5, 0x75, 0x5d, 0xa5, 0xa6,
+	0x69, 0x71, 0xe1, 0x69, 0xb4, 0x51, 0x46, 0x80, 0x3c, 0x76, 0x2f, 0xe3, 0x91, 0xd8, 0xa8, 0xc8,
+	0x5e, 0x1e, 0x32, 0x87, 0x2b, 0x8f, 0xe1, 0x07, 0x4d, 0xb3, 0x4e, 0xdd, 0x32, 0x1d, 0x46, 0xb6,
+	0xa0, 0xc7, 0x3b, 0x70, 0x4c, 0x9a, 0x91, 0x16, 0xfa, 0xd7, 0x47, 0xf3, 0x91, 0x8b, 0xcf, 0x7b,
+	0x06, 0xdb, 0x7d, 0xef, 0xbf, 0x9c, 0xee, 0xf8, 0xc7, 0xb7, 0xff, 0x5a, 0x92, 0x8a, 0x68, 0xa1,
+	0xe4, 0x41, 0x16, 0x5b, 0xee, 0x31, 0xbe, 0xab, 0x6b, 0xbb, 0xe8, 0x5f, 0x3c, 0x90, 0x5c, 0x82,
+	0x2e, 0x4d, 0xd7, 0xc4, 0xb6, 0x7d, 0x45, 0xf7, 0xa3, 0xa2, 0x41, 0x2e, 0x11, 0x8f, 0x54, 0xee,
+	0xc1, 0x40, 0xf8, 0x9e, 0x90, 0xd0, 0x44, 0x8c, 0x50, 0xc8, 0x76, 0xbb, 0xdb, 0x65, 0x55, 0xec,
+	0xd7, 0x82, 0x29, 0x45, 0x43, 0x56, 0x05, 0xc3, 0x48, 0x60, 0x75, 0x1f, 0x20, 0x88, 0x4d, 0x3c,
+	0xe2, 0x5a, 0xde, 0x0b, 0xe4, 0xbc, 0x1b, 0xc8, 0x79, 0xef, 0x5b, 0x80, 0x81, 0x9c, 0x7f, 0x44,
+	0x2b, 0x0c, 0x6d, 0x8b, 0x21, 0x4b, 0xe5, 0x9f, 0x12, 0xe4, 0x12, 0x8f, 0x49, 0x15, 0xd3, 0xf5,
+	0x19, 0x62, 0xc8, 0x5e, 0x13, 0xdd, 0x4e, 0x41, 0xf7, 0x7a, 0x5b, 0xba, 0x1e, 0x87, 0x26, 0xbe,
+	0x37, 0x9b, 0x7c, 0xbf, 0x7d, 0x5c, 0xd0, 0x34, 0x9b, 0x39, 0x7e, 0x74, 0x90, 0x31, 0xb8, 0x48,
+	0xbd, 0x19, 0xbc, 0x30, 0x7f, 0xa8, 0xbc, 0x96, 0x60, 0x22, 0xd9, 0x12, 0x95, 0xce, 0xc2, 0xa0,
+	0xee, 0x94, 0x6c, 0x56, 0xd1, 0x1d, 0xce, 0x6c, 0xe6, 0xdd, 0x78, 0x6f, 0x71, 0x40, 0x77, 0x8a,
+	0x8d, 0x39, 0x3f, 0x18, 0x3a, 0x1b, 0xc1, 0x40, 0xae, 0xc3, 0xf0, 0x0b, 0x5a, 0x66, 0xa5, 0xb2,
+	0x55, 0xab, 0xe9, 0x5c, 0xf8, 0xa8, 0x5b, 0xac, 0x0e, 0xb9, 0xd3, 0x3b, 0x8d, 0xd9, 0x9f, 0x76,
+	0xf7, 0x76, 0x5d, 0xea, 0x2e, 0xf6, 0x09, 0x70, 0x95, 0x3a, 0x55, 0x65, 0x19, 0x46, 0x05, 0xa1,
+	0x67, 0xd4, 0xd0, 0x35, 0xca, 0xd9, 0xae, 0xae, 0xa5, 0xc7, 0xdc, 0x1f, 0x24, 0x18, 0x8b, 0xa3,
+	0x91, 0xfa, 0x08, 0x5c, 0x38, 0xa2, 0x06, 0x1a, 0xf4, 0x16, 0xbd, 0x01, 0x99, 0x87, 0x21, 0xd3,
+	0xb2, 0x6b, 0xd4, 0xd0, 0x7f, 0xc5, 0xb4, 0x52, 0x40, 0x7b, 0x30, 0x98, 0xdd, 0xd5, 0x35, 0x57,
+	0x77, 0x99, 0x9a, 0x96, 0xa9, 0x97, 0xa9, 0x21, 0x50, 0x5d, 0x02, 0x35, 0xd0, 0x98, 0x74, 0x41,
+	0x57, 0xa0, 0xc7, 0x66, 0xd4, 0xb1, 0x4c, 0x14, 0x87, 0x23, 0xe5, 0x17, 0x30, 0xe3, 0x3b, 0xb5,
+	0xc8, 0x6a, 0x16, 0x67, 0x05, 0x91, 0x0e, 0xc5, 0x5d, 0xf9, 0x62, 0x26, 0x01, 0xca, 0x55, 0x6a,
+	0x9a, 0xcc, 0x28, 0x35, 0x34, 0xf5, 0xe1, 0xcc, 0x03, 0x2d, 0x7c, 0x65, 0x9d, 0xcd, 0x57, 0xf6,
+	0x6b, 0xb8, 0xda, 0x62, 0x73, 0xd4, 0xfe, 0x1c, 0x88, 0x2d, 0x16, 0x4b, 0x34, 0x58, 0xc5, 0x2f,
+	0x84, 0x12, 0x0b, 0xd3, 0xd8, 0x3e, 0x18, 0xac, 0x97, 0xed, 0xe8, 0x82, 0x72, 0x1f, 0x66, 0x9b,
+	0xe2, 0xe5, 0x1e, 0xe6, 0xd5, 0x82, 0x97, 0x56, 0x7d, 0x75, 0xd3, 0xd0, 0xcf, 0x78, 0xb5, 0xd4,
+	0x1c, 0x75, 0xc0, 0x78, 0x15, 0xe3, 0x4b, 0x79, 0x01, 0x73, 0xad, 0xf7, 0x41, 0x21, 0x3f, 0x86,
+	0x6e, 0x43, 0x37, 0x0f, 0x90, 0xfa, 0x5c, 0x8c, 0x7a, 0xc4, 0xee, 0xa1, 0x6e, 0x1e, 0x20, 0x79,
+	0x61, 0xa7, 0xfc, 0x0c, 0xcf, 0x89, 0xe0, 0x76, 0xaa, 0xd4, 0x30, 0x98, 0x59, 0x61, 0xa9, 0xb1,
+	0x15, 0x95, 0xd0, 0x19, 0x93, 0x70, 0x0f, 0xe6, 0xdb, 0x6c, 0x8d, 0x1a, 0x26, 0xa0, 0xaf, 0xec,
+	0x4f, 0x86, 0x6e, 0xda, 0x9b, 0x50, 0xee, 0xc0, 0x82, 0xef, 0x89, 0x47, 0xcc, 0xd4, 0x74, 0xb3,
+	0xb2, 0xd3, 0x78, 0x1c, 0x9f, 0xe2, 0xdb, 0x98, 0xfe, 0x0d, 0xf8, 0x8b, 0x04, 0x8b, 0x19, 0xcc,
+	0x91, 0x49, 0x1d, 0x72, 0x75, 0x0f, 0x54, 0x4a, 0x78, 0x81, 0xd1, 0xc9, 0x4b, 0xf1, 0x47, 0x22,
+	0x6d, 0x63, 0x74, 0xf5, 0x78, 0x3d, 0x0d, 0xa0, 0xd8, 0xa8, 0xae, 0x60, 0x18, 0x6d, 0xd5, 0x9d,
+	0x57, 0xf6, 0xfe, 0xc6, 0xf7, 0x49, 0xeb, 0x43, 0xb3, 0xfa, 0xa4, 0xeb, 0x9c, 0x7d, 0x72, 0x7e,
+	0x69, 0x7f, 0x03, 0xc6, 0x85, 0xce, 0x22, 0x73, 0x2c, 0xe3, 0x88, 0xfd, 0x44, 0xd4, 0x25, 0xbe,
+	0x37, 0xaf, 0x40, 0x8f, 0x57, 0xa8, 0x60, 0xb8, 0xe0, 0x48, 0x79, 0x02, 0x72, 0x92, 0x11, 0x7a,
+	0x63, 0xb3, 0xc9, 0x2a, 0xa9, 0x62, 0xf0, 0x0c, 0x50, 0xa5, 0xbf, 0x69, 0xa8, 0x58, 0xc0, 0xf5,
+	0xe3, 0x96, 0x89, 0xfb, 0x29, 0xe4, 0x12, 0xf1, 0xdf, 0x8f, 0x85, 0x1a, 0xec, 0xba, 0x17, 0xaa,
+	0xe3, 0xd2, 0x69, 0x54, 0x60, 0x22, 0xd9, 0x00, 0x79, 0xec, 0xc1, 0x40, 0xb8, 0x20, 0x44, 0x36,
+	0x93, 0x31, 0x36, 0x61, 0x63, 0xe4, 0xd4, 0x64, 0xa8, 0xdc, 0x45, 0xff, 0x3c, 0xd4, 0x1d, 0xbe,
+	0xcb, 0xdc, 0xd0, 0x60, 0x26, 0x6f, 0xbc, 0xcf, 0x57, 0x83, 0x63, 0x4a, 0x01, 0xc3, 0x7e, 0x7f,
+	0x6e, 0x57, 0xd7, 0x94, 0x2a, 0xe4, 0x12, 0x37, 0x40, 0xa2, 0x0f, 0x60, 0x30, 0x7c, 0x9e, 0x83,
+	0x61, 0x9b, 0x89, 0x69, 0xb3, 0xa5, 0x72, 0xcb, 0x2f, 0x7d, 0xb0, 0x84, 0x7f, 0xc2, 0x6d, 0xfd,
+	0x80, 0x35, 0xb8, 0xca, 0xd0, 0xeb, 0x17, 0xf7, 0xc8, 0xb3, 0x31, 0x56, 0x7e, 0x04, 0x13, 0xc9,
+	0xa6, 0xc8, 0x72, 0x0c, 0x2e, 0x3a, 0xde, 0x94, 0x30, 0xed, 0x2e, 0xfa, 0x43, 0x65, 0x03, 0x5f,
+	0xfd, 0x3d, 0xc6, 0x7d, 0xe3, 0xf6, 0xc5, 0xcb, 0x73, 0x18, 0x8b, 0x1b, 0xe1, 0x51, 0xb7, 0x23,
+	0x34, 0xfb, 0xd7, 0xc7, 0x63, 0xbe, 0xf0, 0x8d, 0xd0, 0x0f, 0x81, 0x0e, 0x8a, 0x6c, 0x0a, 0x86,
+	0x11, 0x65, 0x73, 0x5e, 0x39, 0xea, 0x6f, 0x7e, 0xe5, 0xd2, 0x74, 0x46, 0x22, 0xf9, 0xae, 0x33,
+	0x91, 0x3f, 0xbf, 0xec, 0xf2, 0x0c, 0xb3, 0xcb, 0x1e, 0xe3, 0x3b, 0x8d, 0x1e, 0x2c, 0xfd, 0xbd,
+	0xbc, 0x0e, 0xc3, 0x41, 0xab, 0x56, 0xe2, 0xc7, 0x75, 0x86, 0x6f, 0xe6, 0x50, 0x30, 0xfd, 0xf4,
+	0xb8, 0xce, 0x94, 0x5f, 0x82, 0x9c, 0xb4, 0x2f, 0x6a, 0x2f, 0x00, 0x04, 0x78, 0x74, 0x70, 0x2e,
+	0xa6, 0x3e, 0x30, 0x44, 0xfd, 0x21, 0x23, 0x37, 0xf3, 0xd1, 0x32, 0xd7, 0x8f, 0x3c, 0x02, 0xbd,
+	0x45, 0x1c, 0xad, 0xbf, 0x1b, 0x85, 0x0b, 0xe2, 0x64, 0xc2, 0xa1, 0xc7, 0x6b, 0x7c, 0xc8, 0x6c,
+	0x6c, 0xeb, 0x78, 0x77, 0x25, 0xcf, 0xb5, 0x06, 0x79, 0xcc, 0x95, 0xe9, 0xdf, 0xfc, 0xff, 0xeb,
+	0x37, 0x9d, 0xe3, 0x64, 0x54, 0x4d, 0x6e, 0xfa, 0xc8, 0x1f, 0x25, 0x18, 0x6a, 0xee, 0x8e, 0xc8,
+	0x72, 0xf2, 0xce, 0x89, 0x3d, 0x97, 0xbc, 0x92, 0x0d, 0x8c, 0x74, 0x96, 0x05, 0x9d, 0x79, 0x32,
+	0xab, 0xb6, 0xea, 0x97, 0xd5, 0x13, 0x4d, 0xd7, 0x4e, 0xc9, 0x1b, 0x09, 0x86, 0x45, 0x6a, 0x69,
+	0xcf, 0x2d, 0xb1, 0xf3, 0x92, 0x57, 0xb2, 0x81, 0x91, 0xdb, 0xbc, 0xe0, 0x36, 0x4d, 0x26, 0x5b,
+	0x72, 0x23, 0x6f, 0x25, 0x18, 0x8e, 0x34, 0x26, 0xa4, 0xa5, 0x13, 0xa2, 0x9d, 0x8f, 0xbc, 0x9a,
+	0x11, 0x8d, 0xbc, 0x36, 0x05, 0x2f, 0x95, 0xac, 0xc6, 0x78, 0x55, 0x18, 0x77, 0x53, 0x73, 0x69,
+	0xff, 0xd8, 0xaf, 0x08, 0xd5, 0x13, 0xfc, 0x70, 0x4a, 0x5e, 0x4b, 0xd0, 0x1f, 0xea, 0x40, 0xc8,
+	0x42, 0xf2, 0xa9, 0xf1, 0x96, 0x46, 0x5e, 0xcc, 0x80, 0x6c, 0x7b, 0x9f, 0x47, 0x88, 0x76, 0x09,
+	0xe2, 0x7d, 0xbe, 0x93, 0x60, 0x24, 0xa9, 0x41, 0x20, 0x6b, 0xa9, 0x0e, 0x49, 0xeb, 0x54, 0xe4,
+	0xf5, 0xb3, 0x98, 0x20, 0xd9, 0x6d, 0x41, 0xf6, 0x0e, 0xd9, 0x8a, 0x91, 0x8d, 0xb7, 0x25, 0xea,
+	0x49, 0xd0, 0x08, 0x9d, 0x86, 0xbc, 0xfa, 0x6f, 0x09, 0x46, 0x53, 0xda, 0x03, 0x72, 0xa3, 0xf5,
+	0xbd, 0x26, 0x77, 0x25, 0xf2, 0xe6, 0x19, 0xad, 0x50, 0xcc, 0x4d, 0x21, 0x66, 0x8d, 0xa8, 0x6a,
+	0xbb, 0xbf, 0x2a, 0xa9, 0x27, 0xa1, 0x96, 0xe1, 0x94, 0xfc, 0x4f, 0x82, 0xb1, 0xb4, 0xee, 0x80,
+	0xa4, 0x90, 0x69, 0xd3, 0xa8, 0xc8, 0x3f, 0x3c, 0xab, 0x19, 0x8a, 0xd8, 0x13, 0x22, 0x0a, 0xe4,
+	0x6e, 0x5b, 0x11, 0xa5, 0x46, 0x6f, 0xe2, 0x05, 0x53, 0x44, 0xd4, 0x7f, 0x25, 0x98, 0x68, 0xd5,
+	0x6c, 0x90, 0x5b, 0xa9, 0x5e, 0x6e, 0xd7, 0x01, 0xc8, 0x5b, 0x9f, 0x63, 0x8a, 0x02, 0xb7, 0x84,
+	0xc0, 0x1b, 0x64, 0x3d, 0x9e, 0x7e, 0xd3, 0xcb, 0x7b, 0xfc, 0xba, 0xfc, 0x47, 0x82, 0x49, 0x37,
+	0xfd, 0x9d, 0x59, 0x54, 0x86, 0xb6, 0x46, 0xde, 0xfa, 0x1c, 0x53, 0x14, 0x75, 0x43, 0x88, 0xca,
+	0x93, 0x95, 0xb3, 0x88, 0x22, 0x7f, 0xf6, 0xf2, 0x66, 0xb8, 0xd6, 0x6b, 0x91, 0x37, 0x13, 0x4a,
+	0x65, 0x79, 0x35, 0x23, 0xba, 0x6d, 0x6e, 0x0a, 0xd7, 0x96, 0xe8, 0xec, 0xbf, 0x4a, 0x30, 0xd4,
+	0x5c, 0xc6, 0xa6, 0x3d, 0x35, 0x89, 0xd5, 0xb2, 0xbc, 0x92, 0x0d, 0x8c, 0xd4, 0xd6, 0x05, 0xb5,
+	0x15, 0xb2, 0x14, 0x7f, 0x6a, 0x1a, 0x60, 0xf5, 0x24, 0x5c, 0x7e, 0x9f, 0x92, 0xbf, 0x4b, 0x30,
+	0x1c, 0xa9, 0x61, 0xd3, 0xfc, 0x97, 0x5c, 0x25, 0xcb, 0xab, 0x19, 0xd1, 0x6d, 0xaf, 0xd9, 0x2f,
+	0xeb, 0x4a, 0x58, 0x29, 0xab, 0x27, 0xfe, 0x8c, 0xf7, 0xec, 0x84, 0x6a, 0xdf, 0xb4, 0x67, 0x27,
+	0x5e, 0x53, 0xcb, 0x8b, 0x19, 0x90, 0x6d, 0xaf, 0xd6, 0x27, 0x12, 0x4a, 0xd9, 0xbf, 0x95, 0x60,
+	0xc0, 0xbd, 0x87, 0x76, 0x94, 0xe2, 0x85, 0xb5, 0xbc, 0x98, 0x01, 0x89, 0x94, 0xae, 0x0a, 0x4a,
+	0x39, 0x32, 0x9e, 0x4a, 0x89, 0xfc, 0x5e, 0x82, 0xc1, 0xa6, 0x06, 0x97, 0x2c, 0x25, 0xef, 0x9f,
+	0xd4, 0x3a, 0xcb, 0xcb, 0x99, 0xb0, 0xc8, 0x66, 0x41, 0xb0, 0x51, 0xc8, 0x8c, 0x9a, 0xfc, 0x3b,
+	0x81, 0x7a, 0xe2, 0xfd, 0x7f, 0x4a, 0xfe, 0xe4, 0xd5, 0x7f, 0xa1, 0x86, 0xb7, 0x45, 0xfd, 0x17,
+	0x6f, 0xa3, 0xe5, 0x95, 0x6c, 0x60, 0xe4, 0xb5, 0x22, 0x78, 0x5d, 0x23, 0x73, 0x29, 0xbc, 0xdc,
+	0x52, 0x26, 0x28, 0x18, 0xde, 0x4a, 0x30, 0xd8, 0x54, 0x90, 0xa7, 0x39, 0x2c, 0xa9, 0x1b, 0x90,
+	0x97, 0x33, 0x61, 0x91, 0xd8, 0x6d, 0x41, 0x6c, 0x93, 0x6c, 0xa8, 0xe9, 0x3f, 0xf5, 0xf8, 0x2f,
+	0x4f, 0xa4, 0xa3, 0x38, 0xdd, 0xce, 0xbf, 0xff, 0x38, 0x25, 0x7d, 0xf8, 0x38, 0x25, 0x7d, 0xf5,
+	0x71, 0x4a, 0xfa, 0xdd, 0xa7, 0xa9, 0x8e, 0x0f, 0x9f, 0xa6, 0x3a, 0xbe, 0xf8, 0x34, 0xd5, 0xf1,
+	0xf3, 0x11, 0x77, 0xb7, 0x57, 0xc1, 0x7e, 0x2e, 0xde, 0xd9, 0xef, 0x11, 0xbf, 0x9a, 0x6c, 0x7c,
+	0x37, 0x00, 0x75, 0x18, 0xbb, 0x7a, 0x0f, 0x1b, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ListDidDocument Queries a list of DidDocument items.
+	GetDidDocument(ctx context.Context, in *QueryGetDidDocumentRequest, opts ...grpc.CallOption) (*QueryGetDidDocumentResponse, error)
+	// ListDidDocument defines the ListDidDocument RPC.
+	ListDidDocument(ctx context.Context, in *QueryAllDidDocumentRequest, opts ...grpc.CallOption) (*QueryAllDidDocumentResponse, error)
+	// GetDidByAddress Queries a list of GetDidByAddress items.
+	GetDidByAddress(ctx context.Context, in *QueryGetDidByAddressRequest, opts ...grpc.CallOption) (*QueryGetDidByAddressResponse, error)
+	// ValidateDid validates a DID string against the did:dtc method and returns
+	// its normalised and canonical forms.
+	ValidateDid(ctx context.Context, in *QueryValidateDidRequest, opts ...grpc.CallOption) (*QueryValidateDidResponse, error)
+	// GetRemoteAttestation queries an identity attestation received over IBC.
+	GetRemoteAttestation(ctx context.Context, in *QueryGetRemoteAttestationRequest, opts ...grpc.CallOption) (*QueryGetRemoteAttestationResponse, error)
+	// GetDidByExternalAccount resolves a linked Ethereum address to its DID.
+	GetDidByExternalAccount(ctx context.Context, in *QueryGetDidByExternalAccountRequest, opts ...grpc.CallOption) (*QueryGetDidByExternalAccountResponse, error)
+	// ExternalAccountChallenge returns the message an Ethereum key must personal_sign to be linked to a DID.
+	ExternalAccountChallenge(ctx context.Context, in *QueryExternalAccountChallengeRequest, opts ...grpc.CallOption) (*QueryExternalAccountChallengeResponse, error)
+	// GetPendingControllerTransfer queries the pending controller transfer of a DID.
+	GetPendingControllerTransfer(ctx context.Context, in *QueryGetPendingControllerTransferRequest, opts ...grpc.CallOption) (*QueryGetPendingControllerTransferResponse, error)
+	// ListPendingControllerTransfer queries all pending controller transfers.
+	ListPendingControllerTransfer(ctx context.Context, in *QueryAllPendingControllerTransferRequest, opts ...grpc.CallOption) (*QueryAllPendingControllerTransferResponse, error)
+	// GetGuardianship queries the guardian of a dependent DID.
+	GetGuardianship(ctx context.Context, in *QueryGetGuardianshipRequest, opts ...grpc.CallOption) (*QueryGetGuardianshipResponse, error)
+	// ListDependents queries the dependent DIDs of a guardian DID.
+	ListDependents(ctx context.Context, in *QueryListDependentsRequest, opts ...grpc.CallOption) (*QueryListDependentsResponse, error)
+	// AttestorStrikes queries the duplicate-identity strikes recorded against an attestor.
+	AttestorStrikes(ctx context.Context, in *QueryAttestorStrikesRequest, opts ...grpc.CallOption) (*QueryAttestorStrikesResponse, error)
+	// GetAttestor queries a bonded attestor by address.
+	GetAttestor(ctx context.Context, in *QueryGetAttestorRequest, opts ...grpc.CallOption) (*QueryGetAttestorResponse, error)
+	// ListAttestor queries all attestors in the registry.
+	ListAttestor(ctx context.Context, in *QueryAllAttestorRequest, opts ...grpc.CallOption) (*QueryAllAttestorResponse, error)
+	// ResolveHandle resolves an active handle to its DID.
+	ResolveHandle(ctx context.Context, in *QueryResolveHandleRequest, opts ...grpc.CallOption) (*QueryResolveHandleResponse, error)
+	// GetHandleByDid queries the active handle of a DID.
+	GetHandleByDid(ctx context.Context, in *QueryGetHandleByDidRequest, opts ...grpc.CallOption) (*QueryGetHandleByDidResponse, error)
+	// GetCredential queries a credential of a DID by type.
+	GetCredential(ctx context.Context, in *QueryGetCredentialRequest, opts ...grpc.CallOption) (*QueryGetCredentialResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetDidDocument(ctx context.Context, in *QueryGetDidDocumentRequest, opts ...grpc.CallOption) (*QueryGetDidDocumentResponse, error) {
+	out := new(QueryGetDidDocumentResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/GetDidDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListDidDocument(ctx context.Context, in *QueryAllDidDocumentRequest, opts ...grpc.CallOption) (*QueryAllDidDocumentResponse, error) {
+	out := new(QueryAllDidDocumentResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/ListDidDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetDidByAddress(ctx context.Context, in *QueryGetDidByAddressRequest, opts ...grpc.CallOption) (*QueryGetDidByAddressResponse, error) {
+	out := new(QueryGetDidByAddressResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/GetDidByAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ValidateDid(ctx context.Context, in *QueryValidateDidRequest, opts ...grpc.CallOption) (*QueryValidateDidResponse, error) {
+	out := new(QueryValidateDidResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/ValidateDid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetRemoteAttestation(ctx context.Context, in *QueryGetRemoteAttestationRequest, opts ...grpc.CallOption) (*QueryGetRemoteAttestationResponse, error) {
+	out := new(QueryGetRemoteAttestationResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/GetRemoteAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetDidByExternalAccount(ctx context.Context, in *QueryGetDidByExternalAccountRequest, opts ...grpc.CallOption) (*QueryGetDidByExternalAccountResponse, error) {
+	out := new(QueryGetDidByExternalAccountResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/GetDidByExternalAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ExternalAccountChallenge(ctx context.Context, in *QueryExternalAccountChallengeRequest, opts ...grpc.CallOption) (*QueryExternalAccountChallengeResponse, error) {
+	out := new(QueryExternalAccountChallengeResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/ExternalAccountChallenge", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetPendingControllerTransfer(ctx context.Context, in *QueryGetPendingControllerTransferRequest, opts ...grpc.CallOption) (*QueryGetPendingControllerTransferResponse, error) {
+	out := new(QueryGetPendingControllerTransferResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/GetPendingControllerTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListPendingControllerTransfer(ctx context.Context, in *QueryAllPendingControllerTransferRequest, opts ...grpc.CallOption) (*QueryAllPendingControllerTransferResponse, error) {
+	out := new(QueryAllPendingControllerTransferResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/ListPendingControllerTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetGuardianship(ctx context.Context, in *QueryGetGuardianshipRequest, opts ...grpc.CallOption) (*QueryGetGuardianshipResponse, error) {
+	out := new(QueryGetGuardianshipResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/GetGuardianship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListDependents(ctx context.Context, in *QueryListDependentsRequest, opts ...grpc.CallOption) (*QueryListDependentsResponse, error) {
+	out := new(QueryListDependentsResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/ListDependents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AttestorStrikes(ctx context.Context, in *QueryAttestorStrikesRequest, opts ...grpc.CallOption) (*QueryAttestorStrikesResponse, error) {
+	out := new(QueryAttestorStrikesResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/AttestorStrikes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetAttestor(ctx context.Context, in *QueryGetAttestorRequest, opts ...grpc.CallOption) (*QueryGetAttestorResponse, error) {
+	out := new(QueryGetAttestorResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/GetAttestor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListAttestor(ctx context.Context, in *QueryAllAttestorRequest, opts ...grpc.CallOption) (*QueryAllAttestorResponse, error) {
+	out := new(QueryAllAttestorResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/ListAttestor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResolveHandle(ctx context.Context, in *QueryResolveHandleRequest, opts ...grpc.CallOption) (*QueryResolveHandleResponse, error) {
+	out := new(QueryResolveHandleResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/ResolveHandle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetHandleByDid(ctx context.Context, in *QueryGetHandleByDidRequest, opts ...grpc.CallOption) (*QueryGetHandleByDidResponse, error) {
+	out := new(QueryGetHandleByDidResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/GetHandleByDid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetCredential(ctx context.Context, in *QueryGetCredentialRequest, opts ...grpc.CallOption) (*QueryGetCredentialResponse, error) {
+	out := new(QueryGetCredentialResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Query/GetCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ListDidDocument Queries a list of DidDocument items.
+	GetDidDocument(context.Context, *QueryGetDidDocumentRequest) (*QueryGetDidDocumentResponse, error)
+	// ListDidDocument defines the ListDidDocument RPC.
+	ListDidDocument(context.Context, *QueryAllDidDocumentRequest) (*QueryAllDidDocumentResponse, error)
+	// GetDidByAddress Queries a list of GetDidByAddress items.
+	GetDidByAddress(context.Context, *QueryGetDidByAddressRequest) (*QueryGetDidByAddressResponse, error)
+	// ValidateDid validates a DID string against the did:dtc method and returns
+	// its normalised and canonical forms.
+	ValidateDid(context.Context, *QueryValidateDidRequest) (*QueryValidateDidResponse, error)
+	// GetRemoteAttestation queries an identity attestation received over IBC.
+	GetRemoteAttestation(context.Context, *QueryGetRemoteAttestationRequest) (*QueryGetRemoteAttestationResponse, error)
+	// GetDidByExternalAccount resolves a linked Ethereum address to its DID.
+	GetDidByExternalAccount(context.Context, *QueryGetDidByExternalAccountRequest) (*QueryGetDidByExternalAccountResponse, error)
+	// ExternalAccountChallenge returns the message an Ethereum key must personal_sign to be linked to a DID.
+	ExternalAccountChallenge(context.Context, *QueryExternalAccountChallengeRequest) (*QueryExternalAccountChallengeResponse, error)
+	// GetPendingControllerTransfer queries the pending controller transfer of a DID.
+	GetPendingControllerTransfer(context.Context, *QueryGetPendingControllerTransferRequest) (*QueryGetPendingControllerTransferResponse, error)
+	// ListPendingControllerTransfer queries all pending controller transfers.
+	ListPendingControllerTransfer(context.Context, *QueryAllPendingControllerTransferRequest) (*QueryAllPendingControllerTransferResponse, error)
+	// GetGuardianship queries the guardian of a dependent DID.
+	GetGuardianship(context.Context, *QueryGetGuardianshipRequest) (*QueryGetGuardianshipResponse, error)
+	// ListDependents queries the dependent DIDs of a guardian DID.
+	ListDependents(context.Context, *QueryListDependentsRequest) (*QueryListDependentsResponse, error)
+	// AttestorStrikes queries the duplicate-identity strikes recorded against an attestor.
+	AttestorStrikes(context.Context, *QueryAttestorStrikesRequest) (*QueryAttestorStrikesResponse, error)
+	// GetAttestor queries a bonded attestor by address.
+	GetAttestor(context.Context, *QueryGetAttestorRequest) (*QueryGetAttestorResponse, error)
+	// ListAttestor queries all attestors in the registry.
+	ListAttestor(context.Context, *QueryAllAttestorRequest) (*QueryAllAttestorResponse, error)
+	// ResolveHandle resolves an active handle to its DID.
+	ResolveHandle(context.Context, *QueryResolveHandleRequest) (*QueryResolveHandleResponse, error)
+	// GetHandleByDid queries the active handle of a DID.
+	GetHandleByDid(context.Context, *QueryGetHandleByDidRequest) (*QueryGetHandleByDidResponse, error)
+	// GetCredential queries a credential of a DID by type.
+	GetCredential(context.Context, *QueryGetCredentialRequest) (*QueryGetCredentialResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetDidDocument(ctx context.Context, req *QueryGetDidDocumentRequest) (*QueryGetDidDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDidDocument not implemented")
+}
+func (*UnimplementedQueryServer) ListDidDocument(ctx context.Context, req *QueryAllDidDocumentRequest) (*QueryAllDidDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDidDocument not implemented")
+}
+func (*UnimplementedQueryServer) GetDidByAddress(ctx context.Context, req *QueryGetDidByAddressRequest) (*QueryGetDidByAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDidByAddress not implemented")
+}
+func (*UnimplementedQueryServer) ValidateDid(ctx context.Context, req *QueryValidateDidRequest) (*QueryValidateDidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateDid not implemented")
+}
+func (*UnimplementedQueryServer) GetRemoteAttestation(ctx context.Context, req *QueryGetRemoteAttestationRequest) (*QueryGetRemoteAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRemoteAttestation not implemented")
+}
+func (*UnimplementedQueryServer) GetDidByExternalAccount(ctx context.Context, req *QueryGetDidByExternalAccountRequest) (*QueryGetDidByExternalAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDidByExternalAccount not implemented")
+}
+func (*UnimplementedQueryServer) ExternalAccountChallenge(ctx context.Context, req *QueryExternalAccountChallengeRequest) (*QueryExternalAccountChallengeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExternalAccountChallenge not implemented")
+}
+func (*UnimplementedQueryServer) GetPendingControllerTransfer(ctx context.Context, req *QueryGetPendingControllerTransferRequest) (*QueryGetPendingControllerTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPendingControllerTransfer not implemented")
+}
+func (*UnimplementedQueryServer) ListPendingControllerTransfer(ctx context.Context, req *QueryAllPendingControllerTransferRequest) (*QueryAllPendingControllerTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingControllerTransfer not implemented")
+}
+func (*UnimplementedQueryServer) GetGuardianship(ctx context.Context, req *QueryGetGuardianshipRequest) (*QueryGetGuardianshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetGuardianship not implemented")
+}
+func (*UnimplementedQueryServer) ListDependents(ctx context.Context, req *QueryListDependentsRequest) (*QueryListDependentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDependents not implemented")
+}
+func (*UnimplementedQueryServer) AttestorStrikes(ctx context.Context, req *QueryAttestorStrikesRequest) (*QueryAttestorStrikesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AttestorStrikes not implemented")
+}
+func (*UnimplementedQueryServer) GetAttestor(ctx context.Context, req *QueryGetAttestorRequest) (*QueryGetAttestorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAttestor not implemented")
+}
+func (*UnimplementedQueryServer) ListAttestor(ctx context.Context, req *QueryAllAttestorRequest) (*QueryAllAttestorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAttestor not implemented")
+}
+func (*UnimplementedQueryServer) ResolveHandle(ctx context.Context, req *QueryResolveHandleRequest) (*QueryResolveHandleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolveHandle not implemented")
+}
+func (*UnimplementedQueryServer) GetHandleByDid(ctx context.Context, req *QueryGetHandleByDidRequest) (*QueryGetHandleByDidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHandleByDid not implemented")
+}
+func (*UnimplementedQueryServer) GetCredential(ctx context.Context, req *QueryGetCredentialRequest) (*QueryGetCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCredential not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDidDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDidDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDidDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Query/GetDidDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDidDocument(ctx, req.(*QueryGetDidDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListDidDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllDidDocumentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListDidDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Query/ListDidDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListDidDocument(ctx, req.(*QueryAllDidDocumentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDidByAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDidByAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDidByAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Query/GetDidByAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDidByAddress(ctx, req.(*QueryGetDidByAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ValidateDid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryValidateDidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ValidateDid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Query/ValidateDid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ValidateDid(ctx, req.(*QueryValidateDidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetRemoteAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetRemoteAttestationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetRemoteAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Query/GetRemoteAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetRemoteAttestation(ctx, req.(*QueryGetRemoteAttestationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetDidByExternalAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetDidByExternalAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetDidByExternalAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Query/GetDidByExternalAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetDidByExternalAccount(ctx, req.(*QueryGetDidByExternalAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ExternalAccountChallenge_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryExternalAccountChallengeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ExternalAccountChallenge(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Query/ExternalAccountChallenge",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ExternalAccountChallenge(ctx, req.(*QueryExternalAccountChallengeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetPendingControllerTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetPendingControllerTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetPendingControllerTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Query/GetPendingControllerTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetPendingControllerTransfer(ctx, req.(*QueryGetPendingControllerTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListPendingControllerTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllPendingControllerTransferRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListPendingControllerTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Query/ListPendingControllerTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListPendingControllerTransfer(ctx, req.(*QueryAllPendingControllerTransferRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetGuardianship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetGuardianshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetGuardianship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Query/GetGuardianship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetGuardianship(ctx, req.(*QueryGetGuardianshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListDependents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListDependentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListDependents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Query/ListDependents",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListDependents(ctx, req.(*QueryListDependentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AttestorStrikes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAttestorStrikesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AttestorStrikes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Query/AttestorStrikes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AttestorStrikes(ctx, req.(*QueryAttestorStrikesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetAttestor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetAttestorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetAttestor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Query/GetAttestor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetAttestor(ctx, req.(*QueryGetAttestorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListAttestor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllAttestorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListAttestor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Query/ListAttestor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListAttestor(ctx, req.(*QueryAllAttestorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResolveHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResolveHandleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResolveHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Query/ResolveHandle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResolveHandle(ctx, req.(*QueryResolveHandleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetHandleByDid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetHandleByDidRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetHandleByDid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Query/GetHandleByDid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetHandleByDid(ctx, req.(*QueryGetHandleByDidRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetCredentialRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Query/GetCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetCredential(ctx, req.(*QueryGetCredentialRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dtc.identity.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetDidDocument",
+			Handler:    _Query_GetDidDocument_Handler,
+		},
+		{
+			MethodName: "ListDidDocument",
+			Handler:    _Query_ListDidDocument_Handler,
+		},
+		{
+			MethodName: "GetDidByAddress",
+			Handler:    _Query_GetDidByAddress_Handler,
+		},
+		{
+			MethodName: "ValidateDid",
+			Handler:    _Query_ValidateDid_Handler,
+		},
+		{
+			MethodName: "GetRemoteAttestation",
+			Handler:    _Query_GetRemoteAttestation_Handler,
+		},
+		{
+			MethodName: "GetDidByExternalAccount",
+			Handler:    _Query_GetDidByExternalAccount_Handler,
+		},
+		{
+			MethodName: "ExternalAccountChallenge",
+			Handler:    _Query_ExternalAccountChallenge_Handler,
+		},
+		{
+			MethodName: "GetPendingControllerTransfer",
+			Handler:    _Query_GetPendingControllerTransfer_Handler,
+		},
+		{
+			MethodName: "ListPendingControllerTransfer",
+			Handler:    _Query_ListPendingControllerTransfer_Handler,
+		},
+		{
+			MethodName: "GetGuardianship",
+			Handler:    _Query_GetGuardianship_Handler,
+		},
+		{
+			MethodName: "ListDependents",
+			Handler:    _Query_ListDependents_Handler,
+		},
+		{
+			MethodName: "AttestorStrikes",
+			Handler:    _Query_AttestorStrikes_Handler,
+		},
+		{
+			MethodName: "GetAttestor",
+			Handler:    _Query_GetAttestor_Handler,
+		},
+		{
+			MethodName: "ListAttestor",
+			Handler:    _Query_ListAttestor_Handler,
+		},
+		{
+			MethodName: "ResolveHandle",
+			Handler:    _Query_ResolveHandle_Handler,
+		},
+		{
+			MethodName: "GetHandleByDid",
+			Handler:    _Query_GetHandleByDid_Handler,
+		},
+		{
+			MethodName: "GetCredential",
+			Handler:    _Query_GetCredential_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dtc/identity/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDidDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDidDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDidDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDidDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDidDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDidDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.DidDocument.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDidDocumentRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDidDocumentRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDidDocumentRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllDidDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllDidDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllDidDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.DidDocument) > 0 {
+		for iNdEx := len(m.DidDocument) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DidDocument[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDidByAddressRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDidByAddressRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDidByAddressRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDidByAddressResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDidByAddressResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDidByAddressResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FaceCommitment) > 0 {
+		i -= len(m.FaceCommitment)
+		copy(dAtA[i:], m.FaceCommitment)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FaceCommitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.IsRegistered {
+		i--
+		if m.IsRegistered {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateDidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateDidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateDidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryValidateDidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryValidateDidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryValidateDidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CanonicalDid) > 0 {
+		i -= len(m.CanonicalDid)
+		copy(dAtA[i:], m.CanonicalDid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CanonicalDid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.NormalizedDid) > 0 {
+		i -= len(m.NormalizedDid)
+		copy(dAtA[i:], m.NormalizedDid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.NormalizedDid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Valid {
+		i--
+		if m.Valid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRemoteAttestationRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRemoteAttestationRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRemoteAttestationRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetRemoteAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetRemoteAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetRemoteAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.RemoteAttestation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDidByExternalAccountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDidByExternalAccountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDidByExternalAccountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetDidByExternalAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetDidByExternalAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetDidByExternalAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Link.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExternalAccountChallengeRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExternalAccountChallengeRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExternalAccountChallengeRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryExternalAccountChallengeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryExternalAccountChallengeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryExternalAccountChallengeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Challenge) > 0 {
+		i -= len(m.Challenge)
+		copy(dAtA[i:], m.Challenge)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Challenge)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingControllerTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingControllerTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingControllerTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetPendingControllerTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetPendingControllerTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetPendingControllerTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingControllerTransfer.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPendingControllerTransferRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPendingControllerTransferRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPendingControllerTransferRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllPendingControllerTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllPendingControllerTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllPendingControllerTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PendingControllerTransfer) > 0 {
+		for iNdEx := len(m.PendingControllerTransfer) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingControllerTransfer[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveHandleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveHandleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveHandleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Handle) > 0 {
+		i -= len(m.Handle)
+		copy(dAtA[i:], m.Handle)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Handle)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryResolveHandleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryResolveHandleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryResolveHandleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Handle.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetHandleByDidRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetHandleByDidRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetHandleByDidRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetHandleByDidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetHandleByDidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetHandleByDidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Handle.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGuardianshipRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGuardianshipRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGuardianshipRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetGuardianshipResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetGuardianshipResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetGuardianshipResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Guardianship.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListDependentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListDependentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListDependentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.GuardianDid) > 0 {
+		i -= len(m.GuardianDid)
+		copy(dAtA[i:], m.GuardianDid)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.GuardianDid)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListDependentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListDependentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListDependentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Guardianships) > 0 {
+		for iNdEx := len(m.Guardianships) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Guardianships[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestorStrikesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestorStrikesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestorStrikesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Attestor) > 0 {
+		i -= len(m.Attestor)
+		copy(dAtA[i:], m.Attestor)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Attestor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAttestorStrikesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAttestorStrikesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAttestorStrikesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Strikes != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Strikes))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAttestorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAttestorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAttestorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetAttestorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetAttestorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetAttestorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Attestor.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAttestorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAttestorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAttestorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllAttestorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllAttestorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllAttestorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Attestor) > 0 {
+		for iNdEx := len(m.Attestor) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Attestor[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCredentialRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCredentialRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCredentialRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CredentialType) > 0 {
+		i -= len(m.CredentialType)
+		copy(dAtA[i:], m.CredentialType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.CredentialType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Active {
+		i--
+		if m.Active {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size, err := m.Credential.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetDidDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDidDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.DidDocument.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllDidDocumentRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllDidDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DidDocument) > 0 {
+		for _, e := range m.DidDocument {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDidByAddressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDidByAddressResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IsRegistered {
+		n += 2
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.FaceCommitment)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateDidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryValidateDidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Valid {
+		n += 2
+	}
+	l = len(m.NormalizedDid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CanonicalDid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRemoteAttestationRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetRemoteAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RemoteAttestation.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetDidByExternalAccountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetDidByExternalAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Link.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryExternalAccountChallengeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryExternalAccountChallengeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Challenge)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPendingControllerTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetPendingControllerTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingControllerTransfer.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllPendingControllerTransferRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllPendingControllerTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingControllerTransfer) > 0 {
+		for _, e := range m.PendingControllerTransfer {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveHandleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Handle)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryResolveHandleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Handle.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetHandleByDidRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetHandleByDidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Handle.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetGuardianshipRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetGuardianshipResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Guardianship.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListDependentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.GuardianDid)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListDependentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Guardianships) > 0 {
+		for _, e := range m.Guardianships {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryAttestorStrikesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Attestor)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAttestorStrikesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Strikes != 0 {
+		n += 1 + sovQuery(uint64(m.Strikes))
+	}
+	return n
+}
+
+func (m *QueryGetAttestorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetAttestorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Attestor.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllAttestorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllAttestorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Attestor) > 0 {
+		for _, e := range m.Attestor {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCredentialRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.CredentialType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Credential.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.Active {
+		n += 2
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDidDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDidDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDidDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDidDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDidDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDidDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDidDocumentRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDidDocumentRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDidDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllDidDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllDidDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllDidDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DidDocument = append(m.DidDocument, DidDocument{})
+			if err := m.DidDocument[len(m.DidDocument)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDidByAddressRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDidByAddressRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDidByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDidByAddressResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDidByAddressResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDidByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsRegistered", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsRegistered = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FaceCommitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FaceCommitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidateDidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateDidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateDidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryValidateDidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryValidateDidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryValidateDidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Valid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Valid = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NormalizedDid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NormalizedDid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanonicalDid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CanonicalDid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRemoteAttestationRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRemoteAttestationRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRemoteAttestationRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetRemoteAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetRemoteAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetRemoteAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoteAttestation", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemoteAttestation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDidByExternalAccountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDidByExternalAccountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDidByExternalAccountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetDidByExternalAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetDidByExternalAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetDidByExternalAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Link", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Link.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExternalAccountChallengeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExternalAccountChallengeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExternalAccountChallengeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryExternalAccountChallengeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryExternalAccountChallengeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryExternalAccountChallengeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Challenge", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Challenge = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingControllerTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingControllerTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingControllerTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetPendingControllerTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetPendingControllerTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetPendingControllerTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingControllerTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingControllerTransfer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingControllerTransferRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingControllerTransferRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingControllerTransferRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllPendingControllerTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllPendingControllerTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllPendingControllerTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingControllerTransfer", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingControllerTransfer = append(m.PendingControllerTransfer, PendingControllerTransfer{})
+			if err := m.PendingControllerTransfer[len(m.PendingControllerTransfer)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveHandleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveHandleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveHandleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryResolveHandleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryResolveHandleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryResolveHandleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Handle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetHandleByDidRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetHandleByDidRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetHandleByDidRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetHandleByDidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetHandleByDidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetHandleByDidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handle", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Handle.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGuardianshipRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGuardianshipRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGuardianshipRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetGuardianshipResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetGuardianshipResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetGuardianshipResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardianship", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Guardianship.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListDependentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListDependentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListDependentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianDid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianDid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryGetDidByAddressResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.IsRegistered {
-		n += 2
-	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.FaceHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryListDependentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1091,12 +6907,46 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryListDependentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryListDependentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardianships", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardianships = append(m.Guardianships, Guardianship{})
+			if err := m.Guardianships[len(m.Guardianships)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1118,7 +6968,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAttestorStrikesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1141,17 +6991,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAttestorStrikesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAttestorStrikesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1161,25 +7011,93 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Attestor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAttestorStrikesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAttestorStrikesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAttestorStrikesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Strikes", wireType)
+			}
+			m.Strikes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Strikes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1201,7 +7119,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDidDocumentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAttestorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1224,15 +7142,15 @@ func (m *QueryGetDidDocumentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDidDocumentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAttestorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDidDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAttestorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1260,7 +7178,7 @@ func (m *QueryGetDidDocumentRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1283,7 +7201,7 @@ func (m *QueryGetDidDocumentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDidDocumentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetAttestorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1306,15 +7224,15 @@ func (m *QueryGetDidDocumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDidDocumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetAttestorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDidDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetAttestorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1341,7 +7259,7 @@ func (m *QueryGetDidDocumentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.DidDocument.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Attestor.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1366,7 +7284,7 @@ func (m *QueryGetDidDocumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDidDocumentRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAttestorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1389,10 +7307,10 @@ func (m *QueryAllDidDocumentRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDidDocumentRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAttestorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDidDocumentRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAttestorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1452,7 +7370,7 @@ func (m *QueryAllDidDocumentRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllDidDocumentResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAllAttestorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1475,15 +7393,15 @@ func (m *QueryAllDidDocumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllDidDocumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAllAttestorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllDidDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAllAttestorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DidDocument", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1510,8 +7428,8 @@ func (m *QueryAllDidDocumentResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DidDocument = append(m.DidDocument, DidDocument{})
-			if err := m.DidDocument[len(m.DidDocument)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Attestor = append(m.Attestor, Attestor{})
+			if err := m.Attestor[len(m.Attestor)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1572,7 +7490,7 @@ func (m *QueryAllDidDocumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDidByAddressRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCredentialRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1595,15 +7513,15 @@ func (m *QueryGetDidByAddressRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDidByAddressRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCredentialRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDidByAddressRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCredentialRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1631,7 +7549,39 @@ func (m *QueryGetDidByAddressRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1654,7 +7604,7 @@ func (m *QueryGetDidByAddressRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetDidByAddressResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryGetCredentialResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1677,37 +7627,17 @@ func (m *QueryGetDidByAddressResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetDidByAddressResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryGetCredentialResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetDidByAddressResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryGetCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsRegistered", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.IsRegistered = bool(v != 0)
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Credential", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1717,29 +7647,30 @@ func (m *QueryGetDidByAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
+			if err := m.Credential.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FaceHash", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Active", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1749,24 +7680,12 @@ func (m *QueryGetDidByAddressResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FaceHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			m.Active = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -5,8 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -126,12 +128,18 @@ var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
 // MsgCreateDidDocument defines the MsgCreateDidDocument message.
 type MsgCreateDidDocument struct {
-	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// did 为空时由链根据 controller 与 face_nullifier 派生（did:dtc:<sha256(controller+face_nullifier) 前 16 字节>）
 	Did        string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
 	Controller string `protobuf:"bytes,3,opt,name=controller,proto3" json:"controller,omitempty"`
-	FaceHash   string `protobuf:"bytes,4,opt,name=faceHash,proto3" json:"faceHash,omitempty"`
 	Pubkeys    string `protobuf:"bytes,5,opt,name=pubkeys,proto3" json:"pubkeys,omitempty"`
 	Signature  []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	// face_nullifier 由认证方以私有盐值派生，链上仅据此做人脸唯一性校验
+	FaceNullifier string `protobuf:"bytes,7,opt,name=face_nullifier,json=faceNullifier,proto3" json:"face_nullifier,omitempty"`
+	// face_commitment 是对人脸特征的承诺值
+	FaceCommitment string `protobuf:"bytes,8,opt,name=face_commitment,json=faceCommitment,proto3" json:"face_commitment,omitempty"`
+	// attestor_pubkey 是签名认证方登记的公钥（hex），必须是已质押的认证方
+	AttestorPubkey string `protobuf:"bytes,9,opt,name=attestor_pubkey,json=attestorPubkey,proto3" json:"attestor_pubkey,omitempty"`
 }
 
 func (m *MsgCreateDidDocument) Reset()         { *m = MsgCreateDidDocument{} }
@@ -188,13 +196,6 @@ func (m *MsgCreateDidDocument) GetController() string {
 	return ""
 }
 
-func (m *MsgCreateDidDocument) GetFaceHash() string {
-	if m != nil {
-		return m.FaceHash
-	}
-	return ""
-}
-
 func (m *MsgCreateDidDocument) GetPubkeys() string {
 	if m != nil {
 		return m.Pubkeys
@@ -209,8 +210,31 @@ func (m *MsgCreateDidDocument) GetSignature() []byte {
 	return nil
 }
 
+func (m *MsgCreateDidDocument) GetFaceNullifier() string {
+	if m != nil {
+		return m.FaceNullifier
+	}
+	return ""
+}
+
+func (m *MsgCreateDidDocument) GetFaceCommitment() string {
+	if m != nil {
+		return m.FaceCommitment
+	}
+	return ""
+}
+
+func (m *MsgCreateDidDocument) GetAttestorPubkey() string {
+	if m != nil {
+		return m.AttestorPubkey
+	}
+	return ""
+}
+
 // MsgCreateDidDocumentResponse defines the MsgCreateDidDocumentResponse message.
 type MsgCreateDidDocumentResponse struct {
+	// did 是链上登记的规范 DID
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
 }
 
 func (m *MsgCreateDidDocumentResponse) Reset()         { *m = MsgCreateDidDocumentResponse{} }
@@ -246,7 +270,15 @@ func (m *MsgCreateDidDocumentResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCreateDidDocumentResponse proto.InternalMessageInfo
 
+func (m *MsgCreateDidDocumentResponse) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
 // MsgUpdateDidDocument defines the MsgUpdateDidDocument message.
+// controller 只能为空或等于当前 controller，变更 controller 需使用 MsgProposeControllerTransfer。
 type MsgUpdateDidDocument struct {
 	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	Did        string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
@@ -442,689 +474,10149 @@ func (m *MsgDeleteDidDocumentResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgDeleteDidDocumentResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "dtc.identity.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dtc.identity.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateDidDocument)(nil), "dtc.identity.v1.MsgCreateDidDocument")
-	proto.RegisterType((*MsgCreateDidDocumentResponse)(nil), "dtc.identity.v1.MsgCreateDidDocumentResponse")
-	proto.RegisterType((*MsgUpdateDidDocument)(nil), "dtc.identity.v1.MsgUpdateDidDocument")
-	proto.RegisterType((*MsgUpdateDidDocumentResponse)(nil), "dtc.identity.v1.MsgUpdateDidDocumentResponse")
-	proto.RegisterType((*MsgDeleteDidDocument)(nil), "dtc.identity.v1.MsgDeleteDidDocument")
-	proto.RegisterType((*MsgDeleteDidDocumentResponse)(nil), "dtc.identity.v1.MsgDeleteDidDocumentResponse")
+// DidDocumentEntry 是批量注册中的单个条目，字段含义与 MsgCreateDidDocument 相同
+type DidDocumentEntry struct {
+	Did            string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+	Controller     string `protobuf:"bytes,2,opt,name=controller,proto3" json:"controller,omitempty"`
+	Pubkeys        string `protobuf:"bytes,3,opt,name=pubkeys,proto3" json:"pubkeys,omitempty"`
+	FaceNullifier  string `protobuf:"bytes,4,opt,name=face_nullifier,json=faceNullifier,proto3" json:"face_nullifier,omitempty"`
+	FaceCommitment string `protobuf:"bytes,5,opt,name=face_commitment,json=faceCommitment,proto3" json:"face_commitment,omitempty"`
 }
 
-func init() { proto.RegisterFile("dtc/identity/v1/tx.proto", fileDescriptor_3a8612da342778f3) }
+func (m *DidDocumentEntry) Reset()         { *m = DidDocumentEntry{} }
+func (m *DidDocumentEntry) String() string { return proto.CompactTextString(m) }
+func (*DidDocumentEntry) ProtoMessage()    {}
+func (*DidDocumentEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{8}
+}
+func (m *DidDocumentEntry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DidDocumentEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DidDocumentEntry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DidDocumentEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DidDocumentEntry.Merge(m, src)
+}
+func (m *DidDocumentEntry) XXX_Size() int {
+	return m.Size()
+}
+func (m *DidDocumentEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DidDocumentEntry.DiscardUnknown(m)
+}
 
-var fileDescriptor_3a8612da342778f3 = []byte{
-	// 544 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x54, 0x31, 0x6f, 0xda, 0x40,
-	0x14, 0xe6, 0x4a, 0x42, 0xca, 0x15, 0x29, 0xcd, 0x09, 0x09, 0xc7, 0x42, 0x2e, 0x42, 0x8a, 0x84,
-	0x90, 0x62, 0x0b, 0x2a, 0x75, 0x60, 0x2b, 0x65, 0xe8, 0x82, 0x54, 0xb9, 0xea, 0x92, 0xa5, 0x72,
-	0xec, 0xab, 0x73, 0x2d, 0xf6, 0x59, 0x77, 0x47, 0x14, 0xb6, 0xaa, 0x63, 0xa7, 0xfe, 0x88, 0x0e,
-	0xed, 0xc6, 0xd0, 0xa1, 0x3f, 0x21, 0x63, 0xd4, 0xa9, 0x53, 0x54, 0xc1, 0xc0, 0xdf, 0xa8, 0x7c,
-	0x87, 0x81, 0xd8, 0x46, 0x74, 0x69, 0x16, 0x74, 0xef, 0x7d, 0xdf, 0xe3, 0x7b, 0xdf, 0xbb, 0xe7,
-	0x83, 0x9a, 0x27, 0x5c, 0x8b, 0x78, 0x38, 0x14, 0x44, 0x4c, 0xac, 0xcb, 0x8e, 0x25, 0xae, 0xcc,
-	0x88, 0x51, 0x41, 0xd1, 0xa1, 0x27, 0x5c, 0x33, 0x41, 0xcc, 0xcb, 0x8e, 0x7e, 0xe4, 0x04, 0x24,
-	0xa4, 0x96, 0xfc, 0x55, 0x1c, 0xbd, 0xe6, 0x52, 0x1e, 0x50, 0x6e, 0x05, 0xdc, 0x8f, 0x6b, 0x03,
-	0xee, 0x2f, 0x81, 0x63, 0x05, 0xbc, 0x95, 0x91, 0xa5, 0x82, 0x25, 0x54, 0x4f, 0x2b, 0x46, 0x0e,
-	0x73, 0x82, 0x04, 0xad, 0xfa, 0xd4, 0xa7, 0xaa, 0x2a, 0x3e, 0xa9, 0x6c, 0xf3, 0x27, 0x80, 0x87,
-	0x43, 0xee, 0xbf, 0x89, 0x3c, 0x47, 0xe0, 0x57, 0x92, 0x8f, 0x9e, 0xc1, 0xb2, 0x33, 0x16, 0x17,
-	0x94, 0x11, 0x31, 0xd1, 0x40, 0x03, 0xb4, 0xca, 0x7d, 0xed, 0xd7, 0x8f, 0xd3, 0xea, 0x52, 0xec,
-	0xb9, 0xe7, 0x31, 0xcc, 0xf9, 0x6b, 0xc1, 0x48, 0xe8, 0xdb, 0x6b, 0x2a, 0xea, 0xc1, 0x92, 0x52,
-	0xd4, 0x1e, 0x34, 0x40, 0xeb, 0x51, 0xb7, 0x66, 0xa6, 0x8c, 0x9a, 0x4a, 0xa0, 0x5f, 0xbe, 0xbe,
-	0x7d, 0x52, 0xf8, 0xb6, 0x98, 0xb6, 0x81, 0xbd, 0xac, 0xe8, 0x75, 0x3e, 0x2d, 0xa6, 0xed, 0xf5,
-	0x7f, 0x7d, 0x5e, 0x4c, 0xdb, 0x46, 0x6c, 0xe7, 0x6a, 0x6d, 0x28, 0xd5, 0x66, 0xf3, 0x18, 0xd6,
-	0x52, 0x29, 0x1b, 0xf3, 0x88, 0x86, 0x1c, 0x37, 0x6f, 0x01, 0xac, 0x0e, 0xb9, 0xff, 0x82, 0x61,
-	0x47, 0xe0, 0x01, 0xf1, 0x06, 0xd4, 0x1d, 0x07, 0x38, 0x14, 0xa8, 0x0b, 0x0f, 0xdc, 0x38, 0x49,
-	0xd9, 0x4e, 0x63, 0x09, 0x11, 0x3d, 0x86, 0x45, 0x8f, 0x78, 0xd2, 0x53, 0xd9, 0x8e, 0x8f, 0xc8,
-	0x80, 0xd0, 0xa5, 0xa1, 0x60, 0x74, 0x34, 0xc2, 0x4c, 0x2b, 0x4a, 0x60, 0x23, 0x83, 0x74, 0xf8,
-	0xf0, 0x9d, 0xe3, 0xe2, 0x97, 0x0e, 0xbf, 0xd0, 0xf6, 0x24, 0xba, 0x8a, 0x91, 0x06, 0x0f, 0xa2,
-	0xf1, 0xf9, 0x07, 0x3c, 0xe1, 0xda, 0xbe, 0x84, 0x92, 0x10, 0xd5, 0x61, 0x99, 0x13, 0x3f, 0x74,
-	0xc4, 0x98, 0x61, 0xad, 0xd4, 0x00, 0xad, 0x8a, 0xbd, 0x4e, 0xf4, 0x2a, 0xf1, 0x80, 0x92, 0x9e,
-	0x9a, 0x06, 0xac, 0xe7, 0xf9, 0x5b, 0x0d, 0xe0, 0xab, 0x1a, 0x80, 0x1a, 0xce, 0xfd, 0x0f, 0x60,
-	0xc3, 0xe4, 0xde, 0x1d, 0x93, 0xb9, 0x36, 0x32, 0x5d, 0xae, 0x6c, 0xbc, 0x97, 0x2e, 0x06, 0x78,
-	0x84, 0xff, 0x83, 0x8b, 0xdc, 0x5e, 0x32, 0x5a, 0x49, 0x2f, 0xdd, 0xef, 0x45, 0x58, 0x1c, 0x72,
-	0x1f, 0x9d, 0xc1, 0xca, 0x9d, 0xaf, 0xa5, 0x91, 0xd9, 0xf2, 0xd4, 0x56, 0xea, 0xad, 0x5d, 0x8c,
-	0x44, 0x03, 0x11, 0x78, 0x94, 0xdd, 0xd9, 0x93, 0xbc, 0xf2, 0x0c, 0x4d, 0x3f, 0xfd, 0x27, 0xda,
-	0xa6, 0x54, 0x76, 0x3b, 0x4e, 0xb6, 0x77, 0xba, 0x53, 0x6a, 0xeb, 0x2d, 0xc6, 0x52, 0xd9, 0x2b,
-	0xcc, 0x95, 0xca, 0xd0, 0xf2, 0xa5, 0xb6, 0x5e, 0x92, 0xbe, 0xff, 0x31, 0x7e, 0x55, 0xfa, 0xe6,
-	0xf5, 0xcc, 0x00, 0x37, 0x33, 0x03, 0xfc, 0x99, 0x19, 0xe0, 0xcb, 0xdc, 0x28, 0xdc, 0xcc, 0x8d,
-	0xc2, 0xef, 0xb9, 0x51, 0x38, 0xab, 0xa6, 0x1e, 0x15, 0x31, 0x89, 0x30, 0x3f, 0x2f, 0xc9, 0xc7,
-	0xf0, 0xe9, 0xdf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x4b, 0x09, 0x7d, 0xf2, 0xb4, 0x05, 0x00, 0x00,
+var xxx_messageInfo_DidDocumentEntry proto.InternalMessageInfo
+
+func (m *DidDocumentEntry) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *DidDocumentEntry) GetController() string {
+	if m != nil {
+		return m.Controller
+	}
+	return ""
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *DidDocumentEntry) GetPubkeys() string {
+	if m != nil {
+		return m.Pubkeys
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// CreateDidDocument defines the CreateDidDocument RPC.
-	CreateDidDocument(ctx context.Context, in *MsgCreateDidDocument, opts ...grpc.CallOption) (*MsgCreateDidDocumentResponse, error)
-	// UpdateDidDocument defines the UpdateDidDocument RPC.
-	UpdateDidDocument(ctx context.Context, in *MsgUpdateDidDocument, opts ...grpc.CallOption) (*MsgUpdateDidDocumentResponse, error)
-	// DeleteDidDocument defines the DeleteDidDocument RPC.
-	DeleteDidDocument(ctx context.Context, in *MsgDeleteDidDocument, opts ...grpc.CallOption) (*MsgDeleteDidDocumentResponse, error)
+func (m *DidDocumentEntry) GetFaceNullifier() string {
+	if m != nil {
+		return m.FaceNullifier
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *DidDocumentEntry) GetFaceCommitment() string {
+	if m != nil {
+		return m.FaceCommitment
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// MsgBatchCreateDidDocuments defines the MsgBatchCreateDidDocuments message.
+// signature 是认证方对全部条目 Merkle 根的单个签名。
+type MsgBatchCreateDidDocuments struct {
+	Creator   string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Entries   []DidDocumentEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+	Signature []byte             `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// attestor_pubkey 是签名认证方登记的公钥（hex），必须是已质押的认证方
+	AttestorPubkey string `protobuf:"bytes,4,opt,name=attestor_pubkey,json=attestorPubkey,proto3" json:"attestor_pubkey,omitempty"`
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgBatchCreateDidDocuments) Reset()         { *m = MsgBatchCreateDidDocuments{} }
+func (m *MsgBatchCreateDidDocuments) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCreateDidDocuments) ProtoMessage()    {}
+func (*MsgBatchCreateDidDocuments) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{9}
+}
+func (m *MsgBatchCreateDidDocuments) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBatchCreateDidDocuments) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchCreateDidDocuments.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgBatchCreateDidDocuments) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCreateDidDocuments.Merge(m, src)
+}
+func (m *MsgBatchCreateDidDocuments) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchCreateDidDocuments) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCreateDidDocuments.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateDidDocument(ctx context.Context, in *MsgCreateDidDocument, opts ...grpc.CallOption) (*MsgCreateDidDocumentResponse, error) {
-	out := new(MsgCreateDidDocumentResponse)
-	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/CreateDidDocument", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBatchCreateDidDocuments proto.InternalMessageInfo
+
+func (m *MsgBatchCreateDidDocuments) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) UpdateDidDocument(ctx context.Context, in *MsgUpdateDidDocument, opts ...grpc.CallOption) (*MsgUpdateDidDocumentResponse, error) {
-	out := new(MsgUpdateDidDocumentResponse)
-	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/UpdateDidDocument", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgBatchCreateDidDocuments) GetEntries() []DidDocumentEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) DeleteDidDocument(ctx context.Context, in *MsgDeleteDidDocument, opts ...grpc.CallOption) (*MsgDeleteDidDocumentResponse, error) {
-	out := new(MsgDeleteDidDocumentResponse)
-	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/DeleteDidDocument", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgBatchCreateDidDocuments) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// CreateDidDocument defines the CreateDidDocument RPC.
-	CreateDidDocument(context.Context, *MsgCreateDidDocument) (*MsgCreateDidDocumentResponse, error)
-	// UpdateDidDocument defines the UpdateDidDocument RPC.
-	UpdateDidDocument(context.Context, *MsgUpdateDidDocument) (*MsgUpdateDidDocumentResponse, error)
-	// DeleteDidDocument defines the DeleteDidDocument RPC.
-	DeleteDidDocument(context.Context, *MsgDeleteDidDocument) (*MsgDeleteDidDocumentResponse, error)
+func (m *MsgBatchCreateDidDocuments) GetAttestorPubkey() string {
+	if m != nil {
+		return m.AttestorPubkey
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// BatchCreateDidResult 记录批量注册中单个条目的处理结果
+type BatchCreateDidResult struct {
+	Index   uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Did     string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	Success bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	Error   string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *BatchCreateDidResult) Reset()         { *m = BatchCreateDidResult{} }
+func (m *BatchCreateDidResult) String() string { return proto.CompactTextString(m) }
+func (*BatchCreateDidResult) ProtoMessage()    {}
+func (*BatchCreateDidResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{10}
 }
-func (*UnimplementedMsgServer) CreateDidDocument(ctx context.Context, req *MsgCreateDidDocument) (*MsgCreateDidDocumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateDidDocument not implemented")
+func (m *BatchCreateDidResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) UpdateDidDocument(ctx context.Context, req *MsgUpdateDidDocument) (*MsgUpdateDidDocumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDidDocument not implemented")
+func (m *BatchCreateDidResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BatchCreateDidResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) DeleteDidDocument(ctx context.Context, req *MsgDeleteDidDocument) (*MsgDeleteDidDocumentResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteDidDocument not implemented")
+func (m *BatchCreateDidResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchCreateDidResult.Merge(m, src)
 }
-
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+func (m *BatchCreateDidResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *BatchCreateDidResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchCreateDidResult.DiscardUnknown(m)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dtc.identity.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+var xxx_messageInfo_BatchCreateDidResult proto.InternalMessageInfo
+
+func (m *BatchCreateDidResult) GetIndex() uint32 {
+	if m != nil {
+		return m.Index
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-func _Msg_CreateDidDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateDidDocument)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateDidDocument(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dtc.identity.v1.Msg/CreateDidDocument",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateDidDocument(ctx, req.(*MsgCreateDidDocument))
+func (m *BatchCreateDidResult) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateDidDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateDidDocument)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateDidDocument(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dtc.identity.v1.Msg/UpdateDidDocument",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateDidDocument(ctx, req.(*MsgUpdateDidDocument))
+func (m *BatchCreateDidResult) GetSuccess() bool {
+	if m != nil {
+		return m.Success
 	}
-	return interceptor(ctx, in, info, handler)
+	return false
 }
 
-func _Msg_DeleteDidDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteDidDocument)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteDidDocument(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dtc.identity.v1.Msg/DeleteDidDocument",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteDidDocument(ctx, req.(*MsgDeleteDidDocument))
+func (m *BatchCreateDidResult) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dtc.identity.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateDidDocument",
-			Handler:    _Msg_CreateDidDocument_Handler,
-		},
-		{
-			MethodName: "UpdateDidDocument",
-			Handler:    _Msg_UpdateDidDocument_Handler,
-		},
-		{
-			MethodName: "DeleteDidDocument",
-			Handler:    _Msg_DeleteDidDocument_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dtc/identity/v1/tx.proto",
+// MsgBatchCreateDidDocumentsResponse defines the MsgBatchCreateDidDocumentsResponse message.
+type MsgBatchCreateDidDocumentsResponse struct {
+	// merkle_root 是链上计算出的条目 Merkle 根（hex 编码）
+	MerkleRoot string                 `protobuf:"bytes,1,opt,name=merkle_root,json=merkleRoot,proto3" json:"merkle_root,omitempty"`
+	Results    []BatchCreateDidResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results"`
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgBatchCreateDidDocumentsResponse) Reset()         { *m = MsgBatchCreateDidDocumentsResponse{} }
+func (m *MsgBatchCreateDidDocumentsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchCreateDidDocumentsResponse) ProtoMessage()    {}
+func (*MsgBatchCreateDidDocumentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{11}
 }
-
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBatchCreateDidDocumentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgBatchCreateDidDocumentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBatchCreateDidDocumentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgBatchCreateDidDocumentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchCreateDidDocumentsResponse.Merge(m, src)
+}
+func (m *MsgBatchCreateDidDocumentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBatchCreateDidDocumentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchCreateDidDocumentsResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgBatchCreateDidDocumentsResponse proto.InternalMessageInfo
+
+func (m *MsgBatchCreateDidDocumentsResponse) GetMerkleRoot() string {
+	if m != nil {
+		return m.MerkleRoot
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgBatchCreateDidDocumentsResponse) GetResults() []BatchCreateDidResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSendIdentityAttestation 将 creator 的身份证明通过 IBC 推送至对手链
+type MsgSendIdentityAttestation struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// timeout_timestamp 是以纳秒为单位的绝对超时时间
+	TimeoutTimestamp uint64 `protobuf:"varint,3,opt,name=timeout_timestamp,json=timeoutTimestamp,proto3" json:"timeout_timestamp,omitempty"`
 }
 
-func (m *MsgCreateDidDocument) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSendIdentityAttestation) Reset()         { *m = MsgSendIdentityAttestation{} }
+func (m *MsgSendIdentityAttestation) String() string { return proto.CompactTextString(m) }
+func (*MsgSendIdentityAttestation) ProtoMessage()    {}
+func (*MsgSendIdentityAttestation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{12}
+}
+func (m *MsgSendIdentityAttestation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSendIdentityAttestation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendIdentityAttestation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgCreateDidDocument) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSendIdentityAttestation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendIdentityAttestation.Merge(m, src)
+}
+func (m *MsgSendIdentityAttestation) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendIdentityAttestation) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendIdentityAttestation.DiscardUnknown(m)
 }
 
-func (m *MsgCreateDidDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Pubkeys) > 0 {
-		i -= len(m.Pubkeys)
-		copy(dAtA[i:], m.Pubkeys)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Pubkeys)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.FaceHash) > 0 {
-		i -= len(m.FaceHash)
-		copy(dAtA[i:], m.FaceHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FaceHash)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Controller) > 0 {
-		i -= len(m.Controller)
-		copy(dAtA[i:], m.Controller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_MsgSendIdentityAttestation proto.InternalMessageInfo
+
+func (m *MsgSendIdentityAttestation) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgCreateDidDocumentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgSendIdentityAttestation) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateDidDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSendIdentityAttestation) GetTimeoutTimestamp() uint64 {
+	if m != nil {
+		return m.TimeoutTimestamp
+	}
+	return 0
 }
 
-func (m *MsgCreateDidDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgSendIdentityAttestationResponse defines the MsgSendIdentityAttestationResponse message.
+type MsgSendIdentityAttestationResponse struct {
+	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (m *MsgUpdateDidDocument) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSendIdentityAttestationResponse) Reset()         { *m = MsgSendIdentityAttestationResponse{} }
+func (m *MsgSendIdentityAttestationResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSendIdentityAttestationResponse) ProtoMessage()    {}
+func (*MsgSendIdentityAttestationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{13}
 }
-
-func (m *MsgUpdateDidDocument) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSendIdentityAttestationResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateDidDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Pubkeys) > 0 {
-		i -= len(m.Pubkeys)
-		copy(dAtA[i:], m.Pubkeys)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Pubkeys)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Controller) > 0 {
-		i -= len(m.Controller)
-		copy(dAtA[i:], m.Controller)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+func (m *MsgSendIdentityAttestationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSendIdentityAttestationResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSendIdentityAttestationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSendIdentityAttestationResponse.Merge(m, src)
+}
+func (m *MsgSendIdentityAttestationResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSendIdentityAttestationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSendIdentityAttestationResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateDidDocumentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSendIdentityAttestationResponse proto.InternalMessageInfo
+
+func (m *MsgSendIdentityAttestationResponse) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateDidDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgLinkExternalAccount 将以太坊地址绑定到 creator 控制的 DID。
+// signature 是以太坊私钥对链上挑战（见 Query/ExternalAccountChallenge）的 EIP-191 personal_sign 签名，65 字节 R || S || V。
+type MsgLinkExternalAccount struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Did        string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	EthAddress string `protobuf:"bytes,3,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
+	Signature  []byte `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
 }
 
-func (m *MsgUpdateDidDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgLinkExternalAccount) Reset()         { *m = MsgLinkExternalAccount{} }
+func (m *MsgLinkExternalAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgLinkExternalAccount) ProtoMessage()    {}
+func (*MsgLinkExternalAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{14}
 }
-
-func (m *MsgDeleteDidDocument) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgLinkExternalAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLinkExternalAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLinkExternalAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *MsgDeleteDidDocument) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgLinkExternalAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLinkExternalAccount.Merge(m, src)
+}
+func (m *MsgLinkExternalAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLinkExternalAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLinkExternalAccount.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteDidDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Did) > 0 {
-		i -= len(m.Did)
-		copy(dAtA[i:], m.Did)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
-		i--
-		dAtA[i] = 0x12
+var xxx_messageInfo_MsgLinkExternalAccount proto.InternalMessageInfo
+
+func (m *MsgLinkExternalAccount) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgLinkExternalAccount) GetDid() string {
+	if m != nil {
+		return m.Did
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDeleteDidDocumentResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgLinkExternalAccount) GetEthAddress() string {
+	if m != nil {
+		return m.EthAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeleteDidDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgLinkExternalAccount) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
 }
 
-func (m *MsgDeleteDidDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgLinkExternalAccountResponse defines the MsgLinkExternalAccountResponse message.
+type MsgLinkExternalAccountResponse struct {
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgLinkExternalAccountResponse) Reset()         { *m = MsgLinkExternalAccountResponse{} }
+func (m *MsgLinkExternalAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgLinkExternalAccountResponse) ProtoMessage()    {}
+func (*MsgLinkExternalAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{15}
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgLinkExternalAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgLinkExternalAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgLinkExternalAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgLinkExternalAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgLinkExternalAccountResponse.Merge(m, src)
+}
+func (m *MsgLinkExternalAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgLinkExternalAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgLinkExternalAccountResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgLinkExternalAccountResponse proto.InternalMessageInfo
+
+// MsgUnlinkExternalAccount 解除以太坊地址与 DID 的绑定，只能由 DID 的 controller 发起
+type MsgUnlinkExternalAccount struct {
+	Creator    string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	EthAddress string `protobuf:"bytes,2,opt,name=eth_address,json=ethAddress,proto3" json:"eth_address,omitempty"`
 }
 
-func (m *MsgCreateDidDocument) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Controller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.FaceHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Pubkeys)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUnlinkExternalAccount) Reset()         { *m = MsgUnlinkExternalAccount{} }
+func (m *MsgUnlinkExternalAccount) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlinkExternalAccount) ProtoMessage()    {}
+func (*MsgUnlinkExternalAccount) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{16}
+}
+func (m *MsgUnlinkExternalAccount) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlinkExternalAccount) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlinkExternalAccount.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgUnlinkExternalAccount) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlinkExternalAccount.Merge(m, src)
+}
+func (m *MsgUnlinkExternalAccount) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlinkExternalAccount) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlinkExternalAccount.DiscardUnknown(m)
 }
 
-func (m *MsgCreateDidDocumentResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgUnlinkExternalAccount proto.InternalMessageInfo
+
+func (m *MsgUnlinkExternalAccount) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	var l int
-	_ = l
-	return n
+	return ""
 }
 
-func (m *MsgUpdateDidDocument) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Controller)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Pubkeys)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUnlinkExternalAccount) GetEthAddress() string {
+	if m != nil {
+		return m.EthAddress
 	}
-	return n
+	return ""
 }
 
-func (m *MsgUpdateDidDocumentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+// MsgUnlinkExternalAccountResponse defines the MsgUnlinkExternalAccountResponse message.
+type MsgUnlinkExternalAccountResponse struct {
 }
 
-func (m *MsgDeleteDidDocument) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Did)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgUnlinkExternalAccountResponse) Reset()         { *m = MsgUnlinkExternalAccountResponse{} }
+func (m *MsgUnlinkExternalAccountResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnlinkExternalAccountResponse) ProtoMessage()    {}
+func (*MsgUnlinkExternalAccountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{17}
+}
+func (m *MsgUnlinkExternalAccountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnlinkExternalAccountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnlinkExternalAccountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return n
+}
+func (m *MsgUnlinkExternalAccountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnlinkExternalAccountResponse.Merge(m, src)
+}
+func (m *MsgUnlinkExternalAccountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnlinkExternalAccountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnlinkExternalAccountResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteDidDocumentResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+var xxx_messageInfo_MsgUnlinkExternalAccountResponse proto.InternalMessageInfo
+
+// MsgProposeControllerTransfer 由当前 controller 提议将 DID 转移给新 controller
+type MsgProposeControllerTransfer struct {
+	Creator       string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Did           string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	NewController string `protobuf:"bytes,3,opt,name=new_controller,json=newController,proto3" json:"new_controller,omitempty"`
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgProposeControllerTransfer) Reset()         { *m = MsgProposeControllerTransfer{} }
+func (m *MsgProposeControllerTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeControllerTransfer) ProtoMessage()    {}
+func (*MsgProposeControllerTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{18}
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *MsgProposeControllerTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+func (m *MsgProposeControllerTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeControllerTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeControllerTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeControllerTransfer.Merge(m, src)
+}
+func (m *MsgProposeControllerTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeControllerTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeControllerTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeControllerTransfer proto.InternalMessageInfo
+
+func (m *MsgProposeControllerTransfer) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgProposeControllerTransfer) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *MsgProposeControllerTransfer) GetNewController() string {
+	if m != nil {
+		return m.NewController
+	}
+	return ""
+}
+
+// MsgProposeControllerTransferResponse defines the MsgProposeControllerTransferResponse message.
+type MsgProposeControllerTransferResponse struct {
+	ExpiryHeight int64 `protobuf:"varint,1,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *MsgProposeControllerTransferResponse) Reset()         { *m = MsgProposeControllerTransferResponse{} }
+func (m *MsgProposeControllerTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgProposeControllerTransferResponse) ProtoMessage()    {}
+func (*MsgProposeControllerTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{19}
+}
+func (m *MsgProposeControllerTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgProposeControllerTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgProposeControllerTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgProposeControllerTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgProposeControllerTransferResponse.Merge(m, src)
+}
+func (m *MsgProposeControllerTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgProposeControllerTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgProposeControllerTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgProposeControllerTransferResponse proto.InternalMessageInfo
+
+func (m *MsgProposeControllerTransferResponse) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+// MsgAcceptControllerTransfer 由被提议的新 controller 在到期前接受转移
+type MsgAcceptControllerTransfer struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Did     string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+}
+
+func (m *MsgAcceptControllerTransfer) Reset()         { *m = MsgAcceptControllerTransfer{} }
+func (m *MsgAcceptControllerTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptControllerTransfer) ProtoMessage()    {}
+func (*MsgAcceptControllerTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{20}
+}
+func (m *MsgAcceptControllerTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptControllerTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptControllerTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptControllerTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptControllerTransfer.Merge(m, src)
+}
+func (m *MsgAcceptControllerTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptControllerTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptControllerTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptControllerTransfer proto.InternalMessageInfo
+
+func (m *MsgAcceptControllerTransfer) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgAcceptControllerTransfer) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+// MsgAcceptControllerTransferResponse defines the MsgAcceptControllerTransferResponse message.
+type MsgAcceptControllerTransferResponse struct {
+}
+
+func (m *MsgAcceptControllerTransferResponse) Reset()         { *m = MsgAcceptControllerTransferResponse{} }
+func (m *MsgAcceptControllerTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgAcceptControllerTransferResponse) ProtoMessage()    {}
+func (*MsgAcceptControllerTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{21}
+}
+func (m *MsgAcceptControllerTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgAcceptControllerTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgAcceptControllerTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgAcceptControllerTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgAcceptControllerTransferResponse.Merge(m, src)
+}
+func (m *MsgAcceptControllerTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgAcceptControllerTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgAcceptControllerTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgAcceptControllerTransferResponse proto.InternalMessageInfo
+
+// MsgCancelControllerTransfer 由当前 controller 取消待处理的转移
+type MsgCancelControllerTransfer struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Did     string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+}
+
+func (m *MsgCancelControllerTransfer) Reset()         { *m = MsgCancelControllerTransfer{} }
+func (m *MsgCancelControllerTransfer) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelControllerTransfer) ProtoMessage()    {}
+func (*MsgCancelControllerTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{22}
+}
+func (m *MsgCancelControllerTransfer) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelControllerTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelControllerTransfer.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelControllerTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelControllerTransfer.Merge(m, src)
+}
+func (m *MsgCancelControllerTransfer) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelControllerTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelControllerTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelControllerTransfer proto.InternalMessageInfo
+
+func (m *MsgCancelControllerTransfer) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCancelControllerTransfer) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+// MsgCancelControllerTransferResponse defines the MsgCancelControllerTransferResponse message.
+type MsgCancelControllerTransferResponse struct {
+}
+
+func (m *MsgCancelControllerTransferResponse) Reset()         { *m = MsgCancelControllerTransferResponse{} }
+func (m *MsgCancelControllerTransferResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelControllerTransferResponse) ProtoMessage()    {}
+func (*MsgCancelControllerTransferResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{23}
+}
+func (m *MsgCancelControllerTransferResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelControllerTransferResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelControllerTransferResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelControllerTransferResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelControllerTransferResponse.Merge(m, src)
+}
+func (m *MsgCancelControllerTransferResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelControllerTransferResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelControllerTransferResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelControllerTransferResponse proto.InternalMessageInfo
+
+// MsgClaimHandle 为 creator 控制的 DID 认领句柄，每个 DID 至多一个句柄
+type MsgClaimHandle struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Did     string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	Handle  string `protobuf:"bytes,3,opt,name=handle,proto3" json:"handle,omitempty"`
+}
+
+func (m *MsgClaimHandle) Reset()         { *m = MsgClaimHandle{} }
+func (m *MsgClaimHandle) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimHandle) ProtoMessage()    {}
+func (*MsgClaimHandle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{24}
+}
+func (m *MsgClaimHandle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimHandle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimHandle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimHandle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimHandle.Merge(m, src)
+}
+func (m *MsgClaimHandle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimHandle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimHandle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimHandle proto.InternalMessageInfo
+
+func (m *MsgClaimHandle) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgClaimHandle) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *MsgClaimHandle) GetHandle() string {
+	if m != nil {
+		return m.Handle
+	}
+	return ""
+}
+
+// MsgClaimHandleResponse defines the MsgClaimHandleResponse message.
+type MsgClaimHandleResponse struct {
+	// handle 是规范化（小写）后的句柄
+	Handle string `protobuf:"bytes,1,opt,name=handle,proto3" json:"handle,omitempty"`
+}
+
+func (m *MsgClaimHandleResponse) Reset()         { *m = MsgClaimHandleResponse{} }
+func (m *MsgClaimHandleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimHandleResponse) ProtoMessage()    {}
+func (*MsgClaimHandleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{25}
+}
+func (m *MsgClaimHandleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimHandleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimHandleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimHandleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimHandleResponse.Merge(m, src)
+}
+func (m *MsgClaimHandleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimHandleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimHandleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimHandleResponse proto.InternalMessageInfo
+
+func (m *MsgClaimHandleResponse) GetHandle() string {
+	if m != nil {
+		return m.Handle
+	}
+	return ""
+}
+
+// MsgReleaseHandle 释放 DID 的句柄，冷却期结束后句柄可被重新认领
+type MsgReleaseHandle struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Did     string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+}
+
+func (m *MsgReleaseHandle) Reset()         { *m = MsgReleaseHandle{} }
+func (m *MsgReleaseHandle) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseHandle) ProtoMessage()    {}
+func (*MsgReleaseHandle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{26}
+}
+func (m *MsgReleaseHandle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseHandle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseHandle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseHandle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseHandle.Merge(m, src)
+}
+func (m *MsgReleaseHandle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseHandle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseHandle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseHandle proto.InternalMessageInfo
+
+func (m *MsgReleaseHandle) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgReleaseHandle) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+// MsgReleaseHandleResponse defines the MsgReleaseHandleResponse message.
+type MsgReleaseHandleResponse struct {
+	ReleaseHeight int64 `protobuf:"varint,1,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
+}
+
+func (m *MsgReleaseHandleResponse) Reset()         { *m = MsgReleaseHandleResponse{} }
+func (m *MsgReleaseHandleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseHandleResponse) ProtoMessage()    {}
+func (*MsgReleaseHandleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{27}
+}
+func (m *MsgReleaseHandleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReleaseHandleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseHandleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReleaseHandleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseHandleResponse.Merge(m, src)
+}
+func (m *MsgReleaseHandleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseHandleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseHandleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReleaseHandleResponse proto.InternalMessageInfo
+
+func (m *MsgReleaseHandleResponse) GetReleaseHeight() int64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+// MsgTransferHandle 将 DID 的句柄立即转移给另一个尚无句柄的 DID
+type MsgTransferHandle struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Did     string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	ToDid   string `protobuf:"bytes,3,opt,name=to_did,json=toDid,proto3" json:"to_did,omitempty"`
+}
+
+func (m *MsgTransferHandle) Reset()         { *m = MsgTransferHandle{} }
+func (m *MsgTransferHandle) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferHandle) ProtoMessage()    {}
+func (*MsgTransferHandle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{28}
+}
+func (m *MsgTransferHandle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferHandle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferHandle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferHandle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferHandle.Merge(m, src)
+}
+func (m *MsgTransferHandle) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferHandle) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferHandle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferHandle proto.InternalMessageInfo
+
+func (m *MsgTransferHandle) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgTransferHandle) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *MsgTransferHandle) GetToDid() string {
+	if m != nil {
+		return m.ToDid
+	}
+	return ""
+}
+
+// MsgTransferHandleResponse defines the MsgTransferHandleResponse message.
+type MsgTransferHandleResponse struct {
+}
+
+func (m *MsgTransferHandleResponse) Reset()         { *m = MsgTransferHandleResponse{} }
+func (m *MsgTransferHandleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgTransferHandleResponse) ProtoMessage()    {}
+func (*MsgTransferHandleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{29}
+}
+func (m *MsgTransferHandleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgTransferHandleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgTransferHandleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgTransferHandleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgTransferHandleResponse.Merge(m, src)
+}
+func (m *MsgTransferHandleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgTransferHandleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgTransferHandleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgTransferHandleResponse proto.InternalMessageInfo
+
+// MsgCreateOrganisationDid 注册组织 DID，creator 必须是 x/group 策略账户（通过组提案执行），并成为该 DID 的 controller
+type MsgCreateOrganisationDid struct {
+	Creator string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Members []string `protobuf:"bytes,2,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (m *MsgCreateOrganisationDid) Reset()         { *m = MsgCreateOrganisationDid{} }
+func (m *MsgCreateOrganisationDid) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateOrganisationDid) ProtoMessage()    {}
+func (*MsgCreateOrganisationDid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{30}
+}
+func (m *MsgCreateOrganisationDid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateOrganisationDid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateOrganisationDid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateOrganisationDid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateOrganisationDid.Merge(m, src)
+}
+func (m *MsgCreateOrganisationDid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateOrganisationDid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateOrganisationDid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateOrganisationDid proto.InternalMessageInfo
+
+func (m *MsgCreateOrganisationDid) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCreateOrganisationDid) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+// MsgCreateOrganisationDidResponse defines the MsgCreateOrganisationDidResponse message.
+type MsgCreateOrganisationDidResponse struct {
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+}
+
+func (m *MsgCreateOrganisationDidResponse) Reset()         { *m = MsgCreateOrganisationDidResponse{} }
+func (m *MsgCreateOrganisationDidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateOrganisationDidResponse) ProtoMessage()    {}
+func (*MsgCreateOrganisationDidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{31}
+}
+func (m *MsgCreateOrganisationDidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateOrganisationDidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateOrganisationDidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateOrganisationDidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateOrganisationDidResponse.Merge(m, src)
+}
+func (m *MsgCreateOrganisationDidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateOrganisationDidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateOrganisationDidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateOrganisationDidResponse proto.InternalMessageInfo
+
+func (m *MsgCreateOrganisationDidResponse) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+// MsgUpdateOrganisationMembers 由组织 DID 的 controller 替换成员 DID 列表
+type MsgUpdateOrganisationMembers struct {
+	Creator string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Did     string   `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	Members []string `protobuf:"bytes,3,rep,name=members,proto3" json:"members,omitempty"`
+}
+
+func (m *MsgUpdateOrganisationMembers) Reset()         { *m = MsgUpdateOrganisationMembers{} }
+func (m *MsgUpdateOrganisationMembers) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateOrganisationMembers) ProtoMessage()    {}
+func (*MsgUpdateOrganisationMembers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{32}
+}
+func (m *MsgUpdateOrganisationMembers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateOrganisationMembers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateOrganisationMembers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateOrganisationMembers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateOrganisationMembers.Merge(m, src)
+}
+func (m *MsgUpdateOrganisationMembers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateOrganisationMembers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateOrganisationMembers.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateOrganisationMembers proto.InternalMessageInfo
+
+func (m *MsgUpdateOrganisationMembers) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUpdateOrganisationMembers) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *MsgUpdateOrganisationMembers) GetMembers() []string {
+	if m != nil {
+		return m.Members
+	}
+	return nil
+}
+
+// MsgUpdateOrganisationMembersResponse defines the MsgUpdateOrganisationMembersResponse message.
+type MsgUpdateOrganisationMembersResponse struct {
+}
+
+func (m *MsgUpdateOrganisationMembersResponse) Reset()         { *m = MsgUpdateOrganisationMembersResponse{} }
+func (m *MsgUpdateOrganisationMembersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateOrganisationMembersResponse) ProtoMessage()    {}
+func (*MsgUpdateOrganisationMembersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{33}
+}
+func (m *MsgUpdateOrganisationMembersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateOrganisationMembersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateOrganisationMembersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateOrganisationMembersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateOrganisationMembersResponse.Merge(m, src)
+}
+func (m *MsgUpdateOrganisationMembersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateOrganisationMembersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateOrganisationMembersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateOrganisationMembersResponse proto.InternalMessageInfo
+
+// MsgCreateDependentDid 注册被监护 DID。creator 必须是 guardian_did 的 controller，
+// signature 是认证方对 guardian_did + face_nullifier + face_commitment + maturity_time 的签名
+type MsgCreateDependentDid struct {
+	Creator        string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	GuardianDid    string `protobuf:"bytes,2,opt,name=guardian_did,json=guardianDid,proto3" json:"guardian_did,omitempty"`
+	FaceNullifier  string `protobuf:"bytes,3,opt,name=face_nullifier,json=faceNullifier,proto3" json:"face_nullifier,omitempty"`
+	FaceCommitment string `protobuf:"bytes,4,opt,name=face_commitment,json=faceCommitment,proto3" json:"face_commitment,omitempty"`
+	// maturity_time 是认证方记录的成年时间（Unix 秒）
+	MaturityTime int64  `protobuf:"varint,5,opt,name=maturity_time,json=maturityTime,proto3" json:"maturity_time,omitempty"`
+	Signature    []byte `protobuf:"bytes,6,opt,name=signature,proto3" json:"signature,omitempty"`
+	// attestor_pubkey 是签名认证方登记的公钥（hex），必须是已质押的认证方
+	AttestorPubkey string `protobuf:"bytes,7,opt,name=attestor_pubkey,json=attestorPubkey,proto3" json:"attestor_pubkey,omitempty"`
+}
+
+func (m *MsgCreateDependentDid) Reset()         { *m = MsgCreateDependentDid{} }
+func (m *MsgCreateDependentDid) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDependentDid) ProtoMessage()    {}
+func (*MsgCreateDependentDid) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{34}
+}
+func (m *MsgCreateDependentDid) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDependentDid) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDependentDid.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateDependentDid) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDependentDid.Merge(m, src)
+}
+func (m *MsgCreateDependentDid) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDependentDid) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDependentDid.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDependentDid proto.InternalMessageInfo
+
+func (m *MsgCreateDependentDid) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgCreateDependentDid) GetGuardianDid() string {
+	if m != nil {
+		return m.GuardianDid
+	}
+	return ""
+}
+
+func (m *MsgCreateDependentDid) GetFaceNullifier() string {
+	if m != nil {
+		return m.FaceNullifier
+	}
+	return ""
+}
+
+func (m *MsgCreateDependentDid) GetFaceCommitment() string {
+	if m != nil {
+		return m.FaceCommitment
+	}
+	return ""
+}
+
+func (m *MsgCreateDependentDid) GetMaturityTime() int64 {
+	if m != nil {
+		return m.MaturityTime
+	}
+	return 0
+}
+
+func (m *MsgCreateDependentDid) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *MsgCreateDependentDid) GetAttestorPubkey() string {
+	if m != nil {
+		return m.AttestorPubkey
+	}
+	return ""
+}
+
+// MsgCreateDependentDidResponse defines the MsgCreateDependentDidResponse message.
+type MsgCreateDependentDidResponse struct {
+	Did string `protobuf:"bytes,1,opt,name=did,proto3" json:"did,omitempty"`
+}
+
+func (m *MsgCreateDependentDidResponse) Reset()         { *m = MsgCreateDependentDidResponse{} }
+func (m *MsgCreateDependentDidResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateDependentDidResponse) ProtoMessage()    {}
+func (*MsgCreateDependentDidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{35}
+}
+func (m *MsgCreateDependentDidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateDependentDidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateDependentDidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCreateDependentDidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateDependentDidResponse.Merge(m, src)
+}
+func (m *MsgCreateDependentDidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateDependentDidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateDependentDidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateDependentDidResponse proto.InternalMessageInfo
+
+func (m *MsgCreateDependentDidResponse) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+// MsgClaimMaturity 由被监护人自己的地址在成年后认领 DID 控制权，
+// signature 是认证方对 did + creator 的签名
+type MsgClaimMaturity struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Did       string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	Signature []byte `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+	// attestor_pubkey 是签名认证方登记的公钥（hex），必须是已质押的认证方
+	AttestorPubkey string `protobuf:"bytes,4,opt,name=attestor_pubkey,json=attestorPubkey,proto3" json:"attestor_pubkey,omitempty"`
+}
+
+func (m *MsgClaimMaturity) Reset()         { *m = MsgClaimMaturity{} }
+func (m *MsgClaimMaturity) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimMaturity) ProtoMessage()    {}
+func (*MsgClaimMaturity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{36}
+}
+func (m *MsgClaimMaturity) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimMaturity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimMaturity.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimMaturity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimMaturity.Merge(m, src)
+}
+func (m *MsgClaimMaturity) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimMaturity) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimMaturity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimMaturity proto.InternalMessageInfo
+
+func (m *MsgClaimMaturity) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgClaimMaturity) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *MsgClaimMaturity) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func (m *MsgClaimMaturity) GetAttestorPubkey() string {
+	if m != nil {
+		return m.AttestorPubkey
+	}
+	return ""
+}
+
+// MsgClaimMaturityResponse defines the MsgClaimMaturityResponse message.
+type MsgClaimMaturityResponse struct {
+}
+
+func (m *MsgClaimMaturityResponse) Reset()         { *m = MsgClaimMaturityResponse{} }
+func (m *MsgClaimMaturityResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimMaturityResponse) ProtoMessage()    {}
+func (*MsgClaimMaturityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{37}
+}
+func (m *MsgClaimMaturityResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimMaturityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimMaturityResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimMaturityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimMaturityResponse.Merge(m, src)
+}
+func (m *MsgClaimMaturityResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimMaturityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimMaturityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimMaturityResponse proto.InternalMessageInfo
+
+// MsgBondAttestor 质押保证金加入认证方注册表。首次加入时必须提供签名公钥，且质押后保证金不低于下限
+type MsgBondAttestor struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	// pubkey 是认证方签名使用的 secp256k1 压缩公钥（hex），追加保证金时可留空
+	Pubkey string     `protobuf:"bytes,2,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *MsgBondAttestor) Reset()         { *m = MsgBondAttestor{} }
+func (m *MsgBondAttestor) String() string { return proto.CompactTextString(m) }
+func (*MsgBondAttestor) ProtoMessage()    {}
+func (*MsgBondAttestor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{38}
+}
+func (m *MsgBondAttestor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBondAttestor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBondAttestor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBondAttestor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondAttestor.Merge(m, src)
+}
+func (m *MsgBondAttestor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBondAttestor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondAttestor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBondAttestor proto.InternalMessageInfo
+
+func (m *MsgBondAttestor) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgBondAttestor) GetPubkey() string {
+	if m != nil {
+		return m.Pubkey
+	}
+	return ""
+}
+
+func (m *MsgBondAttestor) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// MsgBondAttestorResponse defines the MsgBondAttestorResponse message.
+type MsgBondAttestorResponse struct {
+}
+
+func (m *MsgBondAttestorResponse) Reset()         { *m = MsgBondAttestorResponse{} }
+func (m *MsgBondAttestorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBondAttestorResponse) ProtoMessage()    {}
+func (*MsgBondAttestorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{39}
+}
+func (m *MsgBondAttestorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBondAttestorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBondAttestorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBondAttestorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBondAttestorResponse.Merge(m, src)
+}
+func (m *MsgBondAttestorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBondAttestorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBondAttestorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBondAttestorResponse proto.InternalMessageInfo
+
+// MsgUnbondAttestor 退出认证方注册表
+type MsgUnbondAttestor struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *MsgUnbondAttestor) Reset()         { *m = MsgUnbondAttestor{} }
+func (m *MsgUnbondAttestor) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondAttestor) ProtoMessage()    {}
+func (*MsgUnbondAttestor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{40}
+}
+func (m *MsgUnbondAttestor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondAttestor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondAttestor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbondAttestor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondAttestor.Merge(m, src)
+}
+func (m *MsgUnbondAttestor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondAttestor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondAttestor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbondAttestor proto.InternalMessageInfo
+
+func (m *MsgUnbondAttestor) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// MsgUnbondAttestorResponse defines the MsgUnbondAttestorResponse message.
+type MsgUnbondAttestorResponse struct {
+	CompletionHeight int64 `protobuf:"varint,1,opt,name=completion_height,json=completionHeight,proto3" json:"completion_height,omitempty"`
+}
+
+func (m *MsgUnbondAttestorResponse) Reset()         { *m = MsgUnbondAttestorResponse{} }
+func (m *MsgUnbondAttestorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnbondAttestorResponse) ProtoMessage()    {}
+func (*MsgUnbondAttestorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{41}
+}
+func (m *MsgUnbondAttestorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnbondAttestorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnbondAttestorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnbondAttestorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnbondAttestorResponse.Merge(m, src)
+}
+func (m *MsgUnbondAttestorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnbondAttestorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnbondAttestorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnbondAttestorResponse proto.InternalMessageInfo
+
+func (m *MsgUnbondAttestorResponse) GetCompletionHeight() int64 {
+	if m != nil {
+		return m.CompletionHeight
+	}
+	return 0
+}
+
+// MsgSlashAttestor 由治理按比例罚没认证方保证金
+type MsgSlashAttestor struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string                      `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	Attestor  string                      `protobuf:"bytes,2,opt,name=attestor,proto3" json:"attestor,omitempty"`
+	Fraction  cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=fraction,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"fraction"`
+	Reason    string                      `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *MsgSlashAttestor) Reset()         { *m = MsgSlashAttestor{} }
+func (m *MsgSlashAttestor) String() string { return proto.CompactTextString(m) }
+func (*MsgSlashAttestor) ProtoMessage()    {}
+func (*MsgSlashAttestor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{42}
+}
+func (m *MsgSlashAttestor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSlashAttestor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSlashAttestor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSlashAttestor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSlashAttestor.Merge(m, src)
+}
+func (m *MsgSlashAttestor) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSlashAttestor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSlashAttestor.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSlashAttestor proto.InternalMessageInfo
+
+func (m *MsgSlashAttestor) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSlashAttestor) GetAttestor() string {
+	if m != nil {
+		return m.Attestor
+	}
+	return ""
+}
+
+func (m *MsgSlashAttestor) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+// MsgSlashAttestorResponse defines the MsgSlashAttestorResponse message.
+type MsgSlashAttestorResponse struct {
+	Slashed types.Coin `protobuf:"bytes,1,opt,name=slashed,proto3" json:"slashed"`
+}
+
+func (m *MsgSlashAttestorResponse) Reset()         { *m = MsgSlashAttestorResponse{} }
+func (m *MsgSlashAttestorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSlashAttestorResponse) ProtoMessage()    {}
+func (*MsgSlashAttestorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{43}
+}
+func (m *MsgSlashAttestorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSlashAttestorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSlashAttestorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSlashAttestorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSlashAttestorResponse.Merge(m, src)
+}
+func (m *MsgSlashAttestorResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSlashAttestorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSlashAttestorResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSlashAttestorResponse proto.InternalMessageInfo
+
+func (m *MsgSlashAttestorResponse) GetSlashed() types.Coin {
+	if m != nil {
+		return m.Slashed
+	}
+	return types.Coin{}
+}
+
+// MsgIssueCredential 由处于质押状态的认证方为 DID 签发凭证，已有同类型凭证时覆盖
+type MsgIssueCredential struct {
+	Creator        string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Did            string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	CredentialType string `protobuf:"bytes,3,opt,name=credential_type,json=credentialType,proto3" json:"credential_type,omitempty"`
+	// expiry_height 为 0 表示永不过期
+	ExpiryHeight int64 `protobuf:"varint,4,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *MsgIssueCredential) Reset()         { *m = MsgIssueCredential{} }
+func (m *MsgIssueCredential) String() string { return proto.CompactTextString(m) }
+func (*MsgIssueCredential) ProtoMessage()    {}
+func (*MsgIssueCredential) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{44}
+}
+func (m *MsgIssueCredential) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIssueCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIssueCredential.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIssueCredential) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIssueCredential.Merge(m, src)
+}
+func (m *MsgIssueCredential) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIssueCredential) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIssueCredential.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIssueCredential proto.InternalMessageInfo
+
+func (m *MsgIssueCredential) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgIssueCredential) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *MsgIssueCredential) GetCredentialType() string {
+	if m != nil {
+		return m.CredentialType
+	}
+	return ""
+}
+
+func (m *MsgIssueCredential) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+// MsgIssueCredentialResponse defines the MsgIssueCredentialResponse message.
+type MsgIssueCredentialResponse struct {
+}
+
+func (m *MsgIssueCredentialResponse) Reset()         { *m = MsgIssueCredentialResponse{} }
+func (m *MsgIssueCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgIssueCredentialResponse) ProtoMessage()    {}
+func (*MsgIssueCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{45}
+}
+func (m *MsgIssueCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgIssueCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgIssueCredentialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgIssueCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgIssueCredentialResponse.Merge(m, src)
+}
+func (m *MsgIssueCredentialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgIssueCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgIssueCredentialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgIssueCredentialResponse proto.InternalMessageInfo
+
+// MsgRevokeCredential 由签发方撤销凭证
+type MsgRevokeCredential struct {
+	Creator        string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Did            string `protobuf:"bytes,2,opt,name=did,proto3" json:"did,omitempty"`
+	CredentialType string `protobuf:"bytes,3,opt,name=credential_type,json=credentialType,proto3" json:"credential_type,omitempty"`
+}
+
+func (m *MsgRevokeCredential) Reset()         { *m = MsgRevokeCredential{} }
+func (m *MsgRevokeCredential) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeCredential) ProtoMessage()    {}
+func (*MsgRevokeCredential) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{46}
+}
+func (m *MsgRevokeCredential) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeCredential) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeCredential.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeCredential) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeCredential.Merge(m, src)
+}
+func (m *MsgRevokeCredential) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeCredential) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeCredential.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeCredential proto.InternalMessageInfo
+
+func (m *MsgRevokeCredential) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgRevokeCredential) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *MsgRevokeCredential) GetCredentialType() string {
+	if m != nil {
+		return m.CredentialType
+	}
+	return ""
+}
+
+// MsgRevokeCredentialResponse defines the MsgRevokeCredentialResponse message.
+type MsgRevokeCredentialResponse struct {
+}
+
+func (m *MsgRevokeCredentialResponse) Reset()         { *m = MsgRevokeCredentialResponse{} }
+func (m *MsgRevokeCredentialResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeCredentialResponse) ProtoMessage()    {}
+func (*MsgRevokeCredentialResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3a8612da342778f3, []int{47}
+}
+func (m *MsgRevokeCredentialResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRevokeCredentialResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRevokeCredentialResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRevokeCredentialResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeCredentialResponse.Merge(m, src)
+}
+func (m *MsgRevokeCredentialResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRevokeCredentialResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeCredentialResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRevokeCredentialResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "dtc.identity.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dtc.identity.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateDidDocument)(nil), "dtc.identity.v1.MsgCreateDidDocument")
+	proto.RegisterType((*MsgCreateDidDocumentResponse)(nil), "dtc.identity.v1.MsgCreateDidDocumentResponse")
+	proto.RegisterType((*MsgUpdateDidDocument)(nil), "dtc.identity.v1.MsgUpdateDidDocument")
+	proto.RegisterType((*MsgUpdateDidDocumentResponse)(nil), "dtc.identity.v1.MsgUpdateDidDocumentResponse")
+	proto.RegisterType((*MsgDeleteDidDocument)(nil), "dtc.identity.v1.MsgDeleteDidDocument")
+	proto.RegisterType((*MsgDeleteDidDocumentResponse)(nil), "dtc.identity.v1.MsgDeleteDidDocumentResponse")
+	proto.RegisterType((*DidDocumentEntry)(nil), "dtc.identity.v1.DidDocumentEntry")
+	proto.RegisterType((*MsgBatchCreateDidDocuments)(nil), "dtc.identity.v1.MsgBatchCreateDidDocuments")
+	proto.RegisterType((*BatchCreateDidResult)(nil), "dtc.identity.v1.BatchCreateDidResult")
+	proto.RegisterType((*MsgBatchCreateDidDocumentsResponse)(nil), "dtc.identity.v1.MsgBatchCreateDidDocumentsResponse")
+	proto.RegisterType((*MsgSendIdentityAttestation)(nil), "dtc.identity.v1.MsgSendIdentityAttestation")
+	proto.RegisterType((*MsgSendIdentityAttestationResponse)(nil), "dtc.identity.v1.MsgSendIdentityAttestationResponse")
+	proto.RegisterType((*MsgLinkExternalAccount)(nil), "dtc.identity.v1.MsgLinkExternalAccount")
+	proto.RegisterType((*MsgLinkExternalAccountResponse)(nil), "dtc.identity.v1.MsgLinkExternalAccountResponse")
+	proto.RegisterType((*MsgUnlinkExternalAccount)(nil), "dtc.identity.v1.MsgUnlinkExternalAccount")
+	proto.RegisterType((*MsgUnlinkExternalAccountResponse)(nil), "dtc.identity.v1.MsgUnlinkExternalAccountResponse")
+	proto.RegisterType((*MsgProposeControllerTransfer)(nil), "dtc.identity.v1.MsgProposeControllerTransfer")
+	proto.RegisterType((*MsgProposeControllerTransferResponse)(nil), "dtc.identity.v1.MsgProposeControllerTransferResponse")
+	proto.RegisterType((*MsgAcceptControllerTransfer)(nil), "dtc.identity.v1.MsgAcceptControllerTransfer")
+	proto.RegisterType((*MsgAcceptControllerTransferResponse)(nil), "dtc.identity.v1.MsgAcceptControllerTransferResponse")
+	proto.RegisterType((*MsgCancelControllerTransfer)(nil), "dtc.identity.v1.MsgCancelControllerTransfer")
+	proto.RegisterType((*MsgCancelControllerTransferResponse)(nil), "dtc.identity.v1.MsgCancelControllerTransferResponse")
+	proto.RegisterType((*MsgClaimHandle)(nil), "dtc.identity.v1.MsgClaimHandle")
+	proto.RegisterType((*MsgClaimHandleResponse)(nil), "dtc.identity.v1.MsgClaimHandleResponse")
+	proto.RegisterType((*MsgReleaseHandle)(nil), "dtc.identity.v1.MsgReleaseHandle")
+	proto.RegisterType((*MsgReleaseHandleResponse)(nil), "dtc.identity.v1.MsgReleaseHandleResponse")
+	proto.RegisterType((*MsgTransferHandle)(nil), "dtc.identity.v1.MsgTransferHandle")
+	proto.RegisterType((*MsgTransferHandleResponse)(nil), "dtc.identity.v1.MsgTransferHandleResponse")
+	proto.RegisterType((*MsgCreateOrganisationDid)(nil), "dtc.identity.v1.MsgCreateOrganisationDid")
+	proto.RegisterType((*MsgCreateOrganisationDidResponse)(nil), "dtc.identity.v1.MsgCreateOrganisationDidResponse")
+	proto.RegisterType((*MsgUpdateOrganisationMembers)(nil), "dtc.identity.v1.MsgUpdateOrganisationMembers")
+	proto.RegisterType((*MsgUpdateOrganisationMembersResponse)(nil), "dtc.identity.v1.MsgUpdateOrganisationMembersResponse")
+	proto.RegisterType((*MsgCreateDependentDid)(nil), "dtc.identity.v1.MsgCreateDependentDid")
+	proto.RegisterType((*MsgCreateDependentDidResponse)(nil), "dtc.identity.v1.MsgCreateDependentDidResponse")
+	proto.RegisterType((*MsgClaimMaturity)(nil), "dtc.identity.v1.MsgClaimMaturity")
+	proto.RegisterType((*MsgClaimMaturityResponse)(nil), "dtc.identity.v1.MsgClaimMaturityResponse")
+	proto.RegisterType((*MsgBondAttestor)(nil), "dtc.identity.v1.MsgBondAttestor")
+	proto.RegisterType((*MsgBondAttestorResponse)(nil), "dtc.identity.v1.MsgBondAttestorResponse")
+	proto.RegisterType((*MsgUnbondAttestor)(nil), "dtc.identity.v1.MsgUnbondAttestor")
+	proto.RegisterType((*MsgUnbondAttestorResponse)(nil), "dtc.identity.v1.MsgUnbondAttestorResponse")
+	proto.RegisterType((*MsgSlashAttestor)(nil), "dtc.identity.v1.MsgSlashAttestor")
+	proto.RegisterType((*MsgSlashAttestorResponse)(nil), "dtc.identity.v1.MsgSlashAttestorResponse")
+	proto.RegisterType((*MsgIssueCredential)(nil), "dtc.identity.v1.MsgIssueCredential")
+	proto.RegisterType((*MsgIssueCredentialResponse)(nil), "dtc.identity.v1.MsgIssueCredentialResponse")
+	proto.RegisterType((*MsgRevokeCredential)(nil), "dtc.identity.v1.MsgRevokeCredential")
+	proto.RegisterType((*MsgRevokeCredentialResponse)(nil), "dtc.identity.v1.MsgRevokeCredentialResponse")
+}
+
+func init() { proto.RegisterFile("dtc/identity/v1/tx.proto", fileDescriptor_3a8612da342778f3) }
+
+var fileDescriptor_3a8612da342778f3 = []byte{
+	// 1944 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x59, 0x5b, 0x6f, 0x1c, 0x49,
+	0x15, 0x4e, 0x7b, 0xc6, 0xb7, 0xe3, 0x7b, 0xc7, 0x49, 0xda, 0x1d, 0x67, 0xec, 0x74, 0xe2, 0x8d,
+	0xed, 0x6c, 0x66, 0x76, 0x9c, 0x2c, 0x12, 0x11, 0x12, 0xf8, 0x12, 0x29, 0x81, 0x1d, 0x88, 0x3a,
+	0x89, 0x90, 0x22, 0xa1, 0xa1, 0xdd, 0x5d, 0xee, 0x69, 0xdc, 0xdd, 0x35, 0xdb, 0x55, 0xe3, 0xf5,
+	0x08, 0x58, 0x01, 0x8f, 0x68, 0x1f, 0x10, 0xaf, 0xbc, 0xf2, 0xc0, 0x45, 0xa0, 0x48, 0xac, 0xd0,
+	0xfe, 0x84, 0x95, 0x78, 0x59, 0xed, 0x13, 0x02, 0x69, 0x85, 0x92, 0x87, 0xf0, 0x33, 0x50, 0xdf,
+	0x6a, 0xfa, 0x52, 0x3d, 0x33, 0x36, 0x93, 0xcd, 0x4b, 0x32, 0x75, 0xea, 0xab, 0x73, 0xbe, 0x73,
+	0xea, 0x54, 0xf5, 0x39, 0x65, 0x90, 0x0c, 0xaa, 0xd7, 0x2c, 0x03, 0xb9, 0xd4, 0xa2, 0xdd, 0xda,
+	0x49, 0xbd, 0x46, 0x4f, 0xab, 0x6d, 0x0f, 0x53, 0x2c, 0x2e, 0x18, 0x54, 0xaf, 0xc6, 0x33, 0xd5,
+	0x93, 0xba, 0xbc, 0xa4, 0x39, 0x96, 0x8b, 0x6b, 0xc1, 0xbf, 0x21, 0x46, 0xae, 0xe8, 0x98, 0x38,
+	0x98, 0xd4, 0x0e, 0x35, 0x82, 0x6a, 0x27, 0xf5, 0x43, 0x44, 0xb5, 0x7a, 0x4d, 0xc7, 0x96, 0x1b,
+	0xcd, 0x5f, 0x89, 0xe6, 0x1d, 0x62, 0xfa, 0xba, 0x1d, 0x62, 0x46, 0x13, 0x2b, 0xe1, 0x44, 0x33,
+	0x18, 0xd5, 0xc2, 0x41, 0x34, 0xb5, 0x9a, 0x65, 0xd4, 0xd6, 0x3c, 0xcd, 0x89, 0x67, 0x97, 0x4d,
+	0x6c, 0xe2, 0x70, 0x95, 0xff, 0x2b, 0x94, 0x2a, 0x9f, 0x09, 0xb0, 0xd0, 0x20, 0xe6, 0xb3, 0xb6,
+	0xa1, 0x51, 0xf4, 0x38, 0xc0, 0x8b, 0xdf, 0x80, 0x69, 0xad, 0x43, 0x5b, 0xd8, 0xb3, 0x68, 0x57,
+	0x12, 0xd6, 0x85, 0xcd, 0xe9, 0x3d, 0xe9, 0xcb, 0x4f, 0xef, 0x2c, 0x47, 0xc6, 0x76, 0x0d, 0xc3,
+	0x43, 0x84, 0x3c, 0xa1, 0x9e, 0xe5, 0x9a, 0x6a, 0x0f, 0x2a, 0xde, 0x87, 0x89, 0xd0, 0xa2, 0x34,
+	0xb6, 0x2e, 0x6c, 0xce, 0xec, 0x5c, 0xa9, 0x66, 0x02, 0x51, 0x0d, 0x0d, 0xec, 0x4d, 0x7f, 0xfe,
+	0xd5, 0xda, 0x85, 0x3f, 0xbc, 0x7e, 0xb1, 0x2d, 0xa8, 0xd1, 0x8a, 0xfb, 0xf5, 0x5f, 0xbd, 0x7e,
+	0xb1, 0xdd, 0xd3, 0xf5, 0xeb, 0xd7, 0x2f, 0xb6, 0x2b, 0xbe, 0x3b, 0xa7, 0x3d, 0x87, 0x32, 0x34,
+	0x95, 0x15, 0xb8, 0x92, 0x11, 0xa9, 0x88, 0xb4, 0xb1, 0x4b, 0x90, 0xf2, 0x8f, 0x31, 0x58, 0x6e,
+	0x10, 0x73, 0xdf, 0x43, 0x1a, 0x45, 0x07, 0x96, 0x71, 0x80, 0xf5, 0x8e, 0x83, 0x5c, 0x2a, 0xee,
+	0xc0, 0xa4, 0xee, 0x0b, 0xb1, 0x37, 0xd0, 0xb1, 0x18, 0x28, 0x2e, 0x42, 0xc9, 0xb0, 0x8c, 0xc0,
+	0xa7, 0x69, 0xd5, 0xff, 0x29, 0x56, 0x00, 0x74, 0xec, 0x52, 0x0f, 0xdb, 0x36, 0xf2, 0xa4, 0x52,
+	0x30, 0x91, 0x90, 0x88, 0x12, 0x4c, 0xb6, 0x3b, 0x87, 0xc7, 0xa8, 0x4b, 0xa4, 0xf1, 0x60, 0x32,
+	0x1e, 0x8a, 0xab, 0x30, 0x4d, 0x2c, 0xd3, 0xd5, 0x68, 0xc7, 0x43, 0xd2, 0xc4, 0xba, 0xb0, 0x39,
+	0xab, 0xf6, 0x04, 0xe2, 0x06, 0xcc, 0x1f, 0x69, 0x3a, 0x6a, 0xba, 0x1d, 0xdb, 0xb6, 0x8e, 0x2c,
+	0xe4, 0x49, 0x93, 0xc1, 0xf2, 0x39, 0x5f, 0xfa, 0xfd, 0x58, 0x28, 0xde, 0x82, 0x85, 0x00, 0xa6,
+	0x63, 0xc7, 0xb1, 0xa8, 0xef, 0x97, 0x34, 0x15, 0xe0, 0x82, 0xd5, 0xfb, 0x4c, 0xea, 0x03, 0x35,
+	0x4a, 0x11, 0xa1, 0xd8, 0x6b, 0x86, 0x0c, 0xa4, 0xe9, 0x10, 0x18, 0x8b, 0x1f, 0x07, 0xd2, 0xfb,
+	0xb3, 0x7e, 0xf4, 0x63, 0x87, 0xbf, 0x5b, 0x9e, 0x2a, 0x2f, 0x8e, 0xab, 0x53, 0xbe, 0xb2, 0x87,
+	0x1a, 0x69, 0x29, 0xef, 0xc1, 0x2a, 0x2f, 0x98, 0x71, 0xb4, 0xe3, 0x00, 0x09, 0x2c, 0x40, 0xca,
+	0xef, 0x05, 0x58, 0x66, 0x7b, 0xf3, 0x56, 0xe3, 0x5f, 0x4e, 0xc5, 0x3f, 0xed, 0xa8, 0x52, 0x81,
+	0x55, 0x1e, 0x4b, 0x96, 0x46, 0x3f, 0x09, 0xbc, 0x38, 0x40, 0x36, 0x7a, 0x03, 0x5e, 0x70, 0xb9,
+	0xe4, 0x6c, 0x31, 0x2e, 0x7f, 0x15, 0x60, 0x31, 0x21, 0x7f, 0xe0, 0x52, 0xaf, 0x9b, 0x8f, 0x7c,
+	0x26, 0x34, 0x63, 0xfd, 0x42, 0x53, 0x4a, 0xa7, 0x66, 0x3e, 0xf9, 0xca, 0x43, 0x26, 0xdf, 0x38,
+	0x2f, 0xf9, 0x94, 0xff, 0x0a, 0x20, 0x37, 0x88, 0xb9, 0xa7, 0x51, 0xbd, 0x95, 0xcb, 0x1d, 0x72,
+	0xae, 0x18, 0xee, 0xc2, 0x24, 0x72, 0xa9, 0x67, 0x21, 0xff, 0x86, 0x29, 0x6d, 0xce, 0xec, 0x5c,
+	0xcf, 0xdd, 0x30, 0xd9, 0x10, 0xed, 0x95, 0xfd, 0xbb, 0x46, 0x8d, 0xd7, 0xa5, 0x0f, 0x60, 0x29,
+	0x7b, 0x00, 0x39, 0x07, 0xa6, 0x3c, 0xf8, 0xc0, 0x28, 0x2e, 0x2c, 0xa7, 0xdd, 0x54, 0x11, 0xe9,
+	0xd8, 0x54, 0x5c, 0x86, 0x71, 0xcb, 0x35, 0xd0, 0x69, 0xe0, 0xe1, 0x9c, 0x1a, 0x0e, 0x38, 0xf9,
+	0x2c, 0xc1, 0x24, 0xe9, 0xe8, 0x3a, 0x22, 0xe1, 0xa6, 0x4c, 0xa9, 0xf1, 0xd0, 0xd7, 0x80, 0x3c,
+	0x0f, 0xc7, 0x7b, 0x11, 0x0e, 0x94, 0x4f, 0x04, 0x50, 0x8a, 0x43, 0xcb, 0xce, 0xe5, 0x1a, 0xcc,
+	0x38, 0xc8, 0x3b, 0xb6, 0x51, 0xd3, 0xc3, 0x98, 0x46, 0x59, 0x02, 0xa1, 0x48, 0xc5, 0x98, 0x8a,
+	0x0f, 0x60, 0xd2, 0x0b, 0x98, 0xc6, 0xf1, 0xdc, 0xc8, 0xc5, 0x93, 0xe7, 0x57, 0x1c, 0xd3, 0x68,
+	0xad, 0xf2, 0xa7, 0x70, 0xa7, 0x9f, 0x20, 0xd7, 0x78, 0x14, 0x2d, 0xdd, 0x0d, 0xc2, 0xa5, 0x51,
+	0x0b, 0xbb, 0xe7, 0xda, 0xe9, 0x6b, 0x00, 0x7a, 0x4b, 0x73, 0x5d, 0x64, 0x37, 0x59, 0xa8, 0xa6,
+	0x23, 0xc9, 0x23, 0x43, 0xbc, 0x0d, 0x4b, 0xd4, 0x72, 0x10, 0xee, 0xd0, 0xa6, 0xff, 0x3f, 0xa1,
+	0x9a, 0xd3, 0x0e, 0x42, 0x57, 0x56, 0x17, 0xa3, 0x89, 0xa7, 0xb1, 0x3c, 0xb3, 0x57, 0xdf, 0x01,
+	0xa5, 0x98, 0x2b, 0x0b, 0x9d, 0x0c, 0x53, 0x04, 0x7d, 0xd8, 0x41, 0xae, 0x8e, 0x02, 0xd2, 0x65,
+	0x95, 0x8d, 0x95, 0x3f, 0x0b, 0x70, 0xb9, 0x41, 0xcc, 0x0f, 0x2c, 0xf7, 0xf8, 0xc1, 0x29, 0x45,
+	0x9e, 0xab, 0xd9, 0xbb, 0xba, 0x8e, 0x3b, 0x23, 0xbb, 0xde, 0xd6, 0x60, 0x06, 0xd1, 0x56, 0x53,
+	0x0b, 0xf1, 0xf1, 0xfd, 0x86, 0x68, 0x2b, 0xd2, 0x90, 0x4e, 0xe2, 0x72, 0x26, 0x89, 0x33, 0xfe,
+	0xae, 0x43, 0x85, 0x4f, 0x96, 0xdd, 0x2c, 0x3f, 0x07, 0xc9, 0xbf, 0x05, 0x5d, 0x7b, 0x44, 0x0e,
+	0x65, 0xe8, 0x8f, 0x65, 0xe9, 0x67, 0x08, 0x2a, 0xb0, 0x5e, 0x64, 0x9e, 0x51, 0xfc, 0x9b, 0x10,
+	0xdc, 0x8e, 0x8f, 0x3d, 0xdc, 0xc6, 0x04, 0xed, 0xb3, 0xeb, 0xec, 0xa9, 0xa7, 0xb9, 0xe4, 0x08,
+	0x79, 0x23, 0x0a, 0xfc, 0xb7, 0x61, 0xde, 0x45, 0x1f, 0x35, 0xb3, 0xdf, 0x96, 0x3e, 0xca, 0xe6,
+	0x5c, 0xf4, 0x51, 0x8f, 0x4e, 0xc6, 0xb3, 0xef, 0xc1, 0xcd, 0x7e, 0xa4, 0x59, 0xb2, 0xdd, 0x80,
+	0x39, 0x74, 0xda, 0xb6, 0xbc, 0x6e, 0xb3, 0x85, 0x2c, 0xb3, 0x15, 0x9e, 0xd4, 0x92, 0x3a, 0x1b,
+	0x0a, 0x1f, 0x06, 0x32, 0xe5, 0x43, 0xb8, 0xda, 0x20, 0xe6, 0xae, 0xae, 0xa3, 0x36, 0x7d, 0x53,
+	0x01, 0xc8, 0xf0, 0xdf, 0x80, 0x1b, 0x7d, 0x4c, 0xb2, 0xcd, 0x09, 0x99, 0xed, 0x6b, 0xae, 0x8e,
+	0xec, 0xaf, 0x95, 0x59, 0x91, 0x49, 0xc6, 0xec, 0x67, 0x30, 0xef, 0xc3, 0x6c, 0xcd, 0x72, 0x1e,
+	0x6a, 0xae, 0x61, 0xa3, 0x11, 0xe5, 0xc9, 0x65, 0x98, 0x68, 0x05, 0xfa, 0xa2, 0xb3, 0x19, 0x8d,
+	0x32, 0x24, 0xdf, 0x83, 0xcb, 0x69, 0xeb, 0x6c, 0xc3, 0x7b, 0xeb, 0x85, 0xe4, 0x7a, 0xe5, 0x08,
+	0x16, 0x1b, 0xc4, 0x54, 0x91, 0x8d, 0x34, 0x82, 0x46, 0xc9, 0x38, 0xc3, 0x6c, 0x17, 0xa4, 0xac,
+	0x1d, 0xc6, 0x6d, 0x03, 0xe6, 0xbd, 0x70, 0x22, 0x9d, 0x8d, 0x73, 0x91, 0x34, 0x4a, 0xc7, 0x8f,
+	0x61, 0xa9, 0x41, 0xcc, 0x38, 0xe2, 0x23, 0x8d, 0xee, 0x25, 0x98, 0xa0, 0xb8, 0xe9, 0x0b, 0xc3,
+	0xe8, 0x8e, 0x53, 0x7c, 0x90, 0x73, 0xe1, 0x2a, 0xac, 0xe4, 0xec, 0xb3, 0x7d, 0x3f, 0x09, 0xfc,
+	0x0b, 0xbf, 0x5a, 0x3f, 0xf0, 0x4c, 0xcd, 0xb5, 0x48, 0x70, 0xbd, 0x1f, 0x58, 0xc6, 0xb9, 0x38,
+	0x4a, 0x30, 0xe9, 0x20, 0xe7, 0x10, 0x79, 0xe1, 0x77, 0x72, 0x5a, 0x8d, 0x87, 0x19, 0x52, 0xf7,
+	0x60, 0xbd, 0xc8, 0x6e, 0x9f, 0x62, 0xf9, 0x13, 0x21, 0x51, 0x86, 0x26, 0x97, 0x35, 0x42, 0x23,
+	0x23, 0x0a, 0x6b, 0xc2, 0x89, 0x52, 0x3f, 0x27, 0xde, 0x81, 0x9b, 0xfd, 0xd8, 0xb0, 0x20, 0x7f,
+	0x36, 0x06, 0x97, 0x7a, 0x6d, 0x01, 0x6a, 0x23, 0xd7, 0x40, 0x2e, 0x3d, 0x6f, 0x88, 0xaf, 0xc3,
+	0xac, 0xd9, 0xd1, 0x3c, 0xc3, 0xd2, 0xdc, 0x66, 0x8f, 0xf8, 0x4c, 0x2c, 0xf3, 0xd5, 0xe6, 0x0b,
+	0xd4, 0xd2, 0x90, 0x05, 0x6a, 0x99, 0xdb, 0x1d, 0xdd, 0x80, 0x39, 0xc7, 0xff, 0x62, 0x5a, 0xb4,
+	0x1b, 0x54, 0x11, 0x41, 0x1d, 0x5b, 0x52, 0x67, 0x63, 0xa1, 0x5f, 0x41, 0x0c, 0x68, 0xd8, 0x38,
+	0xf5, 0xe2, 0xe4, 0x10, 0xf5, 0x62, 0x1d, 0xae, 0x71, 0x23, 0xd7, 0x27, 0x49, 0xfe, 0x22, 0xc0,
+	0x62, 0x7c, 0x9b, 0x34, 0x22, 0x82, 0x23, 0x4a, 0x8c, 0x37, 0x52, 0x12, 0xcb, 0x20, 0x65, 0xe9,
+	0xb2, 0xcc, 0xf9, 0x63, 0xf8, 0xe6, 0xb0, 0x87, 0x5d, 0x63, 0x37, 0xd2, 0x71, 0x2e, 0x57, 0x2e,
+	0xc3, 0x44, 0xc4, 0x28, 0xf4, 0x26, 0x1a, 0x89, 0xdf, 0x82, 0x09, 0xcd, 0xf1, 0xeb, 0x87, 0xc0,
+	0x9b, 0x99, 0x9d, 0x95, 0x6a, 0xa4, 0xc7, 0x7f, 0x6c, 0xa9, 0x46, 0x8f, 0x2d, 0xd5, 0x7d, 0x6c,
+	0xb9, 0xa9, 0x97, 0x88, 0x70, 0x4d, 0xc6, 0x8f, 0xf0, 0x91, 0x21, 0x49, 0x95, 0xb9, 0xf1, 0x2c,
+	0xb8, 0x02, 0x9f, 0xb9, 0x87, 0xff, 0xa7, 0x1f, 0x19, 0x8b, 0x0f, 0x61, 0x25, 0xa7, 0x96, 0x25,
+	0xc6, 0x6d, 0x58, 0xd2, 0xb1, 0xd3, 0xb6, 0x91, 0x7f, 0x22, 0xd3, 0x17, 0xf4, 0x62, 0x6f, 0x22,
+	0xba, 0xa3, 0x7f, 0x37, 0x16, 0xe4, 0xcc, 0x13, 0x5b, 0x23, 0x2d, 0x46, 0xf0, 0xbc, 0x8f, 0x3b,
+	0xf7, 0x60, 0x2a, 0xde, 0x70, 0x69, 0x6c, 0xc0, 0x32, 0x86, 0x14, 0x1b, 0x30, 0x75, 0xe4, 0x69,
+	0xba, 0x4f, 0x2a, 0xaa, 0xa5, 0xea, 0x7e, 0xc4, 0xff, 0xf5, 0xd5, 0xda, 0xd5, 0x70, 0x25, 0x31,
+	0x8e, 0xab, 0x16, 0xae, 0x39, 0x1a, 0x6d, 0x55, 0x3f, 0x40, 0xa6, 0xa6, 0x77, 0x0f, 0x90, 0xfe,
+	0xe5, 0xa7, 0x77, 0x20, 0x52, 0x7c, 0x80, 0x74, 0x95, 0xa9, 0xf0, 0x77, 0xdc, 0x43, 0x1a, 0xc1,
+	0x6e, 0x94, 0x83, 0xd1, 0xe8, 0xfe, 0x4e, 0xfe, 0xf5, 0x68, 0x2d, 0xff, 0x7a, 0x94, 0x0a, 0x84,
+	0xf2, 0x0c, 0xa4, 0xac, 0x8c, 0x85, 0xf9, 0x9b, 0x30, 0x49, 0xfc, 0x09, 0x14, 0x9e, 0xc1, 0xbe,
+	0x29, 0x14, 0x35, 0x43, 0x11, 0x5e, 0xf9, 0xbb, 0x00, 0x62, 0x83, 0x98, 0x8f, 0x08, 0xe9, 0xa0,
+	0x7d, 0x0f, 0x05, 0xe6, 0x35, 0x7b, 0x44, 0x47, 0xf5, 0x16, 0x2c, 0xe8, 0x4c, 0x67, 0x93, 0x76,
+	0xdb, 0x71, 0x05, 0x32, 0xdf, 0x13, 0x3f, 0xed, 0xb6, 0x39, 0x25, 0x65, 0x39, 0x5f, 0x52, 0x66,
+	0xf2, 0x6e, 0x15, 0xe4, 0x3c, 0x6f, 0x96, 0xec, 0xbf, 0x15, 0xe0, 0x62, 0x50, 0x33, 0x9c, 0xe0,
+	0xe3, 0xb7, 0xe7, 0x57, 0x86, 0xf2, 0x35, 0xb8, 0xca, 0xe1, 0x14, 0x73, 0xde, 0xf9, 0xf7, 0x45,
+	0x28, 0x35, 0x88, 0x29, 0x3e, 0x87, 0xd9, 0xd4, 0xfb, 0xe6, 0x7a, 0xae, 0xcb, 0xcd, 0xbc, 0x23,
+	0xca, 0x9b, 0x83, 0x10, 0x2c, 0x53, 0x2c, 0x58, 0xca, 0xbf, 0x32, 0x6e, 0xf0, 0x96, 0xe7, 0x60,
+	0xf2, 0x9d, 0xa1, 0x60, 0x49, 0x53, 0xf9, 0x07, 0xb5, 0x8d, 0x62, 0xa6, 0x03, 0x4d, 0x15, 0x3e,
+	0x7c, 0xf9, 0xa6, 0xf2, 0xaf, 0x5e, 0x5c, 0x53, 0x39, 0x98, 0x7c, 0x67, 0x28, 0x18, 0x33, 0xf5,
+	0x53, 0xb8, 0x52, 0xf4, 0x44, 0x74, 0x9b, 0xa7, 0xa9, 0x00, 0x2c, 0xdf, 0x3d, 0x03, 0x38, 0x69,
+	0xbc, 0xe8, 0xd5, 0x82, 0x6b, 0xbc, 0x00, 0x2c, 0xdf, 0x3d, 0x03, 0x98, 0x19, 0xc7, 0x70, 0x91,
+	0xf7, 0x86, 0x70, 0x8b, 0xa7, 0x8b, 0x03, 0x94, 0x6b, 0x43, 0x02, 0x99, 0xc1, 0x0e, 0x5c, 0xe2,
+	0x77, 0xf9, 0x5b, 0xdc, 0xec, 0xe0, 0x41, 0xe5, 0xfa, 0xd0, 0x50, 0x66, 0xf6, 0x97, 0x02, 0xac,
+	0x14, 0x77, 0xee, 0xdc, 0x74, 0x29, 0x84, 0xcb, 0xef, 0x9f, 0x09, 0xce, 0x38, 0x7c, 0x0c, 0x52,
+	0x61, 0xeb, 0xfc, 0x2e, 0x4f, 0x65, 0x11, 0x5a, 0xbe, 0x77, 0x16, 0x74, 0xd2, 0x7e, 0x61, 0x83,
+	0xcc, 0xb5, 0x5f, 0x84, 0x96, 0xef, 0x9d, 0x05, 0xcd, 0xec, 0xff, 0x10, 0x66, 0x92, 0x6d, 0xf0,
+	0x1a, 0x57, 0x49, 0x0f, 0x20, 0xdf, 0x1a, 0x00, 0x60, 0x8a, 0x7f, 0x04, 0x73, 0xe9, 0x7e, 0xf5,
+	0x3a, 0x6f, 0x65, 0x0a, 0x22, 0x6f, 0x0d, 0x84, 0x30, 0xf5, 0x3f, 0x86, 0xf9, 0x4c, 0x8f, 0xa9,
+	0xf0, 0x16, 0xa7, 0x31, 0xf2, 0xf6, 0x60, 0x4c, 0xf2, 0x50, 0xf0, 0x1b, 0xc5, 0xad, 0xe2, 0xdb,
+	0x39, 0x03, 0x95, 0xeb, 0x43, 0x43, 0x53, 0x87, 0xa2, 0xb8, 0xe3, 0xeb, 0x73, 0x5d, 0x73, 0xe0,
+	0xf2, 0xfb, 0x67, 0x82, 0x33, 0x0e, 0x36, 0x88, 0x9c, 0xee, 0xed, 0x9d, 0x3e, 0x5f, 0xa5, 0x04,
+	0x4e, 0xae, 0x0e, 0x87, 0x4b, 0x66, 0x4a, 0xba, 0x7b, 0xb9, 0x5e, 0x98, 0x63, 0x31, 0x44, 0xde,
+	0x1a, 0x08, 0x61, 0xea, 0x9f, 0xc3, 0x6c, 0xaa, 0xa1, 0xe0, 0x7e, 0xe4, 0x93, 0x08, 0x79, 0x73,
+	0x10, 0x22, 0x99, 0x85, 0x99, 0x32, 0x5f, 0xe1, 0x5f, 0x83, 0x49, 0x8c, 0xbc, 0x3d, 0x18, 0x93,
+	0x0c, 0x4e, 0xba, 0x4c, 0xe7, 0x06, 0x27, 0x05, 0x91, 0xb7, 0x06, 0x42, 0x98, 0x7a, 0x1d, 0x16,
+	0xb2, 0x05, 0xe9, 0x0d, 0xde, 0xea, 0x0c, 0x48, 0xbe, 0x3d, 0x04, 0x88, 0x19, 0x39, 0x82, 0xc5,
+	0x5c, 0x79, 0x78, 0x93, 0x7f, 0xd4, 0xd3, 0x28, 0xf9, 0xdd, 0x61, 0x50, 0xb1, 0x1d, 0x79, 0xfc,
+	0x17, 0x7e, 0xbf, 0xb6, 0x57, 0xfd, 0xfc, 0x65, 0x45, 0xf8, 0xe2, 0x65, 0x45, 0xf8, 0xcf, 0xcb,
+	0x8a, 0xf0, 0x9b, 0x57, 0x95, 0x0b, 0x5f, 0xbc, 0xaa, 0x5c, 0xf8, 0xe7, 0xab, 0xca, 0x85, 0xe7,
+	0xcb, 0x99, 0xd2, 0xdf, 0xaf, 0x27, 0xc9, 0xe1, 0x44, 0xf0, 0x07, 0xef, 0xbb, 0xff, 0x1b, 0x00,
+	0x69, 0xd0, 0x71, 0x30, 0xb8, 0x1f, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// CreateDidDocument defines the CreateDidDocument RPC.
+	CreateDidDocument(ctx context.Context, in *MsgCreateDidDocument, opts ...grpc.CallOption) (*MsgCreateDidDocumentResponse, error)
+	// UpdateDidDocument defines the UpdateDidDocument RPC.
+	UpdateDidDocument(ctx context.Context, in *MsgUpdateDidDocument, opts ...grpc.CallOption) (*MsgUpdateDidDocumentResponse, error)
+	// DeleteDidDocument defines the DeleteDidDocument RPC.
+	DeleteDidDocument(ctx context.Context, in *MsgDeleteDidDocument, opts ...grpc.CallOption) (*MsgDeleteDidDocumentResponse, error)
+	// BatchCreateDidDocuments defines the BatchCreateDidDocuments RPC.
+	BatchCreateDidDocuments(ctx context.Context, in *MsgBatchCreateDidDocuments, opts ...grpc.CallOption) (*MsgBatchCreateDidDocumentsResponse, error)
+	// SendIdentityAttestation defines the SendIdentityAttestation RPC.
+	SendIdentityAttestation(ctx context.Context, in *MsgSendIdentityAttestation, opts ...grpc.CallOption) (*MsgSendIdentityAttestationResponse, error)
+	// LinkExternalAccount defines the LinkExternalAccount RPC.
+	LinkExternalAccount(ctx context.Context, in *MsgLinkExternalAccount, opts ...grpc.CallOption) (*MsgLinkExternalAccountResponse, error)
+	// UnlinkExternalAccount defines the UnlinkExternalAccount RPC.
+	UnlinkExternalAccount(ctx context.Context, in *MsgUnlinkExternalAccount, opts ...grpc.CallOption) (*MsgUnlinkExternalAccountResponse, error)
+	// ProposeControllerTransfer defines the ProposeControllerTransfer RPC.
+	ProposeControllerTransfer(ctx context.Context, in *MsgProposeControllerTransfer, opts ...grpc.CallOption) (*MsgProposeControllerTransferResponse, error)
+	// AcceptControllerTransfer defines the AcceptControllerTransfer RPC.
+	AcceptControllerTransfer(ctx context.Context, in *MsgAcceptControllerTransfer, opts ...grpc.CallOption) (*MsgAcceptControllerTransferResponse, error)
+	// CancelControllerTransfer defines the CancelControllerTransfer RPC.
+	CancelControllerTransfer(ctx context.Context, in *MsgCancelControllerTransfer, opts ...grpc.CallOption) (*MsgCancelControllerTransferResponse, error)
+	// ClaimHandle defines the ClaimHandle RPC.
+	ClaimHandle(ctx context.Context, in *MsgClaimHandle, opts ...grpc.CallOption) (*MsgClaimHandleResponse, error)
+	// ReleaseHandle defines the ReleaseHandle RPC.
+	ReleaseHandle(ctx context.Context, in *MsgReleaseHandle, opts ...grpc.CallOption) (*MsgReleaseHandleResponse, error)
+	// TransferHandle defines the TransferHandle RPC.
+	TransferHandle(ctx context.Context, in *MsgTransferHandle, opts ...grpc.CallOption) (*MsgTransferHandleResponse, error)
+	// CreateOrganisationDid 由 x/group 策略账户为组织注册 DID
+	CreateOrganisationDid(ctx context.Context, in *MsgCreateOrganisationDid, opts ...grpc.CallOption) (*MsgCreateOrganisationDidResponse, error)
+	// UpdateOrganisationMembers 替换组织 DID 的成员列表
+	UpdateOrganisationMembers(ctx context.Context, in *MsgUpdateOrganisationMembers, opts ...grpc.CallOption) (*MsgUpdateOrganisationMembersResponse, error)
+	// CreateDependentDid 由监护人为无法持有私钥的被监护人注册 DID
+	CreateDependentDid(ctx context.Context, in *MsgCreateDependentDid, opts ...grpc.CallOption) (*MsgCreateDependentDidResponse, error)
+	// ClaimMaturity 由成年的被监护人凭认证方签名接管自己的 DID
+	ClaimMaturity(ctx context.Context, in *MsgClaimMaturity, opts ...grpc.CallOption) (*MsgClaimMaturityResponse, error)
+	// BondAttestor 质押保证金加入认证方注册表，或为已加入的认证方追加保证金
+	BondAttestor(ctx context.Context, in *MsgBondAttestor, opts ...grpc.CallOption) (*MsgBondAttestorResponse, error)
+	// UnbondAttestor 退出认证方注册表，保证金在解除质押期结束后退还
+	UnbondAttestor(ctx context.Context, in *MsgUnbondAttestor, opts ...grpc.CallOption) (*MsgUnbondAttestorResponse, error)
+	// SlashAttestor 由治理罚没认证方保证金，罚没资金进入 GBDP 资金池
+	SlashAttestor(ctx context.Context, in *MsgSlashAttestor, opts ...grpc.CallOption) (*MsgSlashAttestorResponse, error)
+	// IssueCredential 由有效认证方为 DID 签发可验证凭证
+	IssueCredential(ctx context.Context, in *MsgIssueCredential, opts ...grpc.CallOption) (*MsgIssueCredentialResponse, error)
+	// RevokeCredential 由凭证签发方撤销凭证
+	RevokeCredential(ctx context.Context, in *MsgRevokeCredential, opts ...grpc.CallOption) (*MsgRevokeCredentialResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateDidDocument(ctx context.Context, in *MsgCreateDidDocument, opts ...grpc.CallOption) (*MsgCreateDidDocumentResponse, error) {
+	out := new(MsgCreateDidDocumentResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/CreateDidDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateDidDocument(ctx context.Context, in *MsgUpdateDidDocument, opts ...grpc.CallOption) (*MsgUpdateDidDocumentResponse, error) {
+	out := new(MsgUpdateDidDocumentResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/UpdateDidDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeleteDidDocument(ctx context.Context, in *MsgDeleteDidDocument, opts ...grpc.CallOption) (*MsgDeleteDidDocumentResponse, error) {
+	out := new(MsgDeleteDidDocumentResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/DeleteDidDocument", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchCreateDidDocuments(ctx context.Context, in *MsgBatchCreateDidDocuments, opts ...grpc.CallOption) (*MsgBatchCreateDidDocumentsResponse, error) {
+	out := new(MsgBatchCreateDidDocumentsResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/BatchCreateDidDocuments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SendIdentityAttestation(ctx context.Context, in *MsgSendIdentityAttestation, opts ...grpc.CallOption) (*MsgSendIdentityAttestationResponse, error) {
+	out := new(MsgSendIdentityAttestationResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/SendIdentityAttestation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) LinkExternalAccount(ctx context.Context, in *MsgLinkExternalAccount, opts ...grpc.CallOption) (*MsgLinkExternalAccountResponse, error) {
+	out := new(MsgLinkExternalAccountResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/LinkExternalAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnlinkExternalAccount(ctx context.Context, in *MsgUnlinkExternalAccount, opts ...grpc.CallOption) (*MsgUnlinkExternalAccountResponse, error) {
+	out := new(MsgUnlinkExternalAccountResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/UnlinkExternalAccount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ProposeControllerTransfer(ctx context.Context, in *MsgProposeControllerTransfer, opts ...grpc.CallOption) (*MsgProposeControllerTransferResponse, error) {
+	out := new(MsgProposeControllerTransferResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/ProposeControllerTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) AcceptControllerTransfer(ctx context.Context, in *MsgAcceptControllerTransfer, opts ...grpc.CallOption) (*MsgAcceptControllerTransferResponse, error) {
+	out := new(MsgAcceptControllerTransferResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/AcceptControllerTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CancelControllerTransfer(ctx context.Context, in *MsgCancelControllerTransfer, opts ...grpc.CallOption) (*MsgCancelControllerTransferResponse, error) {
+	out := new(MsgCancelControllerTransferResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/CancelControllerTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimHandle(ctx context.Context, in *MsgClaimHandle, opts ...grpc.CallOption) (*MsgClaimHandleResponse, error) {
+	out := new(MsgClaimHandleResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/ClaimHandle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReleaseHandle(ctx context.Context, in *MsgReleaseHandle, opts ...grpc.CallOption) (*MsgReleaseHandleResponse, error) {
+	out := new(MsgReleaseHandleResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/ReleaseHandle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) TransferHandle(ctx context.Context, in *MsgTransferHandle, opts ...grpc.CallOption) (*MsgTransferHandleResponse, error) {
+	out := new(MsgTransferHandleResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/TransferHandle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateOrganisationDid(ctx context.Context, in *MsgCreateOrganisationDid, opts ...grpc.CallOption) (*MsgCreateOrganisationDidResponse, error) {
+	out := new(MsgCreateOrganisationDidResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/CreateOrganisationDid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateOrganisationMembers(ctx context.Context, in *MsgUpdateOrganisationMembers, opts ...grpc.CallOption) (*MsgUpdateOrganisationMembersResponse, error) {
+	out := new(MsgUpdateOrganisationMembersResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/UpdateOrganisationMembers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateDependentDid(ctx context.Context, in *MsgCreateDependentDid, opts ...grpc.CallOption) (*MsgCreateDependentDidResponse, error) {
+	out := new(MsgCreateDependentDidResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/CreateDependentDid", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimMaturity(ctx context.Context, in *MsgClaimMaturity, opts ...grpc.CallOption) (*MsgClaimMaturityResponse, error) {
+	out := new(MsgClaimMaturityResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/ClaimMaturity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BondAttestor(ctx context.Context, in *MsgBondAttestor, opts ...grpc.CallOption) (*MsgBondAttestorResponse, error) {
+	out := new(MsgBondAttestorResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/BondAttestor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnbondAttestor(ctx context.Context, in *MsgUnbondAttestor, opts ...grpc.CallOption) (*MsgUnbondAttestorResponse, error) {
+	out := new(MsgUnbondAttestorResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/UnbondAttestor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SlashAttestor(ctx context.Context, in *MsgSlashAttestor, opts ...grpc.CallOption) (*MsgSlashAttestorResponse, error) {
+	out := new(MsgSlashAttestorResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/SlashAttestor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) IssueCredential(ctx context.Context, in *MsgIssueCredential, opts ...grpc.CallOption) (*MsgIssueCredentialResponse, error) {
+	out := new(MsgIssueCredentialResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/IssueCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeCredential(ctx context.Context, in *MsgRevokeCredential, opts ...grpc.CallOption) (*MsgRevokeCredentialResponse, error) {
+	out := new(MsgRevokeCredentialResponse)
+	err := c.cc.Invoke(ctx, "/dtc.identity.v1.Msg/RevokeCredential", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// CreateDidDocument defines the CreateDidDocument RPC.
+	CreateDidDocument(context.Context, *MsgCreateDidDocument) (*MsgCreateDidDocumentResponse, error)
+	// UpdateDidDocument defines the UpdateDidDocument RPC.
+	UpdateDidDocument(context.Context, *MsgUpdateDidDocument) (*MsgUpdateDidDocumentResponse, error)
+	// DeleteDidDocument defines the DeleteDidDocument RPC.
+	DeleteDidDocument(context.Context, *MsgDeleteDidDocument) (*MsgDeleteDidDocumentResponse, error)
+	// BatchCreateDidDocuments defines the BatchCreateDidDocuments RPC.
+	BatchCreateDidDocuments(context.Context, *MsgBatchCreateDidDocuments) (*MsgBatchCreateDidDocumentsResponse, error)
+	// SendIdentityAttestation defines the SendIdentityAttestation RPC.
+	SendIdentityAttestation(context.Context, *MsgSendIdentityAttestation) (*MsgSendIdentityAttestationResponse, error)
+	// LinkExternalAccount defines the LinkExternalAccount RPC.
+	LinkExternalAccount(context.Context, *MsgLinkExternalAccount) (*MsgLinkExternalAccountResponse, error)
+	// UnlinkExternalAccount defines the UnlinkExternalAccount RPC.
+	UnlinkExternalAccount(context.Context, *MsgUnlinkExternalAccount) (*MsgUnlinkExternalAccountResponse, error)
+	// ProposeControllerTransfer defines the ProposeControllerTransfer RPC.
+	ProposeControllerTransfer(context.Context, *MsgProposeControllerTransfer) (*MsgProposeControllerTransferResponse, error)
+	// AcceptControllerTransfer defines the AcceptControllerTransfer RPC.
+	AcceptControllerTransfer(context.Context, *MsgAcceptControllerTransfer) (*MsgAcceptControllerTransferResponse, error)
+	// CancelControllerTransfer defines the CancelControllerTransfer RPC.
+	CancelControllerTransfer(context.Context, *MsgCancelControllerTransfer) (*MsgCancelControllerTransferResponse, error)
+	// ClaimHandle defines the ClaimHandle RPC.
+	ClaimHandle(context.Context, *MsgClaimHandle) (*MsgClaimHandleResponse, error)
+	// ReleaseHandle defines the ReleaseHandle RPC.
+	ReleaseHandle(context.Context, *MsgReleaseHandle) (*MsgReleaseHandleResponse, error)
+	// TransferHandle defines the TransferHandle RPC.
+	TransferHandle(context.Context, *MsgTransferHandle) (*MsgTransferHandleResponse, error)
+	// CreateOrganisationDid 由 x/group 策略账户为组织注册 DID
+	CreateOrganisationDid(context.Context, *MsgCreateOrganisationDid) (*MsgCreateOrganisationDidResponse, error)
+	// UpdateOrganisationMembers 替换组织 DID 的成员列表
+	UpdateOrganisationMembers(context.Context, *MsgUpdateOrganisationMembers) (*MsgUpdateOrganisationMembersResponse, error)
+	// CreateDependentDid 由监护人为无法持有私钥的被监护人注册 DID
+	CreateDependentDid(context.Context, *MsgCreateDependentDid) (*MsgCreateDependentDidResponse, error)
+	// ClaimMaturity 由成年的被监护人凭认证方签名接管自己的 DID
+	ClaimMaturity(context.Context, *MsgClaimMaturity) (*MsgClaimMaturityResponse, error)
+	// BondAttestor 质押保证金加入认证方注册表，或为已加入的认证方追加保证金
+	BondAttestor(context.Context, *MsgBondAttestor) (*MsgBondAttestorResponse, error)
+	// UnbondAttestor 退出认证方注册表，保证金在解除质押期结束后退还
+	UnbondAttestor(context.Context, *MsgUnbondAttestor) (*MsgUnbondAttestorResponse, error)
+	// SlashAttestor 由治理罚没认证方保证金，罚没资金进入 GBDP 资金池
+	SlashAttestor(context.Context, *MsgSlashAttestor) (*MsgSlashAttestorResponse, error)
+	// IssueCredential 由有效认证方为 DID 签发可验证凭证
+	IssueCredential(context.Context, *MsgIssueCredential) (*MsgIssueCredentialResponse, error)
+	// RevokeCredential 由凭证签发方撤销凭证
+	RevokeCredential(context.Context, *MsgRevokeCredential) (*MsgRevokeCredentialResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) CreateDidDocument(ctx context.Context, req *MsgCreateDidDocument) (*MsgCreateDidDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDidDocument not implemented")
+}
+func (*UnimplementedMsgServer) UpdateDidDocument(ctx context.Context, req *MsgUpdateDidDocument) (*MsgUpdateDidDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDidDocument not implemented")
+}
+func (*UnimplementedMsgServer) DeleteDidDocument(ctx context.Context, req *MsgDeleteDidDocument) (*MsgDeleteDidDocumentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDidDocument not implemented")
+}
+func (*UnimplementedMsgServer) BatchCreateDidDocuments(ctx context.Context, req *MsgBatchCreateDidDocuments) (*MsgBatchCreateDidDocumentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchCreateDidDocuments not implemented")
+}
+func (*UnimplementedMsgServer) SendIdentityAttestation(ctx context.Context, req *MsgSendIdentityAttestation) (*MsgSendIdentityAttestationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendIdentityAttestation not implemented")
+}
+func (*UnimplementedMsgServer) LinkExternalAccount(ctx context.Context, req *MsgLinkExternalAccount) (*MsgLinkExternalAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LinkExternalAccount not implemented")
+}
+func (*UnimplementedMsgServer) UnlinkExternalAccount(ctx context.Context, req *MsgUnlinkExternalAccount) (*MsgUnlinkExternalAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnlinkExternalAccount not implemented")
+}
+func (*UnimplementedMsgServer) ProposeControllerTransfer(ctx context.Context, req *MsgProposeControllerTransfer) (*MsgProposeControllerTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProposeControllerTransfer not implemented")
+}
+func (*UnimplementedMsgServer) AcceptControllerTransfer(ctx context.Context, req *MsgAcceptControllerTransfer) (*MsgAcceptControllerTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AcceptControllerTransfer not implemented")
+}
+func (*UnimplementedMsgServer) CancelControllerTransfer(ctx context.Context, req *MsgCancelControllerTransfer) (*MsgCancelControllerTransferResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelControllerTransfer not implemented")
+}
+func (*UnimplementedMsgServer) ClaimHandle(ctx context.Context, req *MsgClaimHandle) (*MsgClaimHandleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimHandle not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseHandle(ctx context.Context, req *MsgReleaseHandle) (*MsgReleaseHandleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseHandle not implemented")
+}
+func (*UnimplementedMsgServer) TransferHandle(ctx context.Context, req *MsgTransferHandle) (*MsgTransferHandleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferHandle not implemented")
+}
+func (*UnimplementedMsgServer) CreateOrganisationDid(ctx context.Context, req *MsgCreateOrganisationDid) (*MsgCreateOrganisationDidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateOrganisationDid not implemented")
+}
+func (*UnimplementedMsgServer) UpdateOrganisationMembers(ctx context.Context, req *MsgUpdateOrganisationMembers) (*MsgUpdateOrganisationMembersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOrganisationMembers not implemented")
+}
+func (*UnimplementedMsgServer) CreateDependentDid(ctx context.Context, req *MsgCreateDependentDid) (*MsgCreateDependentDidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDependentDid not implemented")
+}
+func (*UnimplementedMsgServer) ClaimMaturity(ctx context.Context, req *MsgClaimMaturity) (*MsgClaimMaturityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimMaturity not implemented")
+}
+func (*UnimplementedMsgServer) BondAttestor(ctx context.Context, req *MsgBondAttestor) (*MsgBondAttestorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BondAttestor not implemented")
+}
+func (*UnimplementedMsgServer) UnbondAttestor(ctx context.Context, req *MsgUnbondAttestor) (*MsgUnbondAttestorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondAttestor not implemented")
+}
+func (*UnimplementedMsgServer) SlashAttestor(ctx context.Context, req *MsgSlashAttestor) (*MsgSlashAttestorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SlashAttestor not implemented")
+}
+func (*UnimplementedMsgServer) IssueCredential(ctx context.Context, req *MsgIssueCredential) (*MsgIssueCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method IssueCredential not implemented")
+}
+func (*UnimplementedMsgServer) RevokeCredential(ctx context.Context, req *MsgRevokeCredential) (*MsgRevokeCredentialResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeCredential not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateDidDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDidDocument)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDidDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/CreateDidDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDidDocument(ctx, req.(*MsgCreateDidDocument))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateDidDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateDidDocument)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateDidDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/UpdateDidDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateDidDocument(ctx, req.(*MsgUpdateDidDocument))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeleteDidDocument_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeleteDidDocument)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeleteDidDocument(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/DeleteDidDocument",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeleteDidDocument(ctx, req.(*MsgDeleteDidDocument))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchCreateDidDocuments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchCreateDidDocuments)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchCreateDidDocuments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/BatchCreateDidDocuments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchCreateDidDocuments(ctx, req.(*MsgBatchCreateDidDocuments))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SendIdentityAttestation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendIdentityAttestation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SendIdentityAttestation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/SendIdentityAttestation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SendIdentityAttestation(ctx, req.(*MsgSendIdentityAttestation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_LinkExternalAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgLinkExternalAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).LinkExternalAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/LinkExternalAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).LinkExternalAccount(ctx, req.(*MsgLinkExternalAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnlinkExternalAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnlinkExternalAccount)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnlinkExternalAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/UnlinkExternalAccount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnlinkExternalAccount(ctx, req.(*MsgUnlinkExternalAccount))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ProposeControllerTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgProposeControllerTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ProposeControllerTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/ProposeControllerTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ProposeControllerTransfer(ctx, req.(*MsgProposeControllerTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_AcceptControllerTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgAcceptControllerTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).AcceptControllerTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/AcceptControllerTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).AcceptControllerTransfer(ctx, req.(*MsgAcceptControllerTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CancelControllerTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelControllerTransfer)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelControllerTransfer(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/CancelControllerTransfer",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelControllerTransfer(ctx, req.(*MsgCancelControllerTransfer))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimHandle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/ClaimHandle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimHandle(ctx, req.(*MsgClaimHandle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReleaseHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseHandle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/ReleaseHandle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseHandle(ctx, req.(*MsgReleaseHandle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_TransferHandle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgTransferHandle)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).TransferHandle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/TransferHandle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).TransferHandle(ctx, req.(*MsgTransferHandle))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateOrganisationDid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateOrganisationDid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateOrganisationDid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/CreateOrganisationDid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateOrganisationDid(ctx, req.(*MsgCreateOrganisationDid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateOrganisationMembers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateOrganisationMembers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateOrganisationMembers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/UpdateOrganisationMembers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateOrganisationMembers(ctx, req.(*MsgUpdateOrganisationMembers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateDependentDid_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateDependentDid)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateDependentDid(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/CreateDependentDid",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateDependentDid(ctx, req.(*MsgCreateDependentDid))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimMaturity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimMaturity)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimMaturity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/ClaimMaturity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimMaturity(ctx, req.(*MsgClaimMaturity))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BondAttestor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBondAttestor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BondAttestor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/BondAttestor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BondAttestor(ctx, req.(*MsgBondAttestor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnbondAttestor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnbondAttestor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnbondAttestor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/UnbondAttestor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnbondAttestor(ctx, req.(*MsgUnbondAttestor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SlashAttestor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSlashAttestor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SlashAttestor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/SlashAttestor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SlashAttestor(ctx, req.(*MsgSlashAttestor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_IssueCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgIssueCredential)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).IssueCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/IssueCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).IssueCredential(ctx, req.(*MsgIssueCredential))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeCredential_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeCredential)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeCredential(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.identity.v1.Msg/RevokeCredential",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeCredential(ctx, req.(*MsgRevokeCredential))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dtc.identity.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateDidDocument",
+			Handler:    _Msg_CreateDidDocument_Handler,
+		},
+		{
+			MethodName: "UpdateDidDocument",
+			Handler:    _Msg_UpdateDidDocument_Handler,
+		},
+		{
+			MethodName: "DeleteDidDocument",
+			Handler:    _Msg_DeleteDidDocument_Handler,
+		},
+		{
+			MethodName: "BatchCreateDidDocuments",
+			Handler:    _Msg_BatchCreateDidDocuments_Handler,
+		},
+		{
+			MethodName: "SendIdentityAttestation",
+			Handler:    _Msg_SendIdentityAttestation_Handler,
+		},
+		{
+			MethodName: "LinkExternalAccount",
+			Handler:    _Msg_LinkExternalAccount_Handler,
+		},
+		{
+			MethodName: "UnlinkExternalAccount",
+			Handler:    _Msg_UnlinkExternalAccount_Handler,
+		},
+		{
+			MethodName: "ProposeControllerTransfer",
+			Handler:    _Msg_ProposeControllerTransfer_Handler,
+		},
+		{
+			MethodName: "AcceptControllerTransfer",
+			Handler:    _Msg_AcceptControllerTransfer_Handler,
+		},
+		{
+			MethodName: "CancelControllerTransfer",
+			Handler:    _Msg_CancelControllerTransfer_Handler,
+		},
+		{
+			MethodName: "ClaimHandle",
+			Handler:    _Msg_ClaimHandle_Handler,
+		},
+		{
+			MethodName: "ReleaseHandle",
+			Handler:    _Msg_ReleaseHandle_Handler,
+		},
+		{
+			MethodName: "TransferHandle",
+			Handler:    _Msg_TransferHandle_Handler,
+		},
+		{
+			MethodName: "CreateOrganisationDid",
+			Handler:    _Msg_CreateOrganisationDid_Handler,
+		},
+		{
+			MethodName: "UpdateOrganisationMembers",
+			Handler:    _Msg_UpdateOrganisationMembers_Handler,
+		},
+		{
+			MethodName: "CreateDependentDid",
+			Handler:    _Msg_CreateDependentDid_Handler,
+		},
+		{
+			MethodName: "ClaimMaturity",
+			Handler:    _Msg_ClaimMaturity_Handler,
+		},
+		{
+			MethodName: "BondAttestor",
+			Handler:    _Msg_BondAttestor_Handler,
+		},
+		{
+			MethodName: "UnbondAttestor",
+			Handler:    _Msg_UnbondAttestor_Handler,
+		},
+		{
+			MethodName: "SlashAttestor",
+			Handler:    _Msg_SlashAttestor_Handler,
+		},
+		{
+			MethodName: "IssueCredential",
+			Handler:    _Msg_IssueCredential_Handler,
+		},
+		{
+			MethodName: "RevokeCredential",
+			Handler:    _Msg_RevokeCredential_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dtc/identity/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDidDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDidDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDidDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AttestorPubkey) > 0 {
+		i -= len(m.AttestorPubkey)
+		copy(dAtA[i:], m.AttestorPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AttestorPubkey)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if len(m.FaceCommitment) > 0 {
+		i -= len(m.FaceCommitment)
+		copy(dAtA[i:], m.FaceCommitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FaceCommitment)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.FaceNullifier) > 0 {
+		i -= len(m.FaceNullifier)
+		copy(dAtA[i:], m.FaceNullifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FaceNullifier)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Pubkeys) > 0 {
+		i -= len(m.Pubkeys)
+		copy(dAtA[i:], m.Pubkeys)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Pubkeys)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDidDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDidDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDidDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDidDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDidDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDidDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Pubkeys) > 0 {
+		i -= len(m.Pubkeys)
+		copy(dAtA[i:], m.Pubkeys)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Pubkeys)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateDidDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateDidDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateDidDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteDidDocument) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteDidDocument) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteDidDocument) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeleteDidDocumentResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeleteDidDocumentResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeleteDidDocumentResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *DidDocumentEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DidDocumentEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DidDocumentEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FaceCommitment) > 0 {
+		i -= len(m.FaceCommitment)
+		copy(dAtA[i:], m.FaceCommitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FaceCommitment)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FaceNullifier) > 0 {
+		i -= len(m.FaceNullifier)
+		copy(dAtA[i:], m.FaceNullifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FaceNullifier)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Pubkeys) > 0 {
+		i -= len(m.Pubkeys)
+		copy(dAtA[i:], m.Pubkeys)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Pubkeys)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Controller) > 0 {
+		i -= len(m.Controller)
+		copy(dAtA[i:], m.Controller)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Controller)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchCreateDidDocuments) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchCreateDidDocuments) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchCreateDidDocuments) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AttestorPubkey) > 0 {
+		i -= len(m.AttestorPubkey)
+		copy(dAtA[i:], m.AttestorPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AttestorPubkey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchCreateDidResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchCreateDidResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchCreateDidResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchCreateDidDocumentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchCreateDidDocumentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchCreateDidDocumentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.MerkleRoot) > 0 {
+		i -= len(m.MerkleRoot)
+		copy(dAtA[i:], m.MerkleRoot)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MerkleRoot)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendIdentityAttestation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendIdentityAttestation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendIdentityAttestation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TimeoutTimestamp != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.TimeoutTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSendIdentityAttestationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSendIdentityAttestationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSendIdentityAttestationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLinkExternalAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLinkExternalAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLinkExternalAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgLinkExternalAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgLinkExternalAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgLinkExternalAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlinkExternalAccount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlinkExternalAccount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlinkExternalAccount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnlinkExternalAccountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnlinkExternalAccountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnlinkExternalAccountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeControllerTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeControllerTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeControllerTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewController) > 0 {
+		i -= len(m.NewController)
+		copy(dAtA[i:], m.NewController)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewController)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgProposeControllerTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgProposeControllerTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgProposeControllerTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptControllerTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptControllerTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptControllerTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgAcceptControllerTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgAcceptControllerTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgAcceptControllerTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelControllerTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelControllerTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelControllerTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelControllerTransferResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelControllerTransferResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelControllerTransferResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimHandle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimHandle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimHandle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Handle) > 0 {
+		i -= len(m.Handle)
+		copy(dAtA[i:], m.Handle)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Handle)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimHandleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimHandleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimHandleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Handle) > 0 {
+		i -= len(m.Handle)
+		copy(dAtA[i:], m.Handle)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Handle)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseHandle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseHandle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseHandle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseHandleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseHandleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseHandleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferHandle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferHandle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferHandle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ToDid) > 0 {
+		i -= len(m.ToDid)
+		copy(dAtA[i:], m.ToDid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ToDid)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgTransferHandleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgTransferHandleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgTransferHandleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateOrganisationDid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOrganisationDid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOrganisationDid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateOrganisationDidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateOrganisationDidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateOrganisationDidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateOrganisationMembers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOrganisationMembers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOrganisationMembers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Members[iNdEx])
+			copy(dAtA[i:], m.Members[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Members[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateOrganisationMembersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOrganisationMembersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOrganisationMembersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDependentDid) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDependentDid) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDependentDid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AttestorPubkey) > 0 {
+		i -= len(m.AttestorPubkey)
+		copy(dAtA[i:], m.AttestorPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AttestorPubkey)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.MaturityTime != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaturityTime))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.FaceCommitment) > 0 {
+		i -= len(m.FaceCommitment)
+		copy(dAtA[i:], m.FaceCommitment)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FaceCommitment)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.FaceNullifier) > 0 {
+		i -= len(m.FaceNullifier)
+		copy(dAtA[i:], m.FaceNullifier)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FaceNullifier)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.GuardianDid) > 0 {
+		i -= len(m.GuardianDid)
+		copy(dAtA[i:], m.GuardianDid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.GuardianDid)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateDependentDidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateDependentDidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateDependentDidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimMaturity) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimMaturity) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimMaturity) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AttestorPubkey) > 0 {
+		i -= len(m.AttestorPubkey)
+		copy(dAtA[i:], m.AttestorPubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.AttestorPubkey)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimMaturityResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimMaturityResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimMaturityResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBondAttestor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondAttestor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondAttestor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Pubkey) > 0 {
+		i -= len(m.Pubkey)
+		copy(dAtA[i:], m.Pubkey)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Pubkey)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBondAttestorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBondAttestorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBondAttestorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondAttestor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondAttestor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondAttestor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnbondAttestorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnbondAttestorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnbondAttestorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CompletionHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.CompletionHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSlashAttestor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSlashAttestor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSlashAttestor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Fraction.Size()
+		i -= size
+		if _, err := m.Fraction.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Attestor) > 0 {
+		i -= len(m.Attestor)
+		copy(dAtA[i:], m.Attestor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Attestor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSlashAttestorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSlashAttestorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSlashAttestorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Slashed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIssueCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIssueCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIssueCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.CredentialType) > 0 {
+		i -= len(m.CredentialType)
+		copy(dAtA[i:], m.CredentialType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CredentialType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgIssueCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgIssueCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgIssueCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeCredential) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeCredential) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeCredential) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.CredentialType) > 0 {
+		i -= len(m.CredentialType)
+		copy(dAtA[i:], m.CredentialType)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CredentialType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeCredentialResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeCredentialResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeCredentialResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateDidDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Pubkeys)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FaceNullifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FaceCommitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AttestorPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDidDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDidDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Pubkeys)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateDidDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeleteDidDocument) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDeleteDidDocumentResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *DidDocumentEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Controller)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Pubkeys)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FaceNullifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FaceCommitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchCreateDidDocuments) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AttestorPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchCreateDidResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchCreateDidDocumentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MerkleRoot)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSendIdentityAttestation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.TimeoutTimestamp != 0 {
+		n += 1 + sovTx(uint64(m.TimeoutTimestamp))
+	}
+	return n
+}
+
+func (m *MsgSendIdentityAttestationResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgLinkExternalAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgLinkExternalAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnlinkExternalAccount) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnlinkExternalAccountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgProposeControllerTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.NewController)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgProposeControllerTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *MsgAcceptControllerTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgAcceptControllerTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelControllerTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelControllerTransferResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimHandle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Handle)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimHandleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Handle)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReleaseHandle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReleaseHandleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovTx(uint64(m.ReleaseHeight))
+	}
+	return n
+}
+
+func (m *MsgTransferHandle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ToDid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgTransferHandleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateOrganisationDid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgCreateOrganisationDidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateOrganisationMembers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Members) > 0 {
+		for _, s := range m.Members {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateOrganisationMembersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateDependentDid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.GuardianDid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FaceNullifier)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FaceCommitment)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.MaturityTime != 0 {
+		n += 1 + sovTx(uint64(m.MaturityTime))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AttestorPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCreateDependentDidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimMaturity) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.AttestorPubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimMaturityResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBondAttestor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Pubkey)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgBondAttestorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUnbondAttestor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnbondAttestorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.CompletionHeight != 0 {
+		n += 1 + sovTx(uint64(m.CompletionHeight))
+	}
+	return n
+}
+
+func (m *MsgSlashAttestor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Attestor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Fraction.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSlashAttestorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Slashed.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgIssueCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CredentialType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *MsgIssueCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeCredential) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CredentialType)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeCredentialResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDidDocument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDidDocument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDidDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkeys = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FaceNullifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FaceNullifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FaceCommitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FaceCommitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestorPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestorPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDidDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDidDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDidDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDidDocument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDidDocument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDidDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkeys = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateDidDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateDidDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateDidDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteDidDocument) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteDidDocument: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteDidDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeleteDidDocumentResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeleteDidDocumentResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeleteDidDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DidDocumentEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DidDocumentEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DidDocumentEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Controller = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkeys = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FaceNullifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FaceNullifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FaceCommitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FaceCommitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchCreateDidDocuments) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchCreateDidDocuments: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchCreateDidDocuments: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, DidDocumentEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestorPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestorPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchCreateDidResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchCreateDidResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchCreateDidResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchCreateDidDocumentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchCreateDidDocumentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchCreateDidDocumentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleRoot", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleRoot = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, BatchCreateDidResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendIdentityAttestation) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendIdentityAttestation: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendIdentityAttestation: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TimeoutTimestamp", wireType)
+			}
+			m.TimeoutTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TimeoutTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendIdentityAttestationResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendIdentityAttestationResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendIdentityAttestationResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLinkExternalAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLinkExternalAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLinkExternalAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgLinkExternalAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgLinkExternalAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgLinkExternalAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnlinkExternalAccount) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnlinkExternalAccount: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnlinkExternalAccount: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnlinkExternalAccountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnlinkExternalAccountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnlinkExternalAccountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeControllerTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeControllerTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeControllerTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewController", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewController = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgProposeControllerTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgProposeControllerTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgProposeControllerTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptControllerTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptControllerTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptControllerTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAcceptControllerTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAcceptControllerTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAcceptControllerTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelControllerTransfer) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelControllerTransfer: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelControllerTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCancelControllerTransferResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCancelControllerTransferResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCancelControllerTransferResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimHandle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimHandle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimHandle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimHandleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimHandleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimHandleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Handle", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Handle = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReleaseHandle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseHandle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseHandle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReleaseHandleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseHandleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseHandleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
+			}
+			m.ReleaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferHandle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferHandle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferHandle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ToDid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ToDid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgTransferHandleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgTransferHandleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgTransferHandleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateOrganisationDid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateOrganisationDid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateOrganisationDid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateOrganisationDidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateOrganisationDidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateOrganisationDidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateOrganisationMembers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOrganisationMembers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOrganisationMembers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateOrganisationMembersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOrganisationMembersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOrganisationMembersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDependentDid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDependentDid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDependentDid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GuardianDid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.GuardianDid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FaceNullifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FaceNullifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FaceCommitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FaceCommitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaturityTime", wireType)
+			}
+			m.MaturityTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaturityTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestorPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestorPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateDependentDidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateDependentDidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateDependentDidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimMaturity) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimMaturity: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimMaturity: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestorPubkey", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestorPubkey = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimMaturityResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimMaturityResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimMaturityResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBondAttestor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -1139,19 +10631,51 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBondAttestor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBondAttestor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkey", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1179,11 +10703,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Pubkey = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1210,7 +10734,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1235,7 +10759,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBondAttestorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1258,10 +10782,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBondAttestorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBondAttestorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1285,7 +10809,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDidDocument) Unmarshal(dAtA []byte) error {
+func (m *MsgUnbondAttestor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1308,10 +10832,10 @@ func (m *MsgCreateDidDocument) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDidDocument: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnbondAttestor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDidDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnbondAttestor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1346,11 +10870,61 @@ func (m *MsgCreateDidDocument) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnbondAttestorResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnbondAttestorResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnbondAttestorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CompletionHeight", wireType)
+			}
+			m.CompletionHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1360,27 +10934,64 @@ func (m *MsgCreateDidDocument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.CompletionHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Did = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSlashAttestor) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSlashAttestor: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSlashAttestor: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1408,11 +11019,11 @@ func (m *MsgCreateDidDocument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Controller = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FaceHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Attestor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1440,11 +11051,11 @@ func (m *MsgCreateDidDocument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FaceHash = string(dAtA[iNdEx:postIndex])
+			m.Attestor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pubkeys", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Fraction", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1472,13 +11083,15 @@ func (m *MsgCreateDidDocument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Pubkeys = string(dAtA[iNdEx:postIndex])
+			if err := m.Fraction.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 6:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1488,25 +11101,23 @@ func (m *MsgCreateDidDocument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
-			if m.Signature == nil {
-				m.Signature = []byte{}
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1529,7 +11140,7 @@ func (m *MsgCreateDidDocument) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateDidDocumentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSlashAttestorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1552,12 +11163,45 @@ func (m *MsgCreateDidDocumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateDidDocumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSlashAttestorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateDidDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSlashAttestorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Slashed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Slashed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1579,7 +11223,7 @@ func (m *MsgCreateDidDocumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDidDocument) Unmarshal(dAtA []byte) error {
+func (m *MsgIssueCredential) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1602,10 +11246,10 @@ func (m *MsgUpdateDidDocument) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDidDocument: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgIssueCredential: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDidDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgIssueCredential: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1674,7 +11318,7 @@ func (m *MsgUpdateDidDocument) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Controller", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialType", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1702,13 +11346,13 @@ func (m *MsgUpdateDidDocument) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Controller = string(dAtA[iNdEx:postIndex])
+			m.CredentialType = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pubkeys", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
 			}
-			var stringLen uint64
+			m.ExpiryHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1718,24 +11362,11 @@ func (m *MsgUpdateDidDocument) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ExpiryHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Pubkeys = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1757,7 +11388,7 @@ func (m *MsgUpdateDidDocument) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateDidDocumentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgIssueCredentialResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1780,10 +11411,10 @@ func (m *MsgUpdateDidDocumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateDidDocumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgIssueCredentialResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateDidDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgIssueCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1807,7 +11438,7 @@ func (m *MsgUpdateDidDocumentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteDidDocument) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeCredential) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1830,10 +11461,10 @@ func (m *MsgDeleteDidDocument) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteDidDocument: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeCredential: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteDidDocument: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeCredential: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1900,6 +11531,38 @@ func (m *MsgDeleteDidDocument) Unmarshal(dAtA []byte) error {
 			}
 			m.Did = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CredentialType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CredentialType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1921,7 +11584,7 @@ func (m *MsgDeleteDidDocument) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteDidDocumentResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeCredentialResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1944,10 +11607,10 @@ func (m *MsgDeleteDidDocumentResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteDidDocumentResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeCredentialResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteDidDocumentResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeCredentialResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dtc/x/task/types"
+)
+
+// appendClaimRecord 追加一条领取记录，同时维护二级索引、领取次数与累计支付金额。
+// 领取账本只通过此方法写入新记录
+func (k Keeper) appendClaimRecord(ctx context.Context, record types.ClaimRecord) error {
+	if err := k.ClaimRecord.Set(ctx, record.ClaimHash, record); err != nil {
+		return err
+	}
+	if err := k.indexClaimRecord(ctx, record); err != nil {
+		return err
+	}
+
+	key := collections.Join(record.TaskId, record.UserId)
+	count, err := k.UserClaimCount.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if err := k.UserClaimCount.Set(ctx, key, count+1); err != nil {
+		return err
+	}
+	if record.Did != "" {
+		didKey := collections.Join(record.TaskId, record.Did)
+		count, err := k.DidClaimCount.Get(ctx, didKey)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		if err := k.DidClaimCount.Set(ctx, didKey, count+1); err != nil {
+			return err
+		}
+	}
+
+	if record.Disputed || record.Amount.IsNil() || !record.Amount.IsPositive() {
+		return nil
+	}
+	for _, total := range []struct {
+		coll collections.Map[collections.Pair[string, string], math.Int]
+		key  string
+	}{
+		{k.PaidByTask, record.TaskId},
+		{k.PaidByUser, record.UserId},
+		{k.PaidByCreator, record.Creator},
+	} {
+		if err := addPaid(ctx, total.coll, total.key, record.Amount); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// indexClaimRecord 写入领取记录的二级索引
+func (k Keeper) indexClaimRecord(ctx context.Context, record types.ClaimRecord) error {
+	if err := k.ClaimsByUser.Set(ctx, collections.Join(record.UserId, record.ClaimHash)); err != nil {
+		return err
+	}
+	if err := k.ClaimsByTask.Set(ctx, collections.Join(record.TaskId, record.ClaimHash)); err != nil {
+		return err
+	}
+	return k.ClaimsByCreator.Set(ctx, collections.Join(record.Creator, record.ClaimHash))
+}
+
+// addPaid 在 (key, denom) 上累加支付金额
+func addPaid(ctx context.Context, coll collections.Map[collections.Pair[string, string], math.Int], key string, amount sdk.Coin) error {
+	pk := collections.Join(key, amount.Denom)
+	total, err := coll.Get(ctx, pk)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		total = math.ZeroInt()
+	}
+	return coll.Set(ctx, pk, total.Add(amount.Amount))
+}
+
+// subPaid 在 (key, denom) 上扣减支付金额，归零时删除条目
+func subPaid(ctx context.Context, coll collections.Map[collections.Pair[string, string], math.Int], key string, amount sdk.Coin) error {
+	pk := collections.Join(key, amount.Denom)
+	total, err := coll.Get(ctx, pk)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil
+		}
+		return err
+	}
+	total = total.Sub(amount.Amount)
+	if !total.IsPositive() {
+		return coll.Remove(ctx, pk)
+	}
+	return coll.Set(ctx, pk, total)
+}
+
+// totalPaid 汇总 key 下各币种的累计支付金额
+func totalPaid(ctx context.Context, coll collections.Map[collections.Pair[string, string], math.Int], key string) (sdk.Coins, error) {
+	total := sdk.NewCoins()
+	rng := collections.NewPrefixedPairRange[string, string](key)
+	if err := coll.Walk(ctx, rng, func(pk collections.Pair[string, string], amount math.Int) (bool, error) {
+		total = total.Add(sdk.NewCoin(pk.K2(), amount))
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+	return total, nil
+}
@@ -0,0 +1,101 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dtc/x/task/types"
+)
+
+// setPendingPayout 保存争议期内的待定奖励并登记到发放队列
+func (k Keeper) setPendingPayout(ctx context.Context, pending types.PendingPayout) error {
+	if err := k.PendingPayout.Set(ctx, pending.ClaimHash, pending); err != nil {
+		return err
+	}
+	return k.PendingPayoutQueue.Set(ctx, collections.Join(pending.ReleaseHeight, pending.ClaimHash))
+}
+
+// removePendingPayout 删除待定奖励并移出发放队列
+func (k Keeper) removePendingPayout(ctx context.Context, pending types.PendingPayout) error {
+	if err := k.PendingPayout.Remove(ctx, pending.ClaimHash); err != nil {
+		return err
+	}
+	return k.PendingPayoutQueue.Remove(ctx, collections.Join(pending.ReleaseHeight, pending.ClaimHash))
+}
+
+// finalizePendingPayouts 发放争议期已结束且未被争议的待定奖励。
+// 每笔发放在独立的缓存上下文中执行，发放失败（如接收地址被禁止收款）时奖金退回任务托管并发出事件，
+// 不向 EndBlocker 返回错误，避免单笔坏数据导致链停止
+func (k Keeper) finalizePendingPayouts(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var due []collections.Pair[int64, string]
+	rng := new(collections.Range[collections.Pair[int64, string]]).
+		EndExclusive(collections.Join(sdkCtx.BlockHeight()+1, ""))
+	if err := k.PendingPayoutQueue.Walk(ctx, rng, func(key collections.Pair[int64, string]) (bool, error) {
+		due = append(due, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range due {
+		pending, err := k.PendingPayout.Get(ctx, key.K2())
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				if err := k.PendingPayoutQueue.Remove(ctx, key); err != nil {
+					return err
+				}
+				continue
+			}
+			return err
+		}
+		if err := k.removePendingPayout(ctx, pending); err != nil {
+			return err
+		}
+
+		// 任务可能已被删除，此时按立即释放发放
+		task, err := k.Task.Get(ctx, pending.TaskId)
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return err
+		}
+		task.Id = pending.TaskId
+		cacheCtx, write := sdkCtx.CacheContext()
+		streamId, err := k.disburse(cacheCtx, task, pending.Recipient, pending.ClaimHash, pending.Amount)
+		if err != nil {
+			refundErr := k.tryRefundToEscrow(sdkCtx, pending.TaskId, pending.Amount)
+			if refundErr != nil {
+				sdkCtx.Logger().Error("failed to return pending payout to escrow", "module", types.ModuleName, "claim_hash", pending.ClaimHash, "err", refundErr)
+			}
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypePayoutFailed,
+					sdk.NewAttribute(types.AttributeKeyClaimHash, pending.ClaimHash),
+					sdk.NewAttribute(types.AttributeKeyTaskId, pending.TaskId),
+					sdk.NewAttribute(types.AttributeKeyRecipient, pending.Recipient),
+					sdk.NewAttribute(types.AttributeKeyAmount, pending.Amount.String()),
+					sdk.NewAttribute(types.AttributeKeyRefunded, strconv.FormatBool(refundErr == nil)),
+					sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+				),
+			)
+			continue
+		}
+		write()
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePayoutFinalized,
+				sdk.NewAttribute(types.AttributeKeyClaimHash, pending.ClaimHash),
+				sdk.NewAttribute(types.AttributeKeyTaskId, pending.TaskId),
+				sdk.NewAttribute(types.AttributeKeyRecipient, pending.Recipient),
+				sdk.NewAttribute(types.AttributeKeyAmount, pending.Amount.String()),
+				sdk.NewAttribute(types.AttributeKeyStreamId, strconv.FormatUint(streamId, 10)),
+			),
+		)
+	}
+	return nil
+}
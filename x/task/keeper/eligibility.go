@@ -0,0 +1,69 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	identitytypes "dtc/x/identity/types"
+	"dtc/x/task/types"
+)
+
+// checkEligibility 校验接收用户满足任务的身份条件，并按 DID 限制领取次数。
+// dependentDid 非空时为监护人代被监护人领取：recipient 须控制该 DID 的监护人 DID，身份条件按被监护 DID 校验。
+// 任务要求 DID 或代被监护人领取时返回计数所用的 DID，否则返回空字符串
+func (k Keeper) checkEligibility(ctx context.Context, task types.Task, recipient, dependentDid string) (string, error) {
+	eligibility := task.Eligibility
+	if !eligibility.RequiresDid() && dependentDid == "" {
+		return "", nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	status, doc, err := k.identityKeeper.GetAttestationStatus(sdkCtx, recipient)
+	if err != nil {
+		return "", errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load identity: %s", err))
+	}
+	if status == identitytypes.ATTESTATION_STATUS_UNREGISTERED {
+		return "", errorsmod.Wrapf(types.ErrIneligible, "%s has no registered did", recipient)
+	}
+	if dependentDid != "" {
+		guardianship, found := k.identityKeeper.GetGuardianship(ctx, dependentDid)
+		if !found || guardianship.GuardianDid != doc.Did {
+			return "", errorsmod.Wrapf(types.ErrIneligible, "%s is not a dependent of %s", dependentDid, doc.Did)
+		}
+		status, doc, err = k.identityKeeper.GetDidAttestationStatus(sdkCtx, dependentDid)
+		if err != nil {
+			return "", errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load identity: %s", err))
+		}
+		if status == identitytypes.ATTESTATION_STATUS_UNREGISTERED {
+			return "", errorsmod.Wrapf(types.ErrIneligible, "dependent %s is not registered", dependentDid)
+		}
+	}
+	if eligibility.RequireLiveness && status != identitytypes.ATTESTATION_STATUS_ATTESTED {
+		return "", errorsmod.Wrapf(types.ErrIneligible, "did %s has no active liveness attestation: %s", doc.Did, status)
+	}
+	if age := sdkCtx.BlockHeight() - doc.CreatedHeight; age < eligibility.MinDidAgeBlocks {
+		return "", errorsmod.Wrapf(types.ErrIneligible, "did %s is %d blocks old, task requires %d", doc.Did, age, eligibility.MinDidAgeBlocks)
+	}
+	for _, credentialType := range eligibility.RequiredCredentials {
+		if !k.identityKeeper.HasActiveCredential(ctx, doc.Did, credentialType) {
+			return "", errorsmod.Wrapf(types.ErrIneligible, "did %s lacks credential %s", doc.Did, credentialType)
+		}
+	}
+
+	// 同一 DID 控制的多个地址共享领取次数
+	didClaims, err := k.DidClaimCount.Get(ctx, collections.Join(task.Id, doc.Did))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return "", errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load did claim count: %s", err))
+	}
+	if didClaims >= task.UserLimit() {
+		return "", errorsmod.Wrapf(types.ErrDidClaimLimitReached, "did %s has claimed task %s %d times", doc.Did, task.Id, didClaims)
+	}
+
+	return doc.Did, nil
+}
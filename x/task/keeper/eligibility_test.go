@@ -0,0 +1,140 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	identitytypes "dtc/x/identity/types"
+	"dtc/x/task/keeper"
+	"dtc/x/task/types"
+)
+
+func TestClaimReward_Eligibility(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(100)
+
+	addr := func(name string) string {
+		a, err := f.addressCodec.BytesToString([]byte(name + "____________________________"[len(name):]))
+		require.NoError(t, err)
+		return a
+	}
+	owner := addr("taskOwner")
+	ownerAddr, err := f.addressCodec.StringToBytes(owner)
+	require.NoError(t, err)
+	f.bankKeeper.mint(ownerAddr, sdk.NewCoins(sdk.NewInt64Coin("dtc", 100)))
+
+	register := func(address, did string, created int64, status identitytypes.AttestationStatus) {
+		f.identity.dids[address] = identitytypes.DidDocument{Did: did, Controller: address, CreatedHeight: created}
+		f.identity.status[address] = status
+	}
+	unattested, young, noCredential, alice, aliceAlt := addr("unattested"), addr("young"), addr("noCredential"), addr("alice"), addr("aliceAlt")
+	register(unattested, "did:dtc:unattested", 10, identitytypes.ATTESTATION_STATUS_UNATTESTED)
+	register(young, "did:dtc:young", 80, identitytypes.ATTESTATION_STATUS_ATTESTED)
+	register(noCredential, "did:dtc:nocredential", 10, identitytypes.ATTESTATION_STATUS_ATTESTED)
+	register(alice, "did:dtc:alice", 10, identitytypes.ATTESTATION_STATUS_ATTESTED)
+	// 同一 DID 控制的另一个地址
+	register(aliceAlt, "did:dtc:alice", 10, identitytypes.ATTESTATION_STATUS_ATTESTED)
+	f.identity.credentials["did:dtc:young/kyc"] = true
+	f.identity.credentials["did:dtc:alice/kyc"] = true
+
+	_, err = srv.CreateTask(ctx, &types.MsgCreateTask{
+		Creator:        owner,
+		OraclePubkeys:  f.oraclePubkeys(),
+		TaskId:         "gated",
+		RewardPerClaim: sdk.NewInt64Coin("dtc", 10),
+		Budget:         sdk.NewInt64Coin("dtc", 100),
+		Eligibility:    types.TaskEligibility{RequiredCredentials: []string{"KYC level"}},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidTask)
+
+	_, err = srv.CreateTask(ctx, &types.MsgCreateTask{
+		Creator:        owner,
+		OraclePubkeys:  f.oraclePubkeys(),
+		TaskId:         "gated",
+		RewardPerClaim: sdk.NewInt64Coin("dtc", 10),
+		Budget:         sdk.NewInt64Coin("dtc", 100),
+		Eligibility: types.TaskEligibility{
+			RequireLiveness:     true,
+			MinDidAgeBlocks:     50,
+			RequiredCredentials: []string{"kyc"},
+		},
+	})
+	require.NoError(t, err)
+	fundTestTask(t, f, srv, owner, "gated", sdk.NewInt64Coin("dtc", 100))
+
+	tests := []struct {
+		desc      string
+		recipient string
+		err       error
+	}{
+		{desc: "no did", recipient: addr("stranger"), err: types.ErrIneligible},
+		{desc: "no liveness", recipient: unattested, err: types.ErrIneligible},
+		{desc: "did too young", recipient: young, err: types.ErrIneligible},
+		{desc: "missing credential", recipient: noCredential, err: types.ErrIneligible},
+		{desc: "eligible", recipient: alice},
+		{desc: "same did other address", recipient: aliceAlt, err: types.ErrDidClaimLimitReached},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.ClaimReward(ctx, f.signedClaim(t, &types.MsgClaimReward{
+				Creator:   owner,
+				TaskId:    "gated",
+				Amount:    "10dtc",
+				Recipient: tc.recipient,
+			}))
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	count, err := f.keeper.DidClaimCount.Get(ctx, collections.Join("gated", "did:dtc:alice"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+
+	res, err := keeper.NewQueryServerImpl(f.keeper).ListClaimsByTask(ctx, &types.QueryListClaimsByTaskRequest{TaskId: "gated"})
+	require.NoError(t, err)
+	require.Len(t, res.ClaimRecord, 1)
+	require.Equal(t, "did:dtc:alice", res.ClaimRecord[0].Did)
+	require.Equal(t, alice, res.ClaimRecord[0].UserId)
+
+	// 监护人代被监护人领取：身份条件与领取次数按被监护 DID 计算，奖金发给监护人
+	guardian := addr("guardian")
+	register(guardian, "did:dtc:guardian", 10, identitytypes.ATTESTATION_STATUS_ATTESTED)
+	f.identity.dids["did:dtc:kid"] = identitytypes.DidDocument{Did: "did:dtc:kid", Guardian: "did:dtc:guardian", CreatedHeight: 10}
+	f.identity.status["did:dtc:kid"] = identitytypes.ATTESTATION_STATUS_ATTESTED
+	f.identity.credentials["did:dtc:kid/kyc"] = true
+	f.identity.guardianships["did:dtc:kid"] = identitytypes.Guardianship{DependentDid: "did:dtc:kid", GuardianDid: "did:dtc:guardian"}
+	claimFor := func(recipient, dependentDid string) error {
+		_, err := srv.ClaimReward(ctx, f.signedClaim(t, &types.MsgClaimReward{
+			Creator:      owner,
+			TaskId:       "gated",
+			Amount:       "10dtc",
+			Recipient:    recipient,
+			DependentDid: dependentDid,
+		}))
+		return err
+	}
+	require.ErrorIs(t, claimFor(guardian, ""), types.ErrIneligible)
+	require.ErrorIs(t, claimFor(alice, "did:dtc:kid"), types.ErrIneligible)
+	require.ErrorIs(t, claimFor(guardian, "did:dtc:stranger"), types.ErrIneligible)
+	require.NoError(t, claimFor(guardian, "did:dtc:kid"))
+	require.ErrorIs(t, claimFor(guardian, "did:dtc:kid"), types.ErrClaimLimitReached)
+
+	guardianAddr, err := f.addressCodec.StringToBytes(guardian)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), f.bankKeeper.GetBalance(guardianAddr).AmountOf("dtc").Int64())
+	count, err = f.keeper.DidClaimCount.Get(ctx, collections.Join("gated", "did:dtc:kid"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), count)
+	byUser, err := keeper.NewQueryServerImpl(f.keeper).ListClaimsByUser(ctx, &types.QueryListClaimsByUserRequest{User: "did:dtc:kid"})
+	require.NoError(t, err)
+	require.Len(t, byUser.ClaimRecord, 1)
+	require.Equal(t, "did:dtc:kid", byUser.ClaimRecord[0].Did)
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dtc/x/task/types"
+)
+
+// getEscrow 返回任务的托管资金，未注资的任务返回奖励币种的零值
+func (k Keeper) getEscrow(ctx context.Context, task types.Task) (sdk.Coin, error) {
+	escrow, err := k.TaskEscrow.Get(ctx, task.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return sdk.NewCoin(task.RewardPerClaim.Denom, math.ZeroInt()), nil
+		}
+		return sdk.Coin{}, err
+	}
+	return escrow.Amount, nil
+}
+
+// setEscrow 保存任务托管资金，归零时删除记录
+func (k Keeper) setEscrow(ctx context.Context, taskId string, amount sdk.Coin) error {
+	if amount.IsZero() {
+		return k.TaskEscrow.Remove(ctx, taskId)
+	}
+	return k.TaskEscrow.Set(ctx, taskId, types.TaskEscrow{TaskId: taskId, Amount: amount})
+}
@@ -5,14 +5,72 @@ import (
 	"errors"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"dtc/x/task/types"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
+	// 索引、领取次数与累计支付金额均由领取记录推导，不单独导出
 	for _, elem := range genState.ClaimRecordMap {
-		if err := k.ClaimRecord.Set(ctx, elem.ClaimHash, elem); err != nil {
+		if err := k.appendClaimRecord(ctx, elem); err != nil {
+			return err
+		}
+	}
+	// 通过 setTask 导入任务以重建过期队列
+	for _, task := range genState.Tasks {
+		if err := k.setTask(ctx, task); err != nil {
+			return err
+		}
+	}
+	for _, escrow := range genState.Escrows {
+		if err := k.TaskEscrow.Set(ctx, escrow.TaskId, escrow); err != nil {
+			return err
+		}
+	}
+
+	// 奖励流 ID 序列从导入的最大 ID 继续
+	var maxStreamId uint64
+	for _, stream := range genState.RewardStreams {
+		if err := k.setRewardStream(ctx, stream); err != nil {
+			return err
+		}
+		maxStreamId = max(maxStreamId, stream.Id)
+	}
+	if err := k.RewardStreamSeq.Set(ctx, maxStreamId); err != nil {
+		return err
+	}
+
+	for _, pending := range genState.PendingPayouts {
+		if err := k.setPendingPayout(ctx, pending); err != nil {
+			return err
+		}
+	}
+
+	for _, dist := range genState.MerkleDistributions {
+		if err := k.setMerkleDistribution(ctx, dist); err != nil {
+			return err
+		}
+	}
+	for _, word := range genState.ClaimedBitmap {
+		if err := k.ClaimedBitmap.Set(ctx, collections.Join3(word.TaskId, word.Epoch, word.Word), word.Bits); err != nil {
+			return err
+		}
+	}
+	for _, enrollment := range genState.NativeEnrollments {
+		if err := k.NativeEnrollment.Set(ctx, collections.Join(enrollment.TaskId, enrollment.Address), enrollment.Height); err != nil {
+			return err
+		}
+		if enrollment.Deposit.Denom != "" {
+			if err := k.EnrollmentDeposit.Set(ctx, collections.Join(enrollment.TaskId, enrollment.Address), enrollment.Deposit); err != nil {
+				return err
+			}
+		}
+	}
+	for _, total := range genState.IbcTransferTotals {
+		if err := k.IbcTransferTotal.Set(ctx, collections.Join3(total.Sender, total.Channel, total.Amount.Denom), total.Amount.Amount); err != nil {
 			return err
 		}
 	}
@@ -40,5 +98,68 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	if err := k.Task.Walk(ctx, nil, func(_ string, val types.Task) (stop bool, err error) {
+		genesis.Tasks = append(genesis.Tasks, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.TaskEscrow.Walk(ctx, nil, func(_ string, val types.TaskEscrow) (stop bool, err error) {
+		genesis.Escrows = append(genesis.Escrows, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.RewardStream.Walk(ctx, nil, func(_ uint64, val types.RewardStream) (stop bool, err error) {
+		genesis.RewardStreams = append(genesis.RewardStreams, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.PendingPayout.Walk(ctx, nil, func(_ string, val types.PendingPayout) (stop bool, err error) {
+		genesis.PendingPayouts = append(genesis.PendingPayouts, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.MerkleDistribution.Walk(ctx, nil, func(_ collections.Pair[string, uint64], val types.MerkleDistribution) (stop bool, err error) {
+		genesis.MerkleDistributions = append(genesis.MerkleDistributions, val)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.ClaimedBitmap.Walk(ctx, nil, func(key collections.Triple[string, uint64, uint64], bits uint64) (stop bool, err error) {
+		genesis.ClaimedBitmap = append(genesis.ClaimedBitmap, types.ClaimedBitmapWord{TaskId: key.K1(), Epoch: key.K2(), Word: key.K3(), Bits: bits})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.NativeEnrollment.Walk(ctx, nil, func(key collections.Pair[string, string], height int64) (stop bool, err error) {
+		enrollment := types.NativeEnrollment{TaskId: key.K1(), Address: key.K2(), Height: height}
+		deposit, err := k.EnrollmentDeposit.Get(ctx, key)
+		if err == nil {
+			enrollment.Deposit = deposit
+		} else if !errors.Is(err, collections.ErrNotFound) {
+			return true, err
+		}
+		genesis.NativeEnrollments = append(genesis.NativeEnrollments, enrollment)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.IbcTransferTotal.Walk(ctx, nil, func(key collections.Triple[string, string, string], amount math.Int) (stop bool, err error) {
+		genesis.IbcTransferTotals = append(genesis.IbcTransferTotals, types.IbcTransferTotal{Sender: key.K1(), Channel: key.K2(), Amount: sdk.NewCoin(key.K3(), amount)})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
 	return genesis, nil
 }
@@ -1,17 +1,58 @@
 package keeper_test
 
 import (
+	"strings"
 	"testing"
 
+	"dtc/x/task/keeper"
 	"dtc/x/task/types"
 
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
 	genesisState := types.GenesisState{
-		Params:         types.DefaultParams(),
-		ClaimRecordMap: []types.ClaimRecord{{ClaimHash: "0"}, {ClaimHash: "1"}}}
+		Params: types.DefaultParams(),
+		ClaimRecordMap: []types.ClaimRecord{
+			{ClaimHash: "0", TaskId: "t1", UserId: "alice", Creator: "platform", Amount: sdk.NewInt64Coin("dtc", 10)},
+			{ClaimHash: "1", TaskId: "t1", UserId: "alice", Creator: "platform", Amount: sdk.NewInt64Coin("dtc", 10)},
+			{ClaimHash: "2", TaskId: "t1", UserId: "bob", Creator: "platform", Amount: sdk.NewInt64Coin("dtc", 10)},
+			{ClaimHash: "3", TaskId: "t1", UserId: "bob", Creator: "platform", Amount: sdk.NewInt64Coin("dtc", 10), Revoked: true, Disputed: true},
+		},
+		Tasks: []types.Task{{
+			Id:              "t1",
+			RewardPerClaim:  sdk.NewInt64Coin("dtc", 10),
+			Budget:          sdk.NewInt64Coin("dtc", 100),
+			RemainingBudget: sdk.NewInt64Coin("dtc", 80),
+			PerUserLimit:    3,
+			EndHeight:       50,
+			ClaimCount:      2,
+			LastMerkleEpoch: 3,
+			Status:          types.TASK_STATUS_OPEN,
+			OracleSet:       types.NewOracleSet([]string{"0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"}, 1),
+		}, {
+			Id:              "t2",
+			RewardPerClaim:  sdk.NewInt64Coin("dtc", 10),
+			Budget:          sdk.NewInt64Coin("dtc", 100),
+			RemainingBudget: sdk.NewInt64Coin("dtc", 100),
+			Status:          types.TASK_STATUS_OPEN,
+			Predicate:       types.TaskPredicate{Type: types.PredicateBalanceHeld, Amount: sdk.NewInt64Coin("dtc", 50), Blocks: 20},
+		}},
+		Escrows: []types.TaskEscrow{{TaskId: "t1", Amount: sdk.NewInt64Coin("dtc", 80)}},
+		RewardStreams: []types.RewardStream{{
+			Id: 4, TaskId: "t1", Recipient: "alice", ClaimHash: "1", Total: sdk.NewInt64Coin("dtc", 10), Withdrawn: sdk.NewInt64Coin("dtc", 2),
+			StartHeight: 10, CliffHeight: 10, EndHeight: 60,
+		}},
+		PendingPayouts: []types.PendingPayout{{ClaimHash: "2", TaskId: "t1", Recipient: "bob", Amount: sdk.NewInt64Coin("dtc", 10), ReleaseHeight: 70}},
+		MerkleDistributions: []types.MerkleDistribution{{
+			TaskId: "t1", Epoch: 3, Root: strings.Repeat("ab", 32), LeafCount: 100,
+			Total: sdk.NewInt64Coin("dtc", 50), Claimed: sdk.NewInt64Coin("dtc", 5), PostedHeight: 10, ExpiryHeight: 90,
+		}},
+		ClaimedBitmap:     []types.ClaimedBitmapWord{{TaskId: "t1", Epoch: 3, Word: 1, Bits: 0b101}},
+		NativeEnrollments: []types.NativeEnrollment{{TaskId: "t2", Address: "alice", Height: 12, Deposit: sdk.NewInt64Coin("dtc", 50)}},
+		IbcTransferTotals: []types.IbcTransferTotal{{Sender: "alice", Channel: "channel-0", Amount: sdk.NewInt64Coin("dtc", 30)}}}
 
 	f := initFixture(t)
 	err := f.keeper.InitGenesis(f.ctx, genesisState)
@@ -22,5 +63,45 @@ func TestGenesis(t *testing.T) {
 
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
 	require.EqualExportedValues(t, genesisState.ClaimRecordMap, got.ClaimRecordMap)
+	require.EqualExportedValues(t, genesisState.Tasks, got.Tasks)
+	require.EqualExportedValues(t, genesisState.Escrows, got.Escrows)
+	require.EqualExportedValues(t, genesisState.RewardStreams, got.RewardStreams)
+	require.EqualExportedValues(t, genesisState.PendingPayouts, got.PendingPayouts)
+	require.EqualExportedValues(t, genesisState.MerkleDistributions, got.MerkleDistributions)
+	require.EqualExportedValues(t, genesisState.ClaimedBitmap, got.ClaimedBitmap)
+	require.EqualExportedValues(t, genesisState.NativeEnrollments, got.NativeEnrollments)
+	require.EqualExportedValues(t, genesisState.IbcTransferTotals, got.IbcTransferTotals)
 
+	// 导入时重建过期队列，并由领取记录推导领取次数、索引与累计支付
+	queued, err := f.keeper.TaskExpiryQueue.Has(f.ctx, collections.Join(int64(50), "t1"))
+	require.NoError(t, err)
+	require.True(t, queued)
+	count, err := f.keeper.UserClaimCount.Get(f.ctx, collections.Join("t1", "alice"))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), count)
+	indexed, err := f.keeper.ClaimsByUser.Has(f.ctx, collections.Join("alice", "1"))
+	require.NoError(t, err)
+	require.True(t, indexed)
+	paid, err := f.keeper.PaidByCreator.Get(f.ctx, collections.Join("platform", "dtc"))
+	require.NoError(t, err)
+	require.Equal(t, int64(30), paid.Int64())
+	// 奖励流 ID 序列从导入的最大 ID 之后继续
+	next, err := f.keeper.RewardStreamSeq.Peek(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(4), next)
+	// 待定奖励导入时重建发放队列
+	queued, err = f.keeper.PendingPayoutQueue.Has(f.ctx, collections.Join(int64(70), "2"))
+	require.NoError(t, err)
+	require.True(t, queued)
+	// Merkle 分发导入时重建过期队列，位图中的叶子 66 已领取
+	queued, err = f.keeper.MerkleExpiryQueue.Has(f.ctx, collections.Join3(int64(90), "t1", uint64(3)))
+	require.NoError(t, err)
+	require.True(t, queued)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	claimed, err := qs.MerkleLeafClaimed(f.ctx, &types.QueryMerkleLeafClaimedRequest{TaskId: "t1", Epoch: 3, Index: 66})
+	require.NoError(t, err)
+	require.True(t, claimed.Claimed)
+	claimed, err = qs.MerkleLeafClaimed(f.ctx, &types.QueryMerkleLeafClaimedRequest{TaskId: "t1", Epoch: 3, Index: 65})
+	require.NoError(t, err)
+	require.False(t, claimed.Claimed)
 }
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dtc/x/task/types"
+)
+
+// RecordIBCTransfer 累计地址经源通道（IBC v2 为源客户端）跨链转出并被对端确认的金额，
+// 由转账模块的 IBC 中间件在收到成功确认时调用
+func (k Keeper) RecordIBCTransfer(ctx context.Context, sender, channel string, amount sdk.Coin) error {
+	if !amount.IsPositive() {
+		return nil
+	}
+	key := collections.Join3(sender, channel, amount.Denom)
+	total, err := k.IbcTransferTotal.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		total = math.ZeroInt()
+	} else if err != nil {
+		return err
+	}
+	if err := k.IbcTransferTotal.Set(ctx, key, total.Add(amount.Amount)); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeIbcTransferRecorded,
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyChannel, channel),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return nil
+}
+
+// ibcTransferTotal 返回地址经 channel 转出的 denom 累计金额，channel 为空时汇总全部通道
+func (k Keeper) ibcTransferTotal(ctx context.Context, sender, channel, denom string) (math.Int, error) {
+	if channel != "" {
+		total, err := k.IbcTransferTotal.Get(ctx, collections.Join3(sender, channel, denom))
+		if errors.Is(err, collections.ErrNotFound) {
+			return math.ZeroInt(), nil
+		}
+		return total, err
+	}
+
+	total := math.ZeroInt()
+	err := k.IbcTransferTotal.Walk(ctx, collections.NewPrefixedTripleRange[string, string, string](sender), func(key collections.Triple[string, string, string], amount math.Int) (bool, error) {
+		if key.K3() == denom {
+			total = total.Add(amount)
+		}
+		return false, nil
+	})
+	return total, err
+}
@@ -6,7 +6,9 @@ import (
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"dtc/x/task/types"
 )
@@ -19,10 +21,60 @@ type Keeper struct {
 	// Typically, this should be the x/gov module account.
 	authority  []byte
 	bankKeeper types.BankKeeper
+	roleKeeper types.RoleKeeper
+
+	identityKeeper types.IdentityKeeper
+	creditKeeper   types.CreditKeeper
 
 	Schema      collections.Schema
 	Params      collections.Item[types.Params]
 	ClaimRecord collections.Map[string, types.ClaimRecord]
+	// ClaimsByUser、ClaimsByTask、ClaimsByCreator 是领取记录的二级索引，键为 (索引值, claim_hash)
+	ClaimsByUser    collections.KeySet[collections.Pair[string, string]]
+	ClaimsByTask    collections.KeySet[collections.Pair[string, string]]
+	ClaimsByCreator collections.KeySet[collections.Pair[string, string]]
+	// PaidByTask、PaidByUser、PaidByCreator 按 (索引值, 币种) 累计已支付金额
+	PaidByTask    collections.Map[collections.Pair[string, string], math.Int]
+	PaidByUser    collections.Map[collections.Pair[string, string], math.Int]
+	PaidByCreator collections.Map[collections.Pair[string, string], math.Int]
+	// Task 是链上登记的任务
+	Task collections.Map[string, types.Task]
+	// UserClaimCount 记录 (任务, 用户) 的领取次数
+	UserClaimCount collections.Map[collections.Pair[string, string], uint64]
+	// TaskExpiryQueue 按 (截止高度, 任务) 排序，供 EndBlocker 关闭过期任务
+	TaskExpiryQueue collections.KeySet[collections.Pair[int64, string]]
+	// TaskEscrow 记录每个任务在模块账户中托管的资金
+	TaskEscrow collections.Map[string, types.TaskEscrow]
+	// RecipientPayoutWindow、CreatorPayoutWindow 记录 (地址, 币种) 在当前限额窗口内的支付金额
+	RecipientPayoutWindow collections.Map[collections.Pair[string, string], types.PayoutWindow]
+	CreatorPayoutWindow   collections.Map[collections.Pair[string, string], types.PayoutWindow]
+	// BlockPayoutWindow 记录各币种在当前区块内的支付金额
+	BlockPayoutWindow collections.Map[string, types.PayoutWindow]
+	// DidClaimCount 记录 (任务, DID) 的领取次数，用于要求身份条件的任务按 DID 限制领取
+	DidClaimCount collections.Map[collections.Pair[string, string], uint64]
+	// RewardStream 是模块账户持有、按释放计划逐步释放的奖励，StreamsByRecipient 按接收用户索引
+	RewardStream       collections.Map[uint64, types.RewardStream]
+	RewardStreamSeq    collections.Sequence
+	StreamsByRecipient collections.KeySet[collections.Pair[string, uint64]]
+	// PendingPayout 是争议期内尚未发放的奖励，PendingPayoutQueue 按 (发放高度, claim_hash) 排序供 EndBlocker 发放
+	PendingPayout      collections.Map[string, types.PendingPayout]
+	PendingPayoutQueue collections.KeySet[collections.Pair[int64, string]]
+	// CreditRepayShare 记录用户自愿设定的信用负债偿还比例
+	CreditRepayShare collections.Map[string, math.LegacyDec]
+	// MerkleDistribution 是按 (任务, 周期) 发布的 Merkle 根，ClaimedBitmap 按 (任务, 周期, 字序号) 记录已领取的叶子，
+	// MerkleExpiryQueue 按 (过期高度, 任务, 周期) 排序供 EndBlocker 退回未领取的资金
+	MerkleDistribution collections.Map[collections.Pair[string, uint64], types.MerkleDistribution]
+	ClaimedBitmap      collections.Map[collections.Triple[string, uint64, uint64], uint64]
+	MerkleExpiryQueue  collections.KeySet[collections.Triple[int64, string, uint64]]
+	// NativeEnrollment 记录 (任务, 地址) 登记链上判定任务的高度
+	NativeEnrollment collections.Map[collections.Pair[string, string], int64]
+	// EnrollmentDeposit 记录 (任务, 地址) 登记时锁定在模块账户的资金，领取或取消登记时退回
+	EnrollmentDeposit collections.Map[collections.Pair[string, string], sdk.Coin]
+	// IbcTransferTotal 按 (发送地址, 源通道或 IBC v2 源客户端, 币种) 累计被对端确认的跨链转出金额
+	IbcTransferTotal collections.Map[collections.Triple[string, string, string], math.Int]
+
+	// predicates 是按类型注册的链上判定
+	predicates map[string]types.Predicate
 }
 
 func NewKeeper(
@@ -31,6 +83,9 @@ func NewKeeper(
 	addressCodec address.Codec,
 	authority []byte,
 	bankKeeper types.BankKeeper,
+	roleKeeper types.RoleKeeper,
+	identityKeeper types.IdentityKeeper,
+	creditKeeper types.CreditKeeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -44,15 +99,49 @@ func NewKeeper(
 		addressCodec: addressCodec,
 		authority:    authority,
 		bankKeeper:   bankKeeper,
+		roleKeeper:   roleKeeper,
+
+		identityKeeper: identityKeeper,
+		creditKeeper:   creditKeeper,
 
-		Params:      collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
-		ClaimRecord: collections.NewMap(sb, types.ClaimRecordKey, "claimRecord", collections.StringKey, codec.CollValue[types.ClaimRecord](cdc))}
+		Params:                collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		ClaimRecord:           collections.NewMap(sb, types.ClaimRecordKey, "claimRecord", collections.StringKey, codec.CollValue[types.ClaimRecord](cdc)),
+		ClaimsByUser:          collections.NewKeySet(sb, types.ClaimsByUserKey, "claimsByUser", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		ClaimsByTask:          collections.NewKeySet(sb, types.ClaimsByTaskKey, "claimsByTask", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		ClaimsByCreator:       collections.NewKeySet(sb, types.ClaimsByCreatorKey, "claimsByCreator", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		PaidByTask:            collections.NewMap(sb, types.PaidByTaskKey, "paidByTask", collections.PairKeyCodec(collections.StringKey, collections.StringKey), sdk.IntValue),
+		PaidByUser:            collections.NewMap(sb, types.PaidByUserKey, "paidByUser", collections.PairKeyCodec(collections.StringKey, collections.StringKey), sdk.IntValue),
+		PaidByCreator:         collections.NewMap(sb, types.PaidByCreatorKey, "paidByCreator", collections.PairKeyCodec(collections.StringKey, collections.StringKey), sdk.IntValue),
+		Task:                  collections.NewMap(sb, types.TaskKey, "task", collections.StringKey, codec.CollValue[types.Task](cdc)),
+		UserClaimCount:        collections.NewMap(sb, types.UserClaimCountKey, "userClaimCount", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint64Value),
+		TaskExpiryQueue:       collections.NewKeySet(sb, types.TaskExpiryQueueKey, "taskExpiryQueue", collections.PairKeyCodec(collections.Int64Key, collections.StringKey)),
+		TaskEscrow:            collections.NewMap(sb, types.TaskEscrowKey, "taskEscrow", collections.StringKey, codec.CollValue[types.TaskEscrow](cdc)),
+		RecipientPayoutWindow: collections.NewMap(sb, types.RecipientPayoutWindowKey, "recipientPayoutWindow", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.PayoutWindow](cdc)),
+		CreatorPayoutWindow:   collections.NewMap(sb, types.CreatorPayoutWindowKey, "creatorPayoutWindow", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[types.PayoutWindow](cdc)),
+		BlockPayoutWindow:     collections.NewMap(sb, types.BlockPayoutWindowKey, "blockPayoutWindow", collections.StringKey, codec.CollValue[types.PayoutWindow](cdc)),
+		DidClaimCount:         collections.NewMap(sb, types.DidClaimCountKey, "didClaimCount", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Uint64Value),
+		RewardStream:          collections.NewMap(sb, types.RewardStreamKey, "rewardStream", collections.Uint64Key, codec.CollValue[types.RewardStream](cdc)),
+		RewardStreamSeq:       collections.NewSequence(sb, types.RewardStreamSeqKey, "rewardStreamSeq"),
+		StreamsByRecipient:    collections.NewKeySet(sb, types.StreamsByRecipientKey, "streamsByRecipient", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key)),
+		PendingPayout:         collections.NewMap(sb, types.PendingPayoutKey, "pendingPayout", collections.StringKey, codec.CollValue[types.PendingPayout](cdc)),
+		PendingPayoutQueue:    collections.NewKeySet(sb, types.PendingPayoutQueueKey, "pendingPayoutQueue", collections.PairKeyCodec(collections.Int64Key, collections.StringKey)),
+		CreditRepayShare:      collections.NewMap(sb, types.CreditRepayShareKey, "creditRepayShare", collections.StringKey, sdk.LegacyDecValue),
+		MerkleDistribution:    collections.NewMap(sb, types.MerkleDistributionKey, "merkleDistribution", collections.PairKeyCodec(collections.StringKey, collections.Uint64Key), codec.CollValue[types.MerkleDistribution](cdc)),
+		ClaimedBitmap:         collections.NewMap(sb, types.ClaimedBitmapKey, "claimedBitmap", collections.TripleKeyCodec(collections.StringKey, collections.Uint64Key, collections.Uint64Key), collections.Uint64Value),
+		MerkleExpiryQueue:     collections.NewKeySet(sb, types.MerkleExpiryQueueKey, "merkleExpiryQueue", collections.TripleKeyCodec(collections.Int64Key, collections.StringKey, collections.Uint64Key)),
+		NativeEnrollment:      collections.NewMap(sb, types.NativeEnrollmentKey, "nativeEnrollment", collections.PairKeyCodec(collections.StringKey, collections.StringKey), collections.Int64Value),
+		EnrollmentDeposit:     collections.NewMap(sb, types.EnrollmentDepositKey, "enrollmentDeposit", collections.PairKeyCodec(collections.StringKey, collections.StringKey), codec.CollValue[sdk.Coin](cdc)),
+		IbcTransferTotal:      collections.NewMap(sb, types.IbcTransferTotalKey, "ibcTransferTotal", collections.TripleKeyCodec(collections.StringKey, collections.StringKey, collections.StringKey), sdk.IntValue),
+
+		predicates: make(map[string]types.Predicate),
+	}
 
 	schema, err := sb.Build()
 	if err != nil {
 		panic(err)
 	}
 	k.Schema = schema
+	k.registerBuiltinPredicates()
 
 	return k
 }
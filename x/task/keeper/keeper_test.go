@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"cosmossdk.io/core/address"
@@ -13,6 +14,8 @@ import (
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
+	dtctypes "dtc/x/dtc/types"
+	identitytypes "dtc/x/identity/types"
 	"dtc/x/task/keeper"
 	module "dtc/x/task/module"
 	"dtc/x/task/types"
@@ -30,10 +33,105 @@ func (m mockBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, sender
 	return nil
 }
 
+func (m mockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return nil
+}
+
+func (m mockBankKeeper) GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	return sdk.NewCoins()
+}
+
+// mockRoleKeeper 按 "role/address/scope" 记录持有的角色
+type mockRoleKeeper struct {
+	roles map[string]bool
+}
+
+func (m *mockRoleKeeper) HasRole(_ context.Context, role dtctypes.Role, address, scope string) bool {
+	return m.roles[fmt.Sprintf("%s/%s/%s", role, address, scope)] || m.roles[fmt.Sprintf("%s/%s/", role, address)]
+}
+
+// mockIdentityKeeper 按地址记录 DID 及其人格证明状态，按 "did/type" 记录有效凭证，按被监护 DID 记录监护关系。
+// 没有 controller 的被监护 DID 以 DID 本身为键登记
+type mockIdentityKeeper struct {
+	dids          map[string]identitytypes.DidDocument
+	status        map[string]identitytypes.AttestationStatus
+	credentials   map[string]bool
+	guardianships map[string]identitytypes.Guardianship
+}
+
+func newMockIdentityKeeper() *mockIdentityKeeper {
+	return &mockIdentityKeeper{
+		dids:          map[string]identitytypes.DidDocument{},
+		status:        map[string]identitytypes.AttestationStatus{},
+		credentials:   map[string]bool{},
+		guardianships: map[string]identitytypes.Guardianship{},
+	}
+}
+
+func (m *mockIdentityKeeper) GetDidAttestationStatus(_ sdk.Context, did string) (identitytypes.AttestationStatus, identitytypes.DidDocument, error) {
+	for key, doc := range m.dids {
+		if doc.Did == did {
+			return m.status[key], doc, nil
+		}
+	}
+	return identitytypes.ATTESTATION_STATUS_UNREGISTERED, identitytypes.DidDocument{}, nil
+}
+
+func (m *mockIdentityKeeper) GetGuardianship(_ context.Context, did string) (identitytypes.Guardianship, bool) {
+	guardianship, ok := m.guardianships[did]
+	return guardianship, ok
+}
+
+func (m *mockIdentityKeeper) GetAttestationStatus(_ sdk.Context, address string) (identitytypes.AttestationStatus, identitytypes.DidDocument, error) {
+	doc, ok := m.dids[address]
+	if !ok {
+		return identitytypes.ATTESTATION_STATUS_UNREGISTERED, identitytypes.DidDocument{}, nil
+	}
+	return m.status[address], doc, nil
+}
+
+func (m *mockIdentityKeeper) HasActiveCredential(_ context.Context, did, credentialType string) bool {
+	return m.credentials[did+"/"+credentialType]
+}
+
+// mockCreditKeeper 按地址记录信用负债与累计偿还金额，偿还的资金从任务模块账户转入 credit 模块账户
+type mockCreditKeeper struct {
+	liabilities map[string]int64
+	repaid      map[string]uint64
+	bank        *trackableBankKeeper
+}
+
+func newMockCreditKeeper(bank *trackableBankKeeper) *mockCreditKeeper {
+	return &mockCreditKeeper{liabilities: map[string]int64{}, repaid: map[string]uint64{}, bank: bank}
+}
+
+func (m *mockCreditKeeper) RepayLiability(_ context.Context, senderModule, address string, amount sdk.Coin) (sdk.Coin, error) {
+	if amount.Denom != sdk.DefaultBondDenom {
+		return sdk.NewInt64Coin(amount.Denom, 0), nil
+	}
+	repaid := sdk.NewInt64Coin(amount.Denom, min(amount.Amount.Int64(), m.liabilities[address]))
+	if !repaid.IsPositive() {
+		return repaid, nil
+	}
+	if m.bank != nil {
+		if err := m.bank.send(authtypes.NewModuleAddress(senderModule), authtypes.NewModuleAddress("credit"), sdk.NewCoins(repaid)); err != nil {
+			return repaid, err
+		}
+	}
+	m.liabilities[address] -= repaid.Amount.Int64()
+	m.repaid[address] += repaid.Amount.Uint64()
+	return repaid, nil
+}
+
+func (m *mockCreditKeeper) GetRepaidLiability(_ context.Context, address string) (uint64, error) {
+	return m.repaid[address], nil
+}
+
 type fixture struct {
 	ctx          context.Context
 	keeper       keeper.Keeper
 	addressCodec address.Codec
+	roleKeeper   *mockRoleKeeper
 }
 
 func initFixture(t *testing.T) *fixture {
@@ -48,6 +146,7 @@ func initFixture(t *testing.T) *fixture {
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
 	mockBank := mockBankKeeper{}
+	roleKeeper := &mockRoleKeeper{roles: map[string]bool{}}
 
 	k := keeper.NewKeeper(
 		storeService,
@@ -55,6 +154,9 @@ func initFixture(t *testing.T) *fixture {
 		addressCodec,
 		authority,
 		mockBank,
+		roleKeeper,
+		newMockIdentityKeeper(),
+		newMockCreditKeeper(nil),
 	)
 
 	// Initialize params
@@ -66,5 +168,6 @@ func initFixture(t *testing.T) *fixture {
 		ctx:          ctx,
 		keeper:       k,
 		addressCodec: addressCodec,
+		roleKeeper:   roleKeeper,
 	}
 }
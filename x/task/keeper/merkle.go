@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dtc/x/task/types"
+)
+
+// setMerkleDistribution 保存 Merkle 分发并登记到过期队列
+func (k Keeper) setMerkleDistribution(ctx context.Context, dist types.MerkleDistribution) error {
+	if err := k.MerkleDistribution.Set(ctx, collections.Join(dist.TaskId, dist.Epoch), dist); err != nil {
+		return err
+	}
+	return k.MerkleExpiryQueue.Set(ctx, collections.Join3(dist.ExpiryHeight, dist.TaskId, dist.Epoch))
+}
+
+// isLeafClaimed 返回叶子是否已被领取
+func (k Keeper) isLeafClaimed(ctx context.Context, taskId string, epoch, index uint64) (bool, error) {
+	word, mask := types.ClaimedBitmapPosition(index)
+	bits, err := k.ClaimedBitmap.Get(ctx, collections.Join3(taskId, epoch, word))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return false, nil
+		}
+		return false, err
+	}
+	return bits&mask != 0, nil
+}
+
+// setLeafClaimed 在位图中标记叶子已领取
+func (k Keeper) setLeafClaimed(ctx context.Context, taskId string, epoch, index uint64) error {
+	word, mask := types.ClaimedBitmapPosition(index)
+	key := collections.Join3(taskId, epoch, word)
+	bits, err := k.ClaimedBitmap.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	return k.ClaimedBitmap.Set(ctx, key, bits|mask)
+}
+
+// expireMerkleDistributions 删除已过期的 Merkle 分发及其位图，未领取的资金退回任务托管
+func (k Keeper) expireMerkleDistributions(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var expired []collections.Triple[int64, string, uint64]
+	rng := new(collections.Range[collections.Triple[int64, string, uint64]]).
+		EndExclusive(collections.Join3(sdkCtx.BlockHeight()+1, "", uint64(0)))
+	if err := k.MerkleExpiryQueue.Walk(ctx, rng, func(key collections.Triple[int64, string, uint64]) (bool, error) {
+		expired = append(expired, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := k.MerkleExpiryQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+		distKey := collections.Join(key.K2(), key.K3())
+		dist, err := k.MerkleDistribution.Get(ctx, distKey)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+		if err := k.MerkleDistribution.Remove(ctx, distKey); err != nil {
+			return err
+		}
+		if err := k.ClaimedBitmap.Clear(ctx, collections.NewSuperPrefixedTripleRange[string, uint64, uint64](dist.TaskId, dist.Epoch)); err != nil {
+			return err
+		}
+
+		// 退款失败（如任务已不存在）时发出事件并继续处理，避免 EndBlocker 报错导致链停止
+		refund := dist.Remaining()
+		if refund.IsPositive() {
+			if err := k.tryRefundToEscrow(sdkCtx, dist.TaskId, refund); err != nil {
+				sdkCtx.Logger().Error("failed to refund expired merkle distribution", "module", types.ModuleName, "task_id", dist.TaskId, "epoch", dist.Epoch, "err", err)
+				sdkCtx.EventManager().EmitEvent(
+					sdk.NewEvent(
+						types.EventTypeMerkleRefundFailed,
+						sdk.NewAttribute(types.AttributeKeyTaskId, dist.TaskId),
+						sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(dist.Epoch, 10)),
+						sdk.NewAttribute(types.AttributeKeyAmount, refund.String()),
+						sdk.NewAttribute(types.AttributeKeyError, err.Error()),
+					),
+				)
+			}
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeMerkleDistributionExpired,
+				sdk.NewAttribute(types.AttributeKeyTaskId, dist.TaskId),
+				sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(dist.Epoch, 10)),
+				sdk.NewAttribute(types.AttributeKeyAmount, refund.String()),
+			),
+		)
+	}
+	return nil
+}
+
+// refundToEscrow 将资金退回任务托管并恢复剩余预算
+func (k Keeper) refundToEscrow(ctx context.Context, taskId string, amount sdk.Coin) error {
+	task, err := k.getTask(ctx, taskId)
+	if err != nil {
+		return err
+	}
+	escrow, err := k.getEscrow(ctx, task)
+	if err != nil {
+		return err
+	}
+	if err := k.setEscrow(ctx, task.Id, escrow.Add(amount)); err != nil {
+		return err
+	}
+	if task.RemainingBudget.Denom != amount.Denom {
+		return nil
+	}
+	task.RemainingBudget = task.RemainingBudget.Add(amount)
+	return k.setTask(ctx, task)
+}
+
+// tryRefundToEscrow 在缓存上下文中将 amount 退回任务托管，失败时不写入任何状态
+func (k Keeper) tryRefundToEscrow(ctx sdk.Context, taskId string, amount sdk.Coin) error {
+	cacheCtx, write := ctx.CacheContext()
+	if err := k.refundToEscrow(cacheCtx, taskId, amount); err != nil {
+		return err
+	}
+	write()
+	return nil
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dtc/x/task/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 为已有领取记录补建按用户、任务、提交者的二级索引。
+// 旧记录没有支付金额，不计入累计支付统计
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return m.keeper.ClaimRecord.Walk(ctx, nil, func(_ string, record types.ClaimRecord) (bool, error) {
+		return false, m.keeper.indexClaimRecord(ctx, record)
+	})
+}
+
+// Migrate2to3 为已有参数写入默认的限额窗口长度，各项限额默认为空即不限制
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.PayoutEpochBlocks == 0 {
+		params.PayoutEpochBlocks = types.DefaultPayoutEpochBlocks
+	}
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate3to4 为已有参数写入默认的信用负债偿还比例
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.CreditRepayShare.IsNil() {
+		params.CreditRepayShare = types.DefaultCreditRepayShare
+	}
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate4to5 为已有参数写入默认的批量领取条目上限
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.MaxBatchClaimSize == 0 {
+		params.MaxBatchClaimSize = types.DefaultMaxBatchClaimSize
+	}
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate5to6 重写参数以清除已废弃的管理员公钥字段，
+// 预言机签名方改由 x/dtc 角色注册表中的 ROLE_ORACLE 授权
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	return m.keeper.Params.Set(ctx, params)
+}
@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"dtc/x/task/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+func (k msgServer) BatchClaimReward(ctx context.Context, msg *types.MsgBatchClaimReward) (*types.MsgBatchClaimRewardResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	// 条目数量受治理参数限制，上限为 0 时关闭批量领取
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load params: %s", err))
+	}
+	if len(msg.Entries) == 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidBatch, "batch has no entries")
+	}
+	if len(msg.Entries) > int(params.MaxBatchClaimSize) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidBatch, "batch of %d entries exceeds limit %d", len(msg.Entries), params.MaxBatchClaimSize)
+	}
+
+	// 签名一次覆盖全部条目及其领取序号，需满足每个条目所属任务的预言机阈值；同一任务只验证一次
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	signBytes := types.BatchClaimSignBytes(msg.Creator, msg.Entries)
+	verified := make(map[string]error)
+	verifyTask := func(task types.Task) error {
+		err, ok := verified[task.Id]
+		if !ok {
+			err = k.verifyClaimSignature(ctx, task, signBytes, msg.Signature)
+			verified[task.Id] = err
+		}
+		return err
+	}
+
+	// 每个条目在独立的缓存上下文中执行，成功时提交状态与事件，失败时丢弃并记录原因
+	results := make([]types.BatchClaimResult, len(msg.Entries))
+	var succeeded int
+	for i, entry := range msg.Entries {
+		results[i].Index = uint32(i)
+		cacheCtx, write := sdkCtx.CacheContext()
+		res, claimHash, err := k.claimReward(cacheCtx, msg.Creator, entry.TaskId, entry.Recipient, "", entry.Amount, msg.Signature, func(task types.Task, _ string, sequence uint64) error {
+			// 条目声明的领取序号须与链上一致，已执行过的批次不能再次提交
+			if entry.Sequence != sequence {
+				return errorsmod.Wrapf(types.ErrClaimSequence, "entry declares sequence %d, expected %d", entry.Sequence, sequence)
+			}
+			return verifyTask(task)
+		})
+		if err != nil {
+			results[i].Error = err.Error()
+			continue
+		}
+		write()
+		succeeded++
+		results[i].Success = true
+		results[i].ClaimHash = claimHash
+		results[i].StreamId = res.StreamId
+		results[i].ReleaseHeight = res.ReleaseHeight
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeBatchClaimed,
+			sdk.NewAttribute(types.AttributeKeyCreator, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeySucceeded, strconv.Itoa(succeeded)),
+			sdk.NewAttribute(types.AttributeKeyFailed, strconv.Itoa(len(msg.Entries)-succeeded)),
+		),
+	)
+
+	return &types.MsgBatchClaimRewardResponse{Results: results}, nil
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/task/keeper"
+	"dtc/x/task/types"
+)
+
+func TestBatchClaimReward(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	owner, err := f.addressCodec.BytesToString([]byte("taskOwner__________________"))
+	require.NoError(t, err)
+	relayer, err := f.addressCodec.BytesToString([]byte("relayer____________________"))
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString([]byte("alice______________________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString([]byte("bob________________________"))
+	require.NoError(t, err)
+	createTestTask(t, f, srv, owner, "alpha", "10dtc", "20dtc")
+	createTestTask(t, f, srv, owner, "beta", "5dtc", "5dtc")
+
+	// 由其他预言机签名的任务不接受本批次的签名
+	otherKey := secp256k1.GenPrivKey()
+	_, err = srv.CreateTask(ctx, &types.MsgCreateTask{
+		Creator:        owner,
+		TaskId:         "foreign",
+		RewardPerClaim: sdk.NewInt64Coin("dtc", 10),
+		Budget:         sdk.NewInt64Coin("dtc", 10),
+		OraclePubkeys:  []string{hex.EncodeToString(otherKey.PubKey().Bytes())},
+	})
+	require.NoError(t, err)
+	fundTestTask(t, f, srv, owner, "foreign", sdk.NewInt64Coin("dtc", 10))
+
+	batch := func(entries []types.BatchClaimEntry, sign bool) (*types.MsgBatchClaimRewardResponse, error) {
+		signature, err := generateSignature(f.privKey, []byte(types.BatchClaimSignBytes(relayer, entries)))
+		require.NoError(t, err)
+		if !sign {
+			signature, err = generateSignature(f.privKey, []byte("tampered"))
+			require.NoError(t, err)
+		}
+		return srv.BatchClaimReward(ctx, &types.MsgBatchClaimReward{Creator: relayer, Entries: entries, Signature: signature})
+	}
+	balance := func(addr string) int64 {
+		bz, err := f.addressCodec.StringToBytes(addr)
+		require.NoError(t, err)
+		return f.bankKeeper.GetBalance(bz).AmountOf("dtc").Int64()
+	}
+
+	// 空批次与超出治理上限的批次整体拒绝
+	_, err = batch(nil, true)
+	require.ErrorIs(t, err, types.ErrInvalidBatch)
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.MaxBatchClaimSize = 5
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	_, err = batch(make([]types.BatchClaimEntry, 6), true)
+	require.ErrorIs(t, err, types.ErrInvalidBatch)
+
+	// 签名不符时交易成功，但每个条目均失败且不改变状态
+	res, err := batch([]types.BatchClaimEntry{{TaskId: "alpha", Recipient: alice, Amount: "10dtc"}}, false)
+	require.NoError(t, err)
+	require.Len(t, res.Results, 1)
+	require.False(t, res.Results[0].Success)
+	require.Contains(t, res.Results[0].Error, "invalid oracle signature")
+	require.Zero(t, balance(alice))
+
+	// 批量领取与单笔领取一样不接受未经预言机签名的请求
+	res, err = srv.BatchClaimReward(ctx, &types.MsgBatchClaimReward{
+		Creator:   relayer,
+		Entries:   []types.BatchClaimEntry{{TaskId: "alpha", Recipient: alice, Amount: "10dtc"}},
+		Signature: hex.EncodeToString([]byte("signature")),
+	})
+	require.NoError(t, err)
+	require.False(t, res.Results[0].Success)
+	require.Contains(t, res.Results[0].Error, sdkerrors.ErrUnauthorized.Error())
+	require.Zero(t, balance(alice))
+
+	// 各条目独立成功或失败，结果按条目顺序返回
+	res, err = batch([]types.BatchClaimEntry{
+		{TaskId: "alpha", Recipient: alice, Amount: "10dtc"},
+		{TaskId: "alpha", Recipient: alice, Amount: "10dtc"},
+		{TaskId: "beta", Recipient: bob, Amount: "5dtc"},
+		{TaskId: "alpha", Recipient: bob, Amount: "7dtc"},
+		{TaskId: "foreign", Recipient: bob, Amount: "10dtc"},
+	}, true)
+	require.NoError(t, err)
+	require.Len(t, res.Results, 5)
+	for i, tc := range []struct {
+		success bool
+		err     string
+	}{
+		{success: true},
+		{err: types.ErrClaimLimitReached.Error()},
+		{success: true},
+		{err: types.ErrAmountMismatch.Error()},
+		{err: "invalid oracle signature"},
+	} {
+		result := res.Results[i]
+		require.Equal(t, uint32(i), result.Index)
+		require.Equal(t, tc.success, result.Success, result.Error)
+		if tc.success {
+			require.NotEmpty(t, result.ClaimHash)
+			require.Empty(t, result.Error)
+			_, err := f.keeper.ClaimRecord.Get(ctx, result.ClaimHash)
+			require.NoError(t, err)
+		} else {
+			require.Contains(t, result.Error, tc.err)
+		}
+	}
+	require.Equal(t, int64(10), balance(alice))
+	require.Equal(t, int64(5), balance(bob))
+	require.Zero(t, balance(relayer))
+
+	task, err := f.keeper.Task.Get(ctx, "alpha")
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), task.ClaimCount)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 10), task.RemainingBudget)
+	task, err = f.keeper.Task.Get(ctx, "beta")
+	require.NoError(t, err)
+	require.False(t, task.IsOpen())
+	task, err = f.keeper.Task.Get(ctx, "foreign")
+	require.NoError(t, err)
+	require.Zero(t, task.ClaimCount)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// 可重复领取的任务：条目携带领取序号，同一批次重复提交时序号不再匹配
+	_, err = srv.CreateTask(ctx, &types.MsgCreateTask{
+		Creator:        owner,
+		TaskId:         "repeat",
+		RewardPerClaim: sdk.NewInt64Coin("dtc", 10),
+		Budget:         sdk.NewInt64Coin("dtc", 40),
+		PerUserLimit:   4,
+		OraclePubkeys:  f.oraclePubkeys(),
+	})
+	require.NoError(t, err)
+	fundTestTask(t, f, srv, owner, "repeat", sdk.NewInt64Coin("dtc", 40))
+	repeated := []types.BatchClaimEntry{
+		{TaskId: "repeat", Recipient: relayer, Amount: "10dtc", Sequence: 0},
+		{TaskId: "repeat", Recipient: relayer, Amount: "10dtc", Sequence: 1},
+	}
+	res, err = batch(repeated, true)
+	require.NoError(t, err)
+	require.True(t, res.Results[0].Success, res.Results[0].Error)
+	require.True(t, res.Results[1].Success, res.Results[1].Error)
+	res, err = batch(repeated, true)
+	require.NoError(t, err)
+	for _, result := range res.Results {
+		require.False(t, result.Success)
+		require.Contains(t, result.Error, types.ErrClaimSequence.Error())
+	}
+	require.Equal(t, int64(20), balance(relayer))
+
+	// 治理将上限设为 0 时关闭批量领取
+	params.MaxBatchClaimSize = 0
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	_, err = batch([]types.BatchClaimEntry{{TaskId: "alpha", Recipient: bob, Amount: "10dtc"}}, true)
+	require.ErrorIs(t, err, types.ErrInvalidBatch)
+}
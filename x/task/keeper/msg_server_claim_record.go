@@ -4,100 +4,65 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 
 	"dtc/x/task/types"
 
 	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dtctypes "dtc/x/dtc/types"
 )
 
-func (k msgServer) CreateClaimRecord(ctx context.Context, msg *types.MsgCreateClaimRecord) (*types.MsgCreateClaimRecordResponse, error) {
+// RevokeClaimRecord 将领取记录标记为已撤销。记录保留在账本中，因此撤销不会让同一领取再次可用。
+// 调用者须持有以任务 ID 为范围（或全局）的 ROLE_CLAIM_REVOKER 角色
+func (k msgServer) RevokeClaimRecord(ctx context.Context, msg *types.MsgRevokeClaimRecord) (*types.MsgRevokeClaimRecordResponse, error) {
 	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid address: %s", err))
-	}
-
-	// Check if the value already exists
-	ok, err := k.ClaimRecord.Has(ctx, msg.ClaimHash)
-	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
-	} else if ok {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "index already set")
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
 	}
-
-	var claimRecord = types.ClaimRecord{
-		Creator:   msg.Creator,
-		ClaimHash: msg.ClaimHash,
-		TaskId:    msg.TaskId,
-		UserId:    msg.UserId,
-		Signature: msg.Signature,
+	reason := strings.TrimSpace(msg.Reason)
+	if reason == "" {
+		return nil, errorsmod.Wrap(types.ErrInvalidReason, "reason cannot be empty")
 	}
-
-	if err := k.ClaimRecord.Set(ctx, claimRecord.ClaimHash, claimRecord); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	if len(reason) > types.MaxRevokeReasonLength {
+		return nil, errorsmod.Wrapf(types.ErrInvalidReason, "reason exceeds %d characters", types.MaxRevokeReasonLength)
 	}
 
-	return &types.MsgCreateClaimRecordResponse{}, nil
-}
-
-func (k msgServer) UpdateClaimRecord(ctx context.Context, msg *types.MsgUpdateClaimRecord) (*types.MsgUpdateClaimRecordResponse, error) {
-	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
-	}
-
-	// Check if the value exists
-	val, err := k.ClaimRecord.Get(ctx, msg.ClaimHash)
+	record, err := k.ClaimRecord.Get(ctx, msg.ClaimHash)
 	if err != nil {
 		if errors.Is(err, collections.ErrNotFound) {
-			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
+			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "claim record not found")
 		}
-
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
-
-	// Checks if the msg creator is the same as the current owner
-	if msg.Creator != val.Creator {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
-	}
-
-	var claimRecord = types.ClaimRecord{
-		Creator:   msg.Creator,
-		ClaimHash: msg.ClaimHash,
-		TaskId:    msg.TaskId,
-		UserId:    msg.UserId,
-		Signature: msg.Signature,
+	if !k.roleKeeper.HasRole(ctx, dtctypes.ROLE_CLAIM_REVOKER, msg.Creator, record.TaskId) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s does not hold the claim revoker role for task %s", msg.Creator, record.TaskId)
 	}
-
-	if err := k.ClaimRecord.Set(ctx, claimRecord.ClaimHash, claimRecord); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to update claimRecord")
-	}
-
-	return &types.MsgUpdateClaimRecordResponse{}, nil
-}
-
-func (k msgServer) DeleteClaimRecord(ctx context.Context, msg *types.MsgDeleteClaimRecord) (*types.MsgDeleteClaimRecordResponse, error) {
-	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	if record.Revoked {
+		return nil, errorsmod.Wrap(types.ErrClaimRevoked, msg.ClaimHash)
 	}
 
-	// Check if the value exists
-	val, err := k.ClaimRecord.Get(ctx, msg.ClaimHash)
-	if err != nil {
-		if errors.Is(err, collections.ErrNotFound) {
-			return nil, errorsmod.Wrap(sdkerrors.ErrKeyNotFound, "index not set")
-		}
-
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	record.Revoked = true
+	record.RevokedBy = msg.Creator
+	record.RevokeReason = reason
+	record.RevokedHeight = sdkCtx.BlockHeight()
+	if err := k.ClaimRecord.Set(ctx, record.ClaimHash, record); err != nil {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
 	}
 
-	// Checks if the msg creator is the same as the current owner
-	if msg.Creator != val.Creator {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "incorrect owner")
-	}
-
-	if err := k.ClaimRecord.Remove(ctx, msg.ClaimHash); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "failed to remove claimRecord")
-	}
-
-	return &types.MsgDeleteClaimRecordResponse{}, nil
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimRecordRevoked,
+			sdk.NewAttribute(types.AttributeKeyClaimHash, record.ClaimHash),
+			sdk.NewAttribute(types.AttributeKeyTaskId, record.TaskId),
+			sdk.NewAttribute(types.AttributeKeyRecipient, record.UserId),
+			sdk.NewAttribute(types.AttributeKeyRevoker, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+
+	return &types.MsgRevokeClaimRecordResponse{}, nil
 }
@@ -1,157 +1,85 @@
 package keeper_test
 
 import (
-	"strconv"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
+	dtctypes "dtc/x/dtc/types"
 	"dtc/x/task/keeper"
 	"dtc/x/task/types"
 )
 
-func TestClaimRecordMsgServerCreate(t *testing.T) {
-	f := initFixture(t)
-	srv := keeper.NewMsgServerImpl(f.keeper)
-	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
-	require.NoError(t, err)
-
-	for i := 0; i < 5; i++ {
-		expected := &types.MsgCreateClaimRecord{Creator: creator,
-			ClaimHash: strconv.Itoa(i),
-		}
-		_, err := srv.CreateClaimRecord(f.ctx, expected)
-		require.NoError(t, err)
-		rst, err := f.keeper.ClaimRecord.Get(f.ctx, expected.ClaimHash)
-		require.NoError(t, err)
-		require.Equal(t, expected.Creator, rst.Creator)
-	}
-}
-
-func TestClaimRecordMsgServerUpdate(t *testing.T) {
-	f := initFixture(t)
+func TestRevokeClaimRecord(t *testing.T) {
+	f := initClaimRewardFixture(t)
 	srv := keeper.NewMsgServerImpl(f.keeper)
 
-	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	owner, err := f.addressCodec.BytesToString([]byte("taskOwner__________________"))
 	require.NoError(t, err)
-
-	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
+	revoker, err := f.addressCodec.BytesToString([]byte("revoker____________________"))
+	require.NoError(t, err)
+	otherRevoker, err := f.addressCodec.BytesToString([]byte("otherRevoker_______________"))
 	require.NoError(t, err)
 
-	expected := &types.MsgCreateClaimRecord{Creator: creator,
-		ClaimHash: strconv.Itoa(0),
-	}
-	_, err = srv.CreateClaimRecord(f.ctx, expected)
+	createTestTask(t, f, srv, owner, "ledger", "10dtc", "100dtc")
+	_, err = srv.ClaimReward(f.ctx, f.signedClaim(t, &types.MsgClaimReward{Creator: owner, TaskId: "ledger", Amount: "10dtc"}))
 	require.NoError(t, err)
 
+	hash := sha256.Sum256([]byte("ledger" + owner))
+	claimHash := hex.EncodeToString(hash[:])
+
+	f.roleKeeper.roles[fmt.Sprintf("%s/%s/%s", dtctypes.ROLE_CLAIM_REVOKER, revoker, "ledger")] = true
+	f.roleKeeper.roles[fmt.Sprintf("%s/%s/%s", dtctypes.ROLE_CLAIM_REVOKER, otherRevoker, "other-task")] = true
+
 	tests := []struct {
-		desc    string
-		request *types.MsgUpdateClaimRecord
-		err     error
+		desc string
+		msg  types.MsgRevokeClaimRecord
+		err  error
 	}{
-		{
-			desc: "invalid address",
-			request: &types.MsgUpdateClaimRecord{Creator: "invalid",
-				ClaimHash: strconv.Itoa(0),
-			},
-			err: sdkerrors.ErrInvalidAddress,
-		},
-		{
-			desc: "unauthorized",
-			request: &types.MsgUpdateClaimRecord{Creator: unauthorizedAddr,
-				ClaimHash: strconv.Itoa(0),
-			},
-			err: sdkerrors.ErrUnauthorized,
-		},
-		{
-			desc: "key not found",
-			request: &types.MsgUpdateClaimRecord{Creator: creator,
-				ClaimHash: strconv.Itoa(100000),
-			},
-			err: sdkerrors.ErrKeyNotFound,
-		},
-		{
-			desc: "completed",
-			request: &types.MsgUpdateClaimRecord{Creator: creator,
-				ClaimHash: strconv.Itoa(0),
-			},
-		},
+		{desc: "invalid signer", msg: types.MsgRevokeClaimRecord{Creator: "invalid", ClaimHash: claimHash, Reason: "fraud"}, err: sdkerrors.ErrInvalidAddress},
+		{desc: "empty reason", msg: types.MsgRevokeClaimRecord{Creator: revoker, ClaimHash: claimHash, Reason: "  "}, err: types.ErrInvalidReason},
+		{desc: "reason too long", msg: types.MsgRevokeClaimRecord{Creator: revoker, ClaimHash: claimHash, Reason: strings.Repeat("x", types.MaxRevokeReasonLength+1)}, err: types.ErrInvalidReason},
+		{desc: "not found", msg: types.MsgRevokeClaimRecord{Creator: revoker, ClaimHash: "missing", Reason: "fraud"}, err: sdkerrors.ErrKeyNotFound},
+		{desc: "task owner without role", msg: types.MsgRevokeClaimRecord{Creator: owner, ClaimHash: claimHash, Reason: "fraud"}, err: sdkerrors.ErrUnauthorized},
+		{desc: "role scoped to another task", msg: types.MsgRevokeClaimRecord{Creator: otherRevoker, ClaimHash: claimHash, Reason: "fraud"}, err: sdkerrors.ErrUnauthorized},
+		{desc: "revoked", msg: types.MsgRevokeClaimRecord{Creator: revoker, ClaimHash: claimHash, Reason: "fraud"}},
+		{desc: "already revoked", msg: types.MsgRevokeClaimRecord{Creator: revoker, ClaimHash: claimHash, Reason: "again"}, err: types.ErrClaimRevoked},
 	}
 	for _, tc := range tests {
 		t.Run(tc.desc, func(t *testing.T) {
-			_, err = srv.UpdateClaimRecord(f.ctx, tc.request)
+			_, err := srv.RevokeClaimRecord(f.ctx, &tc.msg)
 			if tc.err != nil {
 				require.ErrorIs(t, err, tc.err)
-			} else {
-				require.NoError(t, err)
-				rst, err := f.keeper.ClaimRecord.Get(f.ctx, expected.ClaimHash)
-				require.NoError(t, err)
-				require.Equal(t, expected.Creator, rst.Creator)
+				return
 			}
+			require.NoError(t, err)
 		})
 	}
-}
-
-func TestClaimRecordMsgServerDelete(t *testing.T) {
-	f := initFixture(t)
-	srv := keeper.NewMsgServerImpl(f.keeper)
 
-	creator, err := f.addressCodec.BytesToString([]byte("signerAddr__________________"))
+	record, err := f.keeper.ClaimRecord.Get(f.ctx, claimHash)
 	require.NoError(t, err)
+	require.True(t, record.Revoked)
+	require.Equal(t, revoker, record.RevokedBy)
+	require.Equal(t, "fraud", record.RevokeReason)
 
-	unauthorizedAddr, err := f.addressCodec.BytesToString([]byte("unauthorizedAddr___________"))
-	require.NoError(t, err)
-
-	_, err = srv.CreateClaimRecord(f.ctx, &types.MsgCreateClaimRecord{Creator: creator,
-		ClaimHash: strconv.Itoa(0),
-	})
-	require.NoError(t, err)
-
-	tests := []struct {
-		desc    string
-		request *types.MsgDeleteClaimRecord
-		err     error
-	}{
-		{
-			desc: "invalid address",
-			request: &types.MsgDeleteClaimRecord{Creator: "invalid",
-				ClaimHash: strconv.Itoa(0),
-			},
-			err: sdkerrors.ErrInvalidAddress,
-		},
-		{
-			desc: "unauthorized",
-			request: &types.MsgDeleteClaimRecord{Creator: unauthorizedAddr,
-				ClaimHash: strconv.Itoa(0),
-			},
-			err: sdkerrors.ErrUnauthorized,
-		},
-		{
-			desc: "key not found",
-			request: &types.MsgDeleteClaimRecord{Creator: creator,
-				ClaimHash: strconv.Itoa(100000),
-			},
-			err: sdkerrors.ErrKeyNotFound,
-		},
-		{
-			desc: "completed",
-			request: &types.MsgDeleteClaimRecord{Creator: creator,
-				ClaimHash: strconv.Itoa(0),
-			},
-		},
-	}
-	for _, tc := range tests {
-		t.Run(tc.desc, func(t *testing.T) {
-			_, err = srv.DeleteClaimRecord(f.ctx, tc.request)
-			if tc.err != nil {
-				require.ErrorIs(t, err, tc.err)
-			} else {
-				require.NoError(t, err)
-				found, err := f.keeper.ClaimRecord.Has(f.ctx, tc.request.ClaimHash)
-				require.NoError(t, err)
-				require.False(t, found)
-			}
-		})
+	var found bool
+	for _, event := range sdk.UnwrapSDKContext(f.ctx).EventManager().Events() {
+		if event.Type == types.EventTypeClaimRecordRevoked {
+			found = true
+			reason, ok := event.GetAttribute(types.AttributeKeyReason)
+			require.True(t, ok)
+			require.Equal(t, "fraud", reason.Value)
+		}
 	}
+	require.True(t, found)
+
+	// 撤销后的记录仍然阻止同一用户再次领取
+	_, err = srv.ClaimReward(f.ctx, f.signedClaim(t, &types.MsgClaimReward{Creator: owner, TaskId: "ledger", Amount: "10dtc"}))
+	require.ErrorIs(t, err, types.ErrClaimLimitReached)
 }
@@ -2,24 +2,23 @@ package keeper
 
 import (
 	"context"
-	"crypto/ecdsa"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
 	"fmt"
-	"math/big"
+	"strconv"
 
 	"dtc/x/task/types"
 
+	"cosmossdk.io/collections"
 	errorsmod "cosmossdk.io/errors"
-	"github.com/cometbft/cometbft/crypto/secp256k1"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
-	secp256k1lib "github.com/ethereum/go-ethereum/crypto/secp256k1"
 )
 
-// const defaultOraclePubKeyHex = "020000000000000000000000000000000000000000000000000000000000000001"
-const defaultOraclePubKeyHex = "03555db1e9893d6bafff7c3afdb62ddb99cf2f073d25144701966607f63e561a38"
+// claimVerifier 在领取的前置校验通过后验证预言机签名，recipient 为实际接收奖金的用户地址，
+// sequence 为领取人此前已领取该任务的次数
+type claimVerifier func(task types.Task, recipient string, sequence uint64) error
 
 func (k msgServer) ClaimReward(ctx context.Context, msg *types.MsgClaimReward) (*types.MsgClaimRewardResponse, error) {
 	// 验证 creator 地址格式（creator 是中台地址，用于发起交易）
@@ -27,126 +26,177 @@ func (k msgServer) ClaimReward(ctx context.Context, msg *types.MsgClaimReward) (
 		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
 	}
 
+	res, _, err := k.claimReward(ctx, msg.Creator, msg.TaskId, msg.Recipient, msg.DependentDid, msg.Amount, msg.Signature, func(task types.Task, recipient string, sequence uint64) error {
+		// 3. 签名验证：验证 signature 满足任务预言机集合的阈值，轮换重叠期内旧集合同样有效
+		// 构造待验证数据：taskID + recipient + dependentDid + amount [+ "/" + 领取序号]，未代被监护人领取时 dependentDid 为空
+		return k.verifyClaimSignature(ctx, task, types.ClaimSignBytes(msg.TaskId, recipient, msg.DependentDid, msg.Amount, sequence), msg.Signature)
+	})
+	return res, err
+}
+
+// verifyClaimSignature 验证预言机对领取数据的签名，链上判定任务只能通过 ClaimNative 领取
+func (k Keeper) verifyClaimSignature(ctx context.Context, task types.Task, data, signature string) error {
+	if task.IsNative() {
+		return errorsmod.Wrap(types.ErrNativeTask, task.Id)
+	}
+	return k.verifyOracleSignatures(ctx, task, data, signature)
+}
+
+// claimReward 执行一次领取：校验任务与领取条件、通过 verify 验证签名、扣减托管并发放奖金，返回领取哈希。
+// 单笔领取与批量领取共用该流程，签名的构造方式由调用方决定。
+// dependentDid 非空时由监护人代被监护人领取，领取次数与领取记录按被监护 DID 计算，奖金仍发给 recipient
+func (k msgServer) claimReward(ctx context.Context, creator, taskId, recipient, dependentDid, amountStr, signature string, verify claimVerifier) (*types.MsgClaimRewardResponse, string, error) {
 	// 确定接收奖金的用户地址：如果提供了 recipient，使用 recipient；否则使用 creator
-	recipientAddrStr := msg.Recipient
+	recipientAddrStr := recipient
 	if recipientAddrStr == "" {
-		recipientAddrStr = msg.Creator
+		recipientAddrStr = creator
 	}
-	recipientAddr, err := k.addressCodec.StringToBytes(recipientAddrStr)
-	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid recipient address: %s", err))
+	if _, err := k.addressCodec.StringToBytes(recipientAddrStr); err != nil {
+		return nil, "", errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid recipient address: %s", err))
 	}
 
-	// 读取参数中的 admin 公钥（hex 编码），若为空则使用默认公钥
-	params, err := k.Params.Get(ctx)
+	// 0. 任务校验：任务必须已登记、处于开放状态且在领取窗口内
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	task, err := k.getTask(ctx, taskId)
 	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load params: %s", err))
+		return nil, "", err
 	}
-	adminPubKeyHex := params.AdminPubkey
-	//if adminPubKeyHex == "" {
-	adminPubKeyHex = defaultOraclePubKeyHex
-	//}
-	adminPubKeyBytes, err := hex.DecodeString(adminPubKeyHex)
-	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid admin pubkey hex: %s", err))
+	if !task.IsOpen() || task.IsExpired(sdkCtx.BlockHeight()) {
+		return nil, "", errorsmod.Wrap(types.ErrTaskClosed, task.Id)
+	}
+	if sdkCtx.BlockHeight() < task.StartHeight {
+		return nil, "", errorsmod.Wrapf(types.ErrTaskNotStarted, "task %s starts at height %d", task.Id, task.StartHeight)
+	}
+	if task.IsExhausted() {
+		return nil, "", errorsmod.Wrap(types.ErrTaskExhausted, task.Id)
+	}
+
+	// 领取人：通常为接收地址，代被监护人领取时为被监护 DID
+	claimant := recipientAddrStr
+	if dependentDid != "" {
+		claimant = dependentDid
 	}
-	if len(adminPubKeyBytes) != 33 {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "invalid admin pubkey length; must be 33 bytes compressed secp256k1 key")
+	userClaimKey := collections.Join(task.Id, claimant)
+	userClaims, err := k.UserClaimCount.Get(ctx, userClaimKey)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, "", errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load claim count: %s", err))
+	}
+	if userClaims >= task.UserLimit() {
+		return nil, "", errorsmod.Wrapf(types.ErrClaimLimitReached, "%s has claimed task %s %d times", claimant, task.Id, userClaims)
+	}
+
+	// 身份条件：要求 DID 的任务按 DID 而非地址限制领取次数
+	did, err := k.checkEligibility(ctx, task, recipientAddrStr, dependentDid)
+	if err != nil {
+		return nil, "", err
 	}
-	var adminPubKey secp256k1.PubKey
-	copy(adminPubKey[:], adminPubKeyBytes)
 
-	// 1. 构造哈希：将 taskID 和 recipient（实际接收奖金的用户地址）拼接并进行 SHA256 哈希
-	data := msg.TaskId + recipientAddrStr
+	// 1. 构造哈希：将 taskID 和领取人（实际接收奖金的用户地址或被监护 DID）拼接并进行 SHA256 哈希
+	// 同一用户的后续领取在末尾追加领取序号，保持首次领取的哈希与历史记录一致
+	data := taskId + claimant
+	if userClaims > 0 {
+		data += "/" + strconv.FormatUint(userClaims, 10)
+	}
 	hash := sha256.Sum256([]byte(data))
 	claimHash := hex.EncodeToString(hash[:])
 
 	// 2. 查重：检查 k.ClaimRecord 是否已存在该哈希
 	exists, err := k.ClaimRecord.Has(ctx, claimHash)
 	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to check claim record: %s", err))
+		return nil, "", errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to check claim record: %s", err))
 	}
 	if exists {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "already claimed")
+		return nil, "", errorsmod.Wrap(types.ErrClaimLimitReached, "claim record already exists")
 	}
 
-	// 3. 签名验证（模拟预言机）：验证 signature 是否由"业务中台"的公钥签发
-	// 集成测试跳过：如果 signature 等于 "7369676e6174757265"（"signature" 的十六进制），则跳过验证
-	const integrationTestSignature = "7369676e6174757265"
-	if msg.Signature != integrationTestSignature {
-		// 将十六进制字符串签名解码为字节
-		signatureBytes, err := hex.DecodeString(msg.Signature)
-		if err != nil {
-			return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid signature format: %s", err))
-		}
-
-		// 构造待验证数据：taskID + recipient + amount，先进行 SHA256 哈希
-		data := msg.TaskId + recipientAddrStr + msg.Amount
-		hash := sha256.Sum256([]byte(data))
-		hashBytes := hash[:]
-
-		// 使用底层 ECDSA 验证方法，因为我们已经手动进行了 SHA256 哈希
-		// VerifySignature 可能会再次哈希，所以我们需要直接使用 ECDSA 验证
-		if len(signatureBytes) != 64 {
-			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "invalid signature length")
-		}
-
-		// 解析公钥
-		// adminPubKeyBytes 已经是 33 字节的压缩公钥，直接使用
-
-		// 解压缩公钥得到 X, Y 坐标
-		x, y := secp256k1lib.DecompressPubkey(adminPubKeyBytes)
-		if x == nil || y == nil {
-			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "failed to decompress public key")
-		}
-
-		// 创建 ECDSA 公钥
-		pubKey := &ecdsa.PublicKey{
-			Curve: secp256k1lib.S256(),
-			X:     x,
-			Y:     y,
-		}
-
-		// 解析签名 R || S
-		r := new(big.Int).SetBytes(signatureBytes[:32])
-		s := new(big.Int).SetBytes(signatureBytes[32:64])
-
-		// 验证签名（使用已哈希的数据）
-		valid := ecdsa.Verify(pubKey, hashBytes, r, s)
-		if !valid {
-			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "invalid oracle signature")
-		}
+	// 3. 签名验证
+	if err := verify(task, recipientAddrStr, userClaims); err != nil {
+		return nil, "", err
 	}
 
 	// 4. 资金发放：调用 k.bankKeeper.SendCoinsFromModuleToAccount 向用户发放 DTC 代币
 	// 解析 amount 字符串为 sdk.Coins
-	amount, err := sdk.ParseCoinsNormalized(msg.Amount)
+	amount, err := sdk.ParseCoinsNormalized(amountStr)
 	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, fmt.Sprintf("invalid amount format: %s", err))
+		return nil, "", errorsmod.Wrap(sdkerrors.ErrInvalidCoins, fmt.Sprintf("invalid amount format: %s", err))
+	}
+	if !amount.Equal(sdk.NewCoins(task.RewardPerClaim)) {
+		return nil, "", errorsmod.Wrapf(types.ErrAmountMismatch, "expected %s, got %s", task.RewardPerClaim, amount)
 	}
 
-	// 获取模块账户地址
-	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
-	recipientAccAddr := sdk.AccAddress(recipientAddr)
+	// 奖励只能从任务自身的托管资金中支付
+	escrow, err := k.getEscrow(ctx, task)
+	if err != nil {
+		return nil, "", errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load escrow: %s", err))
+	}
+	if escrow.IsLT(task.RewardPerClaim) {
+		return nil, "", errorsmod.Wrapf(types.ErrInsufficientEscrow, "task %s escrow %s is below reward %s", task.Id, escrow, task.RewardPerClaim)
+	}
 
-	// 从模块账户向用户账户发送代币（中台代办领奖，奖金转入用户地址）
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAccAddr, amount); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to send coins from module %s to account %s: %s", moduleAddr.String(), recipientAddrStr, err))
+	// 治理设定的滚动窗口限额：接收用户、提交者（运营方）按最近 PayoutEpochBlocks 个区块的滚动窗口限额，全局按区块限额
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, "", errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load params: %s", err))
+	}
+	if err := k.checkPayoutLimits(ctx, params, recipientAddrStr, creator, task.RewardPerClaim); err != nil {
+		return nil, "", err
 	}
 
-	// 5. 记录存证：成功后调用 k.ClaimRecord.Set
-	// UserId 使用实际接收奖金的用户地址（recipient 或 creator）
+	// 从模块账户发放奖金（中台代办领奖，奖金转入用户地址或其奖励流）。
+	// 任务设置了争议期时奖金先作为待定奖励留在模块账户，争议期结束后由 EndBlocker 发放
+	streamId, releaseHeight, err := k.payout(ctx, task, recipientAddrStr, claimHash, task.RewardPerClaim)
+	if err != nil {
+		return nil, "", errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	if err := k.setEscrow(ctx, task.Id, escrow.Sub(task.RewardPerClaim)); err != nil {
+		return nil, "", errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to update escrow: %s", err))
+	}
+	if err := k.recordPayout(ctx, params, recipientAddrStr, creator, task.RewardPerClaim); err != nil {
+		return nil, "", errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to record payout: %s", err))
+	}
+
+	// 5. 记录存证：成功后追加领取记录
+	// UserId 使用领取人：实际接收奖金的用户地址（recipient 或 creator），代被监护人领取时为被监护 DID
 	claimRecord := types.ClaimRecord{
-		ClaimHash: claimHash,
-		TaskId:    msg.TaskId,
-		UserId:    recipientAddrStr,
-		Signature: msg.Signature,
-		Creator:   msg.Creator,
+		ClaimHash:   claimHash,
+		TaskId:      taskId,
+		UserId:      claimant,
+		Signature:   signature,
+		Creator:     creator,
+		Amount:      task.RewardPerClaim,
+		BlockTime:   sdkCtx.BlockTime().Unix(),
+		BlockHeight: sdkCtx.BlockHeight(),
+		Did:         did,
 	}
 
-	if err := k.ClaimRecord.Set(ctx, claimHash, claimRecord); err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to set claim record: %s", err))
+	if err := k.appendClaimRecord(ctx, claimRecord); err != nil {
+		return nil, "", errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to set claim record: %s", err))
+	}
+
+	// 6. 更新任务：累计领取次数并扣减剩余预算，耗尽时自动关闭
+	task.ClaimCount++
+	task.RemainingBudget = task.RemainingBudget.Sub(task.RewardPerClaim)
+	if err := k.Task.Set(ctx, task.Id, task); err != nil {
+		return nil, "", errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to update task: %s", err))
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTaskClaimed,
+			sdk.NewAttribute(types.AttributeKeyTaskId, task.Id),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipientAddrStr),
+			sdk.NewAttribute(types.AttributeKeyAmount, task.RewardPerClaim.String()),
+			sdk.NewAttribute(types.AttributeKeyRemainingBudget, task.RemainingBudget.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimCount, strconv.FormatUint(task.ClaimCount, 10)),
+		),
+	)
+
+	if task.IsExhausted() {
+		if err := k.closeTask(ctx, task, types.AttributeValueReasonExhausted); err != nil {
+			return nil, "", errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to close task: %s", err))
+		}
 	}
 
-	return &types.MsgClaimRewardResponse{}, nil
+	return &types.MsgClaimRewardResponse{StreamId: streamId, ReleaseHeight: releaseHeight}, claimHash, nil
 }
@@ -4,14 +4,18 @@ import (
 	"context"
 	"crypto/sha256"
 	"encoding/hex"
+	"errors"
+	"fmt"
 	"sync"
 	"testing"
 
+	dtctypes "dtc/x/dtc/types"
 	"dtc/x/task/keeper"
 	"dtc/x/task/types"
 
 	module "dtc/x/task/module"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cometbft/cometbft/crypto/secp256k1"
@@ -25,15 +29,17 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-// trackableBankKeeper 是一个可以跟踪余额变化的 mock BankKeeper
+// trackableBankKeeper 是一个可以跟踪余额变化的 mock BankKeeper，blocked 中的地址拒绝收款
 type trackableBankKeeper struct {
 	mu              sync.Mutex
 	accountBalances map[string]sdk.Coins
+	blocked         map[string]bool
 }
 
 func newTrackableBankKeeper() *trackableBankKeeper {
 	return &trackableBankKeeper{
 		accountBalances: make(map[string]sdk.Coins),
+		blocked:         make(map[string]bool),
 	}
 }
 
@@ -47,17 +53,43 @@ func (m *trackableBankKeeper) SpendableCoins(ctx context.Context, addr sdk.AccAd
 }
 
 func (m *trackableBankKeeper) SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return m.send(authtypes.NewModuleAddress(senderModule), recipientAddr, amt)
+}
+
+func (m *trackableBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return m.send(senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (m *trackableBankKeeper) GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.GetBalance(addr)
+}
+
+// send 在两个地址间转账，余额不足时返回错误
+func (m *trackableBankKeeper) send(from, to sdk.AccAddress, amt sdk.Coins) error {
 	m.mu.Lock()
 	defer m.mu.Unlock()
 
+	if m.blocked[to.String()] {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s is not allowed to receive funds", to)
+	}
+	remaining, hasNeg := m.accountBalances[from.String()].SafeSub(amt...)
+	if hasNeg {
+		return sdkerrors.ErrInsufficientFunds
+	}
+	m.accountBalances[from.String()] = remaining
 	// 增加接收账户的余额
-	currentBalance := m.accountBalances[recipientAddr.String()]
-	newBalance := currentBalance.Add(amt...)
-	m.accountBalances[recipientAddr.String()] = newBalance
+	m.accountBalances[to.String()] = m.accountBalances[to.String()].Add(amt...)
 
 	return nil
 }
 
+// mint 直接为账户增加余额
+func (m *trackableBankKeeper) mint(addr sdk.AccAddress, amt sdk.Coins) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.accountBalances[addr.String()] = m.accountBalances[addr.String()].Add(amt...)
+}
+
 func (m *trackableBankKeeper) GetBalance(addr sdk.AccAddress) sdk.Coins {
 	m.mu.Lock()
 	defer m.mu.Unlock()
@@ -73,6 +105,9 @@ type claimRewardFixture struct {
 	keeper       keeper.Keeper
 	addressCodec address.Codec
 	bankKeeper   *trackableBankKeeper
+	roleKeeper   *mockRoleKeeper
+	identity     *mockIdentityKeeper
+	credit       *mockCreditKeeper
 	privKey      secp256k1.PrivKey
 	pubKey       secp256k1.PubKey
 }
@@ -112,6 +147,9 @@ func initClaimRewardFixture(t *testing.T) *claimRewardFixture {
 
 	// 创建可跟踪余额的 bankKeeper
 	bankKeeper := newTrackableBankKeeper()
+	roleKeeper := &mockRoleKeeper{roles: map[string]bool{}}
+	identityKeeper := newMockIdentityKeeper()
+	creditKeeper := newMockCreditKeeper(bankKeeper)
 
 	k := keeper.NewKeeper(
 		storeService,
@@ -119,23 +157,103 @@ func initClaimRewardFixture(t *testing.T) *claimRewardFixture {
 		addressCodec,
 		authority,
 		bankKeeper,
+		roleKeeper,
+		identityKeeper,
+		creditKeeper,
 	)
 
 	// Initialize params
-	params := types.DefaultParams()
-	params.AdminPubkey = hex.EncodeToString(pubKey.Bytes())
-	if err := k.Params.Set(ctx, params); err != nil {
+	if err := k.Params.Set(ctx, types.DefaultParams()); err != nil {
 		t.Fatalf("failed to set params: %v", err)
 	}
 
-	return &claimRewardFixture{
+	f := &claimRewardFixture{
 		ctx:          ctx,
 		keeper:       k,
 		addressCodec: addressCodec,
 		bankKeeper:   bankKeeper,
+		roleKeeper:   roleKeeper,
+		identity:     identityKeeper,
+		credit:       creditKeeper,
 		privKey:      privKey,
 		pubKey:       pubKey,
 	}
+	f.grantOracle(t, privKey)
+	return f
+}
+
+// grantOracle 授予 key 对应账户全局预言机角色
+func (f *claimRewardFixture) grantOracle(t *testing.T, key secp256k1.PrivKey) {
+	t.Helper()
+	address, err := f.addressCodec.BytesToString(key.PubKey().Address())
+	require.NoError(t, err)
+	f.roleKeeper.roles[fmt.Sprintf("%s/%s/", dtctypes.ROLE_ORACLE, address)] = true
+}
+
+// createTestTask 登记一个从当前高度起开放、不设截止高度的任务，并由 owner 托管全部预算
+func createTestTask(t *testing.T, f *claimRewardFixture, srv types.MsgServer, owner, taskID, reward, budget string) {
+	t.Helper()
+
+	rewardCoin, err := sdk.ParseCoinNormalized(reward)
+	require.NoError(t, err)
+	budgetCoin, err := sdk.ParseCoinNormalized(budget)
+	require.NoError(t, err)
+
+	_, err = srv.CreateTask(f.ctx, &types.MsgCreateTask{
+		Creator:        owner,
+		TaskId:         taskID,
+		RewardPerClaim: rewardCoin,
+		Budget:         budgetCoin,
+		OraclePubkeys:  f.oraclePubkeys(),
+	})
+	require.NoError(t, err)
+
+	fundTestTask(t, f, srv, owner, taskID, budgetCoin)
+}
+
+// claimSequence 返回 claimant 此前已领取 taskID 的次数，即下一次领取签名使用的序号
+func (f *claimRewardFixture) claimSequence(t *testing.T, taskID, claimant string) uint64 {
+	t.Helper()
+	sequence, err := f.keeper.UserClaimCount.Get(f.ctx, collections.Join(taskID, claimant))
+	if errors.Is(err, collections.ErrNotFound) {
+		return 0
+	}
+	require.NoError(t, err)
+	return sequence
+}
+
+// signedClaim 用 fixture 预言机私钥按领取人当前的领取序号为 msg 签名后返回 msg
+func (f *claimRewardFixture) signedClaim(t *testing.T, msg *types.MsgClaimReward) *types.MsgClaimReward {
+	t.Helper()
+	recipient := msg.Recipient
+	if recipient == "" {
+		recipient = msg.Creator
+	}
+	claimant := recipient
+	if msg.DependentDid != "" {
+		claimant = msg.DependentDid
+	}
+	data := types.ClaimSignBytes(msg.TaskId, recipient, msg.DependentDid, msg.Amount, f.claimSequence(t, msg.TaskId, claimant))
+	signature, err := generateSignature(f.privKey, []byte(data))
+	require.NoError(t, err)
+	msg.Signature = signature
+	return msg
+}
+
+// oraclePubkeys 返回以 fixture 公钥组成的单签预言机集合
+func (f *claimRewardFixture) oraclePubkeys() []string {
+	return []string{hex.EncodeToString(f.pubKey.Bytes())}
+}
+
+// fundTestTask 为 owner 铸造资金并注入任务托管
+func fundTestTask(t *testing.T, f *claimRewardFixture, srv types.MsgServer, owner, taskID string, amount sdk.Coin) {
+	t.Helper()
+
+	ownerAddr, err := f.addressCodec.StringToBytes(owner)
+	require.NoError(t, err)
+	f.bankKeeper.mint(ownerAddr, sdk.NewCoins(amount))
+	_, err = srv.FundTask(f.ctx, &types.MsgFundTask{Creator: owner, TaskId: taskID, Amount: amount})
+	require.NoError(t, err)
 }
 
 // generateSignature 使用私钥对数据进行签名
@@ -158,17 +276,14 @@ func TestClaimReward_FirstClaimSuccess(t *testing.T) {
 
 	taskID := "task-123"
 	amount := "1000dtc"
+	createTestTask(t, f, srv, creator, taskID, amount, "10000dtc")
 
-	// 使用集成测试签名常量，绕过签名校验
-	signature := "7369676e6174757265"
-
-	// 创建消息
-	msg := &types.MsgClaimReward{
-		Creator:   creator,
-		TaskId:    taskID,
-		Amount:    amount,
-		Signature: signature,
-	}
+	// 创建由任务预言机签名的消息
+	msg := f.signedClaim(t, &types.MsgClaimReward{
+		Creator: creator,
+		TaskId:  taskID,
+		Amount:  amount,
+	})
 
 	// 获取初始余额
 	creatorAddr, err := f.addressCodec.StringToBytes(creator)
@@ -176,6 +291,15 @@ func TestClaimReward_FirstClaimSuccess(t *testing.T) {
 	initialBalance := f.bankKeeper.GetBalance(sdk.AccAddress(creatorAddr))
 	require.True(t, initialBalance.IsZero(), "初始余额应该为零")
 
+	// 未经任务预言机签名的领取被拒绝
+	_, err = srv.ClaimReward(f.ctx, &types.MsgClaimReward{
+		Creator:   creator,
+		TaskId:    taskID,
+		Amount:    amount,
+		Signature: hex.EncodeToString([]byte("signature")),
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
 	// 执行 ClaimReward
 	_, err = srv.ClaimReward(f.ctx, msg)
 	require.NoError(t, err, "首次领取应该成功")
@@ -201,7 +325,7 @@ func TestClaimReward_FirstClaimSuccess(t *testing.T) {
 	require.Equal(t, taskID, claimRecord.TaskId)
 	require.Equal(t, creator, claimRecord.UserId)
 	require.Equal(t, creator, claimRecord.Creator)
-	require.Equal(t, signature, claimRecord.Signature)
+	require.Equal(t, msg.Signature, claimRecord.Signature)
 }
 
 // TestClaimReward_DuplicateClaim 测试重复领取的场景
@@ -215,17 +339,14 @@ func TestClaimReward_DuplicateClaim(t *testing.T) {
 
 	taskID := "task-456"
 	amount := "2000dtc"
+	createTestTask(t, f, srv, creator, taskID, amount, "10000dtc")
 
-	// 使用集成测试签名常量，绕过签名校验
-	signature := "7369676e6174757265"
-
-	// 创建消息
-	msg := &types.MsgClaimReward{
-		Creator:   creator,
-		TaskId:    taskID,
-		Amount:    amount,
-		Signature: signature,
-	}
+	// 创建由任务预言机签名的消息
+	msg := f.signedClaim(t, &types.MsgClaimReward{
+		Creator: creator,
+		TaskId:  taskID,
+		Amount:  amount,
+	})
 
 	// 首次领取应该成功
 	_, err = srv.ClaimReward(f.ctx, msg)
@@ -242,7 +363,7 @@ func TestClaimReward_DuplicateClaim(t *testing.T) {
 	// 再次使用相同的 TaskID 和 Creator 领取，应该失败
 	_, err = srv.ClaimReward(f.ctx, msg)
 	require.Error(t, err, "重复领取应该失败")
-	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest, "应该返回 already claimed 错误")
+	require.ErrorIs(t, err, types.ErrClaimLimitReached, "应该返回 already claimed 错误")
 	require.Contains(t, err.Error(), "already claimed", "错误信息应该包含 'already claimed'")
 
 	// 验证余额没有再次增加
@@ -282,6 +403,11 @@ func TestClaimReward_WithSignature(t *testing.T) {
 	adminPubKey := adminPrivKey.PubKey().(secp256k1.PubKey)
 	adminPubKeyHex := hex.EncodeToString(adminPubKey.Bytes())
 
+	// 任务以 admin 公钥作为预言机登记，其账户持有预言机角色
+	f.pubKey = adminPubKey
+	f.grantOracle(t, adminPrivKey)
+	createTestTask(t, f, srv, creator, taskID, amount, "5000000udtc")
+
 	// 打印私钥和公钥信息以便调试
 	t.Logf("=== Key Debug Info ===")
 	t.Logf("Private Key (hex): %s", adminPrivKeyHex)
@@ -292,12 +418,6 @@ func TestClaimReward_WithSignature(t *testing.T) {
 	t.Logf("Public Key length: %d bytes", len(adminPubKey.Bytes()))
 	t.Logf("======================")
 
-	// 设置 Params 中的 AdminPubkey
-	params := types.DefaultParams()
-	params.AdminPubkey = adminPubKeyHex
-	err = f.keeper.Params.Set(f.ctx, params)
-	require.NoError(t, err, "failed to set params")
-
 	// 构造待签名数据：TaskId + Recipient + Amount
 	data := taskID + recipient + amount
 
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	"dtc/x/task/types"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// SetCreditRepayShare 设置发送者自愿用于偿还信用负债的奖励比例，设置为 0 时删除自愿比例
+func (k msgServer) SetCreditRepayShare(ctx context.Context, msg *types.MsgSetCreditRepayShare) (*types.MsgSetCreditRepayShareResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	if msg.Share.IsNil() {
+		return nil, errorsmod.Wrap(types.ErrInvalidRepayShare, "share cannot be empty")
+	}
+	if err := types.ValidateCreditRepayShare(msg.Share); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidRepayShare, err.Error())
+	}
+
+	if msg.Share.IsZero() {
+		if err := k.CreditRepayShare.Remove(ctx, msg.Creator); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+	} else if err := k.CreditRepayShare.Set(ctx, msg.Creator, msg.Share); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeCreditRepayShareSet,
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyShare, msg.Share.String()),
+		),
+	)
+
+	return &types.MsgSetCreditRepayShareResponse{}, nil
+}
@@ -0,0 +1,121 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/task/keeper"
+	"dtc/x/task/types"
+)
+
+func TestCreditRepayShare(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+	denom := sdk.DefaultBondDenom
+
+	owner, err := f.addressCodec.BytesToString([]byte("taskOwner__________________"))
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString([]byte("alice______________________"))
+	require.NoError(t, err)
+	ownerAddr, err := f.addressCodec.StringToBytes(owner)
+	require.NoError(t, err)
+	aliceAddr, err := f.addressCodec.StringToBytes(alice)
+	require.NoError(t, err)
+	f.bankKeeper.mint(ownerAddr, sdk.NewCoins(sdk.NewInt64Coin(denom, 300)))
+	f.credit.liabilities[alice] = 50
+
+	_, err = srv.CreateTask(ctx, &types.MsgCreateTask{
+		Creator:        owner,
+		OraclePubkeys:  f.oraclePubkeys(),
+		TaskId:         "repay",
+		RewardPerClaim: sdk.NewInt64Coin(denom, 100),
+		Budget:         sdk.NewInt64Coin(denom, 300),
+		PerUserLimit:   3,
+	})
+	require.NoError(t, err)
+	fundTestTask(t, f, srv, owner, "repay", sdk.NewInt64Coin(denom, 300))
+
+	claim := func() sdk.Events {
+		em := sdk.NewEventManager()
+		_, err := srv.ClaimReward(ctx.WithEventManager(em), f.signedClaim(t, &types.MsgClaimReward{
+			Creator:   owner,
+			TaskId:    "repay",
+			Amount:    "100" + denom,
+			Recipient: alice,
+		}))
+		require.NoError(t, err)
+		return em.Events()
+	}
+	splitEvent := func(events sdk.Events) map[string]string {
+		for _, event := range events {
+			if event.Type != types.EventTypeRewardSplit {
+				continue
+			}
+			attrs := map[string]string{}
+			for _, attr := range event.Attributes {
+				attrs[attr.Key] = attr.Value
+			}
+			return attrs
+		}
+		return nil
+	}
+
+	// 治理比例 10%：偿还 10，接收用户得到 90
+	split := splitEvent(claim())
+	require.NotNil(t, split)
+	require.Equal(t, "10"+denom, split[types.AttributeKeyCreditRepaid])
+	require.Equal(t, "90"+denom, split[types.AttributeKeyRecipientAmount])
+	require.Equal(t, int64(90), f.bankKeeper.GetBalance(aliceAddr).AmountOf(denom).Int64())
+	require.Equal(t, int64(40), f.credit.liabilities[alice])
+
+	for _, tc := range []struct {
+		desc  string
+		share math.LegacyDec
+		err   error
+	}{
+		{desc: "Empty", share: math.LegacyDec{}, err: types.ErrInvalidRepayShare},
+		{desc: "Negative", share: math.LegacyNewDec(-1), err: types.ErrInvalidRepayShare},
+		{desc: "AboveOne", share: math.LegacyNewDecWithPrec(15, 1), err: types.ErrInvalidRepayShare},
+		{desc: "Valid", share: math.LegacyNewDecWithPrec(5, 1)},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.SetCreditRepayShare(ctx, &types.MsgSetCreditRepayShare{Creator: alice, Share: tc.share})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	res, err := qs.CreditRepayShare(ctx, &types.QueryCreditRepayShareRequest{Address: alice})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultCreditRepayShare, res.Governed)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), res.Effective)
+
+	// 自愿比例 50%：最多偿还 50，但只剩 40 负债
+	split = splitEvent(claim())
+	require.Equal(t, "40"+denom, split[types.AttributeKeyCreditRepaid])
+	require.Equal(t, int64(150), f.bankKeeper.GetBalance(aliceAddr).AmountOf(denom).Int64())
+	require.Zero(t, f.credit.liabilities[alice])
+
+	// 负债清零后全额发放，不再产生拆分事件
+	require.Nil(t, splitEvent(claim()))
+	require.Equal(t, int64(250), f.bankKeeper.GetBalance(aliceAddr).AmountOf(denom).Int64())
+
+	// 设置为 0 取消自愿比例，恢复为治理比例
+	_, err = srv.SetCreditRepayShare(ctx, &types.MsgSetCreditRepayShare{Creator: alice, Share: math.LegacyZeroDec()})
+	require.NoError(t, err)
+	res, err = qs.CreditRepayShare(ctx, &types.QueryCreditRepayShareRequest{Address: alice})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultCreditRepayShare, res.Effective)
+
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+}
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"dtc/x/task/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	dtctypes "dtc/x/dtc/types"
+)
+
+// DisputeClaim 在争议期内对待定奖励提出争议，成功后奖金退回任务托管并撤销领取记录。
+// 调用者须为任务所有者，或持有以任务 ID 为范围（或全局）的 ROLE_TASK_ARBITRATOR 角色
+func (k msgServer) DisputeClaim(ctx context.Context, msg *types.MsgDisputeClaim) (*types.MsgDisputeClaimResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	evidence := strings.TrimSpace(msg.Evidence)
+	if evidence == "" {
+		return nil, errorsmod.Wrap(types.ErrInvalidEvidence, "evidence cannot be empty")
+	}
+	if len(evidence) > types.MaxEvidenceLength {
+		return nil, errorsmod.Wrapf(types.ErrInvalidEvidence, "evidence exceeds %d characters", types.MaxEvidenceLength)
+	}
+
+	pending, err := k.PendingPayout.Get(ctx, msg.ClaimHash)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrap(types.ErrPayoutNotPending, msg.ClaimHash)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	task, err := k.getTask(ctx, pending.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	if msg.Creator != task.Owner && !k.roleKeeper.HasRole(ctx, dtctypes.ROLE_TASK_ARBITRATOR, msg.Creator, task.Id) {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is neither the owner nor an arbitrator of task %s", msg.Creator, task.Id)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	// 发放高度当块的 EndBlocker 会发放奖金，因此争议须在发放高度之前提交
+	if sdkCtx.BlockHeight() >= pending.ReleaseHeight {
+		return nil, errorsmod.Wrapf(types.ErrDisputeWindowClosed, "payout %s released at height %d", pending.ClaimHash, pending.ReleaseHeight)
+	}
+
+	if err := k.removePendingPayout(ctx, pending); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	// 奖金退回任务托管并恢复剩余预算
+	escrow, err := k.getEscrow(ctx, task)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load escrow: %s", err))
+	}
+	if err := k.setEscrow(ctx, task.Id, escrow.Add(pending.Amount)); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to update escrow: %s", err))
+	}
+	if task.RemainingBudget.Denom == pending.Amount.Denom {
+		task.RemainingBudget = task.RemainingBudget.Add(pending.Amount)
+		if err := k.setTask(ctx, task); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to update task: %s", err))
+		}
+	}
+
+	// 领取记录保留在账本中并标记为已撤销，累计支付金额同步扣减
+	record, err := k.ClaimRecord.Get(ctx, pending.ClaimHash)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err == nil {
+		if !record.Revoked {
+			record.Revoked = true
+			record.RevokedBy = msg.Creator
+			record.RevokeReason = evidence
+			record.RevokedHeight = sdkCtx.BlockHeight()
+		}
+		record.Disputed = true
+		if err := k.ClaimRecord.Set(ctx, record.ClaimHash, record); err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		for _, total := range []struct {
+			coll collections.Map[collections.Pair[string, string], math.Int]
+			key  string
+		}{
+			{k.PaidByTask, record.TaskId},
+			{k.PaidByUser, record.UserId},
+			{k.PaidByCreator, record.Creator},
+		} {
+			if err := subPaid(ctx, total.coll, total.key, pending.Amount); err != nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+			}
+		}
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClaimDisputed,
+			sdk.NewAttribute(types.AttributeKeyClaimHash, pending.ClaimHash),
+			sdk.NewAttribute(types.AttributeKeyTaskId, pending.TaskId),
+			sdk.NewAttribute(types.AttributeKeyRecipient, pending.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, pending.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyDisputer, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyEvidence, evidence),
+		),
+	)
+
+	return &types.MsgDisputeClaimResponse{Returned: pending.Amount}, nil
+}
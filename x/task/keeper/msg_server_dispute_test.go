@@ -0,0 +1,258 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	dtctypes "dtc/x/dtc/types"
+	"dtc/x/task/keeper"
+	"dtc/x/task/types"
+)
+
+func TestDisputeClaim(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	owner, err := f.addressCodec.BytesToString([]byte("taskOwner__________________"))
+	require.NoError(t, err)
+	arbitrator, err := f.addressCodec.BytesToString([]byte("arbitrator_________________"))
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString([]byte("alice______________________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString([]byte("bob________________________"))
+	require.NoError(t, err)
+	ownerAddr, err := f.addressCodec.StringToBytes(owner)
+	require.NoError(t, err)
+	aliceAddr, err := f.addressCodec.StringToBytes(alice)
+	require.NoError(t, err)
+	bobAddr, err := f.addressCodec.StringToBytes(bob)
+	require.NoError(t, err)
+	f.bankKeeper.mint(ownerAddr, sdk.NewCoins(sdk.NewInt64Coin("dtc", 300)))
+	f.roleKeeper.roles[fmt.Sprintf("%s/%s/%s", dtctypes.ROLE_TASK_ARBITRATOR, arbitrator, "disputed")] = true
+
+	createMsg := types.MsgCreateTask{
+		Creator:             owner,
+		OraclePubkeys:       f.oraclePubkeys(),
+		TaskId:              "disputed",
+		RewardPerClaim:      sdk.NewInt64Coin("dtc", 100),
+		Budget:              sdk.NewInt64Coin("dtc", 300),
+		PerUserLimit:        2,
+		DisputeWindowBlocks: -1,
+	}
+	_, err = srv.CreateTask(ctx, &createMsg)
+	require.ErrorIs(t, err, types.ErrInvalidTask)
+
+	createMsg.DisputeWindowBlocks = 20
+	_, err = srv.CreateTask(ctx, &createMsg)
+	require.NoError(t, err)
+	fundTestTask(t, f, srv, owner, "disputed", sdk.NewInt64Coin("dtc", 300))
+
+	claim := func(height int64, recipient string) (string, int64) {
+		res, err := srv.ClaimReward(ctx.WithBlockHeight(height), f.signedClaim(t, &types.MsgClaimReward{
+			Creator:   owner,
+			TaskId:    "disputed",
+			Amount:    "100dtc",
+			Recipient: recipient,
+		}))
+		require.NoError(t, err)
+		hash := sha256.Sum256([]byte("disputed" + recipient))
+		return hex.EncodeToString(hash[:]), res.ReleaseHeight
+	}
+
+	// 争议期内奖金作为待定奖励留在模块账户中
+	aliceHash, release := claim(10, alice)
+	require.Equal(t, int64(30), release)
+	require.True(t, f.bankKeeper.GetBalance(aliceAddr).IsZero())
+	pending, err := qs.PendingPayout(ctx, &types.QueryPendingPayoutRequest{ClaimHash: aliceHash})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 100), pending.PendingPayout.Amount)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	bobHash, _ := claim(12, bob)
+
+	for _, tc := range []struct {
+		desc     string
+		height   int64
+		creator  string
+		hash     string
+		evidence string
+		err      error
+	}{
+		{desc: "EmptyEvidence", height: 15, creator: owner, hash: aliceHash, evidence: "  ", err: types.ErrInvalidEvidence},
+		{desc: "LongEvidence", height: 15, creator: owner, hash: aliceHash, evidence: strings.Repeat("e", types.MaxEvidenceLength+1), err: types.ErrInvalidEvidence},
+		{desc: "Unauthorized", height: 15, creator: alice, hash: aliceHash, evidence: "duplicate account", err: sdkerrors.ErrUnauthorized},
+		{desc: "NotPending", height: 15, creator: owner, hash: "unknown", evidence: "duplicate account", err: types.ErrPayoutNotPending},
+		{desc: "WindowClosed", height: 30, creator: owner, hash: aliceHash, evidence: "duplicate account", err: types.ErrDisputeWindowClosed},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.DisputeClaim(ctx.WithBlockHeight(tc.height), &types.MsgDisputeClaim{Creator: tc.creator, ClaimHash: tc.hash, Evidence: tc.evidence})
+			require.ErrorIs(t, err, tc.err)
+		})
+	}
+
+	// 仲裁者争议成功后奖金退回任务托管，领取记录被撤销且不计入累计支付金额
+	res, err := srv.DisputeClaim(ctx.WithBlockHeight(15), &types.MsgDisputeClaim{Creator: arbitrator, ClaimHash: bobHash, Evidence: "duplicate account"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 100), res.Returned)
+
+	escrow, err := qs.TaskEscrow(ctx, &types.QueryTaskEscrowRequest{TaskId: "disputed"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 200), escrow.Escrow.Amount)
+	task, err := f.keeper.Task.Get(ctx, "disputed")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 200), task.RemainingBudget)
+
+	record, err := f.keeper.ClaimRecord.Get(ctx, bobHash)
+	require.NoError(t, err)
+	require.True(t, record.Revoked)
+	require.True(t, record.Disputed)
+	require.Equal(t, arbitrator, record.RevokedBy)
+	require.Equal(t, "duplicate account", record.RevokeReason)
+	paid, err := qs.TotalPaidByTask(ctx, &types.QueryTotalPaidByTaskRequest{TaskId: "disputed"})
+	require.NoError(t, err)
+	require.Equal(t, "100dtc", paid.Total.String())
+
+	_, err = srv.DisputeClaim(ctx.WithBlockHeight(16), &types.MsgDisputeClaim{Creator: owner, ClaimHash: bobHash, Evidence: "again"})
+	require.ErrorIs(t, err, types.ErrPayoutNotPending)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// 争议期结束前 EndBlocker 不发放，结束当块发放未被争议的奖金
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(29)))
+	require.True(t, f.bankKeeper.GetBalance(aliceAddr).IsZero())
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(30)))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("dtc", 100)), f.bankKeeper.GetBalance(aliceAddr))
+	require.True(t, f.bankKeeper.GetBalance(bobAddr).IsZero())
+	_, err = f.keeper.PendingPayout.Get(ctx, aliceHash)
+	require.Error(t, err)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+}
+
+func TestDisputeWindowWithVesting(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	owner, err := f.addressCodec.BytesToString([]byte("taskOwner__________________"))
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString([]byte("alice______________________"))
+	require.NoError(t, err)
+	ownerAddr, err := f.addressCodec.StringToBytes(owner)
+	require.NoError(t, err)
+	f.bankKeeper.mint(ownerAddr, sdk.NewCoins(sdk.NewInt64Coin("dtc", 100)))
+
+	_, err = srv.CreateTask(ctx, &types.MsgCreateTask{
+		Creator:             owner,
+		OraclePubkeys:       f.oraclePubkeys(),
+		TaskId:              "vesting",
+		RewardPerClaim:      sdk.NewInt64Coin("dtc", 100),
+		Budget:              sdk.NewInt64Coin("dtc", 100),
+		PerUserLimit:        1,
+		PayoutSchedule:      types.PayoutSchedule{Type: types.PAYOUT_SCHEDULE_TYPE_LINEAR, DurationBlocks: 100},
+		DisputeWindowBlocks: 5,
+	})
+	require.NoError(t, err)
+	fundTestTask(t, f, srv, owner, "vesting", sdk.NewInt64Coin("dtc", 100))
+
+	res, err := srv.ClaimReward(ctx, f.signedClaim(t, &types.MsgClaimReward{
+		Creator:   owner,
+		TaskId:    "vesting",
+		Amount:    "100dtc",
+		Recipient: alice,
+	}))
+	require.NoError(t, err)
+	require.Zero(t, res.StreamId)
+	require.Equal(t, int64(15), res.ReleaseHeight)
+
+	// 争议期结束后奖金转入奖励流，从发放高度开始释放
+	finalizeCtx := ctx.WithBlockHeight(15)
+	require.NoError(t, f.keeper.EndBlocker(finalizeCtx))
+	stream, err := f.keeper.RewardStream.Get(ctx, 1)
+	require.NoError(t, err)
+	require.Equal(t, alice, stream.Recipient)
+	require.Equal(t, int64(15), stream.StartHeight)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+}
+
+func TestFinalizePendingPayouts_FailedPayout(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	owner, err := f.addressCodec.BytesToString([]byte("taskOwner__________________"))
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString([]byte("alice______________________"))
+	require.NoError(t, err)
+	ownerAddr, err := f.addressCodec.StringToBytes(owner)
+	require.NoError(t, err)
+	aliceAddr, err := f.addressCodec.StringToBytes(alice)
+	require.NoError(t, err)
+	f.bankKeeper.mint(ownerAddr, sdk.NewCoins(sdk.NewInt64Coin("dtc", 100)))
+
+	_, err = srv.CreateTask(ctx, &types.MsgCreateTask{
+		Creator:             owner,
+		OraclePubkeys:       f.oraclePubkeys(),
+		TaskId:              "blocked",
+		RewardPerClaim:      sdk.NewInt64Coin("dtc", 100),
+		Budget:              sdk.NewInt64Coin("dtc", 100),
+		PerUserLimit:        1,
+		DisputeWindowBlocks: 5,
+	})
+	require.NoError(t, err)
+	fundTestTask(t, f, srv, owner, "blocked", sdk.NewInt64Coin("dtc", 100))
+
+	_, err = srv.ClaimReward(ctx, f.signedClaim(t, &types.MsgClaimReward{
+		Creator:   owner,
+		TaskId:    "blocked",
+		Amount:    "100dtc",
+		Recipient: alice,
+	}))
+	require.NoError(t, err)
+	hash := sha256.Sum256([]byte("blocked" + alice))
+	claimHash := hex.EncodeToString(hash[:])
+
+	// 接收地址被禁止收款时 EndBlocker 不报错，奖金退回任务托管并发出事件
+	f.bankKeeper.blocked[sdk.AccAddress(aliceAddr).String()] = true
+	finalizeCtx := ctx.WithBlockHeight(15).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.EndBlocker(finalizeCtx))
+	require.True(t, f.bankKeeper.GetBalance(aliceAddr).IsZero())
+	_, err = f.keeper.PendingPayout.Get(ctx, claimHash)
+	require.Error(t, err)
+
+	escrow, err := qs.TaskEscrow(ctx, &types.QueryTaskEscrowRequest{TaskId: "blocked"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 100), escrow.Escrow.Amount)
+	task, err := f.keeper.Task.Get(ctx, "blocked")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 100), task.RemainingBudget)
+
+	var failed []sdk.Event
+	for _, event := range finalizeCtx.EventManager().Events() {
+		require.NotEqual(t, types.EventTypePayoutFinalized, event.Type)
+		if event.Type == types.EventTypePayoutFailed {
+			failed = append(failed, event)
+		}
+	}
+	require.Len(t, failed, 1)
+	refunded, ok := failed[0].GetAttribute(types.AttributeKeyRefunded)
+	require.True(t, ok)
+	require.Equal(t, "true", refunded.Value)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+}
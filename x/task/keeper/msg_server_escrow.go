@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dtc/x/task/types"
+)
+
+// FundTask 由任务 owner 向任务托管注资，托管总额不超过任务剩余预算
+func (k msgServer) FundTask(ctx context.Context, msg *types.MsgFundTask) (*types.MsgFundTaskResponse, error) {
+	funder, err := k.addressCodec.StringToBytes(msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+	if err := msg.Amount.Validate(); err != nil || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "invalid amount %s", msg.Amount)
+	}
+
+	task, err := k.getTask(ctx, msg.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	if task.Owner != msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the task owner can fund the task")
+	}
+	if !task.IsOpen() {
+		return nil, errorsmod.Wrap(types.ErrTaskClosed, task.Id)
+	}
+	if msg.Amount.Denom != task.RewardPerClaim.Denom {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "task %s pays in %s", task.Id, task.RewardPerClaim.Denom)
+	}
+
+	escrow, err := k.getEscrow(ctx, task)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	escrow = escrow.Add(msg.Amount)
+	if task.RemainingBudget.IsLT(escrow) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTask, "escrow %s would exceed remaining budget %s", escrow, task.RemainingBudget)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, funder, types.ModuleName, sdk.NewCoins(msg.Amount)); err != nil {
+		return nil, err
+	}
+	if err := k.setEscrow(ctx, task.Id, escrow); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTaskFunded,
+			sdk.NewAttribute(types.AttributeKeyTaskId, task.Id),
+			sdk.NewAttribute(types.AttributeKeyFunder, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyEscrow, escrow.String()),
+		),
+	)
+
+	return &types.MsgFundTaskResponse{Escrow: escrow}, nil
+}
+
+// ReclaimTaskEscrow 在任务关闭后将未支付的托管资金退还 owner
+func (k msgServer) ReclaimTaskEscrow(ctx context.Context, msg *types.MsgReclaimTaskEscrow) (*types.MsgReclaimTaskEscrowResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	task, err := k.getTask(ctx, msg.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	if task.Owner != msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the task owner can reclaim the escrow")
+	}
+	if task.IsOpen() {
+		return nil, errorsmod.Wrap(types.ErrTaskStillOpen, task.Id)
+	}
+
+	escrow, err := k.getEscrow(ctx, task)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if !escrow.IsPositive() {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientEscrow, "task %s has no escrow to reclaim", task.Id)
+	}
+
+	owner, err := k.addressCodec.StringToBytes(task.Owner)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(escrow)); err != nil {
+		return nil, err
+	}
+	if err := k.TaskEscrow.Remove(ctx, task.Id); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTaskEscrowReclaimed,
+			sdk.NewAttribute(types.AttributeKeyTaskId, task.Id),
+			sdk.NewAttribute(types.AttributeKeyOwner, task.Owner),
+			sdk.NewAttribute(types.AttributeKeyAmount, escrow.String()),
+		),
+	)
+
+	return &types.MsgReclaimTaskEscrowResponse{Amount: escrow}, nil
+}
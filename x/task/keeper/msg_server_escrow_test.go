@@ -0,0 +1,127 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/task/keeper"
+	"dtc/x/task/types"
+)
+
+func TestFundTask(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("taskOwner__________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("other______________________"))
+	require.NoError(t, err)
+	ownerAddr, err := f.addressCodec.StringToBytes(owner)
+	require.NoError(t, err)
+	otherAddr, err := f.addressCodec.StringToBytes(other)
+	require.NoError(t, err)
+	f.bankKeeper.mint(ownerAddr, sdk.NewCoins(sdk.NewInt64Coin("dtc", 1000), sdk.NewInt64Coin("uatom", 1000)))
+	f.bankKeeper.mint(otherAddr, sdk.NewCoins(sdk.NewInt64Coin("dtc", 1000)))
+
+	_, err = srv.CreateTask(f.ctx, &types.MsgCreateTask{
+		Creator:        owner,
+		OraclePubkeys:  f.oraclePubkeys(),
+		TaskId:         "funded",
+		RewardPerClaim: sdk.NewInt64Coin("dtc", 10),
+		Budget:         sdk.NewInt64Coin("dtc", 100),
+	})
+	require.NoError(t, err)
+
+	tests := []struct {
+		desc string
+		msg  types.MsgFundTask
+		err  error
+	}{
+		{desc: "unknown task", msg: types.MsgFundTask{Creator: owner, TaskId: "missing", Amount: sdk.NewInt64Coin("dtc", 10)}, err: types.ErrTaskNotFound},
+		{desc: "not owner", msg: types.MsgFundTask{Creator: other, TaskId: "funded", Amount: sdk.NewInt64Coin("dtc", 10)}, err: sdkerrors.ErrUnauthorized},
+		{desc: "zero amount", msg: types.MsgFundTask{Creator: owner, TaskId: "funded", Amount: sdk.NewInt64Coin("dtc", 0)}, err: sdkerrors.ErrInvalidCoins},
+		{desc: "wrong denom", msg: types.MsgFundTask{Creator: owner, TaskId: "funded", Amount: sdk.NewInt64Coin("uatom", 10)}, err: sdkerrors.ErrInvalidCoins},
+		{desc: "exceeds budget", msg: types.MsgFundTask{Creator: owner, TaskId: "funded", Amount: sdk.NewInt64Coin("dtc", 101)}, err: types.ErrInvalidTask},
+		{desc: "partial", msg: types.MsgFundTask{Creator: owner, TaskId: "funded", Amount: sdk.NewInt64Coin("dtc", 60)}},
+		{desc: "top up", msg: types.MsgFundTask{Creator: owner, TaskId: "funded", Amount: sdk.NewInt64Coin("dtc", 40)}},
+		{desc: "over top up", msg: types.MsgFundTask{Creator: owner, TaskId: "funded", Amount: sdk.NewInt64Coin("dtc", 1)}, err: types.ErrInvalidTask},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			_, err := srv.FundTask(f.ctx, &tc.msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	escrow, err := f.keeper.TaskEscrow.Get(f.ctx, "funded")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 100), escrow.Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("dtc", 900), sdk.NewInt64Coin("uatom", 1000)), f.bankKeeper.GetBalance(ownerAddr))
+
+	_, broken := keeper.EscrowBalanceInvariant(f.keeper)(sdk.UnwrapSDKContext(f.ctx))
+	require.False(t, broken)
+}
+
+func TestReclaimTaskEscrow(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("taskOwner__________________"))
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString([]byte("alice______________________"))
+	require.NoError(t, err)
+	ownerAddr, err := f.addressCodec.StringToBytes(owner)
+	require.NoError(t, err)
+
+	createTestTask(t, f, srv, owner, "first", "10dtc", "100dtc")
+	createTestTask(t, f, srv, owner, "second", "5dtc", "50dtc")
+
+	_, err = srv.ClaimReward(f.ctx, f.signedClaim(t, &types.MsgClaimReward{Creator: owner, TaskId: "first", Amount: "10dtc", Recipient: alice}))
+	require.NoError(t, err)
+	_, broken := invariant(sdk.UnwrapSDKContext(f.ctx))
+	require.False(t, broken)
+
+	_, err = srv.ReclaimTaskEscrow(f.ctx, &types.MsgReclaimTaskEscrow{Creator: owner, TaskId: "first"})
+	require.ErrorIs(t, err, types.ErrTaskStillOpen)
+
+	_, err = srv.CloseTask(f.ctx, &types.MsgCloseTask{Creator: owner, TaskId: "first"})
+	require.NoError(t, err)
+
+	_, err = srv.ReclaimTaskEscrow(f.ctx, &types.MsgReclaimTaskEscrow{Creator: alice, TaskId: "first"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	res, err := srv.ReclaimTaskEscrow(f.ctx, &types.MsgReclaimTaskEscrow{Creator: owner, TaskId: "first"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 90), res.Amount)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("dtc", 90)), f.bankKeeper.GetBalance(ownerAddr))
+
+	_, err = srv.ReclaimTaskEscrow(f.ctx, &types.MsgReclaimTaskEscrow{Creator: owner, TaskId: "first"})
+	require.ErrorIs(t, err, types.ErrInsufficientEscrow)
+
+	// 另一任务的托管不受影响
+	escrow, err := f.keeper.TaskEscrow.Get(f.ctx, "second")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 50), escrow.Amount)
+	_, broken = invariant(sdk.UnwrapSDKContext(f.ctx))
+	require.False(t, broken)
+
+	// 绕过托管直接转入模块账户的资金不会破坏不变量
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName)
+	f.bankKeeper.mint(moduleAddr, sdk.NewCoins(sdk.NewInt64Coin("dtc", 1)))
+	_, broken = invariant(sdk.UnwrapSDKContext(f.ctx))
+	require.False(t, broken)
+
+	// 模块账户余额低于登记的托管时不变量被破坏
+	require.NoError(t, f.bankKeeper.send(moduleAddr, ownerAddr, sdk.NewCoins(sdk.NewInt64Coin("dtc", 2))))
+	_, broken = invariant(sdk.UnwrapSDKContext(f.ctx))
+	require.True(t, broken)
+}
@@ -0,0 +1,218 @@
+package keeper
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"dtc/x/task/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// PostMerkleRoot 发布任务预言机签名的周期 Merkle 根，并从任务托管划出该周期的奖励总额。
+// 周期须大于任务最近发布的周期，任务须处于开放状态
+func (k msgServer) PostMerkleRoot(ctx context.Context, msg *types.MsgPostMerkleRoot) (*types.MsgPostMerkleRootResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+
+	task, err := k.getTask(ctx, msg.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	if task.IsNative() {
+		return nil, errorsmod.Wrap(types.ErrNativeTask, task.Id)
+	}
+	if !task.IsOpen() {
+		return nil, errorsmod.Wrap(types.ErrTaskClosed, task.Id)
+	}
+	if msg.Epoch <= task.LastMerkleEpoch {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMerkleRoot, "epoch %d must be greater than %d", msg.Epoch, task.LastMerkleEpoch)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	dist := types.MerkleDistribution{
+		TaskId:       task.Id,
+		Epoch:        msg.Epoch,
+		Root:         msg.Root,
+		LeafCount:    msg.LeafCount,
+		Total:        msg.Total,
+		Claimed:      sdk.NewCoin(msg.Total.Denom, math.ZeroInt()),
+		PostedHeight: sdkCtx.BlockHeight(),
+		ExpiryHeight: msg.ExpiryHeight,
+	}
+	if err := dist.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidMerkleRoot, err.Error())
+	}
+	if dist.Total.Denom != task.RewardPerClaim.Denom {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMerkleRoot, "total denom %s does not match task reward denom %s", dist.Total.Denom, task.RewardPerClaim.Denom)
+	}
+
+	signBytes := types.MerkleRootSignBytes(task.Id, msg.Epoch, msg.Root, msg.LeafCount, msg.Total, msg.ExpiryHeight)
+	if err := k.verifyOracleSignatures(ctx, task, signBytes, msg.Signature); err != nil {
+		return nil, err
+	}
+
+	// 周期奖励总额从任务托管划出，由 Merkle 分发持有直到领取或过期
+	escrow, err := k.getEscrow(ctx, task)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load escrow: %s", err))
+	}
+	if escrow.IsLT(dist.Total) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientEscrow, "task %s escrow %s is below distribution total %s", task.Id, escrow, dist.Total)
+	}
+	if err := k.setEscrow(ctx, task.Id, escrow.Sub(dist.Total)); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to update escrow: %s", err))
+	}
+	task.RemainingBudget = task.RemainingBudget.Sub(dist.Total)
+	task.LastMerkleEpoch = msg.Epoch
+	if err := k.setTask(ctx, task); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to update task: %s", err))
+	}
+	if err := k.setMerkleDistribution(ctx, dist); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to set merkle distribution: %s", err))
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMerkleRootPosted,
+			sdk.NewAttribute(types.AttributeKeyTaskId, task.Id),
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(dist.Epoch, 10)),
+			sdk.NewAttribute(types.AttributeKeyRoot, dist.Root),
+			sdk.NewAttribute(types.AttributeKeyAmount, dist.Total.String()),
+			sdk.NewAttribute(types.AttributeKeyExpiryHeight, strconv.FormatInt(dist.ExpiryHeight, 10)),
+		),
+	)
+
+	return &types.MsgPostMerkleRootResponse{}, nil
+}
+
+// ClaimWithProof 凭叶子数据与 Merkle 证明领取周期奖励。叶子金额由预言机在发布根时确定，
+// 领取与逐条领取一样受身份条件、支付限额与争议期约束，
+// 奖金按信用负债偿还比例与任务释放计划发放给 recipient，并追加领取记录。
+// 分发资金在发布根时已从任务托管划出，因此领取只受分发过期高度约束、与任务状态无关：
+// 任务关闭后已发布的周期仍可领取，领取也不计入任务的 claim_count 与 max_claims
+func (k msgServer) ClaimWithProof(ctx context.Context, msg *types.MsgClaimWithProof) (*types.MsgClaimWithProofResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid signer address: %s", err))
+	}
+	if _, err := k.addressCodec.StringToBytes(msg.Recipient); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid recipient address: %s", err))
+	}
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+	if len(msg.Proof) > types.MaxMerkleProofLength {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMerkleProof, "proof exceeds %d levels", types.MaxMerkleProofLength)
+	}
+
+	distKey := collections.Join(msg.TaskId, msg.Epoch)
+	dist, err := k.MerkleDistribution.Get(ctx, distKey)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrDistributionNotFound, "task %s epoch %d", msg.TaskId, msg.Epoch)
+		}
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if sdkCtx.BlockHeight() >= dist.ExpiryHeight {
+		return nil, errorsmod.Wrapf(types.ErrDistributionExpired, "task %s epoch %d expired at height %d", dist.TaskId, dist.Epoch, dist.ExpiryHeight)
+	}
+
+	claimed, err := k.isLeafClaimed(ctx, dist.TaskId, dist.Epoch, msg.Index)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if claimed {
+		return nil, errorsmod.Wrapf(types.ErrLeafClaimed, "task %s epoch %d leaf %d", dist.TaskId, dist.Epoch, msg.Index)
+	}
+
+	proof := make([][]byte, len(msg.Proof))
+	for i, node := range msg.Proof {
+		proof[i], err = hex.DecodeString(node)
+		if err != nil {
+			return nil, errorsmod.Wrapf(types.ErrInvalidMerkleProof, "invalid proof node %d: %s", i, err)
+		}
+	}
+	root, err := hex.DecodeString(dist.Root)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	leaf := types.MerkleLeafHash(dist.TaskId, dist.Epoch, msg.Index, msg.Recipient, msg.Amount)
+	if !types.VerifyMerkleProof(root, leaf, msg.Index, dist.LeafCount, proof) {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMerkleProof, "task %s epoch %d leaf %d", dist.TaskId, dist.Epoch, msg.Index)
+	}
+	if msg.Amount.Denom != dist.Total.Denom || dist.Remaining().IsLT(msg.Amount) {
+		return nil, errorsmod.Wrapf(types.ErrInsufficientEscrow, "distribution remaining %s is below %s", dist.Remaining(), msg.Amount)
+	}
+
+	task, err := k.getTask(ctx, dist.TaskId)
+	if err != nil {
+		return nil, err
+	}
+
+	// 与逐条领取相同的约束：要求 DID 的任务校验身份条件并按 DID 计数，支付计入滚动窗口限额
+	did, err := k.checkEligibility(ctx, task, msg.Recipient, "")
+	if err != nil {
+		return nil, err
+	}
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load params: %s", err))
+	}
+	if err := k.checkPayoutLimits(ctx, params, msg.Recipient, msg.Creator, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	claimHash := hex.EncodeToString(leaf)
+	if err := k.setLeafClaimed(ctx, dist.TaskId, dist.Epoch, msg.Index); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	dist.Claimed = dist.Claimed.Add(msg.Amount)
+	if err := k.MerkleDistribution.Set(ctx, distKey, dist); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	// 任务设置了争议期时奖金先作为待定奖励留在模块账户
+	streamId, releaseHeight, err := k.payout(ctx, task, msg.Recipient, claimHash, msg.Amount)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if err := k.recordPayout(ctx, params, msg.Recipient, msg.Creator, msg.Amount); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to record payout: %s", err))
+	}
+
+	if err := k.appendClaimRecord(ctx, types.ClaimRecord{
+		ClaimHash:   claimHash,
+		TaskId:      dist.TaskId,
+		UserId:      msg.Recipient,
+		Creator:     msg.Creator,
+		Amount:      msg.Amount,
+		BlockTime:   sdkCtx.BlockTime().Unix(),
+		BlockHeight: sdkCtx.BlockHeight(),
+		Did:         did,
+	}); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to set claim record: %s", err))
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeMerkleClaimed,
+			sdk.NewAttribute(types.AttributeKeyTaskId, dist.TaskId),
+			sdk.NewAttribute(types.AttributeKeyEpoch, strconv.FormatUint(dist.Epoch, 10)),
+			sdk.NewAttribute(types.AttributeKeyLeafIndex, strconv.FormatUint(msg.Index, 10)),
+			sdk.NewAttribute(types.AttributeKeyClaimHash, claimHash),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, msg.Amount.String()),
+		),
+	)
+
+	return &types.MsgClaimWithProofResponse{StreamId: streamId, ReleaseHeight: releaseHeight}, nil
+}
@@ -0,0 +1,379 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	identitytypes "dtc/x/identity/types"
+	"dtc/x/task/keeper"
+	"dtc/x/task/types"
+)
+
+func TestMerkleDistribution(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	owner, err := f.addressCodec.BytesToString([]byte("taskOwner__________________"))
+	require.NoError(t, err)
+	relayer, err := f.addressCodec.BytesToString([]byte("relayer____________________"))
+	require.NoError(t, err)
+	var recipients []string
+	for _, name := range []string{"alice", "bob", "carol"} {
+		addr, err := f.addressCodec.BytesToString([]byte(name + "______________________")[:27])
+		require.NoError(t, err)
+		recipients = append(recipients, addr)
+	}
+	amounts := []sdk.Coin{sdk.NewInt64Coin("dtc", 10), sdk.NewInt64Coin("dtc", 20), sdk.NewInt64Coin("dtc", 30)}
+	createTestTask(t, f, srv, owner, "campaign", "10dtc", "100dtc")
+
+	leaves := make([][]byte, len(recipients))
+	for i := range recipients {
+		leaves[i] = types.MerkleLeafHash("campaign", 1, uint64(i), recipients[i], amounts[i])
+	}
+	root := hex.EncodeToString(types.MerkleRoot(leaves))
+	total := sdk.NewInt64Coin("dtc", 60)
+
+	post := func(epoch uint64, total sdk.Coin, expiry int64, sign bool) error {
+		msg := &types.MsgPostMerkleRoot{
+			Creator:      relayer,
+			TaskId:       "campaign",
+			Epoch:        epoch,
+			Root:         root,
+			LeafCount:    uint64(len(leaves)),
+			Total:        total,
+			ExpiryHeight: expiry,
+		}
+		signature, err := generateSignature(f.privKey, []byte(types.MerkleRootSignBytes(msg.TaskId, msg.Epoch, msg.Root, msg.LeafCount, msg.Total, msg.ExpiryHeight)))
+		require.NoError(t, err)
+		msg.Signature = signature
+		if !sign {
+			msg.ExpiryHeight++
+		}
+		_, err = srv.PostMerkleRoot(ctx, msg)
+		return err
+	}
+	claim := func(height int64, index uint64, recipient string, amount sdk.Coin) error {
+		var proof []string
+		for _, node := range types.MerkleProof(leaves, index) {
+			proof = append(proof, hex.EncodeToString(node))
+		}
+		_, err := srv.ClaimWithProof(ctx.WithBlockHeight(height), &types.MsgClaimWithProof{
+			Creator:   relayer,
+			TaskId:    "campaign",
+			Epoch:     1,
+			Index:     index,
+			Recipient: recipient,
+			Amount:    amount,
+			Proof:     proof,
+		})
+		return err
+	}
+	balance := func(addr string) int64 {
+		bz, err := f.addressCodec.StringToBytes(addr)
+		require.NoError(t, err)
+		return f.bankKeeper.GetBalance(bz).AmountOf("dtc").Int64()
+	}
+
+	// 签名不符、金额超出托管、币种不符或过期高度无效时拒绝发布
+	require.ErrorIs(t, post(1, total, 50, false), sdkerrors.ErrUnauthorized)
+	require.ErrorIs(t, post(1, sdk.NewInt64Coin("dtc", 200), 50, true), types.ErrInsufficientEscrow)
+	require.ErrorIs(t, post(1, sdk.NewInt64Coin("other", 60), 50, true), types.ErrInvalidMerkleRoot)
+	require.ErrorIs(t, post(1, total, 10, true), types.ErrInvalidMerkleRoot)
+
+	require.NoError(t, post(1, total, 50, true))
+	require.ErrorIs(t, post(1, total, 50, true), types.ErrInvalidMerkleRoot)
+	escrow, err := qs.TaskEscrow(ctx, &types.QueryTaskEscrowRequest{TaskId: "campaign"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 40), escrow.Escrow.Amount)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// 任何地址均可凭证明代领，奖金发放给叶子中的接收用户
+	require.NoError(t, claim(20, 0, recipients[0], amounts[0]))
+	require.Equal(t, int64(10), balance(recipients[0]))
+	require.Zero(t, balance(relayer))
+	require.ErrorIs(t, claim(21, 0, recipients[0], amounts[0]), types.ErrLeafClaimed)
+
+	// 叶子数据与证明不符时拒绝领取
+	require.ErrorIs(t, claim(21, 1, recipients[1], sdk.NewInt64Coin("dtc", 25)), types.ErrInvalidMerkleProof)
+	require.ErrorIs(t, claim(21, 1, recipients[0], amounts[1]), types.ErrInvalidMerkleProof)
+	require.ErrorIs(t, claim(21, 5, recipients[1], amounts[1]), types.ErrInvalidMerkleProof)
+
+	require.NoError(t, claim(21, 1, recipients[1], amounts[1]))
+	claimed, err := qs.MerkleLeafClaimed(ctx, &types.QueryMerkleLeafClaimedRequest{TaskId: "campaign", Epoch: 1, Index: 1})
+	require.NoError(t, err)
+	require.True(t, claimed.Claimed)
+	claimed, err = qs.MerkleLeafClaimed(ctx, &types.QueryMerkleLeafClaimedRequest{TaskId: "campaign", Epoch: 1, Index: 2})
+	require.NoError(t, err)
+	require.False(t, claimed.Claimed)
+
+	dist, err := qs.MerkleDistribution(ctx, &types.QueryMerkleDistributionRequest{TaskId: "campaign", Epoch: 1})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 30), dist.Distribution.Claimed)
+	task, err := f.keeper.Task.Get(ctx, "campaign")
+	require.NoError(t, err)
+	// Merkle 领取不计入任务领取次数
+	require.Zero(t, task.ClaimCount)
+	require.Equal(t, uint64(1), task.LastMerkleEpoch)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+
+	// 过期后不能再领取，EndBlocker 将未领取的资金退回任务托管并清理位图
+	require.ErrorIs(t, claim(50, 2, recipients[2], amounts[2]), types.ErrDistributionExpired)
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(50)))
+	escrow, err = qs.TaskEscrow(ctx, &types.QueryTaskEscrowRequest{TaskId: "campaign"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 70), escrow.Escrow.Amount)
+	task, err = f.keeper.Task.Get(ctx, "campaign")
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 70), task.RemainingBudget)
+	_, err = qs.MerkleDistribution(ctx, &types.QueryMerkleDistributionRequest{TaskId: "campaign", Epoch: 1})
+	require.Error(t, err)
+	claimed, err = qs.MerkleLeafClaimed(ctx, &types.QueryMerkleLeafClaimedRequest{TaskId: "campaign", Epoch: 1, Index: 0})
+	require.NoError(t, err)
+	require.False(t, claimed.Claimed)
+	require.ErrorIs(t, claim(51, 2, recipients[2], amounts[2]), types.ErrDistributionNotFound)
+
+	// 过期周期不能重新发布
+	require.ErrorIs(t, post(1, total, 100, true), types.ErrInvalidMerkleRoot)
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+}
+
+func TestClaimWithProof_IndependentOfTaskState(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	owner, err := f.addressCodec.BytesToString([]byte("taskOwner__________________"))
+	require.NoError(t, err)
+	relayer, err := f.addressCodec.BytesToString([]byte("relayer____________________"))
+	require.NoError(t, err)
+	var recipients []string
+	for _, name := range []string{"alice", "bob"} {
+		addr, err := f.addressCodec.BytesToString([]byte(name + "______________________")[:27])
+		require.NoError(t, err)
+		recipients = append(recipients, addr)
+	}
+	amount := sdk.NewInt64Coin("dtc", 10)
+
+	_, err = srv.CreateTask(ctx, &types.MsgCreateTask{
+		Creator:        owner,
+		OraclePubkeys:  f.oraclePubkeys(),
+		TaskId:         "capped",
+		RewardPerClaim: amount,
+		Budget:         sdk.NewInt64Coin("dtc", 50),
+		MaxClaims:      1,
+	})
+	require.NoError(t, err)
+	fundTestTask(t, f, srv, owner, "capped", sdk.NewInt64Coin("dtc", 50))
+
+	leaves := make([][]byte, len(recipients))
+	for i := range recipients {
+		leaves[i] = types.MerkleLeafHash("capped", 1, uint64(i), recipients[i], amount)
+	}
+	post := &types.MsgPostMerkleRoot{
+		Creator:      relayer,
+		TaskId:       "capped",
+		Epoch:        1,
+		Root:         hex.EncodeToString(types.MerkleRoot(leaves)),
+		LeafCount:    uint64(len(leaves)),
+		Total:        sdk.NewInt64Coin("dtc", 20),
+		ExpiryHeight: 50,
+	}
+	signature, err := generateSignature(f.privKey, []byte(types.MerkleRootSignBytes(post.TaskId, post.Epoch, post.Root, post.LeafCount, post.Total, post.ExpiryHeight)))
+	require.NoError(t, err)
+	post.Signature = signature
+	_, err = srv.PostMerkleRoot(ctx, post)
+	require.NoError(t, err)
+
+	claim := func(index uint64) error {
+		var proof []string
+		for _, node := range types.MerkleProof(leaves, index) {
+			proof = append(proof, hex.EncodeToString(node))
+		}
+		_, err := srv.ClaimWithProof(ctx, &types.MsgClaimWithProof{
+			Creator:   relayer,
+			TaskId:    "capped",
+			Epoch:     1,
+			Index:     index,
+			Recipient: recipients[index],
+			Amount:    amount,
+			Proof:     proof,
+		})
+		return err
+	}
+
+	// Merkle 领取不计入 max_claims，任务保持开放
+	require.NoError(t, claim(0))
+	task, err := f.keeper.Task.Get(ctx, "capped")
+	require.NoError(t, err)
+	require.True(t, task.IsOpen())
+	require.Zero(t, task.ClaimCount)
+
+	// 任务关闭后已发布周期中的资金仍可领取
+	_, err = srv.CloseTask(ctx, &types.MsgCloseTask{Creator: owner, TaskId: "capped"})
+	require.NoError(t, err)
+	require.NoError(t, claim(1))
+	bz, err := f.addressCodec.StringToBytes(recipients[1])
+	require.NoError(t, err)
+	require.Equal(t, int64(10), f.bankKeeper.GetBalance(bz).AmountOf("dtc").Int64())
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+}
+
+func TestClaimWithProof_ClaimChecks(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(100)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.RecipientEpochLimit = sdk.NewCoins(sdk.NewInt64Coin("dtc", 20))
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	addr := func(name string) string {
+		a, err := f.addressCodec.BytesToString([]byte(name + "___________________________"[len(name):]))
+		require.NoError(t, err)
+		return a
+	}
+	owner, relayer := addr("taskOwner"), addr("relayer")
+	alice, aliceAlt, bob, stranger := addr("alice"), addr("aliceAlt"), addr("bob"), addr("stranger")
+	register := func(address, did string) {
+		f.identity.dids[address] = identitytypes.DidDocument{Did: did, Controller: address, CreatedHeight: 10}
+		f.identity.status[address] = identitytypes.ATTESTATION_STATUS_ATTESTED
+	}
+	register(alice, "did:dtc:alice")
+	// 同一 DID 控制的另一个地址
+	register(aliceAlt, "did:dtc:alice")
+	register(bob, "did:dtc:bob")
+
+	ownerAddr, err := f.addressCodec.StringToBytes(owner)
+	require.NoError(t, err)
+	f.bankKeeper.mint(ownerAddr, sdk.NewCoins(sdk.NewInt64Coin("dtc", 100)))
+	_, err = srv.CreateTask(ctx, &types.MsgCreateTask{
+		Creator:             owner,
+		OraclePubkeys:       f.oraclePubkeys(),
+		TaskId:              "gated",
+		RewardPerClaim:      sdk.NewInt64Coin("dtc", 10),
+		Budget:              sdk.NewInt64Coin("dtc", 100),
+		PerUserLimit:        1,
+		DisputeWindowBlocks: 5,
+		Eligibility:         types.TaskEligibility{RequireLiveness: true},
+	})
+	require.NoError(t, err)
+	fundTestTask(t, f, srv, owner, "gated", sdk.NewInt64Coin("dtc", 100))
+
+	recipients := []string{alice, aliceAlt, bob, stranger}
+	amounts := []sdk.Coin{sdk.NewInt64Coin("dtc", 10), sdk.NewInt64Coin("dtc", 10), sdk.NewInt64Coin("dtc", 30), sdk.NewInt64Coin("dtc", 10)}
+	leaves := make([][]byte, len(recipients))
+	for i := range recipients {
+		leaves[i] = types.MerkleLeafHash("gated", 1, uint64(i), recipients[i], amounts[i])
+	}
+	post := &types.MsgPostMerkleRoot{
+		Creator:      relayer,
+		TaskId:       "gated",
+		Epoch:        1,
+		Root:         hex.EncodeToString(types.MerkleRoot(leaves)),
+		LeafCount:    uint64(len(leaves)),
+		Total:        sdk.NewInt64Coin("dtc", 60),
+		ExpiryHeight: 200,
+	}
+	post.Signature, err = generateSignature(f.privKey, []byte(types.MerkleRootSignBytes(post.TaskId, post.Epoch, post.Root, post.LeafCount, post.Total, post.ExpiryHeight)))
+	require.NoError(t, err)
+	_, err = srv.PostMerkleRoot(ctx, post)
+	require.NoError(t, err)
+
+	claim := func(index uint64) (*types.MsgClaimWithProofResponse, error) {
+		var proof []string
+		for _, node := range types.MerkleProof(leaves, index) {
+			proof = append(proof, hex.EncodeToString(node))
+		}
+		return srv.ClaimWithProof(ctx, &types.MsgClaimWithProof{
+			Creator:   relayer,
+			TaskId:    "gated",
+			Epoch:     1,
+			Index:     index,
+			Recipient: recipients[index],
+			Amount:    amounts[index],
+			Proof:     proof,
+		})
+	}
+	balance := func(address string) int64 {
+		bz, err := f.addressCodec.StringToBytes(address)
+		require.NoError(t, err)
+		return f.bankKeeper.GetBalance(bz).AmountOf("dtc").Int64()
+	}
+
+	// 身份条件与支付限额同样约束 Merkle 领取，失败时叶子保持未领取
+	_, err = claim(3)
+	require.ErrorIs(t, err, types.ErrIneligible)
+	_, err = claim(2)
+	require.ErrorIs(t, err, types.ErrRecipientRateLimited)
+	claimed, err := qs.MerkleLeafClaimed(ctx, &types.QueryMerkleLeafClaimedRequest{TaskId: "gated", Epoch: 1, Index: 2})
+	require.NoError(t, err)
+	require.False(t, claimed.Claimed)
+
+	// 争议期内奖金作为待定奖励留在模块账户
+	res, err := claim(0)
+	require.NoError(t, err)
+	require.Equal(t, int64(105), res.ReleaseHeight)
+	require.Zero(t, res.StreamId)
+	require.Zero(t, balance(alice))
+	pending, err := f.keeper.PendingPayout.Get(ctx, hex.EncodeToString(leaves[0]))
+	require.NoError(t, err)
+	require.Equal(t, alice, pending.Recipient)
+	record, err := f.keeper.ClaimRecord.Get(ctx, hex.EncodeToString(leaves[0]))
+	require.NoError(t, err)
+	require.Equal(t, "did:dtc:alice", record.Did)
+
+	// 同一 DID 的其他地址共享领取次数
+	_, err = claim(1)
+	require.ErrorIs(t, err, types.ErrDidClaimLimitReached)
+
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(105)))
+	require.Equal(t, int64(10), balance(alice))
+}
+
+func TestExpireMerkleDistribution_RefundFailure(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(50).WithEventManager(sdk.NewEventManager())
+
+	// 任务已不存在时无法退回托管，EndBlocker 不报错并发出退款失败事件
+	dist := types.MerkleDistribution{
+		TaskId:       "gone",
+		Epoch:        1,
+		LeafCount:    1,
+		Total:        sdk.NewInt64Coin("dtc", 10),
+		Claimed:      sdk.NewInt64Coin("dtc", 0),
+		ExpiryHeight: 50,
+	}
+	require.NoError(t, f.keeper.MerkleDistribution.Set(ctx, collections.Join(dist.TaskId, dist.Epoch), dist))
+	require.NoError(t, f.keeper.MerkleExpiryQueue.Set(ctx, collections.Join3(dist.ExpiryHeight, dist.TaskId, dist.Epoch)))
+
+	require.NoError(t, f.keeper.EndBlocker(ctx))
+	has, err := f.keeper.MerkleDistribution.Has(ctx, collections.Join(dist.TaskId, dist.Epoch))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	var failed []sdk.Event
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeMerkleRefundFailed {
+			failed = append(failed, event)
+		}
+	}
+	require.Len(t, failed, 1)
+	amount, ok := failed[0].GetAttribute(types.AttributeKeyAmount)
+	require.True(t, ok)
+	require.Equal(t, "10dtc", amount.Value)
+}
@@ -0,0 +1,133 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"dtc/x/task/types"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// EnrollTask 登记参与依赖持续时长的链上判定任务，登记时由判定校验起始状态，
+// 须锁定资金的判定同时将资金转入模块账户
+func (k msgServer) EnrollTask(ctx context.Context, msg *types.MsgEnrollTask) (*types.MsgEnrollTaskResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	task, err := k.getTask(ctx, msg.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	if !task.IsNative() {
+		return nil, errorsmod.Wrap(types.ErrNotNativeTask, task.Id)
+	}
+	if !task.IsOpen() || task.IsExpired(sdkCtx.BlockHeight()) {
+		return nil, errorsmod.Wrap(types.ErrTaskClosed, task.Id)
+	}
+
+	predicate, err := k.getPredicate(task.Predicate.Type)
+	if err != nil {
+		return nil, err
+	}
+	enrollment, ok := predicate.(types.EnrollmentPredicate)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "predicate %s does not use enrollment", task.Predicate.Type)
+	}
+
+	key := collections.Join(task.Id, msg.Creator)
+	enrolledHeight, err := k.NativeEnrollment.Get(ctx, key)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load enrollment: %s", err))
+	}
+	if err == nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "%s already enrolled in task %s at height %d", msg.Creator, task.Id, enrolledHeight)
+	}
+	if err := enrollment.ValidateEnrollment(ctx, task.Predicate, msg.Creator); err != nil {
+		return nil, err
+	}
+	if deposit, ok := predicate.(types.DepositPredicate); ok {
+		if err := k.lockEnrollmentDeposit(ctx, task.Id, msg.Creator, deposit.EnrollmentDeposit(task.Predicate)); err != nil {
+			return nil, err
+		}
+	}
+	if err := k.NativeEnrollment.Set(ctx, key, sdkCtx.BlockHeight()); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to set enrollment: %s", err))
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNativeTaskEnrolled,
+			sdk.NewAttribute(types.AttributeKeyTaskId, task.Id),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyPredicate, task.Predicate.Type),
+			sdk.NewAttribute(types.AttributeKeyHeight, strconv.FormatInt(sdkCtx.BlockHeight(), 10)),
+		),
+	)
+
+	return &types.MsgEnrollTaskResponse{}, nil
+}
+
+// ClaimNative 在链上判定满足后向 creator 发放奖励，其余领取条件与 ClaimReward 相同。
+// 领取成功后清除登记并退回登记时锁定的资金，依赖持续时长的任务再次领取须重新登记
+func (k msgServer) ClaimNative(ctx context.Context, msg *types.MsgClaimNative) (*types.MsgClaimNativeResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	task, err := k.getTask(ctx, msg.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	res, claimHash, err := k.claimReward(ctx, msg.Creator, msg.TaskId, msg.Creator, "", task.RewardPerClaim.String(), "", func(task types.Task, recipient string, _ uint64) error {
+		_, err := k.evaluateNativeTask(ctx, task, recipient)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := k.releaseEnrollment(ctx, task.Id, msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to release enrollment: %s", err))
+	}
+
+	return &types.MsgClaimNativeResponse{ClaimHash: claimHash, StreamId: res.StreamId, ReleaseHeight: res.ReleaseHeight}, nil
+}
+
+// UnenrollTask 取消链上判定任务的登记并退回登记时锁定的资金，任务关闭或过期后仍可取消
+func (k msgServer) UnenrollTask(ctx context.Context, msg *types.MsgUnenrollTask) (*types.MsgUnenrollTaskResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	key := collections.Join(msg.TaskId, msg.Creator)
+	enrolled, err := k.NativeEnrollment.Has(ctx, key)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load enrollment: %s", err))
+	}
+	if !enrolled {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrNotFound, "%s is not enrolled in task %s", msg.Creator, msg.TaskId)
+	}
+	refund, err := k.releaseEnrollment(ctx, msg.TaskId, msg.Creator)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to release enrollment: %s", err))
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeNativeTaskUnenrolled,
+			sdk.NewAttribute(types.AttributeKeyTaskId, msg.TaskId),
+			sdk.NewAttribute(types.AttributeKeyRecipient, msg.Creator),
+			sdk.NewAttribute(types.AttributeKeyAmount, refund.String()),
+		),
+	)
+
+	return &types.MsgUnenrollTaskResponse{Refund: refund}, nil
+}
@@ -0,0 +1,205 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	identitytypes "dtc/x/identity/types"
+	"dtc/x/task/keeper"
+	"dtc/x/task/types"
+)
+
+// allowListPredicate 是测试用的扩展判定，只允许列出的地址领取
+type allowListPredicate map[string]bool
+
+func (allowListPredicate) Validate(types.TaskPredicate) error { return nil }
+
+func (a allowListPredicate) Evaluate(_ context.Context, _ types.TaskPredicate, address string, _ int64) error {
+	if !a[address] {
+		return types.ErrPredicateUnmet
+	}
+	return nil
+}
+
+func TestNativeTask(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	owner, err := f.addressCodec.BytesToString([]byte("taskOwner__________________"))
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString([]byte("alice______________________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString([]byte("bob________________________"))
+	require.NoError(t, err)
+	carol, err := f.addressCodec.BytesToString([]byte("carol______________________"))
+	require.NoError(t, err)
+	balance := func(addr string) int64 {
+		bz, err := f.addressCodec.StringToBytes(addr)
+		require.NoError(t, err)
+		return f.bankKeeper.GetBalance(bz).AmountOf("dtc").Int64()
+	}
+	createNative := func(taskID string, predicate types.TaskPredicate) error {
+		_, err := srv.CreateTask(ctx, &types.MsgCreateTask{
+			Creator:        owner,
+			TaskId:         taskID,
+			RewardPerClaim: sdk.NewInt64Coin("dtc", 10),
+			Budget:         sdk.NewInt64Coin("dtc", 100),
+			Predicate:      predicate,
+		})
+		if err == nil {
+			fundTestTask(t, f, srv, owner, taskID, sdk.NewInt64Coin("dtc", 100))
+		}
+		return err
+	}
+	claim := func(height int64, taskID, addr string) error {
+		_, err := srv.ClaimNative(ctx.WithBlockHeight(height), &types.MsgClaimNative{Creator: addr, TaskId: taskID})
+		return err
+	}
+
+	predicates, err := qs.TaskPredicates(ctx, &types.QueryTaskPredicatesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []string{types.PredicateBalanceHeld, types.PredicateDidRegistered, types.PredicateIbcTransfer, types.PredicateLiabilityRepaid}, predicates.Types)
+
+	// 判定类型须已注册且参数有效，链上判定任务不能登记预言机
+	for _, tc := range []struct {
+		desc      string
+		predicate types.TaskPredicate
+		err       error
+	}{
+		{desc: "Unknown", predicate: types.TaskPredicate{Type: "unknown"}, err: types.ErrUnknownPredicate},
+		{desc: "DidWithAmount", predicate: types.TaskPredicate{Type: types.PredicateDidRegistered, Amount: sdk.NewInt64Coin("dtc", 1)}, err: types.ErrInvalidTask},
+		{desc: "RepaidWrongDenom", predicate: types.TaskPredicate{Type: types.PredicateLiabilityRepaid, Amount: sdk.NewInt64Coin("dtc", 100)}, err: types.ErrInvalidTask},
+		{desc: "BalanceNoBlocks", predicate: types.TaskPredicate{Type: types.PredicateBalanceHeld, Amount: sdk.NewInt64Coin("dtc", 100)}, err: types.ErrInvalidTask},
+		{desc: "IbcBadChannel", predicate: types.TaskPredicate{Type: types.PredicateIbcTransfer, Amount: sdk.NewInt64Coin("dtc", 100), Target: "x"}, err: types.ErrInvalidTask},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			require.ErrorIs(t, createNative("invalid", tc.predicate), tc.err)
+		})
+	}
+	_, err = srv.CreateTask(ctx, &types.MsgCreateTask{
+		Creator:        owner,
+		TaskId:         "invalid",
+		RewardPerClaim: sdk.NewInt64Coin("dtc", 10),
+		Budget:         sdk.NewInt64Coin("dtc", 100),
+		OraclePubkeys:  f.oraclePubkeys(),
+		Predicate:      types.TaskPredicate{Type: types.PredicateDidRegistered},
+	})
+	require.ErrorIs(t, err, types.ErrInvalidOracleSet)
+
+	// 注册 DID 即可领取，无需预言机签名；预言机领取、轮换与 Merkle 发布均被拒绝
+	require.NoError(t, createNative("did", types.TaskPredicate{Type: types.PredicateDidRegistered, Blocks: 5}))
+	require.ErrorIs(t, claim(10, "did", alice), types.ErrPredicateUnmet)
+	f.identity.dids[alice] = identitytypes.DidDocument{Did: "did:dtc:alice", CreatedHeight: 8}
+	f.identity.status[alice] = identitytypes.ATTESTATION_STATUS_ATTESTED
+	status, err := qs.NativeClaimStatus(ctx, &types.QueryNativeClaimStatusRequest{TaskId: "did", Address: alice})
+	require.NoError(t, err)
+	require.False(t, status.Satisfied)
+	require.Contains(t, status.Reason, "2 blocks old")
+	require.NoError(t, claim(13, "did", alice))
+	require.Equal(t, int64(10), balance(alice))
+	require.ErrorIs(t, claim(14, "did", alice), types.ErrClaimLimitReached)
+
+	_, err = srv.ClaimReward(ctx, f.signedClaim(t, &types.MsgClaimReward{Creator: owner, TaskId: "did", Amount: "10dtc", Recipient: bob}))
+	require.ErrorIs(t, err, types.ErrNativeTask)
+	_, err = srv.RotateTaskOracles(ctx, &types.MsgRotateTaskOracles{Creator: owner, TaskId: "did", OraclePubkeys: f.oraclePubkeys()})
+	require.ErrorIs(t, err, types.ErrNativeTask)
+	_, err = srv.PostMerkleRoot(ctx, &types.MsgPostMerkleRoot{Creator: owner, TaskId: "did", Epoch: 1})
+	require.ErrorIs(t, err, types.ErrNativeTask)
+	require.ErrorIs(t, claim(10, "missing", alice), types.ErrTaskNotFound)
+
+	// 累计偿还的信用负债达到要求后可领取
+	require.NoError(t, createNative("repaid", types.TaskPredicate{Type: types.PredicateLiabilityRepaid, Amount: sdk.NewInt64Coin(sdk.DefaultBondDenom, 100)}))
+	f.credit.repaid[bob] = 50
+	require.ErrorIs(t, claim(10, "repaid", bob), types.ErrPredicateUnmet)
+	f.credit.repaid[bob] = 100
+	require.NoError(t, claim(10, "repaid", bob))
+	require.Equal(t, int64(10), balance(bob))
+
+	// 持有余额须先登记，登记时锁定要求的金额，自登记起满 blocks 个区块后才能领取，领取后清除登记并退回锁定的资金
+	require.NoError(t, createNative("hold", types.TaskPredicate{Type: types.PredicateBalanceHeld, Amount: sdk.NewInt64Coin("dtc", 100), Blocks: 10}))
+	_, err = srv.EnrollTask(ctx, &types.MsgEnrollTask{Creator: carol, TaskId: "hold"})
+	require.ErrorIs(t, err, types.ErrPredicateUnmet)
+	carolAddr, err := f.addressCodec.StringToBytes(carol)
+	require.NoError(t, err)
+	f.bankKeeper.mint(carolAddr, sdk.NewCoins(sdk.NewInt64Coin("dtc", 100)))
+	require.ErrorIs(t, claim(10, "hold", carol), types.ErrPredicateUnmet)
+	_, err = srv.EnrollTask(ctx, &types.MsgEnrollTask{Creator: carol, TaskId: "hold"})
+	require.NoError(t, err)
+	require.Zero(t, balance(carol))
+	_, err = srv.EnrollTask(ctx, &types.MsgEnrollTask{Creator: carol, TaskId: "hold"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+	_, err = srv.EnrollTask(ctx, &types.MsgEnrollTask{Creator: carol, TaskId: "did"})
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	createTestTask(t, f, srv, owner, "oracle", "10dtc", "100dtc")
+	_, err = srv.EnrollTask(ctx, &types.MsgEnrollTask{Creator: carol, TaskId: "oracle"})
+	require.ErrorIs(t, err, types.ErrNotNativeTask)
+	_, err = srv.ClaimNative(ctx, &types.MsgClaimNative{Creator: carol, TaskId: "oracle"})
+	require.ErrorIs(t, err, types.ErrNotNativeTask)
+
+	require.ErrorIs(t, claim(15, "hold", carol), types.ErrPredicateUnmet)
+	status, err = qs.NativeClaimStatus(ctx.WithBlockHeight(20), &types.QueryNativeClaimStatusRequest{TaskId: "hold", Address: carol})
+	require.NoError(t, err)
+	require.True(t, status.Satisfied)
+	require.Equal(t, int64(10), status.EnrolledHeight)
+	require.NoError(t, claim(20, "hold", carol))
+	require.Equal(t, int64(110), balance(carol))
+	_, err = f.keeper.NativeEnrollment.Get(ctx, collections.Join("hold", carol))
+	require.Error(t, err)
+	_, err = f.keeper.EnrollmentDeposit.Get(ctx, collections.Join("hold", carol))
+	require.Error(t, err)
+
+	// 锁定期间同一笔余额不能转给其他地址再次登记；取消登记后退回锁定的资金
+	require.NoError(t, createNative("hold-again", types.TaskPredicate{Type: types.PredicateBalanceHeld, Amount: sdk.NewInt64Coin("dtc", 100), Blocks: 10}))
+	_, err = srv.EnrollTask(ctx, &types.MsgEnrollTask{Creator: carol, TaskId: "hold-again"})
+	require.NoError(t, err)
+	require.Equal(t, int64(10), balance(carol))
+	_, err = srv.EnrollTask(ctx, &types.MsgEnrollTask{Creator: carol, TaskId: "hold"})
+	require.ErrorIs(t, err, types.ErrPredicateUnmet)
+	unenrolled, err := srv.UnenrollTask(ctx, &types.MsgUnenrollTask{Creator: carol, TaskId: "hold-again"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 100), unenrolled.Refund)
+	require.Equal(t, int64(110), balance(carol))
+	_, err = srv.UnenrollTask(ctx, &types.MsgUnenrollTask{Creator: carol, TaskId: "hold-again"})
+	require.ErrorIs(t, err, sdkerrors.ErrNotFound)
+	require.ErrorIs(t, claim(30, "hold-again", carol), types.ErrPredicateUnmet)
+
+	// 未锁定资金的登记不满足判定
+	require.NoError(t, f.keeper.NativeEnrollment.Set(ctx, collections.Join("hold-again", bob), 5))
+	require.ErrorIs(t, claim(30, "hold-again", bob), types.ErrPredicateUnmet)
+	unenrolled, err = srv.UnenrollTask(ctx, &types.MsgUnenrollTask{Creator: bob, TaskId: "hold-again"})
+	require.NoError(t, err)
+	require.True(t, unenrolled.Refund.IsZero())
+
+	// 跨链转出被确认的金额按通道累计，未指定通道时汇总全部通道
+	require.NoError(t, createNative("ibc-any", types.TaskPredicate{Type: types.PredicateIbcTransfer, Amount: sdk.NewInt64Coin("dtc", 100)}))
+	require.NoError(t, createNative("ibc-channel", types.TaskPredicate{Type: types.PredicateIbcTransfer, Amount: sdk.NewInt64Coin("dtc", 100), Target: "channel-0"}))
+	require.NoError(t, f.keeper.RecordIBCTransfer(ctx, alice, "channel-0", sdk.NewInt64Coin("dtc", 60)))
+	require.NoError(t, f.keeper.RecordIBCTransfer(ctx, alice, "channel-1", sdk.NewInt64Coin("dtc", 50)))
+	require.ErrorIs(t, claim(10, "ibc-channel", alice), types.ErrPredicateUnmet)
+	require.NoError(t, claim(10, "ibc-any", alice))
+
+	// IBC v2 的转出按源客户端累计
+	require.NoError(t, createNative("ibc-client", types.TaskPredicate{Type: types.PredicateIbcTransfer, Amount: sdk.NewInt64Coin("dtc", 100), Target: "07-tendermint-0"}))
+	require.NoError(t, f.keeper.RecordIBCTransfer(ctx, alice, "07-tendermint-0", sdk.NewInt64Coin("dtc", 100)))
+	require.NoError(t, claim(10, "ibc-client", alice))
+
+	// 其他模块可注册扩展判定
+	f.keeper.RegisterPredicate("allow_list", allowListPredicate{bob: true})
+	require.Panics(t, func() { f.keeper.RegisterPredicate("allow_list", allowListPredicate{}) })
+	require.NoError(t, createNative("custom", types.TaskPredicate{Type: "allow_list"}))
+	require.ErrorIs(t, claim(10, "custom", alice), types.ErrPredicateUnmet)
+	require.NoError(t, claim(10, "custom", bob))
+
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+}
@@ -0,0 +1,172 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"testing"
+
+	"github.com/cometbft/cometbft/crypto/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/task/keeper"
+	"dtc/x/task/types"
+)
+
+// signClaim 用给定私钥依次对 taskID + recipient + amount 签名并拼接
+func signClaim(t *testing.T, data string, keys ...secp256k1.PrivKey) string {
+	t.Helper()
+
+	var signatures []byte
+	for _, key := range keys {
+		signature, err := key.Sign([]byte(data))
+		require.NoError(t, err)
+		signatures = append(signatures, signature...)
+	}
+	return hex.EncodeToString(signatures)
+}
+
+func pubkeyHex(key secp256k1.PrivKey) string {
+	return hex.EncodeToString(key.PubKey().Bytes())
+}
+
+func TestClaimReward_OracleThreshold(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("taskOwner__________________"))
+	require.NoError(t, err)
+	keys := []secp256k1.PrivKey{secp256k1.GenPrivKey(), secp256k1.GenPrivKey(), secp256k1.GenPrivKey()}
+	outsider := secp256k1.GenPrivKey()
+	f.grantOracle(t, keys[0])
+	f.grantOracle(t, keys[2])
+	f.grantOracle(t, outsider)
+
+	_, err = srv.CreateTask(f.ctx, &types.MsgCreateTask{
+		Creator:         owner,
+		TaskId:          "multisig",
+		RewardPerClaim:  sdk.NewInt64Coin("dtc", 10),
+		Budget:          sdk.NewInt64Coin("dtc", 100),
+		OraclePubkeys:   []string{pubkeyHex(keys[0]), pubkeyHex(keys[1]), pubkeyHex(keys[2])},
+		OracleThreshold: 2,
+	})
+	require.NoError(t, err)
+	fundTestTask(t, f, srv, owner, "multisig", sdk.NewInt64Coin("dtc", 100))
+
+	tests := []struct {
+		desc string
+		keys []secp256k1.PrivKey
+		err  error
+	}{
+		{desc: "single signature", keys: keys[:1], err: sdkerrors.ErrUnauthorized},
+		{desc: "same key twice", keys: []secp256k1.PrivKey{keys[0], keys[0]}, err: sdkerrors.ErrUnauthorized},
+		{desc: "outsider counted out", keys: []secp256k1.PrivKey{keys[0], outsider}, err: sdkerrors.ErrUnauthorized},
+		{desc: "signer without oracle role counted out", keys: []secp256k1.PrivKey{keys[0], keys[1]}, err: sdkerrors.ErrUnauthorized},
+		{desc: "two of three", keys: []secp256k1.PrivKey{keys[2], keys[0]}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			recipient, err := f.addressCodec.BytesToString([]byte("recipient__________________"))
+			require.NoError(t, err)
+			_, err = srv.ClaimReward(f.ctx, &types.MsgClaimReward{
+				Creator:   owner,
+				TaskId:    "multisig",
+				Amount:    "10dtc",
+				Recipient: recipient,
+				Signature: signClaim(t, "multisig"+recipient+"10dtc", tc.keys...),
+			})
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestRotateTaskOracles(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(100)
+
+	owner, err := f.addressCodec.BytesToString([]byte("taskOwner__________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("other______________________"))
+	require.NoError(t, err)
+	oldKey := secp256k1.GenPrivKey()
+	newKey := secp256k1.GenPrivKey()
+	newestKey := secp256k1.GenPrivKey()
+	f.grantOracle(t, oldKey)
+	f.grantOracle(t, newKey)
+	f.grantOracle(t, newestKey)
+
+	_, err = srv.CreateTask(ctx, &types.MsgCreateTask{
+		Creator:        owner,
+		TaskId:         "rotating",
+		RewardPerClaim: sdk.NewInt64Coin("dtc", 10),
+		Budget:         sdk.NewInt64Coin("dtc", 100),
+		PerUserLimit:   10,
+		OraclePubkeys:  []string{pubkeyHex(oldKey)},
+	})
+	require.NoError(t, err)
+	fundTestTask(t, f, srv, owner, "rotating", sdk.NewInt64Coin("dtc", 100))
+
+	claim := func(ctx sdk.Context, key secp256k1.PrivKey) error {
+		_, err := srv.ClaimReward(ctx, &types.MsgClaimReward{
+			Creator:   owner,
+			TaskId:    "rotating",
+			Amount:    "10dtc",
+			Signature: signClaim(t, types.ClaimSignBytes("rotating", owner, "", "10dtc", f.claimSequence(t, "rotating", owner)), key),
+		})
+		return err
+	}
+
+	_, err = srv.RotateTaskOracles(ctx, &types.MsgRotateTaskOracles{Creator: other, TaskId: "rotating", OraclePubkeys: []string{pubkeyHex(newKey)}, OverlapBlocks: 10})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = srv.RotateTaskOracles(ctx, &types.MsgRotateTaskOracles{Creator: owner, TaskId: "rotating", OraclePubkeys: []string{pubkeyHex(newKey)}, OverlapBlocks: -1})
+	require.ErrorIs(t, err, types.ErrInvalidOracleSet)
+	_, err = srv.RotateTaskOracles(ctx, &types.MsgRotateTaskOracles{Creator: owner, TaskId: "rotating", OverlapBlocks: 10})
+	require.ErrorIs(t, err, types.ErrInvalidOracleSet)
+
+	res, err := srv.RotateTaskOracles(ctx, &types.MsgRotateTaskOracles{Creator: owner, TaskId: "rotating", OraclePubkeys: []string{pubkeyHex(newKey)}, OverlapBlocks: 10})
+	require.NoError(t, err)
+	require.Equal(t, int64(110), res.PreviousOracleSetExpiry)
+
+	var rotated bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == types.EventTypeTaskOraclesRotated {
+			rotated = true
+			until, ok := event.GetAttribute(types.AttributeKeyOverlapUntil)
+			require.True(t, ok)
+			require.Equal(t, "110", until.Value)
+		}
+	}
+	require.True(t, rotated)
+
+	// 重叠期内新旧公钥都有效
+	require.NoError(t, claim(ctx.WithBlockHeight(105), oldKey))
+	// 签名覆盖领取序号，首次领取的签名不能用于后续领取
+	_, err = srv.ClaimReward(ctx.WithBlockHeight(105), &types.MsgClaimReward{
+		Creator:   owner,
+		TaskId:    "rotating",
+		Amount:    "10dtc",
+		Signature: signClaim(t, types.ClaimSignBytes("rotating", owner, "", "10dtc", 0), oldKey),
+	})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.NoError(t, claim(ctx.WithBlockHeight(110), newKey))
+	require.NoError(t, claim(ctx.WithBlockHeight(110), oldKey))
+	// 重叠期结束后旧公钥失效
+	require.ErrorIs(t, claim(ctx.WithBlockHeight(111), oldKey), sdkerrors.ErrUnauthorized)
+	require.NoError(t, claim(ctx.WithBlockHeight(111), newKey))
+
+	// 不设重叠期的轮换立即生效
+	_, err = srv.RotateTaskOracles(ctx.WithBlockHeight(112), &types.MsgRotateTaskOracles{Creator: owner, TaskId: "rotating", OraclePubkeys: []string{pubkeyHex(newestKey)}})
+	require.NoError(t, err)
+	require.ErrorIs(t, claim(ctx.WithBlockHeight(112), newKey), sdkerrors.ErrUnauthorized)
+	require.NoError(t, claim(ctx.WithBlockHeight(112), newestKey))
+
+	task, err := f.keeper.Task.Get(ctx, "rotating")
+	require.NoError(t, err)
+	require.True(t, task.PreviousOracleSet.IsEmpty())
+	require.Equal(t, uint64(5), task.ClaimCount)
+}
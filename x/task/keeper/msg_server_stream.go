@@ -0,0 +1,66 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dtc/x/task/types"
+)
+
+// WithdrawVested 提取接收用户奖励流中已释放的奖励，stream_id 为 0 时提取其全部奖励流
+func (k msgServer) WithdrawVested(ctx context.Context, msg *types.MsgWithdrawVested) (*types.MsgWithdrawVestedResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	var streams []types.RewardStream
+	if msg.StreamId != 0 {
+		stream, err := k.RewardStream.Get(ctx, msg.StreamId)
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				return nil, errorsmod.Wrapf(types.ErrStreamNotFound, "stream %d", msg.StreamId)
+			}
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		if stream.Recipient != msg.Creator {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the recipient can withdraw a reward stream")
+		}
+		streams = append(streams, stream)
+	} else {
+		iter, err := k.StreamsByRecipient.Iterate(ctx, collections.NewPrefixedPairRange[string, uint64](msg.Creator))
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		keys, err := iter.Keys()
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+		}
+		for _, key := range keys {
+			stream, err := k.RewardStream.Get(ctx, key.K2())
+			if err != nil {
+				return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+			}
+			streams = append(streams, stream)
+		}
+	}
+
+	withdrawn := sdk.NewCoins()
+	for _, stream := range streams {
+		amount, err := k.withdrawVested(ctx, stream)
+		if err != nil {
+			return nil, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to withdraw stream %d: %s", stream.Id, err))
+		}
+		withdrawn = withdrawn.Add(amount)
+	}
+	if withdrawn.IsZero() {
+		return nil, errorsmod.Wrap(types.ErrNothingVested, msg.Creator)
+	}
+
+	return &types.MsgWithdrawVestedResponse{Amount: withdrawn}, nil
+}
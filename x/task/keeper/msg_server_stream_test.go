@@ -0,0 +1,113 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/task/keeper"
+	"dtc/x/task/types"
+)
+
+func TestWithdrawVested(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	invariant := keeper.EscrowBalanceInvariant(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	owner, err := f.addressCodec.BytesToString([]byte("taskOwner__________________"))
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString([]byte("alice______________________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString([]byte("bob________________________"))
+	require.NoError(t, err)
+	ownerAddr, err := f.addressCodec.StringToBytes(owner)
+	require.NoError(t, err)
+	aliceAddr, err := f.addressCodec.StringToBytes(alice)
+	require.NoError(t, err)
+	f.bankKeeper.mint(ownerAddr, sdk.NewCoins(sdk.NewInt64Coin("dtc", 300)))
+
+	createMsg := types.MsgCreateTask{
+		Creator:        owner,
+		OraclePubkeys:  f.oraclePubkeys(),
+		TaskId:         "vesting",
+		RewardPerClaim: sdk.NewInt64Coin("dtc", 100),
+		Budget:         sdk.NewInt64Coin("dtc", 300),
+		PerUserLimit:   2,
+		PayoutSchedule: types.PayoutSchedule{Type: types.PAYOUT_SCHEDULE_TYPE_CLIFF_LINEAR, DurationBlocks: 100, CliffBlocks: 120},
+	}
+	_, err = srv.CreateTask(ctx, &createMsg)
+	require.ErrorIs(t, err, types.ErrInvalidTask)
+
+	createMsg.PayoutSchedule.CliffBlocks = 20
+	_, err = srv.CreateTask(ctx, &createMsg)
+	require.NoError(t, err)
+	fundTestTask(t, f, srv, owner, "vesting", sdk.NewInt64Coin("dtc", 300))
+
+	claim := func(height int64) uint64 {
+		res, err := srv.ClaimReward(ctx.WithBlockHeight(height), f.signedClaim(t, &types.MsgClaimReward{
+			Creator:   owner,
+			TaskId:    "vesting",
+			Amount:    "100dtc",
+			Recipient: alice,
+		}))
+		require.NoError(t, err)
+		return res.StreamId
+	}
+	withdraw := func(height int64, creator string, streamId uint64) (sdk.Coins, error) {
+		res, err := srv.WithdrawVested(ctx.WithBlockHeight(height), &types.MsgWithdrawVested{Creator: creator, StreamId: streamId})
+		if err != nil {
+			return nil, err
+		}
+		return res.Amount, nil
+	}
+
+	// 奖金留在模块账户中，接收用户余额不变
+	first := claim(10)
+	require.Equal(t, uint64(1), first)
+	require.True(t, f.bankKeeper.GetBalance(aliceAddr).IsZero())
+	_, broken := invariant(ctx)
+	require.False(t, broken)
+
+	// 悬崖期（高度 30 之前）内没有可提取的奖励
+	_, err = withdraw(29, alice, first)
+	require.ErrorIs(t, err, types.ErrNothingVested)
+
+	amount, err := withdraw(30, alice, first)
+	require.NoError(t, err)
+	require.Equal(t, "20dtc", amount.String())
+
+	_, err = withdraw(30, bob, first)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = withdraw(30, alice, 99)
+	require.ErrorIs(t, err, types.ErrStreamNotFound)
+
+	second := claim(60)
+	require.Equal(t, uint64(2), second)
+
+	res, err := qs.RewardStreamsByRecipient(ctx.WithBlockHeight(110), &types.QueryRewardStreamsByRecipientRequest{Recipient: alice})
+	require.NoError(t, err)
+	require.Len(t, res.Streams, 2)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 100), res.Streams[0].Vested)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 80), res.Streams[0].Withdrawable)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 50), res.Streams[1].Vested)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 50), res.Streams[1].Withdrawable)
+
+	// stream_id 为 0 时提取全部奖励流，已全部提取的奖励流被删除
+	amount, err = withdraw(110, alice, 0)
+	require.NoError(t, err)
+	require.Equal(t, "130dtc", amount.String())
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("dtc", 150)), f.bankKeeper.GetBalance(aliceAddr))
+
+	_, err = f.keeper.RewardStream.Get(ctx, first)
+	require.Error(t, err)
+	stream, err := f.keeper.RewardStream.Get(ctx, second)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin("dtc", 50), stream.Withdrawn)
+
+	_, broken = invariant(ctx)
+	require.False(t, broken)
+}
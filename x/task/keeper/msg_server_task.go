@@ -0,0 +1,167 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dtc/x/task/types"
+)
+
+// CreateTask 登记新任务，creator 成为任务 owner
+func (k msgServer) CreateTask(ctx context.Context, msg *types.MsgCreateTask) (*types.MsgCreateTaskResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	// 链上判定任务不登记预言机，判定类型须已注册
+	var oracles types.OracleSet
+	if msg.Predicate.IsEmpty() {
+		oracles = types.NewOracleSet(msg.OraclePubkeys, msg.OracleThreshold)
+		if err := oracles.Validate(); err != nil {
+			return nil, errorsmod.Wrap(types.ErrInvalidOracleSet, err.Error())
+		}
+	} else {
+		if len(msg.OraclePubkeys) != 0 || msg.OracleThreshold != 0 {
+			return nil, errorsmod.Wrap(types.ErrInvalidOracleSet, "native task cannot have oracles")
+		}
+		if err := k.validatePredicate(msg.Predicate); err != nil {
+			return nil, err
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	task := types.Task{
+		Id:                  msg.TaskId,
+		Owner:               msg.Creator,
+		RewardPerClaim:      msg.RewardPerClaim,
+		Budget:              msg.Budget,
+		RemainingBudget:     msg.Budget,
+		MaxClaims:           msg.MaxClaims,
+		PerUserLimit:        msg.PerUserLimit,
+		StartHeight:         msg.StartHeight,
+		EndHeight:           msg.EndHeight,
+		Status:              types.TASK_STATUS_OPEN,
+		CreatedHeight:       sdkCtx.BlockHeight(),
+		OracleSet:           oracles,
+		Eligibility:         msg.Eligibility,
+		PayoutSchedule:      msg.PayoutSchedule,
+		DisputeWindowBlocks: msg.DisputeWindowBlocks,
+		Predicate:           msg.Predicate,
+	}
+	if err := task.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidTask, err.Error())
+	}
+	if task.EndHeight != 0 && task.EndHeight <= sdkCtx.BlockHeight() {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTask, "end height %d must be after current height %d", task.EndHeight, sdkCtx.BlockHeight())
+	}
+
+	exists, err := k.Task.Has(ctx, task.Id)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	if exists {
+		return nil, errorsmod.Wrapf(types.ErrInvalidTask, "task %s already exists", task.Id)
+	}
+
+	if err := k.setTask(ctx, task); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTaskCreated,
+			sdk.NewAttribute(types.AttributeKeyTaskId, task.Id),
+			sdk.NewAttribute(types.AttributeKeyOwner, task.Owner),
+			sdk.NewAttribute(types.AttributeKeyAmount, task.RewardPerClaim.String()),
+			sdk.NewAttribute(types.AttributeKeyBudget, task.Budget.String()),
+		),
+	)
+
+	return &types.MsgCreateTaskResponse{}, nil
+}
+
+// CloseTask 由任务 owner 提前关闭任务
+func (k msgServer) CloseTask(ctx context.Context, msg *types.MsgCloseTask) (*types.MsgCloseTaskResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+
+	task, err := k.getTask(ctx, msg.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	if task.Owner != msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the task owner can close the task")
+	}
+	if !task.IsOpen() {
+		return nil, errorsmod.Wrap(types.ErrTaskClosed, task.Id)
+	}
+
+	if err := k.closeTask(ctx, task, types.AttributeValueReasonOwner); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	return &types.MsgCloseTaskResponse{}, nil
+}
+
+// RotateTaskOracles 由任务 owner 轮换预言机公钥集合，旧集合在 overlap_blocks 个区块内仍可签名
+func (k msgServer) RotateTaskOracles(ctx context.Context, msg *types.MsgRotateTaskOracles) (*types.MsgRotateTaskOraclesResponse, error) {
+	if _, err := k.addressCodec.StringToBytes(msg.Creator); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidAddress, fmt.Sprintf("invalid creator address: %s", err))
+	}
+	if msg.OverlapBlocks < 0 {
+		return nil, errorsmod.Wrap(types.ErrInvalidOracleSet, "overlap blocks cannot be negative")
+	}
+	oracles := types.NewOracleSet(msg.OraclePubkeys, msg.OracleThreshold)
+	if err := oracles.Validate(); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalidOracleSet, err.Error())
+	}
+
+	task, err := k.getTask(ctx, msg.TaskId)
+	if err != nil {
+		return nil, err
+	}
+	if task.Owner != msg.Creator {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the task owner can rotate oracles")
+	}
+	if task.IsNative() {
+		return nil, errorsmod.Wrap(types.ErrNativeTask, task.Id)
+	}
+	if !task.IsOpen() {
+		return nil, errorsmod.Wrap(types.ErrTaskClosed, task.Id)
+	}
+
+	// 重叠期内再次轮换时，更早的集合立即失效，只保留刚被替换的集合
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	previous := task.OracleSet
+	task.OracleSet = oracles
+	task.PreviousOracleSet = types.OracleSet{}
+	task.PreviousOracleSetExpiry = 0
+	if msg.OverlapBlocks > 0 {
+		task.PreviousOracleSet = previous
+		task.PreviousOracleSetExpiry = sdkCtx.BlockHeight() + msg.OverlapBlocks
+	}
+	if err := k.Task.Set(ctx, task.Id, task); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTaskOraclesRotated,
+			sdk.NewAttribute(types.AttributeKeyTaskId, task.Id),
+			sdk.NewAttribute(types.AttributeKeyOwner, task.Owner),
+			sdk.NewAttribute(types.AttributeKeyOraclePubkeys, strings.Join(oracles.Pubkeys, ",")),
+			sdk.NewAttribute(types.AttributeKeyThreshold, strconv.FormatUint(uint64(oracles.Threshold), 10)),
+			sdk.NewAttribute(types.AttributeKeyPreviousPubkeys, strings.Join(previous.Pubkeys, ",")),
+			sdk.NewAttribute(types.AttributeKeyOverlapUntil, strconv.FormatInt(task.PreviousOracleSetExpiry, 10)),
+		),
+	)
+
+	return &types.MsgRotateTaskOraclesResponse{PreviousOracleSetExpiry: task.PreviousOracleSetExpiry}, nil
+}
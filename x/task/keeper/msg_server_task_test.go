@@ -0,0 +1,239 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/task/keeper"
+	"dtc/x/task/types"
+)
+
+func TestCreateTask(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(10)
+
+	owner, err := f.addressCodec.BytesToString([]byte("taskOwner__________________"))
+	require.NoError(t, err)
+
+	valid := types.MsgCreateTask{
+		Creator:        owner,
+		OraclePubkeys:  f.oraclePubkeys(),
+		TaskId:         "task-1",
+		RewardPerClaim: sdk.NewInt64Coin("dtc", 100),
+		Budget:         sdk.NewInt64Coin("dtc", 1000),
+		MaxClaims:      5,
+		PerUserLimit:   2,
+		StartHeight:    10,
+		EndHeight:      20,
+	}
+
+	tests := []struct {
+		desc   string
+		mutate func(msg *types.MsgCreateTask)
+		err    error
+	}{
+		{desc: "invalid creator", mutate: func(msg *types.MsgCreateTask) { msg.Creator = "invalid" }, err: sdkerrors.ErrInvalidAddress},
+		{desc: "empty id", mutate: func(msg *types.MsgCreateTask) { msg.TaskId = "" }, err: types.ErrInvalidTask},
+		{desc: "zero reward", mutate: func(msg *types.MsgCreateTask) { msg.RewardPerClaim = sdk.NewInt64Coin("dtc", 0) }, err: types.ErrInvalidTask},
+		{desc: "denom mismatch", mutate: func(msg *types.MsgCreateTask) { msg.Budget = sdk.NewInt64Coin("uatom", 1000) }, err: types.ErrInvalidTask},
+		{desc: "budget below reward", mutate: func(msg *types.MsgCreateTask) { msg.Budget = sdk.NewInt64Coin("dtc", 50) }, err: types.ErrInvalidTask},
+		{desc: "end before start", mutate: func(msg *types.MsgCreateTask) { msg.EndHeight = 10 }, err: types.ErrInvalidTask},
+		{desc: "end in the past", mutate: func(msg *types.MsgCreateTask) { msg.StartHeight, msg.EndHeight = 1, 5 }, err: types.ErrInvalidTask},
+		{desc: "no oracles", mutate: func(msg *types.MsgCreateTask) { msg.OraclePubkeys = nil }, err: types.ErrInvalidOracleSet},
+		{desc: "invalid oracle pubkey", mutate: func(msg *types.MsgCreateTask) { msg.OraclePubkeys = []string{"02abcd"} }, err: types.ErrInvalidOracleSet},
+		{desc: "duplicate oracle pubkey", mutate: func(msg *types.MsgCreateTask) {
+			msg.OraclePubkeys = append(f.oraclePubkeys(), f.oraclePubkeys()...)
+		}, err: types.ErrInvalidOracleSet},
+		{desc: "threshold above key count", mutate: func(msg *types.MsgCreateTask) { msg.OracleThreshold = 2 }, err: types.ErrInvalidOracleSet},
+		{desc: "valid", mutate: func(msg *types.MsgCreateTask) {}},
+		{desc: "duplicate", mutate: func(msg *types.MsgCreateTask) {}, err: types.ErrInvalidTask},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			msg := valid
+			tc.mutate(&msg)
+			_, err := srv.CreateTask(ctx, &msg)
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+
+	task, err := f.keeper.Task.Get(ctx, "task-1")
+	require.NoError(t, err)
+	require.Equal(t, owner, task.Owner)
+	require.Equal(t, types.TASK_STATUS_OPEN, task.Status)
+	require.Equal(t, task.Budget, task.RemainingBudget)
+	require.Equal(t, int64(10), task.CreatedHeight)
+	require.Equal(t, types.NewOracleSet(f.oraclePubkeys(), 1), task.OracleSet)
+}
+
+func TestClaimReward_TaskAccounting(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("taskOwner__________________"))
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString([]byte("alice______________________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString([]byte("bob________________________"))
+	require.NoError(t, err)
+
+	claim := func(taskID, recipient, amount string) error {
+		_, err := srv.ClaimReward(f.ctx, f.signedClaim(t, &types.MsgClaimReward{
+			Creator:   owner,
+			TaskId:    taskID,
+			Amount:    amount,
+			Recipient: recipient,
+		}))
+		return err
+	}
+
+	t.Run("unknown task", func(t *testing.T) {
+		require.ErrorIs(t, claim("missing", alice, "100dtc"), types.ErrTaskNotFound)
+	})
+
+	_, err = srv.CreateTask(f.ctx, &types.MsgCreateTask{
+		Creator:        owner,
+		OraclePubkeys:  f.oraclePubkeys(),
+		TaskId:         "budget",
+		RewardPerClaim: sdk.NewInt64Coin("dtc", 100),
+		Budget:         sdk.NewInt64Coin("dtc", 350),
+		PerUserLimit:   2,
+	})
+	require.NoError(t, err)
+
+	t.Run("unfunded task", func(t *testing.T) {
+		require.ErrorIs(t, claim("budget", alice, "100dtc"), types.ErrInsufficientEscrow)
+	})
+	fundTestTask(t, f, srv, owner, "budget", sdk.NewInt64Coin("dtc", 350))
+
+	t.Run("amount mismatch", func(t *testing.T) {
+		require.ErrorIs(t, claim("budget", alice, "200dtc"), types.ErrAmountMismatch)
+	})
+
+	t.Run("per-user limit and budget exhaustion", func(t *testing.T) {
+		require.NoError(t, claim("budget", alice, "100dtc"))
+		require.NoError(t, claim("budget", alice, "100dtc"))
+		require.ErrorIs(t, claim("budget", alice, "100dtc"), types.ErrClaimLimitReached)
+		require.NoError(t, claim("budget", bob, "100dtc"))
+
+		task, err := f.keeper.Task.Get(f.ctx, "budget")
+		require.NoError(t, err)
+		require.Equal(t, uint64(3), task.ClaimCount)
+		require.Equal(t, sdk.NewInt64Coin("dtc", 50), task.RemainingBudget)
+		// 剩余预算不足一次奖励，任务自动关闭
+		require.Equal(t, types.TASK_STATUS_CLOSED, task.Status)
+
+		require.ErrorIs(t, claim("budget", bob, "100dtc"), types.ErrTaskClosed)
+
+		aliceAddr, err := f.addressCodec.StringToBytes(alice)
+		require.NoError(t, err)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("dtc", 200)), f.bankKeeper.GetBalance(aliceAddr))
+	})
+
+	t.Run("max claims closes the task", func(t *testing.T) {
+		_, err := srv.CreateTask(f.ctx, &types.MsgCreateTask{
+			Creator:        owner,
+			OraclePubkeys:  f.oraclePubkeys(),
+			TaskId:         "capped",
+			RewardPerClaim: sdk.NewInt64Coin("dtc", 10),
+			Budget:         sdk.NewInt64Coin("dtc", 1000),
+			MaxClaims:      1,
+		})
+		require.NoError(t, err)
+		fundTestTask(t, f, srv, owner, "capped", sdk.NewInt64Coin("dtc", 20))
+
+		require.NoError(t, claim("capped", alice, "10dtc"))
+		require.ErrorIs(t, claim("capped", bob, "10dtc"), types.ErrTaskClosed)
+
+		task, err := f.keeper.Task.Get(f.ctx, "capped")
+		require.NoError(t, err)
+		require.Equal(t, types.TASK_STATUS_CLOSED, task.Status)
+		require.Equal(t, sdk.NewInt64Coin("dtc", 990), task.RemainingBudget)
+	})
+}
+
+func TestClaimReward_TaskWindow(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(1)
+
+	owner, err := f.addressCodec.BytesToString([]byte("taskOwner__________________"))
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString([]byte("alice______________________"))
+	require.NoError(t, err)
+
+	_, err = srv.CreateTask(ctx, &types.MsgCreateTask{
+		Creator:        owner,
+		OraclePubkeys:  f.oraclePubkeys(),
+		TaskId:         "window",
+		RewardPerClaim: sdk.NewInt64Coin("dtc", 10),
+		Budget:         sdk.NewInt64Coin("dtc", 100),
+		StartHeight:    5,
+		EndHeight:      8,
+	})
+	require.NoError(t, err)
+	fundTestTask(t, f, srv, owner, "window", sdk.NewInt64Coin("dtc", 100))
+
+	claim := func(ctx sdk.Context) error {
+		_, err := srv.ClaimReward(ctx, f.signedClaim(t, &types.MsgClaimReward{
+			Creator: alice,
+			TaskId:  "window",
+			Amount:  "10dtc",
+		}))
+		return err
+	}
+
+	require.ErrorIs(t, claim(ctx.WithBlockHeight(4)), types.ErrTaskNotStarted)
+	require.ErrorIs(t, claim(ctx.WithBlockHeight(9)), types.ErrTaskClosed)
+
+	// 截止高度之前 EndBlocker 不关闭任务
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(7)))
+	task, err := f.keeper.Task.Get(ctx, "window")
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_OPEN, task.Status)
+
+	require.NoError(t, claim(ctx.WithBlockHeight(8)))
+
+	require.NoError(t, f.keeper.EndBlocker(ctx.WithBlockHeight(8)))
+	task, err = f.keeper.Task.Get(ctx, "window")
+	require.NoError(t, err)
+	require.Equal(t, types.TASK_STATUS_CLOSED, task.Status)
+	require.Equal(t, int64(8), task.ClosedHeight)
+
+	has, err := f.keeper.TaskExpiryQueue.Has(ctx, collections.Join(int64(8), "window"))
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestCloseTask(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+
+	owner, err := f.addressCodec.BytesToString([]byte("taskOwner__________________"))
+	require.NoError(t, err)
+	other, err := f.addressCodec.BytesToString([]byte("other______________________"))
+	require.NoError(t, err)
+	createTestTask(t, f, srv, owner, "closable", "10dtc", "100dtc")
+
+	_, err = srv.CloseTask(f.ctx, &types.MsgCloseTask{Creator: other, TaskId: "closable"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = srv.CloseTask(f.ctx, &types.MsgCloseTask{Creator: owner, TaskId: "missing"})
+	require.ErrorIs(t, err, types.ErrTaskNotFound)
+
+	_, err = srv.CloseTask(f.ctx, &types.MsgCloseTask{Creator: owner, TaskId: "closable"})
+	require.NoError(t, err)
+
+	_, err = srv.CloseTask(f.ctx, &types.MsgCloseTask{Creator: owner, TaskId: "closable"})
+	require.ErrorIs(t, err, types.ErrTaskClosed)
+}
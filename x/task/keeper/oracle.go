@@ -0,0 +1,80 @@
+package keeper
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	errorsmod "cosmossdk.io/errors"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	secp256k1lib "github.com/ethereum/go-ethereum/crypto/secp256k1"
+
+	dtctypes "dtc/x/dtc/types"
+	"dtc/x/task/types"
+)
+
+// oracleSignatureLength 是单个 R || S 签名的字节长度
+const oracleSignatureLength = 64
+
+// verifyOracleSignatures 验证 data 的签名满足任务在当前高度任一有效预言机集合的阈值。
+// signatureHex 是一个或多个 64 字节 R || S 签名按顺序拼接后的 hex 编码
+func (k Keeper) verifyOracleSignatures(ctx context.Context, task types.Task, data string, signatureHex string) error {
+	signatureBytes, err := hex.DecodeString(signatureHex)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, fmt.Sprintf("invalid signature format: %s", err))
+	}
+	if len(signatureBytes) == 0 || len(signatureBytes)%oracleSignatureLength != 0 {
+		return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "invalid signature length")
+	}
+
+	// 先进行 SHA256 哈希，再直接使用底层 ECDSA 验证，避免再次哈希
+	hash := sha256.Sum256([]byte(data))
+	var signatures [][]byte
+	for i := 0; i < len(signatureBytes); i += oracleSignatureLength {
+		signatures = append(signatures, signatureBytes[i:i+oracleSignatureLength])
+	}
+
+	for _, set := range task.ActiveOracleSets(sdk.UnwrapSDKContext(ctx).BlockHeight()) {
+		if k.countOracleSigners(ctx, task.Id, set, hash[:], signatures) >= int(set.Threshold) {
+			return nil
+		}
+	}
+	return errorsmod.Wrap(sdkerrors.ErrUnauthorized, "invalid oracle signature")
+}
+
+// countOracleSigners 统计集合中至少有一个签名验证通过的不同公钥数量。
+// 公钥对应账户须在任务范围或全局持有预言机角色，角色被撤销或过期的公钥不计入阈值
+func (k Keeper) countOracleSigners(ctx context.Context, taskId string, set types.OracleSet, hash []byte, signatures [][]byte) int {
+	signers := 0
+	for _, pubKeyHex := range set.Pubkeys {
+		pubKeyBytes, err := hex.DecodeString(pubKeyHex)
+		if err != nil || len(pubKeyBytes) != secp256k1.PubKeySize {
+			continue
+		}
+		address, err := k.addressCodec.BytesToString((&secp256k1.PubKey{Key: pubKeyBytes}).Address())
+		if err != nil || !k.roleKeeper.HasRole(ctx, dtctypes.ROLE_ORACLE, address, taskId) {
+			continue
+		}
+		// 解压缩公钥得到 X, Y 坐标
+		x, y := secp256k1lib.DecompressPubkey(pubKeyBytes)
+		if x == nil || y == nil {
+			continue
+		}
+		pubKey := &ecdsa.PublicKey{Curve: secp256k1lib.S256(), X: x, Y: y}
+
+		for _, signature := range signatures {
+			r := new(big.Int).SetBytes(signature[:32])
+			s := new(big.Int).SetBytes(signature[32:])
+			if ecdsa.Verify(pubKey, hash, r, s) {
+				signers++
+				break
+			}
+		}
+	}
+	return signers
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dtc/x/task/types"
+)
+
+// payout 支付一次领取的奖金。任务设置了争议期时奖金先作为待定奖励留在模块账户，
+// 争议期结束后由 EndBlocker 发放，返回发放高度；否则立即发放，返回奖励流 ID
+func (k Keeper) payout(ctx context.Context, task types.Task, recipient, claimHash string, amount sdk.Coin) (streamId uint64, releaseHeight int64, err error) {
+	if task.DisputeWindowBlocks > 0 {
+		releaseHeight = sdk.UnwrapSDKContext(ctx).BlockHeight() + task.DisputeWindowBlocks
+		pending := types.PendingPayout{
+			ClaimHash:     claimHash,
+			TaskId:        task.Id,
+			Recipient:     recipient,
+			Amount:        amount,
+			ReleaseHeight: releaseHeight,
+		}
+		if err := k.setPendingPayout(ctx, pending); err != nil {
+			return 0, 0, fmt.Errorf("failed to hold pending payout: %w", err)
+		}
+		return 0, releaseHeight, nil
+	}
+	streamId, err = k.disburse(ctx, task, recipient, claimHash, amount)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to pay reward to %s: %w", recipient, err)
+	}
+	return streamId, 0, nil
+}
+
+// disburse 发放一次领取的奖金：先按信用负债偿还比例为接收用户偿还负债，
+// 其余部分按任务释放计划立即转给接收用户或转入新的奖励流。返回奖励流 ID，未创建奖励流时为 0
+func (k Keeper) disburse(ctx context.Context, task types.Task, recipient, claimHash string, amount sdk.Coin) (uint64, error) {
+	rest, err := k.repayCreditLiability(ctx, task.Id, recipient, claimHash, amount)
+	if err != nil {
+		return 0, err
+	}
+	if !rest.IsPositive() {
+		return 0, nil
+	}
+
+	if !task.PayoutSchedule.IsImmediate() {
+		stream, err := k.createRewardStream(ctx, task, recipient, claimHash, rest)
+		if err != nil {
+			return 0, err
+		}
+		return stream.Id, nil
+	}
+
+	recipientAddr, err := k.addressCodec.StringToBytes(recipient)
+	if err != nil {
+		return 0, err
+	}
+	return 0, k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipientAddr, sdk.NewCoins(rest))
+}
+
+// repayCreditLiability 用奖金的一部分偿还接收用户的信用负债，返回剩余应支付给接收用户的金额
+func (k Keeper) repayCreditLiability(ctx context.Context, taskId, recipient, claimHash string, amount sdk.Coin) (sdk.Coin, error) {
+	_, _, share, err := k.creditRepayShare(ctx, recipient)
+	if err != nil {
+		return amount, err
+	}
+	repayMax := math.LegacyNewDecFromInt(amount.Amount).Mul(share).TruncateInt()
+	if !repayMax.IsPositive() {
+		return amount, nil
+	}
+
+	repaid, err := k.creditKeeper.RepayLiability(ctx, types.ModuleName, recipient, sdk.NewCoin(amount.Denom, repayMax))
+	if err != nil {
+		return amount, err
+	}
+	if !repaid.IsPositive() {
+		return amount, nil
+	}
+	rest := amount.Sub(repaid)
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardSplit,
+			sdk.NewAttribute(types.AttributeKeyClaimHash, claimHash),
+			sdk.NewAttribute(types.AttributeKeyTaskId, taskId),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyCreditRepaid, repaid.String()),
+			sdk.NewAttribute(types.AttributeKeyRecipientAmount, rest.String()),
+		),
+	)
+	return rest, nil
+}
+
+// creditRepayShare 返回治理比例、用户自愿比例以及两者中较大的实际比例
+func (k Keeper) creditRepayShare(ctx context.Context, address string) (governed, optedIn, effective math.LegacyDec, err error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return governed, optedIn, effective, err
+	}
+	governed = params.CreditRepayShare
+	if governed.IsNil() {
+		governed = math.LegacyZeroDec()
+	}
+
+	optedIn, err = k.CreditRepayShare.Get(ctx, address)
+	if err != nil {
+		if !errors.Is(err, collections.ErrNotFound) {
+			return governed, optedIn, effective, err
+		}
+		optedIn = math.LegacyZeroDec()
+	}
+	return governed, optedIn, math.LegacyMaxDec(governed, optedIn), nil
+}
@@ -0,0 +1,281 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	identitytypes "dtc/x/identity/types"
+	"dtc/x/task/types"
+)
+
+// RegisterPredicate 注册链上判定类型，供其他模块扩展可由链上状态验证的任务。
+// 判定表由 Keeper 的所有副本共享，应在应用初始化时注册，重复注册同一类型会 panic
+func (k Keeper) RegisterPredicate(predicateType string, predicate types.Predicate) {
+	if predicateType == "" {
+		panic("predicate type cannot be empty")
+	}
+	if _, ok := k.predicates[predicateType]; ok {
+		panic(fmt.Sprintf("predicate %s already registered", predicateType))
+	}
+	k.predicates[predicateType] = predicate
+}
+
+// PredicateTypes 返回按字典序排列的已注册判定类型
+func (k Keeper) PredicateTypes() []string {
+	predicateTypes := make([]string, 0, len(k.predicates))
+	for predicateType := range k.predicates {
+		predicateTypes = append(predicateTypes, predicateType)
+	}
+	sort.Strings(predicateTypes)
+	return predicateTypes
+}
+
+// getPredicate 返回已注册的判定
+func (k Keeper) getPredicate(predicateType string) (types.Predicate, error) {
+	predicate, ok := k.predicates[predicateType]
+	if !ok {
+		return nil, errorsmod.Wrap(types.ErrUnknownPredicate, predicateType)
+	}
+	return predicate, nil
+}
+
+// validatePredicate 校验任务登记时提交的判定类型已注册且参数有效
+func (k Keeper) validatePredicate(p types.TaskPredicate) error {
+	predicate, err := k.getPredicate(p.Type)
+	if err != nil {
+		return err
+	}
+	if err := p.ValidateBasic(); err != nil {
+		return errorsmod.Wrap(types.ErrInvalidTask, err.Error())
+	}
+	if err := predicate.Validate(p); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidTask, "invalid %s predicate: %s", p.Type, err)
+	}
+	return nil
+}
+
+// evaluateNativeTask 判定地址是否满足任务的链上条件，返回地址登记任务的高度（未登记为 0）
+func (k Keeper) evaluateNativeTask(ctx context.Context, task types.Task, address string) (int64, error) {
+	if !task.IsNative() {
+		return 0, errorsmod.Wrap(types.ErrNotNativeTask, task.Id)
+	}
+	predicate, err := k.getPredicate(task.Predicate.Type)
+	if err != nil {
+		return 0, err
+	}
+	enrolledHeight, err := k.NativeEnrollment.Get(ctx, collections.Join(task.Id, address))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return 0, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load enrollment: %s", err))
+	}
+	if deposit, ok := predicate.(types.DepositPredicate); ok && enrolledHeight > 0 {
+		// 锁定资金前的登记没有押金，须取消登记后重新登记
+		required := deposit.EnrollmentDeposit(task.Predicate)
+		locked, err := k.EnrollmentDeposit.Get(ctx, collections.Join(task.Id, address))
+		if err != nil && !errors.Is(err, collections.ErrNotFound) {
+			return 0, errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load enrollment deposit: %s", err))
+		}
+		if err != nil || locked.Denom != required.Denom || locked.Amount.LT(required.Amount) {
+			return enrolledHeight, errorsmod.Wrapf(types.ErrPredicateUnmet, "%s has not locked %s", address, required)
+		}
+	}
+	return enrolledHeight, predicate.Evaluate(ctx, task.Predicate, address, enrolledHeight)
+}
+
+// lockEnrollmentDeposit 将登记须锁定的资金从地址转入模块账户
+func (k Keeper) lockEnrollmentDeposit(ctx context.Context, taskId, address string, deposit sdk.Coin) error {
+	if !deposit.IsPositive() {
+		return nil
+	}
+	addr, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, addr, types.ModuleName, sdk.NewCoins(deposit)); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFunds, "failed to lock %s: %s", deposit, err)
+	}
+	return k.EnrollmentDeposit.Set(ctx, collections.Join(taskId, address), deposit)
+}
+
+// releaseEnrollment 清除地址的任务登记并退回登记时锁定的资金，返回退回的金额
+func (k Keeper) releaseEnrollment(ctx context.Context, taskId, address string) (sdk.Coin, error) {
+	key := collections.Join(taskId, address)
+	if err := k.NativeEnrollment.Remove(ctx, key); err != nil {
+		return sdk.Coin{}, err
+	}
+	deposit, err := k.EnrollmentDeposit.Get(ctx, key)
+	if errors.Is(err, collections.ErrNotFound) {
+		return sdk.Coin{Amount: math.ZeroInt()}, nil
+	} else if err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.EnrollmentDeposit.Remove(ctx, key); err != nil {
+		return sdk.Coin{}, err
+	}
+	addr, err := k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, sdk.NewCoins(deposit)); err != nil {
+		return sdk.Coin{}, err
+	}
+	return deposit, nil
+}
+
+// registerBuiltinPredicates 注册基于身份、信用、银行与跨链转账状态的内置判定
+func (k Keeper) registerBuiltinPredicates() {
+	k.RegisterPredicate(types.PredicateDidRegistered, didRegisteredPredicate{k: k})
+	k.RegisterPredicate(types.PredicateLiabilityRepaid, liabilityRepaidPredicate{k: k})
+	k.RegisterPredicate(types.PredicateBalanceHeld, balanceHeldPredicate{k: k})
+	k.RegisterPredicate(types.PredicateIbcTransfer, ibcTransferPredicate{k: k})
+}
+
+// requirePositiveAmount 检查判定设置了正的金额
+func requirePositiveAmount(p types.TaskPredicate) error {
+	if p.Amount.Denom == "" || !p.Amount.IsPositive() {
+		return fmt.Errorf("amount must be positive")
+	}
+	return nil
+}
+
+// didRegisteredPredicate 判定地址持有已注册且注册时长不少于 blocks 的 DID
+type didRegisteredPredicate struct {
+	k Keeper
+}
+
+func (didRegisteredPredicate) Validate(p types.TaskPredicate) error {
+	if p.Amount.Denom != "" || p.Target != "" {
+		return fmt.Errorf("amount and target are not used")
+	}
+	return nil
+}
+
+func (d didRegisteredPredicate) Evaluate(ctx context.Context, p types.TaskPredicate, address string, _ int64) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	status, doc, err := d.k.identityKeeper.GetAttestationStatus(sdkCtx, address)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load identity: %s", err))
+	}
+	if status == identitytypes.ATTESTATION_STATUS_UNREGISTERED {
+		return errorsmod.Wrapf(types.ErrPredicateUnmet, "%s has no registered did", address)
+	}
+	if age := sdkCtx.BlockHeight() - doc.CreatedHeight; age < p.Blocks {
+		return errorsmod.Wrapf(types.ErrPredicateUnmet, "did %s is %d blocks old, task requires %d", doc.Did, age, p.Blocks)
+	}
+	return nil
+}
+
+// liabilityRepaidPredicate 判定地址累计偿还的信用负债不少于 amount，负债只以基础币种计价
+type liabilityRepaidPredicate struct {
+	k Keeper
+}
+
+func (liabilityRepaidPredicate) Validate(p types.TaskPredicate) error {
+	if err := requirePositiveAmount(p); err != nil {
+		return err
+	}
+	if p.Amount.Denom != sdk.DefaultBondDenom {
+		return fmt.Errorf("amount denom must be %s", sdk.DefaultBondDenom)
+	}
+	if p.Blocks != 0 || p.Target != "" {
+		return fmt.Errorf("blocks and target are not used")
+	}
+	return nil
+}
+
+func (l liabilityRepaidPredicate) Evaluate(ctx context.Context, p types.TaskPredicate, address string, _ int64) error {
+	repaid, err := l.k.creditKeeper.GetRepaidLiability(ctx, address)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load repaid liability: %s", err))
+	}
+	if math.NewIntFromUint64(repaid).LT(p.Amount.Amount) {
+		return errorsmod.Wrapf(types.ErrPredicateUnmet, "%s has repaid %d%s, task requires %s", address, repaid, p.Amount.Denom, p.Amount)
+	}
+	return nil
+}
+
+// balanceHeldPredicate 判定地址自登记起持有不少于 amount 的余额达到 blocks 个区块。
+// 链上不保存余额历史，登记时将 amount 锁定在模块账户，领取或取消登记时退回，
+// 同一笔余额因此不能在锁定期间被多个地址轮流用于登记
+type balanceHeldPredicate struct {
+	k Keeper
+}
+
+func (balanceHeldPredicate) Validate(p types.TaskPredicate) error {
+	if err := requirePositiveAmount(p); err != nil {
+		return err
+	}
+	if p.Blocks <= 0 {
+		return fmt.Errorf("blocks must be positive")
+	}
+	if p.Target != "" {
+		return fmt.Errorf("target is not used")
+	}
+	return nil
+}
+
+func (b balanceHeldPredicate) ValidateEnrollment(ctx context.Context, p types.TaskPredicate, address string) error {
+	addr, err := b.k.addressCodec.StringToBytes(address)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+	if balance := b.k.bankKeeper.GetAllBalances(ctx, addr).AmountOf(p.Amount.Denom); balance.LT(p.Amount.Amount) {
+		return errorsmod.Wrapf(types.ErrPredicateUnmet, "%s holds %s%s, task requires %s", address, balance, p.Amount.Denom, p.Amount)
+	}
+	return nil
+}
+
+func (balanceHeldPredicate) EnrollmentDeposit(p types.TaskPredicate) sdk.Coin {
+	return p.Amount
+}
+
+func (b balanceHeldPredicate) Evaluate(ctx context.Context, p types.TaskPredicate, address string, enrolledHeight int64) error {
+	if enrolledHeight == 0 {
+		return errorsmod.Wrapf(types.ErrPredicateUnmet, "%s has not enrolled", address)
+	}
+	if held := sdk.UnwrapSDKContext(ctx).BlockHeight() - enrolledHeight; held < p.Blocks {
+		return errorsmod.Wrapf(types.ErrPredicateUnmet, "%s has held for %d blocks, task requires %d", address, held, p.Blocks)
+	}
+	return nil
+}
+
+// ibcTransferPredicate 判定地址经 target（为空不限）跨链转出并被对端确认的累计金额不少于 amount。
+// target 为 IBC v1 的源通道或 IBC v2 的源客户端，两者的转出分别累计
+type ibcTransferPredicate struct {
+	k Keeper
+}
+
+func (ibcTransferPredicate) Validate(p types.TaskPredicate) error {
+	if err := requirePositiveAmount(p); err != nil {
+		return err
+	}
+	if p.Blocks != 0 {
+		return fmt.Errorf("blocks is not used")
+	}
+	if p.Target != "" {
+		// 通道标识符的格式是客户端标识符的子集
+		if err := host.ClientIdentifierValidator(p.Target); err != nil {
+			return fmt.Errorf("invalid target channel or client: %w", err)
+		}
+	}
+	return nil
+}
+
+func (i ibcTransferPredicate) Evaluate(ctx context.Context, p types.TaskPredicate, address string, _ int64) error {
+	total, err := i.k.ibcTransferTotal(ctx, address, p.Target, p.Amount.Denom)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrLogic, fmt.Sprintf("failed to load ibc transfers: %s", err))
+	}
+	if total.LT(p.Amount.Amount) {
+		return errorsmod.Wrapf(types.ErrPredicateUnmet, "%s has transferred %s%s, task requires %s", address, total, p.Amount.Denom, p.Amount)
+	}
+	return nil
+}
@@ -48,3 +48,80 @@ func (q queryServer) GetClaimRecord(ctx context.Context, req *types.QueryGetClai
 
 	return &types.QueryGetClaimRecordResponse{ClaimRecord: val}, nil
 }
+
+// listClaimsByIndex 按二级索引分页返回某一索引值下的领取记录
+func (q queryServer) listClaimsByIndex(
+	ctx context.Context,
+	index collections.KeySet[collections.Pair[string, string]],
+	value string,
+	pagination *query.PageRequest,
+) (*types.QueryListClaimsResponse, error) {
+	claimRecords, pageRes, err := query.CollectionPaginate(
+		ctx,
+		index,
+		pagination,
+		func(key collections.Pair[string, string], _ collections.NoValue) (types.ClaimRecord, error) {
+			return q.k.ClaimRecord.Get(ctx, key.K2())
+		},
+		query.WithCollectionPaginationPairPrefix[string, string](value),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryListClaimsResponse{ClaimRecord: claimRecords, Pagination: pageRes}, nil
+}
+
+func (q queryServer) ListClaimsByUser(ctx context.Context, req *types.QueryListClaimsByUserRequest) (*types.QueryListClaimsResponse, error) {
+	if req == nil || req.User == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	return q.listClaimsByIndex(ctx, q.k.ClaimsByUser, req.User, req.Pagination)
+}
+
+func (q queryServer) ListClaimsByTask(ctx context.Context, req *types.QueryListClaimsByTaskRequest) (*types.QueryListClaimsResponse, error) {
+	if req == nil || req.TaskId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	return q.listClaimsByIndex(ctx, q.k.ClaimsByTask, req.TaskId, req.Pagination)
+}
+
+func (q queryServer) ListClaimsByCreator(ctx context.Context, req *types.QueryListClaimsByCreatorRequest) (*types.QueryListClaimsResponse, error) {
+	if req == nil || req.Creator == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	return q.listClaimsByIndex(ctx, q.k.ClaimsByCreator, req.Creator, req.Pagination)
+}
+
+func (q queryServer) TotalPaidByTask(ctx context.Context, req *types.QueryTotalPaidByTaskRequest) (*types.QueryTotalPaidResponse, error) {
+	if req == nil || req.TaskId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	total, err := totalPaid(ctx, q.k.PaidByTask, req.TaskId)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryTotalPaidResponse{Total: total}, nil
+}
+
+func (q queryServer) TotalPaidByUser(ctx context.Context, req *types.QueryTotalPaidByUserRequest) (*types.QueryTotalPaidResponse, error) {
+	if req == nil || req.User == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	total, err := totalPaid(ctx, q.k.PaidByUser, req.User)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryTotalPaidResponse{Total: total}, nil
+}
+
+func (q queryServer) TotalPaidByCreator(ctx context.Context, req *types.QueryTotalPaidByCreatorRequest) (*types.QueryTotalPaidResponse, error) {
+	if req == nil || req.Creator == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	total, err := totalPaid(ctx, q.k.PaidByCreator, req.Creator)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryTotalPaidResponse{Total: total}, nil
+}
@@ -5,6 +5,7 @@ import (
 	"strconv"
 	"testing"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
@@ -21,6 +22,7 @@ func createNClaimRecord(keeper keeper.Keeper, ctx context.Context, n int) []type
 		items[i].TaskId = strconv.Itoa(i)
 		items[i].UserId = strconv.Itoa(i)
 		items[i].Signature = strconv.Itoa(i)
+		items[i].Amount = sdk.NewInt64Coin("dtc", int64(i+1))
 		_ = keeper.ClaimRecord.Set(ctx, items[i].ClaimHash, items[i])
 	}
 	return items
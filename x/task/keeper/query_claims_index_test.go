@@ -0,0 +1,111 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/task/keeper"
+	"dtc/x/task/types"
+)
+
+func TestClaimsIndexQueries(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	addr := func(name string) string {
+		bz := make([]byte, 20)
+		copy(bz, name)
+		s, err := f.addressCodec.BytesToString(bz)
+		require.NoError(t, err)
+		return s
+	}
+	owner, platformA, platformB, alice, bob := addr("owner"), addr("platformA"), addr("platformB"), addr("alice"), addr("bob")
+
+	createTestTask(t, f, srv, owner, "t1", "10dtc", "100dtc")
+	createTestTask(t, f, srv, owner, "t2", "7dtc", "70dtc")
+
+	claims := []struct{ creator, task, user, amount string }{
+		{platformA, "t1", alice, "10dtc"},
+		{platformA, "t1", bob, "10dtc"},
+		{platformB, "t2", alice, "7dtc"},
+	}
+	for _, c := range claims {
+		_, err := srv.ClaimReward(f.ctx, f.signedClaim(t, &types.MsgClaimReward{Creator: c.creator, TaskId: c.task, Amount: c.amount, Recipient: c.user}))
+		require.NoError(t, err)
+	}
+
+	byUser, err := qs.ListClaimsByUser(f.ctx, &types.QueryListClaimsByUserRequest{User: alice})
+	require.NoError(t, err)
+	require.Len(t, byUser.ClaimRecord, 2)
+	for _, record := range byUser.ClaimRecord {
+		require.Equal(t, alice, record.UserId)
+		require.True(t, record.Amount.IsPositive())
+		require.Equal(t, sdk.UnwrapSDKContext(f.ctx).BlockTime().Unix(), record.BlockTime)
+	}
+
+	byTask, err := qs.ListClaimsByTask(f.ctx, &types.QueryListClaimsByTaskRequest{TaskId: "t1", Pagination: &query.PageRequest{Limit: 1, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, byTask.ClaimRecord, 1)
+	require.Equal(t, uint64(2), byTask.Pagination.Total)
+	next, err := qs.ListClaimsByTask(f.ctx, &types.QueryListClaimsByTaskRequest{TaskId: "t1", Pagination: &query.PageRequest{Key: byTask.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, next.ClaimRecord, 1)
+	require.NotEqual(t, byTask.ClaimRecord[0].ClaimHash, next.ClaimRecord[0].ClaimHash)
+
+	byCreator, err := qs.ListClaimsByCreator(f.ctx, &types.QueryListClaimsByCreatorRequest{Creator: platformB})
+	require.NoError(t, err)
+	require.Len(t, byCreator.ClaimRecord, 1)
+	require.Equal(t, "t2", byCreator.ClaimRecord[0].TaskId)
+
+	tests := []struct {
+		desc  string
+		query func() (*types.QueryTotalPaidResponse, error)
+		total sdk.Coins
+	}{
+		{desc: "by task", query: func() (*types.QueryTotalPaidResponse, error) {
+			return qs.TotalPaidByTask(f.ctx, &types.QueryTotalPaidByTaskRequest{TaskId: "t1"})
+		}, total: sdk.NewCoins(sdk.NewInt64Coin("dtc", 20))},
+		{desc: "by user", query: func() (*types.QueryTotalPaidResponse, error) {
+			return qs.TotalPaidByUser(f.ctx, &types.QueryTotalPaidByUserRequest{User: alice})
+		}, total: sdk.NewCoins(sdk.NewInt64Coin("dtc", 17))},
+		{desc: "by creator", query: func() (*types.QueryTotalPaidResponse, error) {
+			return qs.TotalPaidByCreator(f.ctx, &types.QueryTotalPaidByCreatorRequest{Creator: platformA})
+		}, total: sdk.NewCoins(sdk.NewInt64Coin("dtc", 20))},
+		{desc: "unknown user", query: func() (*types.QueryTotalPaidResponse, error) {
+			return qs.TotalPaidByUser(f.ctx, &types.QueryTotalPaidByUserRequest{User: owner})
+		}, total: sdk.NewCoins()},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			res, err := tc.query()
+			require.NoError(t, err)
+			require.Equal(t, tc.total, res.Total)
+		})
+	}
+}
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	record := types.ClaimRecord{ClaimHash: "legacy", TaskId: "task", UserId: "user", Creator: "creator"}
+	require.NoError(t, f.keeper.ClaimRecord.Set(f.ctx, record.ClaimHash, record))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(sdk.UnwrapSDKContext(f.ctx)))
+
+	for _, index := range []struct {
+		set collections.KeySet[collections.Pair[string, string]]
+		key string
+	}{
+		{f.keeper.ClaimsByUser, "user"},
+		{f.keeper.ClaimsByTask, "task"},
+		{f.keeper.ClaimsByCreator, "creator"},
+	} {
+		has, err := index.set.Has(f.ctx, collections.Join(index.key, "legacy"))
+		require.NoError(t, err)
+		require.True(t, has)
+	}
+}
@@ -0,0 +1,24 @@
+package keeper
+
+import (
+	"context"
+
+	"dtc/x/task/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// CreditRepayShare 返回地址的治理比例、自愿比例与实际用于偿还信用负债的比例
+func (q queryServer) CreditRepayShare(ctx context.Context, req *types.QueryCreditRepayShareRequest) (*types.QueryCreditRepayShareResponse, error) {
+	if req == nil || req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	governed, optedIn, effective, err := q.k.creditRepayShare(ctx, req.Address)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryCreditRepayShareResponse{Governed: governed, OptedIn: optedIn, Effective: effective}, nil
+}
@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"dtc/x/task/types"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PendingPayout 返回争议期内尚未发放的待定奖励
+func (q queryServer) PendingPayout(ctx context.Context, req *types.QueryPendingPayoutRequest) (*types.QueryPendingPayoutResponse, error) {
+	if req == nil || req.ClaimHash == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pending, err := q.k.PendingPayout.Get(ctx, req.ClaimHash)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryPendingPayoutResponse{PendingPayout: pending}, nil
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"dtc/x/task/types"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TaskEscrow 返回任务当前的托管资金
+func (q queryServer) TaskEscrow(ctx context.Context, req *types.QueryTaskEscrowRequest) (*types.QueryTaskEscrowResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	task, err := q.k.Task.Get(ctx, req.TaskId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "task not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	escrow, err := q.k.getEscrow(ctx, task)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryTaskEscrowResponse{Escrow: types.TaskEscrow{TaskId: task.Id, Amount: escrow}}, nil
+}
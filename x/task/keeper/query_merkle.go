@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"dtc/x/task/types"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// MerkleDistribution 返回任务某一周期发布的 Merkle 分发
+func (q queryServer) MerkleDistribution(ctx context.Context, req *types.QueryMerkleDistributionRequest) (*types.QueryMerkleDistributionResponse, error) {
+	if req == nil || req.TaskId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	dist, err := q.k.MerkleDistribution.Get(ctx, collections.Join(req.TaskId, req.Epoch))
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryMerkleDistributionResponse{Distribution: dist}, nil
+}
+
+// MerkleLeafClaimed 返回任务某一周期的叶子是否已被领取
+func (q queryServer) MerkleLeafClaimed(ctx context.Context, req *types.QueryMerkleLeafClaimedRequest) (*types.QueryMerkleLeafClaimedResponse, error) {
+	if req == nil || req.TaskId == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	claimed, err := q.k.isLeafClaimed(ctx, req.TaskId, req.Epoch, req.Index)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryMerkleLeafClaimedResponse{Claimed: claimed}, nil
+}
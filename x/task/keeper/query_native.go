@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"dtc/x/task/types"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// TaskPredicates 返回已注册的链上判定类型
+func (q queryServer) TaskPredicates(_ context.Context, req *types.QueryTaskPredicatesRequest) (*types.QueryTaskPredicatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	return &types.QueryTaskPredicatesResponse{Types: q.k.PredicateTypes()}, nil
+}
+
+// NativeClaimStatus 返回地址当前是否满足链上判定任务的条件
+func (q queryServer) NativeClaimStatus(ctx context.Context, req *types.QueryNativeClaimStatusRequest) (*types.QueryNativeClaimStatusResponse, error) {
+	if req == nil || req.TaskId == "" || req.Address == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	task, err := q.k.getTask(ctx, req.TaskId)
+	if err != nil {
+		if errors.Is(err, types.ErrTaskNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if !task.IsNative() {
+		return nil, status.Error(codes.InvalidArgument, types.ErrNotNativeTask.Error())
+	}
+
+	enrolledHeight, err := q.k.evaluateNativeTask(ctx, task, req.Address)
+	res := &types.QueryNativeClaimStatusResponse{EnrolledHeight: enrolledHeight, Satisfied: err == nil}
+	if err != nil {
+		if !errors.Is(err, types.ErrPredicateUnmet) {
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+		res.Reason = err.Error()
+	}
+
+	return res, nil
+}
@@ -0,0 +1,73 @@
+package keeper
+
+import (
+	"context"
+
+	"dtc/x/task/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// PayoutAllowance 返回接收用户、提交者在当前滚动窗口以及当前区块内的剩余可领额度，
+// 仅列出设置了限额的币种，平台可据此在提交领取前预检
+func (q queryServer) PayoutAllowance(ctx context.Context, req *types.QueryPayoutAllowanceRequest) (*types.QueryPayoutAllowanceResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	if req.Recipient != "" {
+		if _, err := q.k.addressCodec.StringToBytes(req.Recipient); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid recipient address")
+		}
+	}
+	if req.Creator != "" {
+		if _, err := q.k.addressCodec.StringToBytes(req.Creator); err != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid creator address")
+		}
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	resp := &types.QueryPayoutAllowanceResponse{}
+	if params.PayoutEpochBlocks > 0 {
+		bucketBlocks := params.PayoutBucketBlocks()
+		start := params.PayoutWindowStart(height)
+		resp.WindowStartHeight = max(start*bucketBlocks, 0)
+		resp.BucketEndHeight = (params.PayoutBucket(height)+1)*bucketBlocks - 1
+
+		if req.Recipient != "" {
+			for _, limit := range params.RecipientEpochLimit {
+				remaining, _, err := remainingRollingAllowance(ctx, q.k.RecipientPayoutWindow, collections.Join(req.Recipient, limit.Denom), start, limit.Amount)
+				if err != nil {
+					return nil, status.Error(codes.Internal, "internal error")
+				}
+				resp.RecipientRemaining = append(resp.RecipientRemaining, sdk.NewCoin(limit.Denom, remaining))
+			}
+		}
+		if req.Creator != "" {
+			for _, limit := range params.CreatorEpochLimit {
+				remaining, _, err := remainingRollingAllowance(ctx, q.k.CreatorPayoutWindow, collections.Join(req.Creator, limit.Denom), start, limit.Amount)
+				if err != nil {
+					return nil, status.Error(codes.Internal, "internal error")
+				}
+				resp.CreatorRemaining = append(resp.CreatorRemaining, sdk.NewCoin(limit.Denom, remaining))
+			}
+		}
+	}
+
+	for _, limit := range params.BlockLimit {
+		remaining, _, err := remainingAllowance(ctx, q.k.BlockPayoutWindow, limit.Denom, height, limit.Amount)
+		if err != nil {
+			return nil, status.Error(codes.Internal, "internal error")
+		}
+		resp.BlockRemaining = append(resp.BlockRemaining, sdk.NewCoin(limit.Denom, remaining))
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"dtc/x/task/types"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// RewardStreamsByRecipient 分页返回接收用户的奖励流及其在当前高度的释放情况
+func (q queryServer) RewardStreamsByRecipient(ctx context.Context, req *types.QueryRewardStreamsByRecipientRequest) (*types.QueryRewardStreamsByRecipientResponse, error) {
+	if req == nil || req.Recipient == "" {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	streams, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.StreamsByRecipient,
+		req.Pagination,
+		func(key collections.Pair[string, uint64], _ collections.NoValue) (types.RewardStreamStatus, error) {
+			stream, err := q.k.RewardStream.Get(ctx, key.K2())
+			if err != nil {
+				return types.RewardStreamStatus{}, err
+			}
+			return types.RewardStreamStatus{
+				Stream:       stream,
+				Vested:       stream.Vested(height),
+				Withdrawable: stream.Withdrawable(height),
+			}, nil
+		},
+		query.WithCollectionPaginationPairPrefix[string, uint64](req.Recipient),
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRewardStreamsByRecipientResponse{Streams: streams, Pagination: pageRes}, nil
+}
@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"dtc/x/task/types"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (q queryServer) ListTask(ctx context.Context, req *types.QueryAllTaskRequest) (*types.QueryAllTaskResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	tasks, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Task,
+		req.Pagination,
+		func(_ string, value types.Task) (types.Task, error) {
+			return value, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAllTaskResponse{Task: tasks, Pagination: pageRes}, nil
+}
+
+func (q queryServer) GetTask(ctx context.Context, req *types.QueryGetTaskRequest) (*types.QueryGetTaskResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	val, err := q.k.Task.Get(ctx, req.TaskId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "not found")
+		}
+
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryGetTaskResponse{Task: val}, nil
+}
+
+// UserClaimCount 返回用户在任务下的领取次数及上限
+func (q queryServer) UserClaimCount(ctx context.Context, req *types.QueryUserClaimCountRequest) (*types.QueryUserClaimCountResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	task, err := q.k.Task.Get(ctx, req.TaskId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "task not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	count, err := q.k.UserClaimCount.Get(ctx, collections.Join(req.TaskId, req.User))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryUserClaimCountResponse{Count: count, PerUserLimit: task.UserLimit()}, nil
+}
@@ -0,0 +1,168 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dtc/x/task/types"
+)
+
+// usedInWindow 返回 key 在 window 内已支付的金额，记录属于旧窗口时视为 0
+func usedInWindow[K any](ctx context.Context, coll collections.Map[K, types.PayoutWindow], key K, window int64) (math.Int, error) {
+	usage, err := coll.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return math.ZeroInt(), nil
+		}
+		return math.Int{}, err
+	}
+	if usage.Window != window {
+		return math.ZeroInt(), nil
+	}
+	return usage.Amount, nil
+}
+
+// addToWindow 在 key 的当前窗口上累加支付金额
+func addToWindow[K any](ctx context.Context, coll collections.Map[K, types.PayoutWindow], key K, window int64, amount math.Int) error {
+	used, err := usedInWindow(ctx, coll, key, window)
+	if err != nil {
+		return err
+	}
+	return coll.Set(ctx, key, types.PayoutWindow{Window: window, Amount: used.Add(amount)})
+}
+
+// bucketsInWindow 返回 key 记录中编号不小于 start、仍在滚动窗口内的分桶
+func bucketsInWindow[K any](ctx context.Context, coll collections.Map[K, types.PayoutWindow], key K, start int64) ([]types.PayoutBucket, error) {
+	usage, err := coll.Get(ctx, key)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	var buckets []types.PayoutBucket
+	for _, bucket := range usage.Buckets {
+		if bucket.Bucket >= start {
+			buckets = append(buckets, bucket)
+		}
+	}
+	return buckets, nil
+}
+
+// usedInRollingWindow 返回 key 在滚动窗口内各分桶的支付金额之和
+func usedInRollingWindow[K any](ctx context.Context, coll collections.Map[K, types.PayoutWindow], key K, start int64) (math.Int, error) {
+	buckets, err := bucketsInWindow(ctx, coll, key, start)
+	if err != nil {
+		return math.Int{}, err
+	}
+	used := math.ZeroInt()
+	for _, bucket := range buckets {
+		used = used.Add(bucket.Amount)
+	}
+	return used, nil
+}
+
+// addToRollingWindow 将支付金额计入 key 的 current 分桶，并丢弃已移出窗口的分桶
+func addToRollingWindow[K any](ctx context.Context, coll collections.Map[K, types.PayoutWindow], key K, start, current int64, amount math.Int) error {
+	buckets, err := bucketsInWindow(ctx, coll, key, start)
+	if err != nil {
+		return err
+	}
+	if n := len(buckets); n > 0 && buckets[n-1].Bucket == current {
+		buckets[n-1].Amount = buckets[n-1].Amount.Add(amount)
+	} else {
+		buckets = append(buckets, types.PayoutBucket{Bucket: current, Amount: amount})
+	}
+	return coll.Set(ctx, key, types.PayoutWindow{Amount: math.ZeroInt(), Buckets: buckets})
+}
+
+// allowance 返回限额减去已用金额，未设置限额时 limited 为 false
+func allowance(limit, used math.Int) (remaining math.Int, limited bool) {
+	if !limit.IsPositive() {
+		return math.Int{}, false
+	}
+	if used.GTE(limit) {
+		return math.ZeroInt(), true
+	}
+	return limit.Sub(used), true
+}
+
+// remainingAllowance 返回 key 在 window 内的剩余额度，未设置限额时 limited 为 false
+func remainingAllowance[K any](ctx context.Context, coll collections.Map[K, types.PayoutWindow], key K, window int64, limit math.Int) (remaining math.Int, limited bool, err error) {
+	if !limit.IsPositive() {
+		return math.Int{}, false, nil
+	}
+	used, err := usedInWindow(ctx, coll, key, window)
+	if err != nil {
+		return math.Int{}, false, err
+	}
+	remaining, limited = allowance(limit, used)
+	return remaining, limited, nil
+}
+
+// remainingRollingAllowance 返回 key 在以 start 分桶起始的滚动窗口内的剩余额度，未设置限额时 limited 为 false
+func remainingRollingAllowance[K any](ctx context.Context, coll collections.Map[K, types.PayoutWindow], key K, start int64, limit math.Int) (remaining math.Int, limited bool, err error) {
+	if !limit.IsPositive() {
+		return math.Int{}, false, nil
+	}
+	used, err := usedInRollingWindow(ctx, coll, key, start)
+	if err != nil {
+		return math.Int{}, false, err
+	}
+	remaining, limited = allowance(limit, used)
+	return remaining, limited, nil
+}
+
+// checkPayoutLimits 校验本次支付不超过接收用户、提交者的滚动窗口限额以及区块限额
+func (k Keeper) checkPayoutLimits(ctx context.Context, params types.Params, recipient, creator string, amount sdk.Coin) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	if params.PayoutEpochBlocks > 0 {
+		start := params.PayoutWindowStart(height)
+		remaining, limited, err := remainingRollingAllowance(ctx, k.RecipientPayoutWindow, collections.Join(recipient, amount.Denom), start, params.RecipientEpochLimit.AmountOf(amount.Denom))
+		if err != nil {
+			return err
+		}
+		if limited && remaining.LT(amount.Amount) {
+			return errorsmod.Wrapf(types.ErrRecipientRateLimited, "%s has %s%s left in the last %d blocks", recipient, remaining, amount.Denom, params.PayoutEpochBlocks)
+		}
+
+		remaining, limited, err = remainingRollingAllowance(ctx, k.CreatorPayoutWindow, collections.Join(creator, amount.Denom), start, params.CreatorEpochLimit.AmountOf(amount.Denom))
+		if err != nil {
+			return err
+		}
+		if limited && remaining.LT(amount.Amount) {
+			return errorsmod.Wrapf(types.ErrCreatorRateLimited, "%s has %s%s left in the last %d blocks", creator, remaining, amount.Denom, params.PayoutEpochBlocks)
+		}
+	}
+
+	remaining, limited, err := remainingAllowance(ctx, k.BlockPayoutWindow, amount.Denom, height, params.BlockLimit.AmountOf(amount.Denom))
+	if err != nil {
+		return err
+	}
+	if limited && remaining.LT(amount.Amount) {
+		return errorsmod.Wrapf(types.ErrBlockRateLimited, "%s%s left at height %d", remaining, amount.Denom, height)
+	}
+	return nil
+}
+
+// recordPayout 将本次支付计入各限额窗口
+func (k Keeper) recordPayout(ctx context.Context, params types.Params, recipient, creator string, amount sdk.Coin) error {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	if params.PayoutEpochBlocks > 0 {
+		start, current := params.PayoutWindowStart(height), params.PayoutBucket(height)
+		if err := addToRollingWindow(ctx, k.RecipientPayoutWindow, collections.Join(recipient, amount.Denom), start, current, amount.Amount); err != nil {
+			return err
+		}
+		if err := addToRollingWindow(ctx, k.CreatorPayoutWindow, collections.Join(creator, amount.Denom), start, current, amount.Amount); err != nil {
+			return err
+		}
+	}
+	return addToWindow(ctx, k.BlockPayoutWindow, amount.Denom, height, amount.Amount)
+}
@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"dtc/x/task/keeper"
+	"dtc/x/task/types"
+)
+
+func TestClaimReward_PayoutLimits(t *testing.T) {
+	f := initClaimRewardFixture(t)
+	srv := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockHeight(1)
+
+	params, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	params.PayoutEpochBlocks = 10
+	params.RecipientEpochLimit = sdk.NewCoins(sdk.NewInt64Coin("dtc", 20))
+	params.CreatorEpochLimit = sdk.NewCoins(sdk.NewInt64Coin("dtc", 30))
+	params.BlockLimit = sdk.NewCoins(sdk.NewInt64Coin("dtc", 25))
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	owner, err := f.addressCodec.BytesToString([]byte("taskOwner__________________"))
+	require.NoError(t, err)
+	platform, err := f.addressCodec.BytesToString([]byte("platform___________________"))
+	require.NoError(t, err)
+	alice, err := f.addressCodec.BytesToString([]byte("alice______________________"))
+	require.NoError(t, err)
+	bob, err := f.addressCodec.BytesToString([]byte("bob________________________"))
+	require.NoError(t, err)
+	carol, err := f.addressCodec.BytesToString([]byte("carol______________________"))
+	require.NoError(t, err)
+	ownerAddr, err := f.addressCodec.StringToBytes(owner)
+	require.NoError(t, err)
+	f.bankKeeper.mint(ownerAddr, sdk.NewCoins(sdk.NewInt64Coin("dtc", 1000)))
+
+	_, err = srv.CreateTask(ctx, &types.MsgCreateTask{
+		Creator:        owner,
+		OraclePubkeys:  f.oraclePubkeys(),
+		TaskId:         "limited",
+		RewardPerClaim: sdk.NewInt64Coin("dtc", 10),
+		Budget:         sdk.NewInt64Coin("dtc", 1000),
+		PerUserLimit:   10,
+	})
+	require.NoError(t, err)
+	fundTestTask(t, f, srv, owner, "limited", sdk.NewInt64Coin("dtc", 1000))
+
+	claim := func(height int64, recipient string) error {
+		_, err := srv.ClaimReward(ctx.WithBlockHeight(height), f.signedClaim(t, &types.MsgClaimReward{
+			Creator:   platform,
+			TaskId:    "limited",
+			Amount:    "10dtc",
+			Recipient: recipient,
+		}))
+		return err
+	}
+
+	// 最近 10 个区块内：接收用户限额 20，提交者限额 30
+	require.NoError(t, claim(1, alice))
+	require.NoError(t, claim(2, alice))
+	require.ErrorIs(t, claim(3, alice), types.ErrRecipientRateLimited)
+	require.NoError(t, claim(3, bob))
+	require.ErrorIs(t, claim(4, carol), types.ErrCreatorRateLimited)
+
+	res, err := qs.PayoutAllowance(ctx.WithBlockHeight(4), &types.QueryPayoutAllowanceRequest{Recipient: alice, Creator: platform})
+	require.NoError(t, err)
+	require.Equal(t, int64(0), res.WindowStartHeight)
+	require.Equal(t, int64(4), res.BucketEndHeight)
+	require.Equal(t, "0dtc", res.RecipientRemaining.String())
+	require.Equal(t, "0dtc", res.CreatorRemaining.String())
+	require.Equal(t, "25dtc", res.BlockRemaining.String())
+
+	// 窗口随高度滑动，额度不会在固定边界整体重置：高度 10 时高度 1、2 的领取仍在窗口内
+	require.ErrorIs(t, claim(10, alice), types.ErrRecipientRateLimited)
+	// 高度 11 时高度 1 移出窗口，释放 10 的额度
+	require.NoError(t, claim(11, alice))
+	require.ErrorIs(t, claim(11, bob), types.ErrCreatorRateLimited)
+
+	res, err = qs.PayoutAllowance(ctx.WithBlockHeight(12), &types.QueryPayoutAllowanceRequest{Recipient: alice, Creator: platform})
+	require.NoError(t, err)
+	require.Equal(t, int64(3), res.WindowStartHeight)
+	require.Equal(t, int64(12), res.BucketEndHeight)
+	require.Equal(t, "10dtc", res.RecipientRemaining.String())
+	require.Equal(t, "10dtc", res.CreatorRemaining.String())
+	require.Equal(t, "25dtc", res.BlockRemaining.String())
+
+	require.NoError(t, claim(12, bob))
+	require.ErrorIs(t, claim(12, carol), types.ErrCreatorRateLimited)
+
+	// 同一区块受全局区块限额约束
+	require.NoError(t, claim(30, alice))
+	require.NoError(t, claim(30, bob))
+	require.ErrorIs(t, claim(30, carol), types.ErrBlockRateLimited)
+
+	res, err = qs.PayoutAllowance(ctx.WithBlockHeight(30), &types.QueryPayoutAllowanceRequest{Recipient: alice, Creator: platform})
+	require.NoError(t, err)
+	require.Equal(t, "5dtc", res.BlockRemaining.String())
+
+	require.NoError(t, claim(31, carol))
+
+	_, err = qs.PayoutAllowance(ctx, &types.QueryPayoutAllowanceRequest{Recipient: "invalid"})
+	require.Error(t, err)
+}
+
+func TestMigrate2to3(t *testing.T) {
+	f := initFixture(t)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, types.Params{MaxBatchClaimSize: 7}))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate2to3(sdk.UnwrapSDKContext(f.ctx)))
+
+	params, err := f.keeper.Params.Get(f.ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint32(7), params.MaxBatchClaimSize)
+	require.Equal(t, types.DefaultPayoutEpochBlocks, params.PayoutEpochBlocks)
+}
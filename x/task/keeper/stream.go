@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"dtc/x/task/types"
+)
+
+// createRewardStream 为一次领取按任务释放计划创建奖励流，资金继续由模块账户持有
+func (k Keeper) createRewardStream(ctx context.Context, task types.Task, recipient, claimHash string, amount sdk.Coin) (types.RewardStream, error) {
+	id, err := k.RewardStreamSeq.Next(ctx)
+	if err != nil {
+		return types.RewardStream{}, err
+	}
+	// ID 从 1 开始，0 在 MsgWithdrawVested 中表示全部奖励流
+	id++
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	stream := types.NewRewardStream(id, task.PayoutSchedule, task.Id, recipient, claimHash, amount, sdkCtx.BlockHeight())
+	if err := k.setRewardStream(ctx, stream); err != nil {
+		return types.RewardStream{}, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeRewardStreamCreated,
+			sdk.NewAttribute(types.AttributeKeyStreamId, strconv.FormatUint(stream.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyTaskId, stream.TaskId),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, stream.Total.String()),
+			sdk.NewAttribute(types.AttributeKeyCliffHeight, strconv.FormatInt(stream.CliffHeight, 10)),
+			sdk.NewAttribute(types.AttributeKeyEndHeight, strconv.FormatInt(stream.EndHeight, 10)),
+		),
+	)
+	return stream, nil
+}
+
+// setRewardStream 保存奖励流并维护接收用户索引
+func (k Keeper) setRewardStream(ctx context.Context, stream types.RewardStream) error {
+	if err := k.RewardStream.Set(ctx, stream.Id, stream); err != nil {
+		return err
+	}
+	return k.StreamsByRecipient.Set(ctx, collections.Join(stream.Recipient, stream.Id))
+}
+
+// withdrawVested 将奖励流当前可提取的部分转给接收用户，全部提取后删除奖励流
+func (k Keeper) withdrawVested(ctx context.Context, stream types.RewardStream) (sdk.Coin, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	amount := stream.Withdrawable(sdkCtx.BlockHeight())
+	if !amount.IsPositive() {
+		return amount, nil
+	}
+
+	recipient, err := k.addressCodec.StringToBytes(stream.Recipient)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, recipient, sdk.NewCoins(amount)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	stream.Withdrawn = stream.Withdrawn.Add(amount)
+	if stream.Remaining().IsZero() {
+		if err := k.RewardStream.Remove(ctx, stream.Id); err != nil {
+			return sdk.Coin{}, err
+		}
+		if err := k.StreamsByRecipient.Remove(ctx, collections.Join(stream.Recipient, stream.Id)); err != nil {
+			return sdk.Coin{}, err
+		}
+	} else if err := k.RewardStream.Set(ctx, stream.Id, stream); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeVestedWithdrawn,
+			sdk.NewAttribute(types.AttributeKeyStreamId, strconv.FormatUint(stream.Id, 10)),
+			sdk.NewAttribute(types.AttributeKeyRecipient, stream.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		),
+	)
+	return amount, nil
+}
@@ -0,0 +1,109 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"dtc/x/task/types"
+)
+
+// getTask 读取任务，不存在时返回 ErrTaskNotFound
+func (k Keeper) getTask(ctx context.Context, taskId string) (types.Task, error) {
+	task, err := k.Task.Get(ctx, taskId)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return types.Task{}, errorsmod.Wrap(types.ErrTaskNotFound, taskId)
+		}
+		return types.Task{}, errorsmod.Wrap(sdkerrors.ErrLogic, err.Error())
+	}
+	return task, nil
+}
+
+// setTask 保存任务，开放且设置了截止高度的任务同时登记到过期队列
+func (k Keeper) setTask(ctx context.Context, task types.Task) error {
+	if err := k.Task.Set(ctx, task.Id, task); err != nil {
+		return err
+	}
+	if task.IsOpen() && task.EndHeight != 0 {
+		return k.TaskExpiryQueue.Set(ctx, collections.Join(task.EndHeight, task.Id))
+	}
+	return nil
+}
+
+// closeTask 关闭任务并移出过期队列
+func (k Keeper) closeTask(ctx context.Context, task types.Task, reason string) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	task.Status = types.TASK_STATUS_CLOSED
+	task.ClosedHeight = sdkCtx.BlockHeight()
+	if err := k.Task.Set(ctx, task.Id, task); err != nil {
+		return err
+	}
+	if task.EndHeight != 0 {
+		if err := k.TaskExpiryQueue.Remove(ctx, collections.Join(task.EndHeight, task.Id)); err != nil {
+			return err
+		}
+	}
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeTaskClosed,
+			sdk.NewAttribute(types.AttributeKeyTaskId, task.Id),
+			sdk.NewAttribute(types.AttributeKeyOwner, task.Owner),
+			sdk.NewAttribute(types.AttributeKeyRemainingBudget, task.RemainingBudget.String()),
+			sdk.NewAttribute(types.AttributeKeyClaimCount, strconv.FormatUint(task.ClaimCount, 10)),
+			sdk.NewAttribute(types.AttributeKeyReason, reason),
+		),
+	)
+	return nil
+}
+
+// EndBlocker 发放争议期已结束的待定奖励，退回过期 Merkle 分发中未领取的资金，并关闭截止高度已过的任务
+func (k Keeper) EndBlocker(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := k.finalizePendingPayouts(ctx); err != nil {
+		return err
+	}
+	if err := k.expireMerkleDistributions(ctx); err != nil {
+		return err
+	}
+
+	var expired []collections.Pair[int64, string]
+	// 截止高度当块的交易已在 EndBlock 前执行完毕，因此关闭截止高度不大于当前高度的任务
+	rng := new(collections.Range[collections.Pair[int64, string]]).
+		EndExclusive(collections.Join(sdkCtx.BlockHeight()+1, ""))
+	if err := k.TaskExpiryQueue.Walk(ctx, rng, func(key collections.Pair[int64, string]) (bool, error) {
+		expired = append(expired, key)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		if err := k.TaskExpiryQueue.Remove(ctx, key); err != nil {
+			return err
+		}
+		task, err := k.Task.Get(ctx, key.K2())
+		if err != nil {
+			if errors.Is(err, collections.ErrNotFound) {
+				continue
+			}
+			return err
+		}
+		if !task.IsOpen() {
+			continue
+		}
+		if err := k.closeTask(ctx, task, types.AttributeValueReasonExpired); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -29,6 +29,113 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Alias:          []string{"show-claim-record"},
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "claim_hash"}},
 				},
+				{
+					RpcMethod:      "ListClaimsByUser",
+					Use:            "list-claims-by-user [user]",
+					Short:          "List the claimRecords of a recipient",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "user"}},
+				},
+				{
+					RpcMethod:      "ListClaimsByTask",
+					Use:            "list-claims-by-task [task-id]",
+					Short:          "List the claimRecords of a task",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}},
+				},
+				{
+					RpcMethod:      "ListClaimsByCreator",
+					Use:            "list-claims-by-creator [creator]",
+					Short:          "List the claimRecords submitted by a creator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+				{
+					RpcMethod:      "TotalPaidByTask",
+					Use:            "total-paid-by-task [task-id]",
+					Short:          "Shows the total paid out by a task",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}},
+				},
+				{
+					RpcMethod:      "TotalPaidByUser",
+					Use:            "total-paid-by-user [user]",
+					Short:          "Shows the total paid to a recipient",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "user"}},
+				},
+				{
+					RpcMethod:      "TotalPaidByCreator",
+					Use:            "total-paid-by-creator [creator]",
+					Short:          "Shows the total paid through a creator",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "creator"}},
+				},
+				{
+					RpcMethod: "ListTask",
+					Use:       "list-task",
+					Short:     "List all tasks",
+				},
+				{
+					RpcMethod:      "GetTask",
+					Use:            "get-task [task-id]",
+					Short:          "Gets a task",
+					Alias:          []string{"show-task"},
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}},
+				},
+				{
+					RpcMethod:      "TaskEscrow",
+					Use:            "task-escrow [task-id]",
+					Short:          "Shows the escrow held for a task",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}},
+				},
+				{
+					RpcMethod:      "UserClaimCount",
+					Use:            "user-claim-count [task-id] [user]",
+					Short:          "Shows how many times a user has claimed a task",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}, {ProtoField: "user"}},
+				},
+				{
+					RpcMethod:      "PayoutAllowance",
+					Use:            "payout-allowance [recipient] [creator]",
+					Short:          "Shows the remaining payout allowance for a recipient, a creator and the current block",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}, {ProtoField: "creator"}},
+				},
+				{
+					RpcMethod:      "RewardStreamsByRecipient",
+					Use:            "reward-streams [recipient]",
+					Short:          "List the reward streams of a recipient with their vested amounts",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}},
+				},
+				{
+					RpcMethod:      "PendingPayout",
+					Use:            "pending-payout [claim-hash]",
+					Short:          "Show a payout held in its task's dispute window",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "claim_hash"}},
+				},
+				{
+					RpcMethod:      "CreditRepayShare",
+					Use:            "credit-repay-share [address]",
+					Short:          "Show the share of an address's rewards used to repay its credit liability",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod:      "MerkleDistribution",
+					Use:            "merkle-distribution [task-id] [epoch]",
+					Short:          "Show the Merkle root posted for a task epoch",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}, {ProtoField: "epoch"}},
+				},
+				{
+					RpcMethod:      "MerkleLeafClaimed",
+					Use:            "merkle-leaf-claimed [task-id] [epoch] [index]",
+					Short:          "Check whether a leaf of a task epoch has been claimed",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}, {ProtoField: "epoch"}, {ProtoField: "index"}},
+				},
+				{
+					RpcMethod: "TaskPredicates",
+					Use:       "task-predicates",
+					Short:     "List the registered native task predicate types",
+				},
+				{
+					RpcMethod:      "NativeClaimStatus",
+					Use:            "native-claim-status [task-id] [address]",
+					Short:          "Check whether an address satisfies a native task predicate",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}, {ProtoField: "address"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -41,22 +148,10 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Skip:      true, // skipped because authority gated
 				},
 				{
-					RpcMethod:      "CreateClaimRecord",
-					Use:            "create-claim-record [claim_hash] [task-id] [user-id] [signature]",
-					Short:          "Create a new claimRecord",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "claim_hash"}, {ProtoField: "task_id"}, {ProtoField: "user_id"}, {ProtoField: "signature"}},
-				},
-				{
-					RpcMethod:      "UpdateClaimRecord",
-					Use:            "update-claim-record [claim_hash] [task-id] [user-id] [signature]",
-					Short:          "Update claimRecord",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "claim_hash"}, {ProtoField: "task_id"}, {ProtoField: "user_id"}, {ProtoField: "signature"}},
-				},
-				{
-					RpcMethod:      "DeleteClaimRecord",
-					Use:            "delete-claim-record [claim_hash]",
-					Short:          "Delete claimRecord",
-					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "claim_hash"}},
+					RpcMethod:      "RevokeClaimRecord",
+					Use:            "revoke-claim-record [claim_hash] [reason]",
+					Short:          "Mark a claimRecord as revoked",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "claim_hash"}, {ProtoField: "reason"}},
 				},
 				{
 					RpcMethod:      "ClaimReward",
@@ -64,6 +159,91 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:          "Send a claimReward tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}, {ProtoField: "amount"}, {ProtoField: "signature"}},
 				},
+				{
+					RpcMethod:      "CreateTask",
+					Use:            "create-task [task-id] [reward-per-claim] [budget] [oracle-pubkeys]",
+					Short:          "Register a new task",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}, {ProtoField: "reward_per_claim"}, {ProtoField: "budget"}, {ProtoField: "oracle_pubkeys", Varargs: true}},
+				},
+				{
+					RpcMethod:      "CloseTask",
+					Use:            "close-task [task-id]",
+					Short:          "Close a task owned by the sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}},
+				},
+				{
+					RpcMethod:      "RotateTaskOracles",
+					Use:            "rotate-task-oracles [task-id] [oracle-pubkeys] [oracle-threshold] [overlap-blocks]",
+					Short:          "Rotate the oracle keys of a task owned by the sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}, {ProtoField: "oracle_pubkeys"}, {ProtoField: "oracle_threshold"}, {ProtoField: "overlap_blocks"}},
+				},
+				{
+					RpcMethod:      "FundTask",
+					Use:            "fund-task [task-id] [amount]",
+					Short:          "Escrow coins for a task owned by the sender",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}, {ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "ReclaimTaskEscrow",
+					Use:            "reclaim-task-escrow [task-id]",
+					Short:          "Reclaim the unspent escrow of a closed task",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}},
+				},
+				{
+					RpcMethod:      "WithdrawVested",
+					Use:            "withdraw-vested [stream-id]",
+					Short:          "Withdraw the vested part of a reward stream, or of all streams of the sender when stream-id is 0",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "stream_id", Optional: true}},
+				},
+				{
+					RpcMethod:      "DisputeClaim",
+					Use:            "dispute-claim [claim-hash] [evidence]",
+					Short:          "Dispute a payout within its dispute window and return it to the task escrow",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "claim_hash"}, {ProtoField: "evidence"}},
+				},
+				{
+					RpcMethod:      "SetCreditRepayShare",
+					Use:            "set-credit-repay-share [share]",
+					Short:          "Opt in to repaying credit liability with a higher share of task rewards, or 0 to opt out",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "share"}},
+				},
+				{
+					RpcMethod:      "PostMerkleRoot",
+					Use:            "post-merkle-root [task-id] [epoch] [root] [leaf-count] [total] [expiry-height] [signature]",
+					Short:          "Post an oracle-signed Merkle root for a task epoch and reserve its total from the task escrow",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}, {ProtoField: "epoch"}, {ProtoField: "root"}, {ProtoField: "leaf_count"}, {ProtoField: "total"}, {ProtoField: "expiry_height"}, {ProtoField: "signature"}},
+				},
+				{
+					RpcMethod:      "ClaimWithProof",
+					Use:            "claim-with-proof [task-id] [epoch] [index] [recipient] [amount] [proof]",
+					Short:          "Claim a Merkle distribution leaf with its proof",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}, {ProtoField: "epoch"}, {ProtoField: "index"}, {ProtoField: "recipient"}, {ProtoField: "amount"}, {ProtoField: "proof", Varargs: true}},
+				},
+				{
+					RpcMethod:      "BatchClaimReward",
+					Use:            "batch-claim-reward [signature]",
+					Short:          "Claim rewards for several task entries under one oracle signature",
+					Long:           "Entries are passed as JSON with --entries, e.g. --entries '{\"task_id\":\"t1\",\"recipient\":\"dtc1...\",\"amount\":\"10dtc\"}'",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "signature"}},
+				},
+				{
+					RpcMethod:      "EnrollTask",
+					Use:            "enroll-task [task-id]",
+					Short:          "Enroll in a native task whose predicate is measured from enrollment",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}},
+				},
+				{
+					RpcMethod:      "ClaimNative",
+					Use:            "claim-native [task-id]",
+					Short:          "Claim the reward of a native task once its predicate is satisfied",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}},
+				},
+				{
+					RpcMethod:      "UnenrollTask",
+					Use:            "unenroll-task [task-id]",
+					Short:          "Cancel a native task enrollment and release the locked deposit",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "task_id"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -33,8 +33,11 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	AddressCodec address.Codec
 
-	AuthKeeper types.AuthKeeper
-	BankKeeper types.BankKeeper
+	AuthKeeper     types.AuthKeeper
+	BankKeeper     types.BankKeeper
+	RoleKeeper     types.RoleKeeper
+	IdentityKeeper types.IdentityKeeper
+	CreditKeeper   types.CreditKeeper
 }
 
 type ModuleOutputs struct {
@@ -56,6 +59,9 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		in.AddressCodec,
 		authority,
 		in.BankKeeper,
+		in.RoleKeeper,
+		in.IdentityKeeper,
+		in.CreditKeeper,
 	)
 	m := NewAppModule(in.Cdc, k, in.AuthKeeper, in.BankKeeper)
 
@@ -0,0 +1,105 @@
+package task
+
+import (
+	"bytes"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	ibcapi "github.com/cosmos/ibc-go/v10/modules/core/api"
+
+	"dtc/x/task/keeper"
+)
+
+var _ porttypes.IBCModule = TransferMiddleware{}
+
+// TransferMiddleware 包装 ICS-20 转账模块，在转出被对端成功确认后累计转出金额，供 ibc_transfer 判定使用。
+// 按源通道累计，其余回调直接交给被包装的模块
+type TransferMiddleware struct {
+	porttypes.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewTransferMiddleware creates a new TransferMiddleware wrapping the given transfer IBC module
+func NewTransferMiddleware(app porttypes.IBCModule, k keeper.Keeper) TransferMiddleware {
+	return TransferMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface
+func (im TransferMiddleware) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	channelVersion string,
+	packet channeltypes.Packet,
+	acknowledgement []byte,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer); err != nil {
+		return err
+	}
+
+	// 转账模块已校验确认与数据包，这里只记录成功的转出，失败的转出已被退回发送方
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil || !ack.Success() {
+		return nil
+	}
+	data, err := transfertypes.UnmarshalPacketData(packet.GetData(), channelVersion, "")
+	if err != nil {
+		return nil
+	}
+	coin, err := data.Token.ToCoin()
+	if err != nil {
+		return nil
+	}
+	return im.keeper.RecordIBCTransfer(ctx, data.Sender, packet.SourceChannel, coin)
+}
+
+var _ ibcapi.IBCModule = TransferMiddlewareV2{}
+
+// TransferMiddlewareV2 包装 IBC v2 的 ICS-20 转账模块，在转出被对端成功确认后按源客户端累计转出金额，
+// 其余回调直接交给被包装的模块
+type TransferMiddlewareV2 struct {
+	ibcapi.IBCModule
+	keeper keeper.Keeper
+}
+
+// NewTransferMiddlewareV2 creates a new TransferMiddlewareV2 wrapping the given IBC v2 transfer module
+func NewTransferMiddlewareV2(app ibcapi.IBCModule, k keeper.Keeper) TransferMiddlewareV2 {
+	return TransferMiddlewareV2{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnAcknowledgementPacket implements the IBC v2 IBCModule interface
+func (im TransferMiddlewareV2) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	acknowledgement []byte,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	if err := im.IBCModule.OnAcknowledgementPacket(ctx, sourceClient, destinationClient, sequence, acknowledgement, payload, relayer); err != nil {
+		return err
+	}
+
+	// IBC v2 的失败确认为固定的错误确认，转出已被退回发送方
+	if bytes.Equal(acknowledgement, channeltypesv2.ErrorAcknowledgement[:]) {
+		return nil
+	}
+	data, err := transfertypes.UnmarshalPacketData(payload.Value, payload.Version, payload.Encoding)
+	if err != nil {
+		return nil
+	}
+	coin, err := data.Token.ToCoin()
+	if err != nil {
+		return nil
+	}
+	return im.keeper.RecordIBCTransfer(ctx, data.Sender, sourceClient, coin)
+}
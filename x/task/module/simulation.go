@@ -35,64 +35,244 @@ func (am AppModule) RegisterStoreDecoder(_ simtypes.StoreDecoderRegistry) {}
 func (am AppModule) WeightedOperations(simState module.SimulationState) []simtypes.WeightedOperation {
 	operations := make([]simtypes.WeightedOperation, 0)
 	const (
-		opWeightMsgCreateClaimRecord          = "op_weight_msg_task"
-		defaultWeightMsgCreateClaimRecord int = 100
+		opWeightMsgRevokeClaimRecord          = "op_weight_msg_task"
+		defaultWeightMsgRevokeClaimRecord int = 100
 	)
 
-	var weightMsgCreateClaimRecord int
-	simState.AppParams.GetOrGenerate(opWeightMsgCreateClaimRecord, &weightMsgCreateClaimRecord, nil,
+	var weightMsgRevokeClaimRecord int
+	simState.AppParams.GetOrGenerate(opWeightMsgRevokeClaimRecord, &weightMsgRevokeClaimRecord, nil,
 		func(_ *rand.Rand) {
-			weightMsgCreateClaimRecord = defaultWeightMsgCreateClaimRecord
+			weightMsgRevokeClaimRecord = defaultWeightMsgRevokeClaimRecord
 		},
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgCreateClaimRecord,
-		tasksimulation.SimulateMsgCreateClaimRecord(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+		weightMsgRevokeClaimRecord,
+		tasksimulation.SimulateMsgRevokeClaimRecord(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 	const (
-		opWeightMsgUpdateClaimRecord          = "op_weight_msg_task"
-		defaultWeightMsgUpdateClaimRecord int = 100
+		opWeightMsgClaimReward          = "op_weight_msg_task"
+		defaultWeightMsgClaimReward int = 100
 	)
 
-	var weightMsgUpdateClaimRecord int
-	simState.AppParams.GetOrGenerate(opWeightMsgUpdateClaimRecord, &weightMsgUpdateClaimRecord, nil,
+	var weightMsgClaimReward int
+	simState.AppParams.GetOrGenerate(opWeightMsgClaimReward, &weightMsgClaimReward, nil,
 		func(_ *rand.Rand) {
-			weightMsgUpdateClaimRecord = defaultWeightMsgUpdateClaimRecord
+			weightMsgClaimReward = defaultWeightMsgClaimReward
 		},
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgUpdateClaimRecord,
-		tasksimulation.SimulateMsgUpdateClaimRecord(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+		weightMsgClaimReward,
+		tasksimulation.SimulateMsgClaimReward(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 	const (
-		opWeightMsgDeleteClaimRecord          = "op_weight_msg_task"
-		defaultWeightMsgDeleteClaimRecord int = 100
+		opWeightMsgCreateTask          = "op_weight_msg_task"
+		defaultWeightMsgCreateTask int = 100
 	)
 
-	var weightMsgDeleteClaimRecord int
-	simState.AppParams.GetOrGenerate(opWeightMsgDeleteClaimRecord, &weightMsgDeleteClaimRecord, nil,
+	var weightMsgCreateTask int
+	simState.AppParams.GetOrGenerate(opWeightMsgCreateTask, &weightMsgCreateTask, nil,
 		func(_ *rand.Rand) {
-			weightMsgDeleteClaimRecord = defaultWeightMsgDeleteClaimRecord
+			weightMsgCreateTask = defaultWeightMsgCreateTask
 		},
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgDeleteClaimRecord,
-		tasksimulation.SimulateMsgDeleteClaimRecord(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+		weightMsgCreateTask,
+		tasksimulation.SimulateMsgCreateTask(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 	const (
-		opWeightMsgClaimReward          = "op_weight_msg_task"
-		defaultWeightMsgClaimReward int = 100
+		opWeightMsgCloseTask          = "op_weight_msg_task"
+		defaultWeightMsgCloseTask int = 100
 	)
 
-	var weightMsgClaimReward int
-	simState.AppParams.GetOrGenerate(opWeightMsgClaimReward, &weightMsgClaimReward, nil,
+	var weightMsgCloseTask int
+	simState.AppParams.GetOrGenerate(opWeightMsgCloseTask, &weightMsgCloseTask, nil,
 		func(_ *rand.Rand) {
-			weightMsgClaimReward = defaultWeightMsgClaimReward
+			weightMsgCloseTask = defaultWeightMsgCloseTask
 		},
 	)
 	operations = append(operations, simulation.NewWeightedOperation(
-		weightMsgClaimReward,
-		tasksimulation.SimulateMsgClaimReward(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+		weightMsgCloseTask,
+		tasksimulation.SimulateMsgCloseTask(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgFundTask          = "op_weight_msg_task"
+		defaultWeightMsgFundTask int = 100
+	)
+
+	var weightMsgFundTask int
+	simState.AppParams.GetOrGenerate(opWeightMsgFundTask, &weightMsgFundTask, nil,
+		func(_ *rand.Rand) {
+			weightMsgFundTask = defaultWeightMsgFundTask
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgFundTask,
+		tasksimulation.SimulateMsgFundTask(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgReclaimTaskEscrow          = "op_weight_msg_task"
+		defaultWeightMsgReclaimTaskEscrow int = 100
+	)
+
+	var weightMsgReclaimTaskEscrow int
+	simState.AppParams.GetOrGenerate(opWeightMsgReclaimTaskEscrow, &weightMsgReclaimTaskEscrow, nil,
+		func(_ *rand.Rand) {
+			weightMsgReclaimTaskEscrow = defaultWeightMsgReclaimTaskEscrow
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgReclaimTaskEscrow,
+		tasksimulation.SimulateMsgReclaimTaskEscrow(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgRotateTaskOracles          = "op_weight_msg_task"
+		defaultWeightMsgRotateTaskOracles int = 100
+	)
+
+	var weightMsgRotateTaskOracles int
+	simState.AppParams.GetOrGenerate(opWeightMsgRotateTaskOracles, &weightMsgRotateTaskOracles, nil,
+		func(_ *rand.Rand) {
+			weightMsgRotateTaskOracles = defaultWeightMsgRotateTaskOracles
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgRotateTaskOracles,
+		tasksimulation.SimulateMsgRotateTaskOracles(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgWithdrawVested          = "op_weight_msg_task"
+		defaultWeightMsgWithdrawVested int = 100
+	)
+
+	var weightMsgWithdrawVested int
+	simState.AppParams.GetOrGenerate(opWeightMsgWithdrawVested, &weightMsgWithdrawVested, nil,
+		func(_ *rand.Rand) {
+			weightMsgWithdrawVested = defaultWeightMsgWithdrawVested
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgWithdrawVested,
+		tasksimulation.SimulateMsgWithdrawVested(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgDisputeClaim          = "op_weight_msg_task"
+		defaultWeightMsgDisputeClaim int = 100
+	)
+
+	var weightMsgDisputeClaim int
+	simState.AppParams.GetOrGenerate(opWeightMsgDisputeClaim, &weightMsgDisputeClaim, nil,
+		func(_ *rand.Rand) {
+			weightMsgDisputeClaim = defaultWeightMsgDisputeClaim
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgDisputeClaim,
+		tasksimulation.SimulateMsgDisputeClaim(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgSetCreditRepayShare          = "op_weight_msg_task"
+		defaultWeightMsgSetCreditRepayShare int = 100
+	)
+
+	var weightMsgSetCreditRepayShare int
+	simState.AppParams.GetOrGenerate(opWeightMsgSetCreditRepayShare, &weightMsgSetCreditRepayShare, nil,
+		func(_ *rand.Rand) {
+			weightMsgSetCreditRepayShare = defaultWeightMsgSetCreditRepayShare
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgSetCreditRepayShare,
+		tasksimulation.SimulateMsgSetCreditRepayShare(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgPostMerkleRoot          = "op_weight_msg_task"
+		defaultWeightMsgPostMerkleRoot int = 100
+	)
+
+	var weightMsgPostMerkleRoot int
+	simState.AppParams.GetOrGenerate(opWeightMsgPostMerkleRoot, &weightMsgPostMerkleRoot, nil,
+		func(_ *rand.Rand) {
+			weightMsgPostMerkleRoot = defaultWeightMsgPostMerkleRoot
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgPostMerkleRoot,
+		tasksimulation.SimulateMsgPostMerkleRoot(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgClaimWithProof          = "op_weight_msg_task"
+		defaultWeightMsgClaimWithProof int = 100
+	)
+
+	var weightMsgClaimWithProof int
+	simState.AppParams.GetOrGenerate(opWeightMsgClaimWithProof, &weightMsgClaimWithProof, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaimWithProof = defaultWeightMsgClaimWithProof
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgClaimWithProof,
+		tasksimulation.SimulateMsgClaimWithProof(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgBatchClaimReward          = "op_weight_msg_task"
+		defaultWeightMsgBatchClaimReward int = 100
+	)
+
+	var weightMsgBatchClaimReward int
+	simState.AppParams.GetOrGenerate(opWeightMsgBatchClaimReward, &weightMsgBatchClaimReward, nil,
+		func(_ *rand.Rand) {
+			weightMsgBatchClaimReward = defaultWeightMsgBatchClaimReward
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgBatchClaimReward,
+		tasksimulation.SimulateMsgBatchClaimReward(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgEnrollTask          = "op_weight_msg_task"
+		defaultWeightMsgEnrollTask int = 100
+	)
+
+	var weightMsgEnrollTask int
+	simState.AppParams.GetOrGenerate(opWeightMsgEnrollTask, &weightMsgEnrollTask, nil,
+		func(_ *rand.Rand) {
+			weightMsgEnrollTask = defaultWeightMsgEnrollTask
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgEnrollTask,
+		tasksimulation.SimulateMsgEnrollTask(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgClaimNative          = "op_weight_msg_task"
+		defaultWeightMsgClaimNative int = 100
+	)
+
+	var weightMsgClaimNative int
+	simState.AppParams.GetOrGenerate(opWeightMsgClaimNative, &weightMsgClaimNative, nil,
+		func(_ *rand.Rand) {
+			weightMsgClaimNative = defaultWeightMsgClaimNative
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgClaimNative,
+		tasksimulation.SimulateMsgClaimNative(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
+	))
+	const (
+		opWeightMsgUnenrollTask          = "op_weight_msg_task"
+		defaultWeightMsgUnenrollTask int = 100
+	)
+
+	var weightMsgUnenrollTask int
+	simState.AppParams.GetOrGenerate(opWeightMsgUnenrollTask, &weightMsgUnenrollTask, nil,
+		func(_ *rand.Rand) {
+			weightMsgUnenrollTask = defaultWeightMsgUnenrollTask
+		},
+	)
+	operations = append(operations, simulation.NewWeightedOperation(
+		weightMsgUnenrollTask,
+		tasksimulation.SimulateMsgUnenrollTask(am.authKeeper, am.bankKeeper, am.keeper, simState.TxConfig),
 	))
 
 	return operations
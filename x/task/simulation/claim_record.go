@@ -2,19 +2,17 @@ package simulation
 
 import (
 	"math/rand"
-	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
-	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"dtc/x/task/keeper"
 	"dtc/x/task/types"
 )
 
-func SimulateMsgCreateClaimRecord(
+func SimulateMsgRevokeClaimRecord(
 	ak types.AuthKeeper,
 	bk types.BankKeeper,
 	k keeper.Keeper,
@@ -23,149 +21,12 @@ func SimulateMsgCreateClaimRecord(
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
 		simAccount, _ := simtypes.RandomAcc(r, accs)
-
-		i := r.Int()
-		msg := &types.MsgCreateClaimRecord{
-			Creator:   simAccount.Address.String(),
-			ClaimHash: strconv.Itoa(i),
-		}
-
-		found, err := k.ClaimRecord.Has(ctx, msg.ClaimHash)
-		if err == nil && found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "ClaimRecord already exist"), nil, nil
-		}
-
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
-		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
-	}
-}
-
-func SimulateMsgUpdateClaimRecord(
-	ak types.AuthKeeper,
-	bk types.BankKeeper,
-	k keeper.Keeper,
-	txGen client.TxConfig,
-) simtypes.Operation {
-	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
-	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		var (
-			simAccount  = simtypes.Account{}
-			claimRecord = types.ClaimRecord{}
-			msg         = &types.MsgUpdateClaimRecord{}
-			found       = false
-		)
-
-		var allClaimRecord []types.ClaimRecord
-		err := k.ClaimRecord.Walk(ctx, nil, func(key string, value types.ClaimRecord) (stop bool, err error) {
-			allClaimRecord = append(allClaimRecord, value)
-			return false, nil
-		})
-		if err != nil {
-			panic(err)
+		msg := &types.MsgRevokeClaimRecord{
+			Creator: simAccount.Address.String(),
 		}
 
-		for _, obj := range allClaimRecord {
-			acc, err := ak.AddressCodec().StringToBytes(obj.Creator)
-			if err != nil {
-				return simtypes.OperationMsg{}, nil, err
-			}
-
-			simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc))
-			if found {
-				claimRecord = obj
-				break
-			}
-		}
-		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "claimRecord creator not found"), nil, nil
-		}
-		msg.Creator = simAccount.Address.String()
-		msg.ClaimHash = claimRecord.ClaimHash
+		// TODO: Handle the RevokeClaimRecord simulation
 
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
-		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
-	}
-}
-
-func SimulateMsgDeleteClaimRecord(
-	ak types.AuthKeeper,
-	bk types.BankKeeper,
-	k keeper.Keeper,
-	txGen client.TxConfig,
-) simtypes.Operation {
-	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
-	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		var (
-			simAccount  = simtypes.Account{}
-			claimRecord = types.ClaimRecord{}
-			msg         = &types.MsgUpdateClaimRecord{}
-			found       = false
-		)
-
-		var allClaimRecord []types.ClaimRecord
-		err := k.ClaimRecord.Walk(ctx, nil, func(key string, value types.ClaimRecord) (stop bool, err error) {
-			allClaimRecord = append(allClaimRecord, value)
-			return false, nil
-		})
-		if err != nil {
-			panic(err)
-		}
-
-		for _, obj := range allClaimRecord {
-			acc, err := ak.AddressCodec().StringToBytes(obj.Creator)
-			if err != nil {
-				return simtypes.OperationMsg{}, nil, err
-			}
-
-			simAccount, found = simtypes.FindAccount(accs, sdk.AccAddress(acc))
-			if found {
-				claimRecord = obj
-				break
-			}
-		}
-		if !found {
-			return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "claimRecord creator not found"), nil, nil
-		}
-		msg.Creator = simAccount.Address.String()
-		msg.ClaimHash = claimRecord.ClaimHash
-
-		txCtx := simulation.OperationInput{
-			R:               r,
-			App:             app,
-			TxGen:           txGen,
-			Cdc:             nil,
-			Msg:             msg,
-			Context:         ctx,
-			SimAccount:      simAccount,
-			ModuleName:      types.ModuleName,
-			CoinsSpentInMsg: sdk.NewCoins(),
-			AccountKeeper:   ak,
-			Bankkeeper:      bk,
-		}
-		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "RevokeClaimRecord simulation not implemented"), nil, nil
 	}
 }
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"strconv"
+	"strings"
+)
+
+// BatchClaimSignBytes 返回预言机为批量领取签名的数据：每个条目按 "task_id recipient amount sequence" 一行，
+// recipient 为空时按 creator 计入。任务 ID、地址与金额均不含空白字符，拼接结果无歧义
+func BatchClaimSignBytes(creator string, entries []BatchClaimEntry) string {
+	lines := make([]string, len(entries))
+	for i, entry := range entries {
+		recipient := entry.Recipient
+		if recipient == "" {
+			recipient = creator
+		}
+		lines[i] = entry.TaskId + " " + recipient + " " + entry.Amount + " " + strconv.FormatUint(entry.Sequence, 10)
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,14 @@
+package types
+
+import "strconv"
+
+// ClaimSignBytes 返回预言机为单笔领取签名的数据：taskId + recipient + dependentDid + amount。
+// sequence 是领取人此前已领取该任务的次数，后续领取在末尾追加 "/sequence"，
+// 使同一签名不能在 PerUserLimit 大于 1 的任务上重复使用，首次领取的签名数据保持不变
+func ClaimSignBytes(taskId, recipient, dependentDid, amount string, sequence uint64) string {
+	data := taskId + recipient + dependentDid + amount
+	if sequence > 0 {
+		data += "/" + strconv.FormatUint(sequence, 10)
+	}
+	return data
+}
@@ -5,6 +5,9 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
 	math "math"
@@ -23,12 +26,26 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // ClaimRecord defines the ClaimRecord message.
+// 领取记录只由 ClaimReward 追加写入，撤销时仅做标记而不删除
 type ClaimRecord struct {
-	ClaimHash string `protobuf:"bytes,1,opt,name=claim_hash,json=claimHash,proto3" json:"claim_hash,omitempty"`
-	TaskId    string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	UserId    string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
-	Creator   string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	ClaimHash     string `protobuf:"bytes,1,opt,name=claim_hash,json=claimHash,proto3" json:"claim_hash,omitempty"`
+	TaskId        string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	UserId        string `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Signature     string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	Creator       string `protobuf:"bytes,5,opt,name=creator,proto3" json:"creator,omitempty"`
+	Revoked       bool   `protobuf:"varint,6,opt,name=revoked,proto3" json:"revoked,omitempty"`
+	RevokedBy     string `protobuf:"bytes,7,opt,name=revoked_by,json=revokedBy,proto3" json:"revoked_by,omitempty"`
+	RevokeReason  string `protobuf:"bytes,8,opt,name=revoke_reason,json=revokeReason,proto3" json:"revoke_reason,omitempty"`
+	RevokedHeight int64  `protobuf:"varint,9,opt,name=revoked_height,json=revokedHeight,proto3" json:"revoked_height,omitempty"`
+	// amount 是本次领取实际支付的金额
+	Amount types.Coin `protobuf:"bytes,10,opt,name=amount,proto3" json:"amount"`
+	// block_time 是领取时的区块时间（Unix 秒）
+	BlockTime   int64 `protobuf:"varint,11,opt,name=block_time,json=blockTime,proto3" json:"block_time,omitempty"`
+	BlockHeight int64 `protobuf:"varint,12,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// did 是任务要求身份条件时接收用户的 DID
+	Did string `protobuf:"bytes,13,opt,name=did,proto3" json:"did,omitempty"`
+	// disputed 表示奖金在争议期内被争议并退回任务托管，不计入累计支付金额
+	Disputed bool `protobuf:"varint,14,opt,name=disputed,proto3" json:"disputed,omitempty"`
 }
 
 func (m *ClaimRecord) Reset()         { *m = ClaimRecord{} }
@@ -99,6 +116,69 @@ func (m *ClaimRecord) GetCreator() string {
 	return ""
 }
 
+func (m *ClaimRecord) GetRevoked() bool {
+	if m != nil {
+		return m.Revoked
+	}
+	return false
+}
+
+func (m *ClaimRecord) GetRevokedBy() string {
+	if m != nil {
+		return m.RevokedBy
+	}
+	return ""
+}
+
+func (m *ClaimRecord) GetRevokeReason() string {
+	if m != nil {
+		return m.RevokeReason
+	}
+	return ""
+}
+
+func (m *ClaimRecord) GetRevokedHeight() int64 {
+	if m != nil {
+		return m.RevokedHeight
+	}
+	return 0
+}
+
+func (m *ClaimRecord) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *ClaimRecord) GetBlockTime() int64 {
+	if m != nil {
+		return m.BlockTime
+	}
+	return 0
+}
+
+func (m *ClaimRecord) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *ClaimRecord) GetDid() string {
+	if m != nil {
+		return m.Did
+	}
+	return ""
+}
+
+func (m *ClaimRecord) GetDisputed() bool {
+	if m != nil {
+		return m.Disputed
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*ClaimRecord)(nil), "dtc.task.v1.ClaimRecord")
 }
@@ -106,21 +186,34 @@ func init() {
 func init() { proto.RegisterFile("dtc/task/v1/claim_record.proto", fileDescriptor_a21b04c78550b54b) }
 
 var fileDescriptor_a21b04c78550b54b = []byte{
-	// 215 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4b, 0x29, 0x49, 0xd6,
-	0x2f, 0x49, 0x2c, 0xce, 0xd6, 0x2f, 0x33, 0xd4, 0x4f, 0xce, 0x49, 0xcc, 0xcc, 0x8d, 0x2f, 0x4a,
-	0x4d, 0xce, 0x2f, 0x4a, 0xd1, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x4e, 0x29, 0x49, 0xd6,
-	0x03, 0xc9, 0xeb, 0x95, 0x19, 0x2a, 0x4d, 0x63, 0xe4, 0xe2, 0x76, 0x06, 0xa9, 0x09, 0x02, 0x2b,
-	0x11, 0x92, 0xe5, 0xe2, 0x82, 0x68, 0xc9, 0x48, 0x2c, 0xce, 0x90, 0x60, 0x54, 0x60, 0xd4, 0xe0,
-	0x0c, 0xe2, 0x04, 0x8b, 0x78, 0x24, 0x16, 0x67, 0x08, 0x89, 0x73, 0xb1, 0x83, 0x74, 0xc6, 0x67,
-	0xa6, 0x48, 0x30, 0x81, 0xe5, 0xd8, 0x40, 0x5c, 0xcf, 0x14, 0x90, 0x44, 0x69, 0x71, 0x6a, 0x11,
-	0x48, 0x82, 0x19, 0x22, 0x01, 0xe2, 0x7a, 0xa6, 0x08, 0xc9, 0x70, 0x71, 0x16, 0x67, 0xa6, 0xe7,
-	0x25, 0x96, 0x94, 0x16, 0xa5, 0x4a, 0xb0, 0x40, 0xcc, 0x83, 0x0b, 0x08, 0x49, 0x70, 0xb1, 0x27,
-	0x17, 0xa5, 0x26, 0x96, 0xe4, 0x17, 0x49, 0xb0, 0x82, 0xe5, 0x60, 0x5c, 0x27, 0xad, 0x13, 0x8f,
-	0xe4, 0x18, 0x2f, 0x3c, 0x92, 0x63, 0x7c, 0xf0, 0x48, 0x8e, 0x71, 0xc2, 0x63, 0x39, 0x86, 0x0b,
-	0x8f, 0xe5, 0x18, 0x6e, 0x3c, 0x96, 0x63, 0x88, 0x12, 0x00, 0xf9, 0xaf, 0x02, 0xe2, 0xc3, 0x92,
-	0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0, 0xc7, 0x8c, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0xb7,
-	0xb1, 0xb7, 0xe3, 0xfa, 0x00, 0x00, 0x00,
+	// 428 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x92, 0x31, 0x8f, 0xd3, 0x30,
+	0x14, 0xc7, 0x6b, 0x0a, 0x6d, 0xe3, 0xf4, 0x4e, 0x87, 0x85, 0x84, 0xa9, 0x20, 0x14, 0x10, 0x52,
+	0x75, 0x43, 0xa2, 0xc2, 0xca, 0xd4, 0x5b, 0xee, 0xd6, 0x88, 0x89, 0x25, 0x72, 0x6c, 0xab, 0xb1,
+	0x7a, 0x89, 0x2b, 0xdb, 0x8d, 0xe8, 0xb7, 0xe0, 0x63, 0x30, 0xf2, 0x31, 0x8e, 0xed, 0x46, 0x26,
+	0x84, 0xda, 0x81, 0xaf, 0x71, 0xf2, 0xb3, 0x7b, 0x4b, 0xf5, 0xfe, 0xff, 0x9f, 0xfd, 0xfe, 0xaf,
+	0x2f, 0xc6, 0x99, 0x70, 0xbc, 0x70, 0xcc, 0x6e, 0x8a, 0x7e, 0x59, 0xf0, 0x5b, 0xa6, 0xda, 0xca,
+	0x48, 0xae, 0x8d, 0xc8, 0xb7, 0x46, 0x3b, 0x4d, 0x52, 0xe1, 0x78, 0xee, 0x79, 0xde, 0x2f, 0x67,
+	0xcf, 0x59, 0xab, 0x3a, 0x5d, 0xc0, 0x6f, 0xe0, 0xb3, 0x8c, 0x6b, 0xdb, 0x6a, 0x5b, 0xd4, 0xcc,
+	0xca, 0xa2, 0x5f, 0xd6, 0xd2, 0xb1, 0x65, 0xc1, 0xb5, 0xea, 0x22, 0x7f, 0xb1, 0xd6, 0x6b, 0x0d,
+	0x65, 0xe1, 0xab, 0xe0, 0xbe, 0xff, 0x3d, 0xc4, 0xe9, 0x95, 0x0f, 0x2b, 0x21, 0x8b, 0xbc, 0xc1,
+	0x38, 0x64, 0x37, 0xcc, 0x36, 0x14, 0xcd, 0xd1, 0x22, 0x29, 0x13, 0x70, 0xae, 0x99, 0x6d, 0xc8,
+	0x4b, 0x3c, 0xf6, 0x23, 0x54, 0x4a, 0xd0, 0x27, 0xc0, 0x46, 0x5e, 0xde, 0x08, 0x0f, 0x76, 0x56,
+	0x1a, 0x0f, 0x86, 0x01, 0x78, 0x79, 0x23, 0xc8, 0x6b, 0x9c, 0x58, 0xb5, 0xee, 0x98, 0xdb, 0x19,
+	0x49, 0x9f, 0x86, 0x7e, 0x8f, 0x06, 0xa1, 0x78, 0xcc, 0x8d, 0x64, 0x4e, 0x1b, 0xfa, 0x0c, 0xd8,
+	0x49, 0x7a, 0x62, 0x64, 0xaf, 0x37, 0x52, 0xd0, 0xd1, 0x1c, 0x2d, 0x26, 0xe5, 0x49, 0xfa, 0x11,
+	0x63, 0x59, 0xd5, 0x7b, 0x3a, 0x0e, 0x2d, 0xa3, 0xb3, 0xda, 0x93, 0x0f, 0xf8, 0x2c, 0x88, 0xca,
+	0x48, 0x66, 0x75, 0x47, 0x27, 0x70, 0x62, 0x1a, 0xcc, 0x12, 0x3c, 0xf2, 0x11, 0x9f, 0x9f, 0x7a,
+	0x34, 0x52, 0xad, 0x1b, 0x47, 0x93, 0x39, 0x5a, 0x0c, 0xcb, 0x78, 0x55, 0x5c, 0x83, 0x49, 0xbe,
+	0xe0, 0x11, 0x6b, 0xf5, 0xae, 0x73, 0x14, 0xcf, 0xd1, 0x22, 0xfd, 0xf4, 0x2a, 0x0f, 0x4b, 0xce,
+	0xfd, 0x92, 0xf3, 0xb8, 0xe4, 0xfc, 0x4a, 0xab, 0x6e, 0x95, 0xdc, 0xfd, 0x7d, 0x3b, 0xf8, 0xf9,
+	0xff, 0xd7, 0x25, 0x2a, 0xe3, 0x1d, 0x3f, 0x68, 0x7d, 0xab, 0xf9, 0xa6, 0x72, 0xaa, 0x95, 0x34,
+	0x85, 0x80, 0x04, 0x9c, 0xaf, 0xaa, 0x95, 0xe4, 0x1d, 0x9e, 0x06, 0x1c, 0x27, 0x98, 0xc2, 0x81,
+	0x14, 0xbc, 0x98, 0x7f, 0x81, 0x87, 0x42, 0x09, 0x7a, 0x06, 0xff, 0xc0, 0x97, 0x64, 0x86, 0x27,
+	0x42, 0xd9, 0xed, 0xce, 0x49, 0x41, 0xcf, 0x61, 0x2f, 0x8f, 0x7a, 0x75, 0x79, 0x77, 0xc8, 0xd0,
+	0xfd, 0x21, 0x43, 0xff, 0x0e, 0x19, 0xfa, 0x71, 0xcc, 0x06, 0xf7, 0xc7, 0x6c, 0xf0, 0xe7, 0x98,
+	0x0d, 0xbe, 0x5d, 0xf8, 0xb7, 0xf5, 0x3d, 0xbc, 0x2e, 0xb7, 0xdf, 0x4a, 0x5b, 0x8f, 0xe0, 0xf3,
+	0x7f, 0x7e, 0x18, 0x00, 0x03, 0x8f, 0xf0, 0xf1, 0x76, 0x02, 0x00, 0x00,
 }
 
 func (m *ClaimRecord) Marshal() (dAtA []byte, err error) {
@@ -143,6 +236,72 @@ func (m *ClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Disputed {
+		i--
+		if m.Disputed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if len(m.Did) > 0 {
+		i -= len(m.Did)
+		copy(dAtA[i:], m.Did)
+		i = encodeVarintClaimRecord(dAtA, i, uint64(len(m.Did)))
+		i--
+		dAtA[i] = 0x6a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintClaimRecord(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.BlockTime != 0 {
+		i = encodeVarintClaimRecord(dAtA, i, uint64(m.BlockTime))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintClaimRecord(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.RevokedHeight != 0 {
+		i = encodeVarintClaimRecord(dAtA, i, uint64(m.RevokedHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if len(m.RevokeReason) > 0 {
+		i -= len(m.RevokeReason)
+		copy(dAtA[i:], m.RevokeReason)
+		i = encodeVarintClaimRecord(dAtA, i, uint64(len(m.RevokeReason)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.RevokedBy) > 0 {
+		i -= len(m.RevokedBy)
+		copy(dAtA[i:], m.RevokedBy)
+		i = encodeVarintClaimRecord(dAtA, i, uint64(len(m.RevokedBy)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Revoked {
+		i--
+		if m.Revoked {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
 	if len(m.Creator) > 0 {
 		i -= len(m.Creator)
 		copy(dAtA[i:], m.Creator)
@@ -218,6 +377,35 @@ func (m *ClaimRecord) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovClaimRecord(uint64(l))
 	}
+	if m.Revoked {
+		n += 2
+	}
+	l = len(m.RevokedBy)
+	if l > 0 {
+		n += 1 + l + sovClaimRecord(uint64(l))
+	}
+	l = len(m.RevokeReason)
+	if l > 0 {
+		n += 1 + l + sovClaimRecord(uint64(l))
+	}
+	if m.RevokedHeight != 0 {
+		n += 1 + sovClaimRecord(uint64(m.RevokedHeight))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovClaimRecord(uint64(l))
+	if m.BlockTime != 0 {
+		n += 1 + sovClaimRecord(uint64(m.BlockTime))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovClaimRecord(uint64(m.BlockHeight))
+	}
+	l = len(m.Did)
+	if l > 0 {
+		n += 1 + l + sovClaimRecord(uint64(l))
+	}
+	if m.Disputed {
+		n += 2
+	}
 	return n
 }
 
@@ -416,6 +604,232 @@ func (m *ClaimRecord) Unmarshal(dAtA []byte) error {
 			}
 			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revoked", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revoked = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokeReason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokeReason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedHeight", wireType)
+			}
+			m.RevokedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevokedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			m.BlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Did", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthClaimRecord
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Did = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disputed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowClaimRecord
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disputed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipClaimRecord(dAtA[iNdEx:])
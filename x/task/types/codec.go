@@ -9,12 +9,27 @@ import (
 func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgClaimReward{},
+		&MsgBatchClaimReward{},
 	)
 
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
-		&MsgCreateClaimRecord{},
-		&MsgUpdateClaimRecord{},
-		&MsgDeleteClaimRecord{},
+		&MsgCreateTask{},
+		&MsgCloseTask{},
+		&MsgRotateTaskOracles{},
+		&MsgFundTask{},
+		&MsgReclaimTaskEscrow{},
+		&MsgWithdrawVested{},
+		&MsgDisputeClaim{},
+		&MsgSetCreditRepayShare{},
+		&MsgPostMerkleRoot{},
+		&MsgClaimWithProof{},
+		&MsgEnrollTask{},
+		&MsgClaimNative{},
+		&MsgUnenrollTask{},
+	)
+
+	registrar.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgRevokeClaimRecord{},
 	)
 
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
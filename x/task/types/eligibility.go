@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+
+	identitytypes "dtc/x/identity/types"
+)
+
+// MaxRequiredCredentials 是任务可要求的凭证类型数量上限
+const MaxRequiredCredentials = 8
+
+// RequiresDid 判断任务是否要求领取者持有 DID，设置任一身份条件即视为要求
+func (e TaskEligibility) RequiresDid() bool {
+	return e.RequireDid || e.RequireLiveness || e.MinDidAgeBlocks > 0 || len(e.RequiredCredentials) > 0
+}
+
+// Validate 校验身份条件：DID 年龄非负，凭证类型合法且不重复
+func (e TaskEligibility) Validate() error {
+	if e.MinDidAgeBlocks < 0 {
+		return fmt.Errorf("min did age cannot be negative: %d", e.MinDidAgeBlocks)
+	}
+	if len(e.RequiredCredentials) > MaxRequiredCredentials {
+		return fmt.Errorf("at most %d required credentials are allowed, got %d", MaxRequiredCredentials, len(e.RequiredCredentials))
+	}
+	seen := make(map[string]struct{}, len(e.RequiredCredentials))
+	for _, credentialType := range e.RequiredCredentials {
+		if err := identitytypes.ValidateCredentialType(credentialType); err != nil {
+			return err
+		}
+		if _, ok := seen[credentialType]; ok {
+			return fmt.Errorf("duplicate required credential %s", credentialType)
+		}
+		seen[credentialType] = struct{}{}
+	}
+	return nil
+}
@@ -8,5 +8,39 @@ import (
 
 // x/task module sentinel errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner        = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrTaskNotFound         = errors.Register(ModuleName, 1101, "task not found")
+	ErrInvalidTask          = errors.Register(ModuleName, 1102, "invalid task")
+	ErrTaskClosed           = errors.Register(ModuleName, 1103, "task closed")
+	ErrTaskNotStarted       = errors.Register(ModuleName, 1104, "task not started")
+	ErrTaskExhausted        = errors.Register(ModuleName, 1105, "task budget exhausted")
+	ErrClaimLimitReached    = errors.Register(ModuleName, 1106, "already claimed: per-user claim limit reached")
+	ErrAmountMismatch       = errors.Register(ModuleName, 1107, "claim amount does not match task reward")
+	ErrInsufficientEscrow   = errors.Register(ModuleName, 1108, "insufficient task escrow")
+	ErrTaskStillOpen        = errors.Register(ModuleName, 1109, "task is still open")
+	ErrClaimRevoked         = errors.Register(ModuleName, 1110, "claim record already revoked")
+	ErrInvalidReason        = errors.Register(ModuleName, 1111, "invalid revocation reason")
+	ErrInvalidOracleSet     = errors.Register(ModuleName, 1112, "invalid oracle set")
+	ErrRecipientRateLimited = errors.Register(ModuleName, 1113, "recipient payout limit for the epoch exceeded")
+	ErrCreatorRateLimited   = errors.Register(ModuleName, 1114, "creator payout limit for the epoch exceeded")
+	ErrBlockRateLimited     = errors.Register(ModuleName, 1115, "payout limit for the block exceeded")
+	ErrIneligible           = errors.Register(ModuleName, 1116, "recipient does not meet the task eligibility requirements")
+	ErrDidClaimLimitReached = errors.Register(ModuleName, 1117, "already claimed: per-did claim limit reached")
+	ErrStreamNotFound       = errors.Register(ModuleName, 1118, "reward stream not found")
+	ErrNothingVested        = errors.Register(ModuleName, 1119, "no vested reward to withdraw")
+	ErrPayoutNotPending     = errors.Register(ModuleName, 1120, "payout is not pending")
+	ErrDisputeWindowClosed  = errors.Register(ModuleName, 1121, "dispute window closed")
+	ErrInvalidEvidence      = errors.Register(ModuleName, 1122, "invalid dispute evidence")
+	ErrInvalidRepayShare    = errors.Register(ModuleName, 1123, "invalid credit repay share")
+	ErrInvalidMerkleRoot    = errors.Register(ModuleName, 1124, "invalid merkle distribution")
+	ErrDistributionNotFound = errors.Register(ModuleName, 1125, "merkle distribution not found")
+	ErrDistributionExpired  = errors.Register(ModuleName, 1126, "merkle distribution expired")
+	ErrLeafClaimed          = errors.Register(ModuleName, 1127, "merkle leaf already claimed")
+	ErrInvalidMerkleProof   = errors.Register(ModuleName, 1128, "invalid merkle proof")
+	ErrInvalidBatch         = errors.Register(ModuleName, 1129, "invalid claim batch")
+	ErrUnknownPredicate     = errors.Register(ModuleName, 1130, "unknown task predicate")
+	ErrPredicateUnmet       = errors.Register(ModuleName, 1131, "task predicate not satisfied")
+	ErrNativeTask           = errors.Register(ModuleName, 1132, "task is verified on chain")
+	ErrNotNativeTask        = errors.Register(ModuleName, 1133, "task is verified by oracles")
+	ErrClaimSequence        = errors.Register(ModuleName, 1134, "claim sequence mismatch")
 )
@@ -0,0 +1,79 @@
+package types
+
+// task module events
+const (
+	EventTypeTaskCreated = "task_created"
+	EventTypeTaskClosed  = "task_closed"
+	EventTypeTaskClaimed = "task_claimed"
+
+	EventTypeTaskFunded          = "task_funded"
+	EventTypeTaskEscrowReclaimed = "task_escrow_reclaimed"
+
+	EventTypeClaimRecordRevoked = "claim_record_revoked"
+
+	EventTypeTaskOraclesRotated = "task_oracles_rotated"
+
+	EventTypeRewardStreamCreated = "reward_stream_created"
+	EventTypeVestedWithdrawn     = "vested_withdrawn"
+
+	EventTypeClaimDisputed   = "claim_disputed"
+	EventTypePayoutFinalized = "payout_finalized"
+	EventTypePayoutFailed    = "payout_failed"
+
+	EventTypeRewardSplit         = "reward_split"
+	EventTypeCreditRepayShareSet = "credit_repay_share_set"
+
+	EventTypeMerkleRootPosted          = "merkle_root_posted"
+	EventTypeMerkleClaimed             = "merkle_claimed"
+	EventTypeMerkleDistributionExpired = "merkle_distribution_expired"
+	EventTypeMerkleRefundFailed        = "merkle_refund_failed"
+
+	EventTypeBatchClaimed = "batch_claimed"
+
+	EventTypeNativeTaskEnrolled   = "native_task_enrolled"
+	EventTypeNativeTaskUnenrolled = "native_task_unenrolled"
+	EventTypeIbcTransferRecorded  = "ibc_transfer_recorded"
+
+	AttributeKeyTaskId          = "task_id"
+	AttributeKeyOwner           = "owner"
+	AttributeKeyRecipient       = "recipient"
+	AttributeKeyAmount          = "amount"
+	AttributeKeyBudget          = "budget"
+	AttributeKeyRemainingBudget = "remaining_budget"
+	AttributeKeyClaimCount      = "claim_count"
+	AttributeKeyReason          = "reason"
+	AttributeKeyFunder          = "funder"
+	AttributeKeyEscrow          = "escrow"
+	AttributeKeyClaimHash       = "claim_hash"
+	AttributeKeyRevoker         = "revoker"
+	AttributeKeyOraclePubkeys   = "oracle_pubkeys"
+	AttributeKeyThreshold       = "threshold"
+	AttributeKeyPreviousPubkeys = "previous_oracle_pubkeys"
+	AttributeKeyOverlapUntil    = "overlap_until"
+	AttributeKeyStreamId        = "stream_id"
+	AttributeKeyCliffHeight     = "cliff_height"
+	AttributeKeyEndHeight       = "end_height"
+	AttributeKeyReleaseHeight   = "release_height"
+	AttributeKeyDisputer        = "disputer"
+	AttributeKeyEvidence        = "evidence"
+	AttributeKeyCreditRepaid    = "credit_repaid"
+	AttributeKeyRecipientAmount = "recipient_amount"
+	AttributeKeyShare           = "share"
+	AttributeKeyEpoch           = "epoch"
+	AttributeKeyRoot            = "root"
+	AttributeKeyLeafIndex       = "leaf_index"
+	AttributeKeyExpiryHeight    = "expiry_height"
+	AttributeKeyCreator         = "creator"
+	AttributeKeySucceeded       = "succeeded"
+	AttributeKeyFailed          = "failed"
+	AttributeKeyPredicate       = "predicate"
+	AttributeKeyHeight          = "height"
+	AttributeKeySender          = "sender"
+	AttributeKeyChannel         = "channel"
+	AttributeKeyError           = "error"
+	AttributeKeyRefunded        = "refunded"
+
+	AttributeValueReasonExhausted = "exhausted"
+	AttributeValueReasonExpired   = "expired"
+	AttributeValueReasonOwner     = "owner"
+)
@@ -5,6 +5,9 @@ import (
 
 	"cosmossdk.io/core/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	dtctypes "dtc/x/dtc/types"
+	identitytypes "dtc/x/identity/types"
 )
 
 // AuthKeeper defines the expected interface for the Auth module.
@@ -18,9 +21,30 @@ type AuthKeeper interface {
 type BankKeeper interface {
 	SpendableCoins(context.Context, sdk.AccAddress) sdk.Coins
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
 	// Methods imported from bank should be defined here
 }
 
+// RoleKeeper defines the expected interface for the x/dtc role registry.
+type RoleKeeper interface {
+	HasRole(ctx context.Context, role dtctypes.Role, address, scope string) bool
+}
+
+// IdentityKeeper defines the expected interface for the x/identity module.
+type IdentityKeeper interface {
+	GetAttestationStatus(ctx sdk.Context, address string) (identitytypes.AttestationStatus, identitytypes.DidDocument, error)
+	GetDidAttestationStatus(ctx sdk.Context, did string) (identitytypes.AttestationStatus, identitytypes.DidDocument, error)
+	GetGuardianship(ctx context.Context, did string) (identitytypes.Guardianship, bool)
+	HasActiveCredential(ctx context.Context, did, credentialType string) bool
+}
+
+// CreditKeeper defines the expected interface for the x/credit module.
+type CreditKeeper interface {
+	RepayLiability(ctx context.Context, senderModule, address string, amount sdk.Coin) (sdk.Coin, error)
+	GetRepaidLiability(ctx context.Context, address string) (uint64, error)
+}
+
 // ParamSubspace defines the expected Subspace interface for parameters.
 type ParamSubspace interface {
 	Get(context.Context, []byte, interface{})
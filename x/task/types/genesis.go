@@ -5,8 +5,16 @@ import "fmt"
 // DefaultGenesis returns the default genesis state
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
-		Params:         DefaultParams(),
-		ClaimRecordMap: []ClaimRecord{}}
+		Params:              DefaultParams(),
+		ClaimRecordMap:      []ClaimRecord{},
+		Tasks:               []Task{},
+		Escrows:             []TaskEscrow{},
+		RewardStreams:       []RewardStream{},
+		PendingPayouts:      []PendingPayout{},
+		MerkleDistributions: []MerkleDistribution{},
+		ClaimedBitmap:       []ClaimedBitmapWord{},
+		NativeEnrollments:   []NativeEnrollment{},
+		IbcTransferTotals:   []IbcTransferTotal{}}
 }
 
 // Validate performs basic genesis state validation returning an error upon any
@@ -20,6 +28,137 @@ func (gs GenesisState) Validate() error {
 			return fmt.Errorf("duplicated index for claimRecord")
 		}
 		claimRecordIndexMap[index] = struct{}{}
+		if elem.Amount.Denom != "" {
+			if err := elem.Amount.Validate(); err != nil {
+				return fmt.Errorf("invalid amount for claimRecord %s: %w", elem.ClaimHash, err)
+			}
+		}
+	}
+
+	taskIndexMap := make(map[string]Task)
+	for _, task := range gs.Tasks {
+		if _, ok := taskIndexMap[task.Id]; ok {
+			return fmt.Errorf("duplicated task id %s", task.Id)
+		}
+		taskIndexMap[task.Id] = task
+		if err := task.Validate(); err != nil {
+			return fmt.Errorf("invalid task %s: %w", task.Id, err)
+		}
+	}
+
+	escrowIndexMap := make(map[string]struct{})
+	for _, escrow := range gs.Escrows {
+		if _, ok := escrowIndexMap[escrow.TaskId]; ok {
+			return fmt.Errorf("duplicated escrow for task %s", escrow.TaskId)
+		}
+		escrowIndexMap[escrow.TaskId] = struct{}{}
+		task, ok := taskIndexMap[escrow.TaskId]
+		if !ok {
+			return fmt.Errorf("escrow references unknown task %s", escrow.TaskId)
+		}
+		if err := escrow.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid escrow for task %s: %w", escrow.TaskId, err)
+		}
+		if escrow.Amount.Denom != task.RewardPerClaim.Denom {
+			return fmt.Errorf("escrow denom %s does not match task %s reward denom", escrow.Amount.Denom, escrow.TaskId)
+		}
+	}
+
+	streamIndexMap := make(map[uint64]struct{})
+	for _, stream := range gs.RewardStreams {
+		if _, ok := streamIndexMap[stream.Id]; ok {
+			return fmt.Errorf("duplicated reward stream id %d", stream.Id)
+		}
+		streamIndexMap[stream.Id] = struct{}{}
+		if _, ok := taskIndexMap[stream.TaskId]; !ok {
+			return fmt.Errorf("reward stream %d references unknown task %s", stream.Id, stream.TaskId)
+		}
+		if err := stream.Validate(); err != nil {
+			return fmt.Errorf("invalid reward stream %d: %w", stream.Id, err)
+		}
+	}
+
+	pendingIndexMap := make(map[string]struct{})
+	for _, pending := range gs.PendingPayouts {
+		if _, ok := pendingIndexMap[pending.ClaimHash]; ok {
+			return fmt.Errorf("duplicated pending payout %s", pending.ClaimHash)
+		}
+		pendingIndexMap[pending.ClaimHash] = struct{}{}
+		if _, ok := claimRecordIndexMap[pending.ClaimHash]; !ok {
+			return fmt.Errorf("pending payout references unknown claim record %s", pending.ClaimHash)
+		}
+		if _, ok := taskIndexMap[pending.TaskId]; !ok {
+			return fmt.Errorf("pending payout %s references unknown task %s", pending.ClaimHash, pending.TaskId)
+		}
+		if err := pending.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid pending payout %s: %w", pending.ClaimHash, err)
+		}
+	}
+
+	distIndexMap := make(map[string]MerkleDistribution)
+	for _, dist := range gs.MerkleDistributions {
+		index := fmt.Sprintf("%s/%d", dist.TaskId, dist.Epoch)
+		if _, ok := distIndexMap[index]; ok {
+			return fmt.Errorf("duplicated merkle distribution %s", index)
+		}
+		distIndexMap[index] = dist
+		task, ok := taskIndexMap[dist.TaskId]
+		if !ok {
+			return fmt.Errorf("merkle distribution %s references unknown task", index)
+		}
+		if dist.Epoch > task.LastMerkleEpoch {
+			return fmt.Errorf("merkle distribution %s is after task last merkle epoch %d", index, task.LastMerkleEpoch)
+		}
+		if err := dist.Validate(); err != nil {
+			return fmt.Errorf("invalid merkle distribution %s: %w", index, err)
+		}
+	}
+
+	bitmapIndexMap := make(map[string]struct{})
+	for _, word := range gs.ClaimedBitmap {
+		index := fmt.Sprintf("%s/%d/%d", word.TaskId, word.Epoch, word.Word)
+		if _, ok := bitmapIndexMap[index]; ok {
+			return fmt.Errorf("duplicated claimed bitmap word %s", index)
+		}
+		bitmapIndexMap[index] = struct{}{}
+		dist, ok := distIndexMap[fmt.Sprintf("%s/%d", word.TaskId, word.Epoch)]
+		if !ok {
+			return fmt.Errorf("claimed bitmap word %s references unknown merkle distribution", index)
+		}
+		if word.Word > (dist.LeafCount-1)/64 {
+			return fmt.Errorf("claimed bitmap word %s is beyond leaf count %d", index, dist.LeafCount)
+		}
+	}
+
+	enrollmentIndexMap := make(map[string]struct{})
+	for _, enrollment := range gs.NativeEnrollments {
+		index := enrollment.TaskId + "/" + enrollment.Address
+		if _, ok := enrollmentIndexMap[index]; ok {
+			return fmt.Errorf("duplicated native enrollment %s", index)
+		}
+		enrollmentIndexMap[index] = struct{}{}
+		task, ok := taskIndexMap[enrollment.TaskId]
+		if !ok {
+			return fmt.Errorf("native enrollment %s references unknown task", index)
+		}
+		if !task.IsNative() {
+			return fmt.Errorf("native enrollment %s references oracle task", index)
+		}
+		if err := enrollment.Validate(); err != nil {
+			return fmt.Errorf("invalid native enrollment %s: %w", index, err)
+		}
+	}
+
+	transferIndexMap := make(map[string]struct{})
+	for _, total := range gs.IbcTransferTotals {
+		index := fmt.Sprintf("%s/%s/%s", total.Sender, total.Channel, total.Amount.Denom)
+		if _, ok := transferIndexMap[index]; ok {
+			return fmt.Errorf("duplicated ibc transfer total %s", index)
+		}
+		transferIndexMap[index] = struct{}{}
+		if err := total.Validate(); err != nil {
+			return fmt.Errorf("invalid ibc transfer total %s: %w", index, err)
+		}
 	}
 
 	return gs.Params.Validate()
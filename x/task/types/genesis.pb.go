@@ -27,8 +27,16 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState defines the task module's genesis state.
 type GenesisState struct {
 	// params defines all the parameters of the module.
-	Params         Params        `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	ClaimRecordMap []ClaimRecord `protobuf:"bytes,2,rep,name=claim_record_map,json=claimRecordMap,proto3" json:"claim_record_map"`
+	Params              Params               `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ClaimRecordMap      []ClaimRecord        `protobuf:"bytes,2,rep,name=claim_record_map,json=claimRecordMap,proto3" json:"claim_record_map"`
+	Tasks               []Task               `protobuf:"bytes,3,rep,name=tasks,proto3" json:"tasks"`
+	Escrows             []TaskEscrow         `protobuf:"bytes,4,rep,name=escrows,proto3" json:"escrows"`
+	RewardStreams       []RewardStream       `protobuf:"bytes,5,rep,name=reward_streams,json=rewardStreams,proto3" json:"reward_streams"`
+	PendingPayouts      []PendingPayout      `protobuf:"bytes,6,rep,name=pending_payouts,json=pendingPayouts,proto3" json:"pending_payouts"`
+	MerkleDistributions []MerkleDistribution `protobuf:"bytes,7,rep,name=merkle_distributions,json=merkleDistributions,proto3" json:"merkle_distributions"`
+	ClaimedBitmap       []ClaimedBitmapWord  `protobuf:"bytes,8,rep,name=claimed_bitmap,json=claimedBitmap,proto3" json:"claimed_bitmap"`
+	NativeEnrollments   []NativeEnrollment   `protobuf:"bytes,9,rep,name=native_enrollments,json=nativeEnrollments,proto3" json:"native_enrollments"`
+	IbcTransferTotals   []IbcTransferTotal   `protobuf:"bytes,10,rep,name=ibc_transfer_totals,json=ibcTransferTotals,proto3" json:"ibc_transfer_totals"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -78,6 +86,62 @@ func (m *GenesisState) GetClaimRecordMap() []ClaimRecord {
 	return nil
 }
 
+func (m *GenesisState) GetTasks() []Task {
+	if m != nil {
+		return m.Tasks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetEscrows() []TaskEscrow {
+	if m != nil {
+		return m.Escrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRewardStreams() []RewardStream {
+	if m != nil {
+		return m.RewardStreams
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingPayouts() []PendingPayout {
+	if m != nil {
+		return m.PendingPayouts
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMerkleDistributions() []MerkleDistribution {
+	if m != nil {
+		return m.MerkleDistributions
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimedBitmap() []ClaimedBitmapWord {
+	if m != nil {
+		return m.ClaimedBitmap
+	}
+	return nil
+}
+
+func (m *GenesisState) GetNativeEnrollments() []NativeEnrollment {
+	if m != nil {
+		return m.NativeEnrollments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetIbcTransferTotals() []IbcTransferTotal {
+	if m != nil {
+		return m.IbcTransferTotals
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "dtc.task.v1.GenesisState")
 }
@@ -85,23 +149,40 @@ func init() {
 func init() { proto.RegisterFile("dtc/task/v1/genesis.proto", fileDescriptor_74dbfd04aa7ea10f) }
 
 var fileDescriptor_74dbfd04aa7ea10f = []byte{
-	// 247 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4c, 0x29, 0x49, 0xd6,
-	0x2f, 0x49, 0x2c, 0xce, 0xd6, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6,
-	0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x4e, 0x29, 0x49, 0xd6, 0x03, 0x49, 0xe9, 0x95, 0x19,
-	0x4a, 0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0xbc, 0x94, 0x1c, 0xb2, 0xd6,
-	0xe4, 0x9c, 0xc4, 0xcc, 0xdc, 0xf8, 0xa2, 0xd4, 0xe4, 0xfc, 0xa2, 0x14, 0xa8, 0xbc, 0x04, 0xb2,
-	0x7c, 0x41, 0x62, 0x51, 0x62, 0x2e, 0xd4, 0x64, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x30, 0x53,
-	0x1f, 0xc4, 0x82, 0x88, 0x2a, 0x4d, 0x60, 0xe4, 0xe2, 0x71, 0x87, 0xb8, 0x20, 0xb8, 0x24, 0xb1,
-	0x24, 0x55, 0xc8, 0x8c, 0x8b, 0x0d, 0xa2, 0x4d, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48, 0x58,
-	0x0f, 0xc9, 0x45, 0x7a, 0x01, 0x60, 0x29, 0x27, 0xce, 0x13, 0xf7, 0xe4, 0x19, 0x56, 0x3c, 0xdf,
-	0xa0, 0xc5, 0x18, 0x04, 0x55, 0x2d, 0xe4, 0xc1, 0x25, 0x80, 0xec, 0x9c, 0xf8, 0xdc, 0xc4, 0x02,
-	0x09, 0x26, 0x05, 0x66, 0x0d, 0x6e, 0x23, 0x09, 0x14, 0x13, 0x9c, 0x41, 0x8a, 0x82, 0xc0, 0x6a,
-	0x9c, 0x58, 0x40, 0xc6, 0x04, 0xf1, 0x25, 0x23, 0x84, 0x7c, 0x13, 0x0b, 0x9c, 0xb4, 0x4e, 0x3c,
-	0x92, 0x63, 0xbc, 0xf0, 0x48, 0x8e, 0xf1, 0xc1, 0x23, 0x39, 0xc6, 0x09, 0x8f, 0xe5, 0x18, 0x2e,
-	0x3c, 0x96, 0x63, 0xb8, 0xf1, 0x58, 0x8e, 0x21, 0x4a, 0x00, 0xe4, 0xb9, 0x0a, 0x88, 0xf7, 0x4a,
-	0x2a, 0x0b, 0x52, 0x8b, 0x93, 0xd8, 0xc0, 0xbe, 0x30, 0x06, 0x04, 0x00, 0x00, 0xff, 0xff, 0xe9,
-	0x6d, 0x10, 0xa9, 0x52, 0x01, 0x00, 0x00,
+	// 520 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x1c, 0xc6, 0x1b, 0xb6, 0x76, 0xcc, 0x65, 0x63, 0x75, 0x27, 0xf0, 0x8a, 0xc8, 0x26, 0x4e, 0xd3,
+	0x24, 0x5a, 0x6d, 0x48, 0x70, 0x2f, 0x0c, 0x98, 0xd0, 0xd0, 0xd4, 0x56, 0x02, 0x71, 0x89, 0x5c,
+	0xc7, 0x54, 0x56, 0x13, 0x3b, 0xf2, 0xdf, 0xeb, 0xd8, 0x5b, 0xf0, 0x18, 0x1c, 0x79, 0x8c, 0x1d,
+	0x77, 0x44, 0x42, 0x42, 0xa8, 0x3d, 0xf0, 0x1a, 0x28, 0xb6, 0xbb, 0x25, 0x6b, 0x2f, 0xad, 0xf5,
+	0x7d, 0xbf, 0xef, 0x4b, 0xfe, 0x76, 0x8c, 0x76, 0x62, 0xc3, 0x3a, 0x86, 0xc2, 0xb8, 0x33, 0x39,
+	0xec, 0x8c, 0xb8, 0xe4, 0x20, 0xa0, 0x9d, 0x69, 0x65, 0x14, 0xae, 0xc7, 0x86, 0xb5, 0x73, 0xab,
+	0x3d, 0x39, 0x6c, 0x35, 0x68, 0x2a, 0xa4, 0xea, 0xd8, 0x5f, 0xe7, 0xb7, 0xc2, 0x62, 0x94, 0x25,
+	0x54, 0xa4, 0x91, 0xe6, 0x4c, 0xe9, 0xd8, 0xfb, 0xa4, 0xe8, 0xa7, 0x5c, 0x8f, 0x13, 0xbe, 0xcc,
+	0xc9, 0xa8, 0xa6, 0xa9, 0x7f, 0x66, 0xeb, 0x49, 0xc9, 0xd1, 0x3c, 0x16, 0x8c, 0x9a, 0xa5, 0x31,
+	0x30, 0x9a, 0xd3, 0xd4, 0x3b, 0x8f, 0x8a, 0x4e, 0xfe, 0xef, 0xf5, 0xed, 0x91, 0x1a, 0x29, 0xbb,
+	0xec, 0xe4, 0x2b, 0xa7, 0x3e, 0xfb, 0x5d, 0x45, 0x0f, 0xde, 0xb9, 0x51, 0xfb, 0x86, 0x1a, 0x8e,
+	0x5f, 0xa2, 0x9a, 0x7b, 0x0b, 0x12, 0xec, 0x05, 0xfb, 0xf5, 0xa3, 0x66, 0xbb, 0x30, 0x7a, 0xfb,
+	0xcc, 0x5a, 0xdd, 0xf5, 0xab, 0x3f, 0xbb, 0x95, 0x1f, 0xff, 0x7e, 0x1e, 0x04, 0x3d, 0x4f, 0xe3,
+	0xf7, 0x68, 0xab, 0x38, 0x77, 0x94, 0xd2, 0x8c, 0xdc, 0xdb, 0x5b, 0xd9, 0xaf, 0x1f, 0x91, 0x52,
+	0xc3, 0xeb, 0x1c, 0xea, 0x59, 0xa6, 0xbb, 0x9a, 0xd7, 0xf4, 0x36, 0xd9, 0xad, 0x74, 0x4a, 0x33,
+	0xfc, 0x1c, 0x55, 0x73, 0x18, 0xc8, 0x8a, 0x8d, 0x37, 0x4a, 0xf1, 0x01, 0x85, 0xb1, 0xcf, 0x39,
+	0x0a, 0xbf, 0x42, 0x6b, 0x1c, 0x98, 0x56, 0x17, 0x40, 0x56, 0x6d, 0xe0, 0xf1, 0x42, 0xe0, 0xd8,
+	0xfa, 0x3e, 0x36, 0xa7, 0xf1, 0x5b, 0xb4, 0xa9, 0xf9, 0x05, 0xd5, 0x71, 0xe4, 0xf6, 0x0f, 0x48,
+	0xd5, 0xe6, 0x77, 0x4a, 0xf9, 0x9e, 0x45, 0xfa, 0x96, 0xf0, 0x0d, 0x1b, 0xba, 0xa0, 0x01, 0x3e,
+	0x41, 0x0f, 0x33, 0x2e, 0x63, 0x21, 0x47, 0x51, 0x46, 0x2f, 0xd5, 0xb9, 0x01, 0x52, 0xb3, 0x45,
+	0xad, 0xf2, 0xd6, 0x39, 0xe6, 0xcc, 0x22, 0xf3, 0xd1, 0xb3, 0xa2, 0x08, 0xf8, 0x33, 0xda, 0x76,
+	0x1f, 0x47, 0x14, 0x0b, 0x30, 0x5a, 0x0c, 0xcf, 0x8d, 0x50, 0x12, 0xc8, 0x9a, 0xed, 0xdb, 0x2d,
+	0xf5, 0x9d, 0x5a, 0xf0, 0x4d, 0x81, 0xf3, 0xa5, 0xcd, 0x74, 0xc1, 0x01, 0xfc, 0x01, 0xb9, 0x6d,
+	0xe6, 0x71, 0x34, 0x14, 0x26, 0x3f, 0x9c, 0xfb, 0xb6, 0x33, 0x5c, 0x3c, 0x1c, 0x1e, 0x77, 0x2d,
+	0xf1, 0xe9, 0xf6, 0x88, 0x36, 0x58, 0xd1, 0xc0, 0x3d, 0x84, 0x25, 0x35, 0x62, 0xc2, 0x23, 0x2e,
+	0xb5, 0x4a, 0x92, 0x94, 0x4b, 0x03, 0x64, 0xdd, 0x16, 0x3e, 0x2d, 0x15, 0x7e, 0xb4, 0xd8, 0xf1,
+	0x0d, 0xe5, 0xfb, 0x1a, 0xf2, 0x8e, 0x0e, 0xb8, 0x8f, 0x9a, 0x62, 0xc8, 0x22, 0xa3, 0xa9, 0x84,
+	0xaf, 0x5c, 0x47, 0x46, 0x19, 0x9a, 0x00, 0x41, 0x4b, 0x4a, 0x4f, 0x86, 0x6c, 0xe0, 0xb1, 0x41,
+	0x4e, 0xcd, 0x4b, 0xc5, 0x1d, 0x1d, 0xba, 0x07, 0x57, 0xd3, 0x30, 0xb8, 0x9e, 0x86, 0xc1, 0xdf,
+	0x69, 0x18, 0x7c, 0x9f, 0x85, 0x95, 0xeb, 0x59, 0x58, 0xf9, 0x35, 0x0b, 0x2b, 0x5f, 0xb6, 0xf2,
+	0x5b, 0xf2, 0xcd, 0xdd, 0x13, 0x73, 0x99, 0x71, 0x18, 0xd6, 0xec, 0x85, 0x78, 0xf1, 0x7f, 0x00,
+	0x54, 0x04, 0x97, 0x42, 0x06, 0x04, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -124,6 +205,118 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.IbcTransferTotals) > 0 {
+		for iNdEx := len(m.IbcTransferTotals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.IbcTransferTotals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.NativeEnrollments) > 0 {
+		for iNdEx := len(m.NativeEnrollments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NativeEnrollments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ClaimedBitmap) > 0 {
+		for iNdEx := len(m.ClaimedBitmap) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimedBitmap[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.MerkleDistributions) > 0 {
+		for iNdEx := len(m.MerkleDistributions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MerkleDistributions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PendingPayouts) > 0 {
+		for iNdEx := len(m.PendingPayouts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPayouts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RewardStreams) > 0 {
+		for iNdEx := len(m.RewardStreams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RewardStreams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Escrows) > 0 {
+		for iNdEx := len(m.Escrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Escrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Tasks) > 0 {
+		for iNdEx := len(m.Tasks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Tasks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.ClaimRecordMap) > 0 {
 		for iNdEx := len(m.ClaimRecordMap) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -176,6 +369,54 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.Tasks) > 0 {
+		for _, e := range m.Tasks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Escrows) > 0 {
+		for _, e := range m.Escrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RewardStreams) > 0 {
+		for _, e := range m.RewardStreams {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingPayouts) > 0 {
+		for _, e := range m.PendingPayouts {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MerkleDistributions) > 0 {
+		for _, e := range m.MerkleDistributions {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClaimedBitmap) > 0 {
+		for _, e := range m.ClaimedBitmap {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.NativeEnrollments) > 0 {
+		for _, e := range m.NativeEnrollments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.IbcTransferTotals) > 0 {
+		for _, e := range m.IbcTransferTotals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -281,6 +522,278 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Tasks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Tasks = append(m.Tasks, Task{})
+			if err := m.Tasks[len(m.Tasks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Escrows = append(m.Escrows, TaskEscrow{})
+			if err := m.Escrows[len(m.Escrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardStreams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardStreams = append(m.RewardStreams, RewardStream{})
+			if err := m.RewardStreams[len(m.RewardStreams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPayouts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPayouts = append(m.PendingPayouts, PendingPayout{})
+			if err := m.PendingPayouts[len(m.PendingPayouts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MerkleDistributions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MerkleDistributions = append(m.MerkleDistributions, MerkleDistribution{})
+			if err := m.MerkleDistributions[len(m.MerkleDistributions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimedBitmap", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimedBitmap = append(m.ClaimedBitmap, ClaimedBitmapWord{})
+			if err := m.ClaimedBitmap[len(m.ClaimedBitmap)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NativeEnrollments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NativeEnrollments = append(m.NativeEnrollments, NativeEnrollment{})
+			if err := m.NativeEnrollments[len(m.NativeEnrollments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcTransferTotals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.IbcTransferTotals = append(m.IbcTransferTotals, IbcTransferTotal{})
+			if err := m.IbcTransferTotals[len(m.IbcTransferTotals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,13 +1,27 @@
 package types_test
 
 import (
+	"strings"
 	"testing"
 
 	"dtc/x/task/types"
 
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/stretchr/testify/require"
 )
 
+func validTask(id string) types.Task {
+	return types.Task{
+		Id:              id,
+		RewardPerClaim:  sdk.NewInt64Coin("dtc", 10),
+		Budget:          sdk.NewInt64Coin("dtc", 100),
+		RemainingBudget: sdk.NewInt64Coin("dtc", 100),
+		Status:          types.TASK_STATUS_OPEN,
+		OracleSet:       types.NewOracleSet([]string{"0279be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798"}, 1),
+	}
+}
+
 func TestGenesisState_Validate(t *testing.T) {
 	tests := []struct {
 		desc     string
@@ -36,6 +50,175 @@ func TestGenesisState_Validate(t *testing.T) {
 				},
 			},
 			valid: false,
+		}, {
+			desc: "duplicated task",
+			genState: &types.GenesisState{
+				Tasks: []types.Task{validTask("t1"), validTask("t1")},
+			},
+			valid: false,
+		}, {
+			desc: "invalid task",
+			genState: &types.GenesisState{
+				Tasks: []types.Task{func() types.Task {
+					task := validTask("t1")
+					task.Budget = sdk.NewInt64Coin("uatom", 100)
+					return task
+				}()},
+			},
+			valid: false,
+		}, {
+			desc: "valid tasks",
+			genState: &types.GenesisState{
+				Tasks:   []types.Task{validTask("t1"), validTask("t2")},
+				Escrows: []types.TaskEscrow{{TaskId: "t1", Amount: sdk.NewInt64Coin("dtc", 100)}},
+			},
+			valid: true,
+		}, {
+			desc: "escrow for unknown task",
+			genState: &types.GenesisState{
+				Tasks:   []types.Task{validTask("t1")},
+				Escrows: []types.TaskEscrow{{TaskId: "t2", Amount: sdk.NewInt64Coin("dtc", 100)}},
+			},
+			valid: false,
+		}, {
+			desc: "escrow denom mismatch",
+			genState: &types.GenesisState{
+				Tasks:   []types.Task{validTask("t1")},
+				Escrows: []types.TaskEscrow{{TaskId: "t1", Amount: sdk.NewInt64Coin("uatom", 100)}},
+			},
+			valid: false,
+		}, {
+			desc: "invalid payout schedule",
+			genState: &types.GenesisState{Tasks: []types.Task{func() types.Task {
+				task := validTask("t1")
+				task.PayoutSchedule = types.PayoutSchedule{Type: types.PAYOUT_SCHEDULE_TYPE_LINEAR}
+				return task
+			}()}},
+			valid: false,
+		}, {
+			desc: "reward stream for unknown task",
+			genState: &types.GenesisState{
+				Tasks: []types.Task{validTask("t1")},
+				RewardStreams: []types.RewardStream{{
+					Id: 1, TaskId: "t2", Total: sdk.NewInt64Coin("dtc", 10), Withdrawn: sdk.NewInt64Coin("dtc", 0), StartHeight: 1, CliffHeight: 1, EndHeight: 2,
+				}},
+			},
+			valid: false,
+		}, {
+			desc: "reward stream overdrawn",
+			genState: &types.GenesisState{
+				Tasks: []types.Task{validTask("t1")},
+				RewardStreams: []types.RewardStream{{
+					Id: 1, TaskId: "t1", Total: sdk.NewInt64Coin("dtc", 10), Withdrawn: sdk.NewInt64Coin("dtc", 11), StartHeight: 1, CliffHeight: 1, EndHeight: 2,
+				}},
+			},
+			valid: false,
+		}, {
+			desc: "pending payout for unknown claim record",
+			genState: &types.GenesisState{
+				Tasks:          []types.Task{validTask("t1")},
+				PendingPayouts: []types.PendingPayout{{ClaimHash: "0", TaskId: "t1", Amount: sdk.NewInt64Coin("dtc", 10), ReleaseHeight: 5}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated pending payout",
+			genState: &types.GenesisState{
+				ClaimRecordMap: []types.ClaimRecord{{ClaimHash: "0", TaskId: "t1"}},
+				Tasks:          []types.Task{validTask("t1")},
+				PendingPayouts: []types.PendingPayout{
+					{ClaimHash: "0", TaskId: "t1", Amount: sdk.NewInt64Coin("dtc", 10), ReleaseHeight: 5},
+					{ClaimHash: "0", TaskId: "t1", Amount: sdk.NewInt64Coin("dtc", 10), ReleaseHeight: 5},
+				},
+			},
+			valid: false,
+		}, {
+			desc: "merkle distribution after task epoch",
+			genState: &types.GenesisState{
+				Tasks: []types.Task{validTask("t1")},
+				MerkleDistributions: []types.MerkleDistribution{{
+					TaskId: "t1", Epoch: 1, Root: strings.Repeat("ab", 32), LeafCount: 2,
+					Total: sdk.NewInt64Coin("dtc", 10), Claimed: sdk.NewInt64Coin("dtc", 0), PostedHeight: 1, ExpiryHeight: 2,
+				}},
+			},
+			valid: false,
+		}, {
+			desc: "claimed bitmap beyond leaf count",
+			genState: &types.GenesisState{
+				Tasks: []types.Task{func() types.Task {
+					task := validTask("t1")
+					task.LastMerkleEpoch = 1
+					return task
+				}()},
+				MerkleDistributions: []types.MerkleDistribution{{
+					TaskId: "t1", Epoch: 1, Root: strings.Repeat("ab", 32), LeafCount: 64,
+					Total: sdk.NewInt64Coin("dtc", 10), Claimed: sdk.NewInt64Coin("dtc", 0), PostedHeight: 1, ExpiryHeight: 2,
+				}},
+				ClaimedBitmap: []types.ClaimedBitmapWord{{TaskId: "t1", Epoch: 1, Word: 1, Bits: 1}},
+			},
+			valid: false,
+		}, {
+			desc: "valid native task",
+			genState: &types.GenesisState{
+				Tasks: []types.Task{func() types.Task {
+					task := validTask("t1")
+					task.OracleSet = types.OracleSet{}
+					task.Predicate = types.TaskPredicate{Type: types.PredicateBalanceHeld, Amount: sdk.NewInt64Coin("dtc", 10), Blocks: 5}
+					return task
+				}()},
+				NativeEnrollments: []types.NativeEnrollment{{TaskId: "t1", Address: "alice", Height: 3, Deposit: sdk.NewInt64Coin("dtc", 10)}},
+				IbcTransferTotals: []types.IbcTransferTotal{{Sender: "alice", Channel: "channel-0", Amount: sdk.NewInt64Coin("dtc", 10)}},
+			},
+			valid: true,
+		}, {
+			desc: "invalid enrollment deposit",
+			genState: &types.GenesisState{
+				Tasks: []types.Task{func() types.Task {
+					task := validTask("t1")
+					task.OracleSet = types.OracleSet{}
+					task.Predicate = types.TaskPredicate{Type: types.PredicateBalanceHeld, Amount: sdk.NewInt64Coin("dtc", 10), Blocks: 5}
+					return task
+				}()},
+				NativeEnrollments: []types.NativeEnrollment{{TaskId: "t1", Address: "alice", Height: 3, Deposit: sdk.Coin{Denom: "dtc", Amount: sdkmath.NewInt(-1)}}},
+			},
+			valid: false,
+		}, {
+			desc: "native task with oracles",
+			genState: &types.GenesisState{
+				Tasks: []types.Task{func() types.Task {
+					task := validTask("t1")
+					task.Predicate = types.TaskPredicate{Type: types.PredicateDidRegistered}
+					return task
+				}()},
+			},
+			valid: false,
+		}, {
+			desc: "enrollment in oracle task",
+			genState: &types.GenesisState{
+				Tasks:             []types.Task{validTask("t1")},
+				NativeEnrollments: []types.NativeEnrollment{{TaskId: "t1", Address: "alice", Height: 3}},
+			},
+			valid: false,
+		}, {
+			desc: "duplicated ibc transfer total",
+			genState: &types.GenesisState{
+				IbcTransferTotals: []types.IbcTransferTotal{
+					{Sender: "alice", Channel: "channel-0", Amount: sdk.NewInt64Coin("dtc", 10)},
+					{Sender: "alice", Channel: "channel-0", Amount: sdk.NewInt64Coin("dtc", 20)},
+				},
+			},
+			valid: false,
+		}, {
+			desc:     "invalid credit repay share",
+			genState: &types.GenesisState{Params: types.Params{CreditRepayShare: sdkmath.LegacyNewDec(2)}},
+			valid:    false,
+		}, {
+			desc:     "negative payout epoch",
+			genState: &types.GenesisState{Params: types.Params{PayoutEpochBlocks: -1}},
+			valid:    false,
+		}, {
+			desc:     "invalid payout limit",
+			genState: &types.GenesisState{Params: types.Params{BlockLimit: sdk.Coins{sdk.Coin{Denom: "dtc", Amount: sdkmath.ZeroInt()}}}},
+			valid:    false,
 		},
 	}
 	for _, tc := range tests {
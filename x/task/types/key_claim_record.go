@@ -4,3 +4,21 @@ import "cosmossdk.io/collections"
 
 // ClaimRecordKey is the prefix to retrieve all ClaimRecord
 var ClaimRecordKey = collections.NewPrefix("claimRecord/value/")
+
+// ClaimsByUserKey 是按接收用户索引领取记录的前缀
+var ClaimsByUserKey = collections.NewPrefix("claimsByUser/value/")
+
+// ClaimsByTaskKey 是按任务索引领取记录的前缀
+var ClaimsByTaskKey = collections.NewPrefix("claimsByTask/value/")
+
+// ClaimsByCreatorKey 是按提交者索引领取记录的前缀
+var ClaimsByCreatorKey = collections.NewPrefix("claimsByCreator/value/")
+
+// PaidByTaskKey 是按 (任务, 币种) 累计支付金额的前缀
+var PaidByTaskKey = collections.NewPrefix("paidByTask/value/")
+
+// PaidByUserKey 是按 (用户, 币种) 累计支付金额的前缀
+var PaidByUserKey = collections.NewPrefix("paidByUser/value/")
+
+// PaidByCreatorKey 是按 (提交者, 币种) 累计支付金额的前缀
+var PaidByCreatorKey = collections.NewPrefix("paidByCreator/value/")
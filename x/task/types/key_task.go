@@ -0,0 +1,63 @@
+package types
+
+import "cosmossdk.io/collections"
+
+// TaskKey is the prefix to retrieve all Task
+var TaskKey = collections.NewPrefix("task/value/")
+
+// UserClaimCountKey 是 (任务, 用户) 领取次数的前缀
+var UserClaimCountKey = collections.NewPrefix("userClaimCount/value/")
+
+// TaskExpiryQueueKey 是按截止高度排序的任务过期队列前缀
+var TaskExpiryQueueKey = collections.NewPrefix("taskExpiryQueue/value/")
+
+// TaskEscrowKey 是任务托管资金的前缀
+var TaskEscrowKey = collections.NewPrefix("taskEscrow/value/")
+
+// RecipientPayoutWindowKey 是按 (接收用户, 币种) 记录窗口内已领取金额的前缀
+var RecipientPayoutWindowKey = collections.NewPrefix("recipientPayoutWindow/value/")
+
+// CreatorPayoutWindowKey 是按 (提交者, 币种) 记录窗口内已代领金额的前缀
+var CreatorPayoutWindowKey = collections.NewPrefix("creatorPayoutWindow/value/")
+
+// BlockPayoutWindowKey 是按币种记录当前区块已支付金额的前缀
+var BlockPayoutWindowKey = collections.NewPrefix("blockPayoutWindow/value/")
+
+// DidClaimCountKey 是按 (任务 ID, DID) 记录领取次数的前缀
+var DidClaimCountKey = collections.NewPrefix("didClaimCount/value/")
+
+// RewardStreamKey 是按 ID 存储奖励流的前缀
+var RewardStreamKey = collections.NewPrefix("rewardStream/value/")
+
+// RewardStreamSeqKey 是奖励流 ID 序列的前缀
+var RewardStreamSeqKey = collections.NewPrefix("rewardStream/seq/")
+
+// StreamsByRecipientKey 是 (接收用户, 奖励流 ID) 索引的前缀
+var StreamsByRecipientKey = collections.NewPrefix("streamsByRecipient/value/")
+
+// PendingPayoutKey 是按 claim_hash 存储争议期内待定奖励的前缀
+var PendingPayoutKey = collections.NewPrefix("pendingPayout/value/")
+
+// PendingPayoutQueueKey 是 (发放高度, claim_hash) 待发放队列的前缀
+var PendingPayoutQueueKey = collections.NewPrefix("pendingPayoutQueue/value/")
+
+// CreditRepayShareKey 是用户自愿设定的信用负债偿还比例的前缀
+var CreditRepayShareKey = collections.NewPrefix("creditRepayShare/value/")
+
+// MerkleDistributionKey 是按 (任务, 周期) 存储 Merkle 分发的前缀
+var MerkleDistributionKey = collections.NewPrefix("merkleDistribution/value/")
+
+// ClaimedBitmapKey 是 (任务, 周期, 字序号) 已领取叶子位图的前缀
+var ClaimedBitmapKey = collections.NewPrefix("claimedBitmap/value/")
+
+// MerkleExpiryQueueKey 是 (过期高度, 任务, 周期) Merkle 分发过期队列的前缀
+var MerkleExpiryQueueKey = collections.NewPrefix("merkleExpiryQueue/value/")
+
+// NativeEnrollmentKey 是 (任务, 地址) 链上判定任务登记高度的前缀
+var NativeEnrollmentKey = collections.NewPrefix("nativeEnrollment/value/")
+
+// EnrollmentDepositKey 是 (任务, 地址) 登记时锁定资金的前缀
+var EnrollmentDepositKey = collections.NewPrefix("enrollmentDeposit/value/")
+
+// IbcTransferTotalKey 是 (发送地址, 源通道, 币种) 跨链转出累计金额的前缀
+var IbcTransferTotalKey = collections.NewPrefix("ibcTransferTotal/value/")
@@ -0,0 +1,158 @@
+package types
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxMerkleProofLength 是 Merkle 证明的最大层数
+const MaxMerkleProofLength = 64
+
+// 叶子与内部节点使用不同的前缀，防止以内部节点冒充叶子
+const (
+	merkleLeafPrefix = 0x00
+	merkleNodePrefix = 0x01
+)
+
+// MerkleLeafHash 返回叶子 (index, recipient, amount) 在任务周期内的哈希
+func MerkleLeafHash(taskId string, epoch, index uint64, recipient string, amount sdk.Coin) []byte {
+	buf := []byte{merkleLeafPrefix}
+	buf = binary.BigEndian.AppendUint64(buf, epoch)
+	buf = binary.BigEndian.AppendUint64(buf, index)
+	buf = append(buf, []byte(taskId+"/"+recipient+"/"+amount.String())...)
+	hash := sha256.Sum256(buf)
+	return hash[:]
+}
+
+func merkleNodeHash(left, right []byte) []byte {
+	buf := make([]byte, 0, 1+len(left)+len(right))
+	buf = append(buf, merkleNodePrefix)
+	buf = append(buf, left...)
+	buf = append(buf, right...)
+	hash := sha256.Sum256(buf)
+	return hash[:]
+}
+
+// MerkleRoot 计算叶子哈希的 Merkle 根。每层末尾没有兄弟节点的节点直接进入上一层
+func MerkleRoot(leaves [][]byte) []byte {
+	if len(leaves) == 0 {
+		return nil
+	}
+	level := leaves
+	for len(level) > 1 {
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 < len(level) {
+				next = append(next, merkleNodeHash(level[i], level[i+1]))
+			} else {
+				next = append(next, level[i])
+			}
+		}
+		level = next
+	}
+	return level[0]
+}
+
+// MerkleProof 返回第 index 个叶子自下而上的兄弟节点哈希
+func MerkleProof(leaves [][]byte, index uint64) [][]byte {
+	var proof [][]byte
+	level := leaves
+	for len(level) > 1 {
+		sibling := index ^ 1
+		if sibling < uint64(len(level)) {
+			proof = append(proof, level[sibling])
+		}
+		next := make([][]byte, 0, (len(level)+1)/2)
+		for i := 0; i < len(level); i += 2 {
+			if i+1 < len(level) {
+				next = append(next, merkleNodeHash(level[i], level[i+1]))
+			} else {
+				next = append(next, level[i])
+			}
+		}
+		level = next
+		index /= 2
+	}
+	return proof
+}
+
+// VerifyMerkleProof 验证叶子位于共 leafCount 个叶子的树的第 index 个位置，且证明恰好用尽
+func VerifyMerkleProof(root, leaf []byte, index, leafCount uint64, proof [][]byte) bool {
+	if index >= leafCount {
+		return false
+	}
+	node := leaf
+	used := 0
+	for n := leafCount; n > 1; n = (n + 1) / 2 {
+		switch {
+		case index%2 == 1:
+			if used >= len(proof) {
+				return false
+			}
+			node = merkleNodeHash(proof[used], node)
+			used++
+		case index+1 < n:
+			if used >= len(proof) {
+				return false
+			}
+			node = merkleNodeHash(node, proof[used])
+			used++
+		}
+		index /= 2
+	}
+	return used == len(proof) && bytes.Equal(node, root)
+}
+
+// MerkleRootSignBytes 返回预言机为发布 Merkle 根签名的数据
+func MerkleRootSignBytes(taskId string, epoch uint64, root string, leafCount uint64, total sdk.Coin, expiryHeight int64) string {
+	return taskId + "/" + strconv.FormatUint(epoch, 10) + "/" + root + "/" + strconv.FormatUint(leafCount, 10) + "/" +
+		total.String() + "/" + strconv.FormatInt(expiryHeight, 10)
+}
+
+// ClaimedBitmapPosition 返回叶子在已领取位图中的字序号与掩码
+func ClaimedBitmapPosition(index uint64) (word, mask uint64) {
+	return index / 64, 1 << (index % 64)
+}
+
+// ValidateMerkleRoot 检查 root 是 hex 编码的 32 字节哈希
+func ValidateMerkleRoot(root string) error {
+	bz, err := hex.DecodeString(root)
+	if err != nil {
+		return fmt.Errorf("invalid merkle root hex: %w", err)
+	}
+	if len(bz) != sha256.Size {
+		return fmt.Errorf("merkle root must be %d bytes, got %d", sha256.Size, len(bz))
+	}
+	return nil
+}
+
+// Remaining 返回尚未领取的金额
+func (d MerkleDistribution) Remaining() sdk.Coin {
+	return d.Total.Sub(d.Claimed)
+}
+
+// Validate 检查 Merkle 分发的根、叶子数量与金额
+func (d MerkleDistribution) Validate() error {
+	if err := ValidateMerkleRoot(d.Root); err != nil {
+		return err
+	}
+	if d.LeafCount == 0 {
+		return fmt.Errorf("leaf count must be positive")
+	}
+	if !d.Total.IsValid() || !d.Total.IsPositive() {
+		return fmt.Errorf("invalid total: %s", d.Total)
+	}
+	if !d.Claimed.IsValid() || d.Claimed.Denom != d.Total.Denom || d.Total.IsLT(d.Claimed) {
+		return fmt.Errorf("claimed %s exceeds total %s", d.Claimed, d.Total)
+	}
+	if d.ExpiryHeight <= d.PostedHeight {
+		return fmt.Errorf("expiry height %d must be after posted height %d", d.ExpiryHeight, d.PostedHeight)
+	}
+	return nil
+}
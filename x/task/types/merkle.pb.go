@@ -0,0 +1,908 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dtc/task/v1/merkle.proto
+
+package types
+
+import (
+	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MerkleDistribution 是任务预言机按周期发布的 Merkle 根，用户凭叶子数据与证明领取，
+// 资金在发布时从任务托管划出，过期后未领取部分退回任务托管
+type MerkleDistribution struct {
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Epoch  uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// root 是 hex 编码的 32 字节 Merkle 根
+	Root         string     `protobuf:"bytes,3,opt,name=root,proto3" json:"root,omitempty"`
+	LeafCount    uint64     `protobuf:"varint,4,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count,omitempty"`
+	Total        types.Coin `protobuf:"bytes,5,opt,name=total,proto3" json:"total"`
+	Claimed      types.Coin `protobuf:"bytes,6,opt,name=claimed,proto3" json:"claimed"`
+	PostedHeight int64      `protobuf:"varint,7,opt,name=posted_height,json=postedHeight,proto3" json:"posted_height,omitempty"`
+	ExpiryHeight int64      `protobuf:"varint,8,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (m *MerkleDistribution) Reset()         { *m = MerkleDistribution{} }
+func (m *MerkleDistribution) String() string { return proto.CompactTextString(m) }
+func (*MerkleDistribution) ProtoMessage()    {}
+func (*MerkleDistribution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70be55a0d860a5dd, []int{0}
+}
+func (m *MerkleDistribution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MerkleDistribution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MerkleDistribution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MerkleDistribution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MerkleDistribution.Merge(m, src)
+}
+func (m *MerkleDistribution) XXX_Size() int {
+	return m.Size()
+}
+func (m *MerkleDistribution) XXX_DiscardUnknown() {
+	xxx_messageInfo_MerkleDistribution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MerkleDistribution proto.InternalMessageInfo
+
+func (m *MerkleDistribution) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *MerkleDistribution) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *MerkleDistribution) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *MerkleDistribution) GetLeafCount() uint64 {
+	if m != nil {
+		return m.LeafCount
+	}
+	return 0
+}
+
+func (m *MerkleDistribution) GetTotal() types.Coin {
+	if m != nil {
+		return m.Total
+	}
+	return types.Coin{}
+}
+
+func (m *MerkleDistribution) GetClaimed() types.Coin {
+	if m != nil {
+		return m.Claimed
+	}
+	return types.Coin{}
+}
+
+func (m *MerkleDistribution) GetPostedHeight() int64 {
+	if m != nil {
+		return m.PostedHeight
+	}
+	return 0
+}
+
+func (m *MerkleDistribution) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+// ClaimedBitmapWord 是 (任务, 周期) 已领取叶子位图中的一个 64 位字，第 word 个字记录叶子 [64*word, 64*word+64)
+type ClaimedBitmapWord struct {
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Epoch  uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Word   uint64 `protobuf:"varint,3,opt,name=word,proto3" json:"word,omitempty"`
+	Bits   uint64 `protobuf:"varint,4,opt,name=bits,proto3" json:"bits,omitempty"`
+}
+
+func (m *ClaimedBitmapWord) Reset()         { *m = ClaimedBitmapWord{} }
+func (m *ClaimedBitmapWord) String() string { return proto.CompactTextString(m) }
+func (*ClaimedBitmapWord) ProtoMessage()    {}
+func (*ClaimedBitmapWord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_70be55a0d860a5dd, []int{1}
+}
+func (m *ClaimedBitmapWord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimedBitmapWord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimedBitmapWord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ClaimedBitmapWord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimedBitmapWord.Merge(m, src)
+}
+func (m *ClaimedBitmapWord) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimedBitmapWord) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimedBitmapWord.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClaimedBitmapWord proto.InternalMessageInfo
+
+func (m *ClaimedBitmapWord) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *ClaimedBitmapWord) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *ClaimedBitmapWord) GetWord() uint64 {
+	if m != nil {
+		return m.Word
+	}
+	return 0
+}
+
+func (m *ClaimedBitmapWord) GetBits() uint64 {
+	if m != nil {
+		return m.Bits
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MerkleDistribution)(nil), "dtc.task.v1.MerkleDistribution")
+	proto.RegisterType((*ClaimedBitmapWord)(nil), "dtc.task.v1.ClaimedBitmapWord")
+}
+
+func init() { proto.RegisterFile("dtc/task/v1/merkle.proto", fileDescriptor_70be55a0d860a5dd) }
+
+var fileDescriptor_70be55a0d860a5dd = []byte{
+	// 388 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xb1, 0x8e, 0xd3, 0x40,
+	0x10, 0x86, 0xbd, 0x77, 0x4e, 0x42, 0xf6, 0x40, 0xe2, 0x56, 0x27, 0xb1, 0x9c, 0x84, 0xb1, 0x8e,
+	0xc6, 0xba, 0xc2, 0x2b, 0x43, 0x47, 0x41, 0x91, 0x50, 0x40, 0x41, 0xe3, 0x06, 0x89, 0x26, 0x5a,
+	0x7b, 0x97, 0x64, 0x39, 0xdb, 0x63, 0x79, 0xe7, 0xc2, 0xdd, 0x5b, 0xf0, 0x18, 0x94, 0x3c, 0x00,
+	0x0f, 0x70, 0x65, 0x4a, 0x2a, 0x84, 0x92, 0x82, 0xd7, 0x40, 0xbb, 0x9b, 0x3c, 0x00, 0xd7, 0x58,
+	0xff, 0x7c, 0xf3, 0x8f, 0x67, 0x34, 0x3b, 0x94, 0x2b, 0xac, 0x05, 0x4a, 0x7b, 0x25, 0xd6, 0x85,
+	0x68, 0xf5, 0x70, 0xd5, 0xe8, 0xbc, 0x1f, 0x00, 0x81, 0x9d, 0x28, 0xac, 0x73, 0x97, 0xc9, 0xd7,
+	0xc5, 0xf9, 0xa9, 0x6c, 0x4d, 0x07, 0xc2, 0x7f, 0x43, 0xfe, 0x3c, 0xa9, 0xc1, 0xb6, 0x60, 0x45,
+	0x25, 0xad, 0x16, 0xeb, 0xa2, 0xd2, 0x28, 0x0b, 0x51, 0x83, 0xe9, 0xf6, 0xf9, 0xb3, 0x25, 0x2c,
+	0xc1, 0x4b, 0xe1, 0x54, 0xa0, 0x17, 0x3f, 0x8f, 0x28, 0xfb, 0xe0, 0xdb, 0xbc, 0x35, 0x16, 0x07,
+	0x53, 0x5d, 0xa3, 0x81, 0x8e, 0x3d, 0xa1, 0x13, 0xd7, 0x6a, 0x61, 0x14, 0x27, 0x29, 0xc9, 0xa6,
+	0xe5, 0xd8, 0x85, 0xef, 0x15, 0x3b, 0xa3, 0x23, 0xdd, 0x43, 0xbd, 0xe2, 0x47, 0x29, 0xc9, 0xe2,
+	0x32, 0x04, 0x8c, 0xd1, 0x78, 0x00, 0x40, 0x7e, 0xec, 0xbd, 0x5e, 0xb3, 0x67, 0x94, 0x36, 0x5a,
+	0x7e, 0x5e, 0xd4, 0x70, 0xdd, 0x21, 0x8f, 0xbd, 0x7d, 0xea, 0xc8, 0xdc, 0x01, 0xf6, 0x9a, 0x8e,
+	0x10, 0x50, 0x36, 0x7c, 0x94, 0x92, 0xec, 0xe4, 0xe5, 0xd3, 0x3c, 0x8c, 0x9f, 0xbb, 0xf1, 0xf3,
+	0xfd, 0xf8, 0xf9, 0x1c, 0x4c, 0x37, 0x9b, 0xde, 0xfd, 0x7e, 0x1e, 0x7d, 0xff, 0xfb, 0xe3, 0x92,
+	0x94, 0xa1, 0x84, 0xbd, 0xa1, 0x93, 0xba, 0x91, 0xa6, 0xd5, 0x8a, 0x8f, 0xff, 0xa3, 0xfa, 0x50,
+	0xc4, 0x5e, 0xd0, 0x47, 0x3d, 0x58, 0xd4, 0x6a, 0xb1, 0xd2, 0x66, 0xb9, 0x42, 0x3e, 0x49, 0x49,
+	0x76, 0x5c, 0x3e, 0x0c, 0xf0, 0x9d, 0x67, 0xce, 0xa4, 0x6f, 0x7a, 0x33, 0xdc, 0x1e, 0x4c, 0x0f,
+	0x82, 0x29, 0xc0, 0x60, 0xba, 0xf8, 0x42, 0x4f, 0xe7, 0xe1, 0xa7, 0x33, 0x83, 0xad, 0xec, 0x3f,
+	0xc2, 0xa0, 0xee, 0xb1, 0xbc, 0xaf, 0x30, 0x28, 0xbf, 0xbc, 0xb8, 0xf4, 0xda, 0xb1, 0xca, 0xa0,
+	0xdd, 0xaf, 0xcd, 0xeb, 0xd9, 0xe5, 0xdd, 0x36, 0x21, 0x9b, 0x6d, 0x42, 0xfe, 0x6c, 0x13, 0xf2,
+	0x6d, 0x97, 0x44, 0x9b, 0x5d, 0x12, 0xfd, 0xda, 0x25, 0xd1, 0xa7, 0xc7, 0xee, 0x68, 0x6e, 0xc2,
+	0xd9, 0xe0, 0x6d, 0xaf, 0x6d, 0x35, 0xf6, 0xaf, 0xfb, 0xea, 0xdf, 0x00, 0x01, 0x87, 0x60, 0x9e,
+	0x4f, 0x02, 0x00, 0x00,
+}
+
+func (m *MerkleDistribution) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MerkleDistribution) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MerkleDistribution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintMerkle(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PostedHeight != 0 {
+		i = encodeVarintMerkle(dAtA, i, uint64(m.PostedHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Claimed.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMerkle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMerkle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.LeafCount != 0 {
+		i = encodeVarintMerkle(dAtA, i, uint64(m.LeafCount))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintMerkle(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintMerkle(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintMerkle(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimedBitmapWord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimedBitmapWord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimedBitmapWord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Bits != 0 {
+		i = encodeVarintMerkle(dAtA, i, uint64(m.Bits))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Word != 0 {
+		i = encodeVarintMerkle(dAtA, i, uint64(m.Word))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintMerkle(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintMerkle(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMerkle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMerkle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MerkleDistribution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovMerkle(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovMerkle(uint64(m.Epoch))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovMerkle(uint64(l))
+	}
+	if m.LeafCount != 0 {
+		n += 1 + sovMerkle(uint64(m.LeafCount))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovMerkle(uint64(l))
+	l = m.Claimed.Size()
+	n += 1 + l + sovMerkle(uint64(l))
+	if m.PostedHeight != 0 {
+		n += 1 + sovMerkle(uint64(m.PostedHeight))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovMerkle(uint64(m.ExpiryHeight))
+	}
+	return n
+}
+
+func (m *ClaimedBitmapWord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovMerkle(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovMerkle(uint64(m.Epoch))
+	}
+	if m.Word != 0 {
+		n += 1 + sovMerkle(uint64(m.Word))
+	}
+	if m.Bits != 0 {
+		n += 1 + sovMerkle(uint64(m.Bits))
+	}
+	return n
+}
+
+func sovMerkle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMerkle(x uint64) (n int) {
+	return sovMerkle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MerkleDistribution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMerkle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MerkleDistribution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MerkleDistribution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerkle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerkle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerkle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerkle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerkle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerkle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerkle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Root = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafCount", wireType)
+			}
+			m.LeafCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerkle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerkle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMerkle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerkle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerkle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMerkle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerkle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Claimed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PostedHeight", wireType)
+			}
+			m.PostedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerkle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PostedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerkle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMerkle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMerkle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimedBitmapWord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMerkle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimedBitmapWord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimedBitmapWord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerkle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMerkle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMerkle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerkle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Word", wireType)
+			}
+			m.Word = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerkle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Word |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bits", wireType)
+			}
+			m.Bits = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMerkle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bits |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMerkle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMerkle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMerkle(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMerkle
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMerkle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMerkle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMerkle
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMerkle
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMerkle
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMerkle        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMerkle          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMerkle = fmt.Errorf("proto: unexpected end of group")
+)
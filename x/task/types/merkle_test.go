@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"dtc/x/task/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestMerkleProof(t *testing.T) {
+	for n := 1; n <= 9; n++ {
+		leaves := make([][]byte, n)
+		for i := range leaves {
+			leaves[i] = types.MerkleLeafHash("t1", 1, uint64(i), fmt.Sprintf("user%d", i), sdk.NewInt64Coin("dtc", int64(i+1)))
+		}
+		root := types.MerkleRoot(leaves)
+		for i := range leaves {
+			index := uint64(i)
+			proof := types.MerkleProof(leaves, index)
+			require.True(t, types.VerifyMerkleProof(root, leaves[i], index, uint64(n), proof), "n=%d index=%d", n, i)
+
+			// 叶子、位置、根或证明不符时验证失败
+			other := types.MerkleLeafHash("t1", 1, index, "mallory", sdk.NewInt64Coin("dtc", 1000))
+			require.False(t, types.VerifyMerkleProof(root, other, index, uint64(n), proof))
+			require.False(t, types.VerifyMerkleProof(other, leaves[i], index, uint64(n), proof))
+			require.False(t, types.VerifyMerkleProof(root, leaves[i], uint64(n), uint64(n), proof))
+			if n > 1 {
+				require.False(t, types.VerifyMerkleProof(root, leaves[i], index^1, uint64(n), proof))
+				require.False(t, types.VerifyMerkleProof(root, leaves[i], index, uint64(n), proof[1:]))
+			}
+			require.False(t, types.VerifyMerkleProof(root, leaves[i], index, uint64(n), append(proof, leaves[0])))
+		}
+	}
+}
+
+func TestMerkleDistributionValidate(t *testing.T) {
+	valid := types.MerkleDistribution{
+		TaskId:       "t1",
+		Epoch:        1,
+		Root:         fmt.Sprintf("%064x", 1),
+		LeafCount:    2,
+		Total:        sdk.NewInt64Coin("dtc", 100),
+		Claimed:      sdk.NewInt64Coin("dtc", 40),
+		PostedHeight: 10,
+		ExpiryHeight: 20,
+	}
+	require.NoError(t, valid.Validate())
+	require.Equal(t, sdk.NewInt64Coin("dtc", 60), valid.Remaining())
+
+	for _, tc := range []struct {
+		desc   string
+		mutate func(*types.MerkleDistribution)
+	}{
+		{desc: "short root", mutate: func(d *types.MerkleDistribution) { d.Root = "abcd" }},
+		{desc: "non-hex root", mutate: func(d *types.MerkleDistribution) { d.Root = "zz" }},
+		{desc: "no leaves", mutate: func(d *types.MerkleDistribution) { d.LeafCount = 0 }},
+		{desc: "zero total", mutate: func(d *types.MerkleDistribution) { d.Total = sdk.NewInt64Coin("dtc", 0) }},
+		{desc: "overclaimed", mutate: func(d *types.MerkleDistribution) { d.Claimed = sdk.NewInt64Coin("dtc", 101) }},
+		{desc: "claimed denom", mutate: func(d *types.MerkleDistribution) { d.Claimed = sdk.NewInt64Coin("other", 1) }},
+		{desc: "expiry before post", mutate: func(d *types.MerkleDistribution) { d.ExpiryHeight = 10 }},
+	} {
+		t.Run(tc.desc, func(t *testing.T) {
+			dist := valid
+			tc.mutate(&dist)
+			require.Error(t, dist.Validate())
+		})
+	}
+}
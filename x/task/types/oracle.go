@@ -0,0 +1,72 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	secp256k1lib "github.com/ethereum/go-ethereum/crypto/secp256k1"
+)
+
+// MaxOraclePubkeys 是单个任务可登记的预言机公钥数量上限
+const MaxOraclePubkeys = 16
+
+// NewOracleSet 构造预言机公钥集合，threshold 为 0 时按 1 处理
+func NewOracleSet(pubkeys []string, threshold uint32) OracleSet {
+	if threshold == 0 {
+		threshold = 1
+	}
+	return OracleSet{Pubkeys: pubkeys, Threshold: threshold}
+}
+
+// IsEmpty 判断集合是否未登记任何公钥
+func (s OracleSet) IsEmpty() bool {
+	return len(s.Pubkeys) == 0
+}
+
+// Validate 校验公钥为互不重复的 33 字节压缩 secp256k1 公钥，且阈值不超过公钥数量
+func (s OracleSet) Validate() error {
+	if s.IsEmpty() {
+		return fmt.Errorf("at least one oracle pubkey is required")
+	}
+	if len(s.Pubkeys) > MaxOraclePubkeys {
+		return fmt.Errorf("at most %d oracle pubkeys are allowed", MaxOraclePubkeys)
+	}
+	seen := make(map[string]struct{}, len(s.Pubkeys))
+	for _, pubkey := range s.Pubkeys {
+		if err := ValidateOraclePubkey(pubkey); err != nil {
+			return err
+		}
+		if _, ok := seen[pubkey]; ok {
+			return fmt.Errorf("duplicate oracle pubkey %s", pubkey)
+		}
+		seen[pubkey] = struct{}{}
+	}
+	if s.Threshold == 0 || int(s.Threshold) > len(s.Pubkeys) {
+		return fmt.Errorf("threshold must be between 1 and %d, got %d", len(s.Pubkeys), s.Threshold)
+	}
+	return nil
+}
+
+// ValidateOraclePubkey 校验 hex 编码的 33 字节压缩 secp256k1 公钥
+func ValidateOraclePubkey(pubkey string) error {
+	bz, err := hex.DecodeString(pubkey)
+	if err != nil {
+		return fmt.Errorf("invalid oracle pubkey hex: %w", err)
+	}
+	if len(bz) != 33 {
+		return fmt.Errorf("oracle pubkey must be 33 bytes compressed secp256k1 key")
+	}
+	if x, _ := secp256k1lib.DecompressPubkey(bz); x == nil {
+		return fmt.Errorf("oracle pubkey is not on the secp256k1 curve")
+	}
+	return nil
+}
+
+// ActiveOracleSets 返回在给定高度可用于验证签名的公钥集合：当前集合，以及重叠期内的旧集合
+func (t Task) ActiveOracleSets(height int64) []OracleSet {
+	sets := []OracleSet{t.OracleSet}
+	if !t.PreviousOracleSet.IsEmpty() && height <= t.PreviousOracleSetExpiry {
+		sets = append(sets, t.PreviousOracleSet)
+	}
+	return sets
+}
@@ -1,13 +1,29 @@
 package types
 
-import "encoding/hex"
+import (
+	"fmt"
 
-const defaultAdminPubKeyHex = "03555db1e9893d6bafff7c3afdb62ddb99cf2f073d25144701966607f63e561a38"
+	"cosmossdk.io/math"
+)
+
+// DefaultPayoutEpochBlocks 是领取限额滚动窗口的默认长度（约 1 天，按 6 秒出块计算）
+const DefaultPayoutEpochBlocks int64 = 14400
+
+// PayoutWindowBuckets 是滚动限额窗口划分的分桶数量，窗口按分桶粒度随高度滑动
+const PayoutWindowBuckets int64 = 24
+
+// DefaultCreditRepayShare 是每笔奖励偿还信用负债的默认比例（10%）
+var DefaultCreditRepayShare = math.LegacyNewDecWithPrec(1, 1)
+
+// DefaultMaxBatchClaimSize 是一笔批量领取交易默认可包含的条目上限
+const DefaultMaxBatchClaimSize uint32 = 100
 
 // NewParams creates a new Params instance.
 func NewParams() Params {
 	return Params{
-		AdminPubkey: defaultAdminPubKeyHex,
+		PayoutEpochBlocks: DefaultPayoutEpochBlocks,
+		CreditRepayShare:  DefaultCreditRepayShare,
+		MaxBatchClaimSize: DefaultMaxBatchClaimSize,
 	}
 }
 
@@ -18,11 +34,52 @@ func DefaultParams() Params {
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
-	if p.AdminPubkey == "" {
+	if p.PayoutEpochBlocks < 0 {
+		return fmt.Errorf("payout epoch blocks cannot be negative: %d", p.PayoutEpochBlocks)
+	}
+	if err := p.RecipientEpochLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid recipient epoch limit: %w", err)
+	}
+	if err := p.CreatorEpochLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid creator epoch limit: %w", err)
+	}
+	if err := p.BlockLimit.Validate(); err != nil {
+		return fmt.Errorf("invalid block limit: %w", err)
+	}
+	if err := ValidateCreditRepayShare(p.CreditRepayShare); err != nil {
+		return fmt.Errorf("invalid credit repay share: %w", err)
+	}
+	return nil
+}
+
+// PayoutBucketBlocks 返回滚动窗口每个分桶的区块数，至少为 1
+func (p Params) PayoutBucketBlocks() int64 {
+	if blocks := p.PayoutEpochBlocks / PayoutWindowBuckets; blocks > 1 {
+		return blocks
+	}
+	return 1
+}
+
+// PayoutBucket 返回给定高度所在的分桶编号
+func (p Params) PayoutBucket(height int64) int64 {
+	return height / p.PayoutBucketBlocks()
+}
+
+// PayoutWindowStart 返回给定高度的滚动窗口计入的最早分桶编号。
+// 窗口由包含当前高度在内的最近若干个分桶组成，覆盖约 PayoutEpochBlocks 个区块
+func (p Params) PayoutWindowStart(height int64) int64 {
+	bucketBlocks := p.PayoutBucketBlocks()
+	buckets := (p.PayoutEpochBlocks + bucketBlocks - 1) / bucketBlocks
+	return p.PayoutBucket(height) - buckets + 1
+}
+
+// ValidateCreditRepayShare 检查信用负债偿还比例在 [0, 1] 内，未设置视为 0
+func ValidateCreditRepayShare(share math.LegacyDec) error {
+	if share.IsNil() {
 		return nil
 	}
-	if _, err := hex.DecodeString(p.AdminPubkey); err != nil {
-		return err
+	if share.IsNegative() || share.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("share must be between 0 and 1: %s", share)
 	}
 	return nil
 }
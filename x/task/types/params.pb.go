@@ -4,7 +4,11 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,8 +30,18 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // Params defines the parameters for the module.
 type Params struct {
-	// Admin oracle pubkey (hex-encoded secp256k1 compressed public key)
-	AdminPubkey string `protobuf:"bytes,1,opt,name=admin_pubkey,json=adminPubkey,proto3" json:"admin_pubkey,omitempty"`
+	// payout_epoch_blocks 是领取限额滚动窗口覆盖的区块数，窗口按分桶粒度随高度滑动，0 表示关闭按窗口的限额
+	PayoutEpochBlocks int64 `protobuf:"varint,2,opt,name=payout_epoch_blocks,json=payoutEpochBlocks,proto3" json:"payout_epoch_blocks,omitempty"`
+	// recipient_epoch_limit 是单个接收用户在滚动窗口内可领取的金额上限，未列出的币种不限额
+	RecipientEpochLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=recipient_epoch_limit,json=recipientEpochLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recipient_epoch_limit"`
+	// creator_epoch_limit 是单个提交者（中台）在滚动窗口内可代领的金额上限
+	CreatorEpochLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=creator_epoch_limit,json=creatorEpochLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"creator_epoch_limit"`
+	// block_limit 是全链每个区块可支付的金额上限
+	BlockLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,5,rep,name=block_limit,json=blockLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"block_limit"`
+	// credit_repay_share 是每笔奖励优先偿还接收用户信用负债的比例，用户可自愿选择更高的比例
+	CreditRepayShare cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=credit_repay_share,json=creditRepayShare,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"credit_repay_share"`
+	// max_batch_claim_size 是一笔批量领取交易可包含的条目上限，0 表示关闭批量领取
+	MaxBatchClaimSize uint32 `protobuf:"varint,7,opt,name=max_batch_claim_size,json=maxBatchClaimSize,proto3" json:"max_batch_claim_size,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -63,11 +77,39 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
-func (m *Params) GetAdminPubkey() string {
+func (m *Params) GetPayoutEpochBlocks() int64 {
 	if m != nil {
-		return m.AdminPubkey
+		return m.PayoutEpochBlocks
 	}
-	return ""
+	return 0
+}
+
+func (m *Params) GetRecipientEpochLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RecipientEpochLimit
+	}
+	return nil
+}
+
+func (m *Params) GetCreatorEpochLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CreatorEpochLimit
+	}
+	return nil
+}
+
+func (m *Params) GetBlockLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BlockLimit
+	}
+	return nil
+}
+
+func (m *Params) GetMaxBatchClaimSize() uint32 {
+	if m != nil {
+		return m.MaxBatchClaimSize
+	}
+	return 0
 }
 
 func init() {
@@ -77,19 +119,37 @@ func init() {
 func init() { proto.RegisterFile("dtc/task/v1/params.proto", fileDescriptor_b5f1aec73a0ee139) }
 
 var fileDescriptor_b5f1aec73a0ee139 = []byte{
-	// 181 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x48, 0x29, 0x49, 0xd6,
-	0x2f, 0x49, 0x2c, 0xce, 0xd6, 0x2f, 0x33, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x4e, 0x29, 0x49, 0xd6, 0x03, 0xc9, 0xe8, 0x95, 0x19, 0x4a,
-	0x09, 0x26, 0xe6, 0x66, 0xe6, 0xe5, 0xeb, 0x83, 0x49, 0x88, 0xbc, 0x94, 0x48, 0x7a, 0x7e, 0x7a,
-	0x3e, 0x98, 0xa9, 0x0f, 0x62, 0x41, 0x44, 0x95, 0xdc, 0xb9, 0xd8, 0x02, 0xc0, 0xa6, 0x08, 0x29,
-	0x72, 0xf1, 0x24, 0xa6, 0xe4, 0x66, 0xe6, 0xc5, 0x17, 0x94, 0x26, 0x65, 0xa7, 0x56, 0x4a, 0x30,
-	0x2a, 0x30, 0x6a, 0x70, 0x06, 0x71, 0x83, 0xc5, 0x02, 0xc0, 0x42, 0x56, 0x52, 0x2f, 0x16, 0xc8,
-	0x33, 0x76, 0x3d, 0xdf, 0xa0, 0x25, 0x08, 0x72, 0x45, 0x05, 0xc4, 0x1d, 0x10, 0xed, 0x4e, 0x5a,
-	0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84, 0xc7, 0x72,
-	0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0x25, 0x80, 0xa4, 0xb8, 0xa4, 0xb2,
-	0x20, 0xb5, 0x38, 0x89, 0x0d, 0x6c, 0xb7, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0x69, 0x06, 0x1c,
-	0x56, 0xcd, 0x00, 0x00, 0x00,
+	// 479 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x92, 0xb1, 0x6e, 0x13, 0x31,
+	0x1c, 0xc6, 0xe3, 0x36, 0x04, 0x70, 0x40, 0x4a, 0x2e, 0x45, 0x4a, 0x83, 0x74, 0x89, 0x98, 0x4e,
+	0x91, 0x6a, 0x2b, 0x20, 0x18, 0x18, 0xaf, 0x65, 0x41, 0x1d, 0xd0, 0x75, 0x63, 0x39, 0xf9, 0x7c,
+	0x56, 0xce, 0xba, 0xf8, 0x7c, 0x9c, 0x9d, 0x28, 0xd7, 0x89, 0x81, 0x89, 0x89, 0x47, 0x60, 0x44,
+	0x4c, 0x1d, 0x78, 0x88, 0x8e, 0x15, 0x13, 0x62, 0x28, 0x28, 0x19, 0xca, 0x43, 0x30, 0x20, 0xdb,
+	0x07, 0x2a, 0x0f, 0xd0, 0xe5, 0xce, 0xf6, 0x4f, 0xfe, 0xbe, 0x9f, 0xac, 0x3f, 0x1c, 0xa6, 0x9a,
+	0x62, 0x4d, 0x54, 0x8e, 0x57, 0x33, 0x5c, 0x92, 0x8a, 0x08, 0x85, 0xca, 0x4a, 0x6a, 0xe9, 0x75,
+	0x53, 0x4d, 0x91, 0x21, 0x68, 0x35, 0x1b, 0xf5, 0x89, 0xe0, 0x85, 0xc4, 0xf6, 0xeb, 0xf8, 0xc8,
+	0xa7, 0x52, 0x09, 0xa9, 0x70, 0x42, 0x14, 0xc3, 0xab, 0x59, 0xc2, 0x34, 0x99, 0x61, 0x2a, 0x79,
+	0xd1, 0xf0, 0x7d, 0xc7, 0x63, 0xbb, 0xc3, 0x6e, 0xd3, 0xa0, 0xbd, 0xb9, 0x9c, 0x4b, 0x77, 0x6e,
+	0x56, 0xee, 0xf4, 0xd1, 0xef, 0x36, 0xec, 0xbc, 0xb2, 0x06, 0x1e, 0x82, 0x83, 0x92, 0xd4, 0x72,
+	0xa9, 0x63, 0x56, 0x4a, 0x9a, 0xc5, 0xc9, 0x42, 0xd2, 0x5c, 0x0d, 0x77, 0x26, 0x20, 0xd8, 0x8d,
+	0xfa, 0x0e, 0xbd, 0x30, 0x24, 0xb4, 0xc0, 0x7b, 0x07, 0xe0, 0x83, 0x8a, 0x51, 0x5e, 0x72, 0x56,
+	0xfc, 0xbd, 0xb3, 0xe0, 0x82, 0xeb, 0xe1, 0xee, 0x64, 0x37, 0xe8, 0x3e, 0xde, 0x47, 0x4d, 0xbf,
+	0x91, 0x45, 0x8d, 0x2c, 0x3a, 0x94, 0xbc, 0x08, 0x9f, 0x9e, 0x5f, 0x8e, 0x5b, 0x9f, 0x7f, 0x8c,
+	0x83, 0x39, 0xd7, 0xd9, 0x32, 0x41, 0x54, 0x8a, 0x46, 0xb6, 0xf9, 0x1d, 0xa8, 0x34, 0xc7, 0xba,
+	0x2e, 0x99, 0xb2, 0x17, 0xd4, 0xa7, 0xab, 0xb3, 0x29, 0x88, 0x06, 0xff, 0xea, 0xac, 0xc7, 0xb1,
+	0x29, 0xf3, 0xde, 0x02, 0x38, 0xa0, 0x15, 0x23, 0x5a, 0x56, 0xff, 0x49, 0xb4, 0x6f, 0x48, 0xa2,
+	0xdf, 0x94, 0x5d, 0x53, 0x78, 0x03, 0xbb, 0xf6, 0xb1, 0x9a, 0xe6, 0x5b, 0x37, 0xd4, 0x0c, 0x6d,
+	0x89, 0xab, 0x4c, 0xa1, 0x47, 0x2b, 0x96, 0x72, 0x1d, 0x57, 0xac, 0x24, 0x75, 0xac, 0x32, 0x52,
+	0xb1, 0x61, 0x67, 0x02, 0x82, 0xbb, 0xe1, 0x33, 0x13, 0xff, 0xfd, 0x72, 0xfc, 0xd0, 0x85, 0xa9,
+	0x34, 0x47, 0x5c, 0x62, 0x41, 0x74, 0x86, 0x8e, 0xd9, 0x9c, 0xd0, 0xfa, 0x88, 0xd1, 0xaf, 0x5f,
+	0x0e, 0x60, 0xe3, 0x77, 0xc4, 0xa8, 0xcb, 0xef, 0xb9, 0xc4, 0xc8, 0x04, 0x9e, 0x98, 0x3c, 0x0f,
+	0xc3, 0x3d, 0x41, 0xd6, 0x71, 0x42, 0x34, 0xcd, 0x62, 0xba, 0x20, 0x5c, 0xc4, 0x8a, 0x9f, 0xb2,
+	0xe1, 0xed, 0x09, 0x08, 0xee, 0x47, 0x7d, 0x41, 0xd6, 0xa1, 0x41, 0x87, 0x86, 0x9c, 0xf0, 0x53,
+	0xf6, 0x7c, 0xf4, 0xeb, 0xe3, 0x18, 0xbc, 0xbf, 0x3a, 0x9b, 0xf6, 0xcd, 0x88, 0xaf, 0xdd, 0x90,
+	0xbb, 0xf9, 0x7a, 0xd9, 0xbe, 0x03, 0x7a, 0x3b, 0xd1, 0x3d, 0x92, 0x0a, 0x5e, 0xc4, 0xe5, 0x32,
+	0xc9, 0x59, 0x1d, 0x4e, 0xcf, 0x37, 0x3e, 0xb8, 0xd8, 0xf8, 0xe0, 0xe7, 0xc6, 0x07, 0x1f, 0xb6,
+	0x7e, 0xeb, 0x62, 0xeb, 0xb7, 0xbe, 0x6d, 0xfd, 0xd6, 0xeb, 0xde, 0xb5, 0x00, 0xfb, 0x12, 0x49,
+	0xc7, 0x4e, 0xec, 0x93, 0x3f, 0x03, 0x00, 0x87, 0x7a, 0x98, 0x39, 0x3e, 0x03, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -111,7 +171,37 @@ func (this *Params) Equal(that interface{}) bool {
 	} else if this == nil {
 		return false
 	}
-	if this.AdminPubkey != that1.AdminPubkey {
+	if this.PayoutEpochBlocks != that1.PayoutEpochBlocks {
+		return false
+	}
+	if len(this.RecipientEpochLimit) != len(that1.RecipientEpochLimit) {
+		return false
+	}
+	for i := range this.RecipientEpochLimit {
+		if !this.RecipientEpochLimit[i].Equal(&that1.RecipientEpochLimit[i]) {
+			return false
+		}
+	}
+	if len(this.CreatorEpochLimit) != len(that1.CreatorEpochLimit) {
+		return false
+	}
+	for i := range this.CreatorEpochLimit {
+		if !this.CreatorEpochLimit[i].Equal(&that1.CreatorEpochLimit[i]) {
+			return false
+		}
+	}
+	if len(this.BlockLimit) != len(that1.BlockLimit) {
+		return false
+	}
+	for i := range this.BlockLimit {
+		if !this.BlockLimit[i].Equal(&that1.BlockLimit[i]) {
+			return false
+		}
+	}
+	if !this.CreditRepayShare.Equal(that1.CreditRepayShare) {
+		return false
+	}
+	if this.MaxBatchClaimSize != that1.MaxBatchClaimSize {
 		return false
 	}
 	return true
@@ -136,12 +226,67 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
-	if len(m.AdminPubkey) > 0 {
-		i -= len(m.AdminPubkey)
-		copy(dAtA[i:], m.AdminPubkey)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.AdminPubkey)))
+	if m.MaxBatchClaimSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxBatchClaimSize))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x38
+	}
+	{
+		size := m.CreditRepayShare.Size()
+		i -= size
+		if _, err := m.CreditRepayShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.BlockLimit) > 0 {
+		for iNdEx := len(m.BlockLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.CreatorEpochLimit) > 0 {
+		for iNdEx := len(m.CreatorEpochLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreatorEpochLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.RecipientEpochLimit) > 0 {
+		for iNdEx := len(m.RecipientEpochLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecipientEpochLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.PayoutEpochBlocks != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.PayoutEpochBlocks))
+		i--
+		dAtA[i] = 0x10
 	}
 	return len(dAtA) - i, nil
 }
@@ -163,9 +308,31 @@ func (m *Params) Size() (n int) {
 	}
 	var l int
 	_ = l
-	l = len(m.AdminPubkey)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if m.PayoutEpochBlocks != 0 {
+		n += 1 + sovParams(uint64(m.PayoutEpochBlocks))
+	}
+	if len(m.RecipientEpochLimit) > 0 {
+		for _, e := range m.RecipientEpochLimit {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.CreatorEpochLimit) > 0 {
+		for _, e := range m.CreatorEpochLimit {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.BlockLimit) > 0 {
+		for _, e := range m.BlockLimit {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = m.CreditRepayShare.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxBatchClaimSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxBatchClaimSize))
 	}
 	return n
 }
@@ -205,9 +372,130 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutEpochBlocks", wireType)
+			}
+			m.PayoutEpochBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutEpochBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientEpochLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientEpochLimit = append(m.RecipientEpochLimit, types.Coin{})
+			if err := m.RecipientEpochLimit[len(m.RecipientEpochLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AdminPubkey", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorEpochLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorEpochLimit = append(m.CreatorEpochLimit, types.Coin{})
+			if err := m.CreatorEpochLimit[len(m.CreatorEpochLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockLimit = append(m.BlockLimit, types.Coin{})
+			if err := m.BlockLimit[len(m.BlockLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreditRepayShare", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -235,8 +523,29 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AdminPubkey = string(dAtA[iNdEx:postIndex])
+			if err := m.CreditRepayShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBatchClaimSize", wireType)
+			}
+			m.MaxBatchClaimSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxBatchClaimSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,100 @@
+package types
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// 内置的链上判定类型
+const (
+	// PredicateDidRegistered 要求地址持有已注册的 DID，blocks 为 DID 注册后至少经过的区块数
+	PredicateDidRegistered = "did_registered"
+	// PredicateLiabilityRepaid 要求地址累计偿还的信用负债不少于 amount
+	PredicateLiabilityRepaid = "liability_repaid"
+	// PredicateBalanceHeld 要求地址登记时将 amount 锁定在模块账户，自登记起满 blocks 个区块后可领取
+	PredicateBalanceHeld = "balance_held"
+	// PredicateIbcTransfer 要求地址经 target 通道（为空不限）跨链转出并被确认的累计金额不少于 amount
+	PredicateIbcTransfer = "ibc_transfer"
+)
+
+// Predicate 是任务模块根据其他模块状态判定的完成条件，通过 Keeper.RegisterPredicate 按类型注册
+type Predicate interface {
+	// Validate 校验任务登记时提交的判定参数
+	Validate(p TaskPredicate) error
+	// Evaluate 判定 address 是否满足条件，enrolledHeight 是地址登记任务的高度（未登记为 0），
+	// 不满足时返回 ErrPredicateUnmet 说明原因
+	Evaluate(ctx context.Context, p TaskPredicate, address string, enrolledHeight int64) error
+}
+
+// EnrollmentPredicate 由依赖登记高度的判定实现，地址须先登记任务，登记时校验起始状态
+type EnrollmentPredicate interface {
+	Predicate
+	ValidateEnrollment(ctx context.Context, p TaskPredicate, address string) error
+}
+
+// DepositPredicate 由登记时须锁定资金的判定实现，锁定的资金留在模块账户直到领取或取消登记时退回，
+// 使登记期间的条件无法被多个地址轮流满足
+type DepositPredicate interface {
+	EnrollmentPredicate
+	EnrollmentDeposit(p TaskPredicate) sdk.Coin
+}
+
+// IsEmpty 判断任务是否未设置链上判定
+func (p TaskPredicate) IsEmpty() bool {
+	return p.Type == ""
+}
+
+// ValidateBasic 校验与判定类型无关的参数格式
+func (p TaskPredicate) ValidateBasic() error {
+	if p.Type == "" {
+		return fmt.Errorf("predicate type cannot be empty")
+	}
+	if p.Blocks < 0 {
+		return fmt.Errorf("predicate blocks cannot be negative: %d", p.Blocks)
+	}
+	if p.Amount.Denom != "" {
+		if err := p.Amount.Validate(); err != nil {
+			return fmt.Errorf("invalid predicate amount: %w", err)
+		}
+	}
+	if strings.ContainsAny(p.Target, " \t\r\n") {
+		return fmt.Errorf("predicate target cannot contain whitespace")
+	}
+	return nil
+}
+
+// Validate 校验登记记录
+func (e NativeEnrollment) Validate() error {
+	if err := ValidateTaskId(e.TaskId); err != nil {
+		return err
+	}
+	if e.Address == "" {
+		return fmt.Errorf("enrollment address cannot be empty")
+	}
+	if e.Height <= 0 {
+		return fmt.Errorf("enrollment height must be positive: %d", e.Height)
+	}
+	if e.Deposit.Denom != "" {
+		if err := e.Deposit.Validate(); err != nil {
+			return fmt.Errorf("invalid enrollment deposit: %w", err)
+		}
+	}
+	return nil
+}
+
+// Validate 校验跨链转出累计金额
+func (t IbcTransferTotal) Validate() error {
+	if t.Sender == "" || t.Channel == "" {
+		return fmt.Errorf("sender and channel cannot be empty")
+	}
+	if err := t.Amount.Validate(); err != nil {
+		return err
+	}
+	if !t.Amount.IsPositive() {
+		return fmt.Errorf("amount must be positive")
+	}
+	return nil
+}
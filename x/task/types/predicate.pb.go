@@ -0,0 +1,1065 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dtc/task/v1/predicate.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TaskPredicate 是由任务模块根据其他模块状态判定的完成条件，type 为空表示任务由预言机签名验证。
+// 各字段的含义由 type 对应的已注册判定决定
+type TaskPredicate struct {
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	// amount 是判定要求的金额，例如累计偿还的负债、持有的余额或跨链转出的金额
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+	// blocks 是判定要求持续的区块数，例如持有余额的时长
+	Blocks int64 `protobuf:"varint,3,opt,name=blocks,proto3" json:"blocks,omitempty"`
+	// target 是判定的对象，例如跨链转账的源通道（IBC v2 为源客户端），为空表示不限
+	Target string `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+}
+
+func (m *TaskPredicate) Reset()         { *m = TaskPredicate{} }
+func (m *TaskPredicate) String() string { return proto.CompactTextString(m) }
+func (*TaskPredicate) ProtoMessage()    {}
+func (*TaskPredicate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2763bccb390fd008, []int{0}
+}
+func (m *TaskPredicate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskPredicate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskPredicate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskPredicate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskPredicate.Merge(m, src)
+}
+func (m *TaskPredicate) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskPredicate) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskPredicate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskPredicate proto.InternalMessageInfo
+
+func (m *TaskPredicate) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *TaskPredicate) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *TaskPredicate) GetBlocks() int64 {
+	if m != nil {
+		return m.Blocks
+	}
+	return 0
+}
+
+func (m *TaskPredicate) GetTarget() string {
+	if m != nil {
+		return m.Target
+	}
+	return ""
+}
+
+// NativeEnrollment 记录用户登记链上判定任务的高度，依赖持续时长的判定从该高度起计算
+type NativeEnrollment struct {
+	TaskId  string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Height  int64  `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	// deposit 是登记时锁定在模块账户的资金，领取或取消登记时退回
+	Deposit types.Coin `protobuf:"bytes,4,opt,name=deposit,proto3" json:"deposit"`
+}
+
+func (m *NativeEnrollment) Reset()         { *m = NativeEnrollment{} }
+func (m *NativeEnrollment) String() string { return proto.CompactTextString(m) }
+func (*NativeEnrollment) ProtoMessage()    {}
+func (*NativeEnrollment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2763bccb390fd008, []int{1}
+}
+func (m *NativeEnrollment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *NativeEnrollment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_NativeEnrollment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *NativeEnrollment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NativeEnrollment.Merge(m, src)
+}
+func (m *NativeEnrollment) XXX_Size() int {
+	return m.Size()
+}
+func (m *NativeEnrollment) XXX_DiscardUnknown() {
+	xxx_messageInfo_NativeEnrollment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NativeEnrollment proto.InternalMessageInfo
+
+func (m *NativeEnrollment) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *NativeEnrollment) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *NativeEnrollment) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *NativeEnrollment) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+// IbcTransferTotal 是地址经某一源通道（IBC v2 为源客户端）跨链转出并被对端确认的累计金额，denom 为本链上的币种
+type IbcTransferTotal struct {
+	Sender  string     `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Channel string     `protobuf:"bytes,2,opt,name=channel,proto3" json:"channel,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *IbcTransferTotal) Reset()         { *m = IbcTransferTotal{} }
+func (m *IbcTransferTotal) String() string { return proto.CompactTextString(m) }
+func (*IbcTransferTotal) ProtoMessage()    {}
+func (*IbcTransferTotal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2763bccb390fd008, []int{2}
+}
+func (m *IbcTransferTotal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *IbcTransferTotal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_IbcTransferTotal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *IbcTransferTotal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IbcTransferTotal.Merge(m, src)
+}
+func (m *IbcTransferTotal) XXX_Size() int {
+	return m.Size()
+}
+func (m *IbcTransferTotal) XXX_DiscardUnknown() {
+	xxx_messageInfo_IbcTransferTotal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_IbcTransferTotal proto.InternalMessageInfo
+
+func (m *IbcTransferTotal) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *IbcTransferTotal) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
+}
+
+func (m *IbcTransferTotal) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*TaskPredicate)(nil), "dtc.task.v1.TaskPredicate")
+	proto.RegisterType((*NativeEnrollment)(nil), "dtc.task.v1.NativeEnrollment")
+	proto.RegisterType((*IbcTransferTotal)(nil), "dtc.task.v1.IbcTransferTotal")
+}
+
+func init() { proto.RegisterFile("dtc/task/v1/predicate.proto", fileDescriptor_2763bccb390fd008) }
+
+var fileDescriptor_2763bccb390fd008 = []byte{
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x41, 0x8b, 0xd4, 0x30,
+	0x14, 0xc7, 0x1b, 0x67, 0x69, 0x99, 0x2c, 0xc2, 0x18, 0x16, 0xed, 0xae, 0x50, 0x87, 0x39, 0x0d,
+	0x0b, 0x36, 0x76, 0xbd, 0x8a, 0xe0, 0x88, 0x87, 0xbd, 0x88, 0xd4, 0x39, 0x79, 0x59, 0xd2, 0x24,
+	0x76, 0xc2, 0xb4, 0x49, 0x49, 0x62, 0xd1, 0x6f, 0xa1, 0x77, 0x3f, 0x80, 0x47, 0x0f, 0x82, 0x5f,
+	0x61, 0x8f, 0x83, 0x27, 0x4f, 0x22, 0x33, 0x07, 0xbf, 0x86, 0xa4, 0x49, 0xc1, 0x9b, 0x7a, 0x29,
+	0xf9, 0xbf, 0x7f, 0x5e, 0xdf, 0xef, 0xe5, 0x3d, 0x78, 0x97, 0x59, 0x8a, 0x2d, 0x31, 0x5b, 0xdc,
+	0x17, 0xb8, 0xd3, 0x9c, 0x09, 0x4a, 0x2c, 0xcf, 0x3b, 0xad, 0xac, 0x42, 0xc7, 0xcc, 0xd2, 0xdc,
+	0x99, 0x79, 0x5f, 0x9c, 0xdd, 0x22, 0xad, 0x90, 0x0a, 0x0f, 0x5f, 0xef, 0x9f, 0x65, 0x54, 0x99,
+	0x56, 0x19, 0x5c, 0x11, 0xc3, 0x71, 0x5f, 0x54, 0xdc, 0x92, 0x02, 0x53, 0x25, 0x64, 0xf0, 0x4f,
+	0xbd, 0x7f, 0x35, 0x28, 0xec, 0x45, 0xb0, 0x4e, 0x6a, 0x55, 0x2b, 0x1f, 0x77, 0x27, 0x1f, 0x5d,
+	0x7c, 0x00, 0xf0, 0xe6, 0x9a, 0x98, 0xed, 0x8b, 0x11, 0x04, 0x21, 0x78, 0x64, 0xdf, 0x75, 0x3c,
+	0x05, 0x73, 0xb0, 0x9c, 0x96, 0xc3, 0x19, 0x3d, 0x82, 0x31, 0x69, 0xd5, 0x1b, 0x69, 0xd3, 0x1b,
+	0x73, 0xb0, 0x3c, 0xbe, 0x38, 0xcd, 0xc3, 0xaf, 0x1d, 0x47, 0x1e, 0x38, 0xf2, 0xa7, 0x4a, 0xc8,
+	0xd5, 0xf4, 0xfa, 0xc7, 0xbd, 0xe8, 0xd3, 0xaf, 0xcf, 0xe7, 0xa0, 0x0c, 0x39, 0xe8, 0x36, 0x8c,
+	0xab, 0x46, 0xd1, 0xad, 0x49, 0x27, 0x73, 0xb0, 0x9c, 0x94, 0x41, 0xb9, 0xb8, 0x25, 0xba, 0xe6,
+	0x36, 0x3d, 0x1a, 0x6a, 0x05, 0xb5, 0xf8, 0x0a, 0xe0, 0xec, 0x39, 0xb1, 0xa2, 0xe7, 0xcf, 0xa4,
+	0x56, 0x4d, 0xd3, 0x72, 0x69, 0xd1, 0x1d, 0x98, 0xb8, 0x77, 0xb9, 0x12, 0x2c, 0x90, 0xc5, 0x4e,
+	0x5e, 0x32, 0x74, 0x01, 0x13, 0xc2, 0x98, 0xe6, 0xc6, 0x0c, 0x70, 0xd3, 0x55, 0xfa, 0xed, 0xcb,
+	0xfd, 0x93, 0xc0, 0xf7, 0xc4, 0x3b, 0x2f, 0xad, 0x16, 0xb2, 0x2e, 0xc7, 0x8b, 0xae, 0xf2, 0x86,
+	0x8b, 0x7a, 0x63, 0x47, 0x22, 0xaf, 0xd0, 0x63, 0x98, 0x30, 0xde, 0x29, 0x23, 0x3c, 0xd2, 0xbf,
+	0x36, 0x3a, 0x26, 0x2d, 0x3e, 0x02, 0x38, 0xbb, 0xac, 0xe8, 0x5a, 0x13, 0x69, 0x5e, 0x73, 0xbd,
+	0x56, 0x96, 0x34, 0xe8, 0x01, 0x8c, 0x0d, 0x97, 0x8c, 0xeb, 0x14, 0xfc, 0x85, 0x2f, 0xdc, 0x43,
+	0x29, 0x4c, 0xe8, 0x86, 0x48, 0xc9, 0x1b, 0xdf, 0x52, 0x39, 0xca, 0x3f, 0x06, 0x31, 0xf9, 0xff,
+	0x41, 0xac, 0xce, 0xaf, 0xf7, 0x19, 0xd8, 0xed, 0x33, 0xf0, 0x73, 0x9f, 0x81, 0xf7, 0x87, 0x2c,
+	0xda, 0x1d, 0xb2, 0xe8, 0xfb, 0x21, 0x8b, 0x5e, 0xcd, 0xdc, 0x52, 0xbe, 0xf5, 0x6b, 0xe9, 0x26,
+	0x6e, 0xaa, 0x78, 0xd8, 0x8f, 0x87, 0xbf, 0x07, 0x00, 0x4a, 0x53, 0x68, 0xa7, 0xaf, 0x02, 0x00,
+	0x00,
+}
+
+func (m *TaskPredicate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskPredicate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskPredicate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Target) > 0 {
+		i -= len(m.Target)
+		copy(dAtA[i:], m.Target)
+		i = encodeVarintPredicate(dAtA, i, uint64(len(m.Target)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Blocks != 0 {
+		i = encodeVarintPredicate(dAtA, i, uint64(m.Blocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPredicate(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Type) > 0 {
+		i -= len(m.Type)
+		copy(dAtA[i:], m.Type)
+		i = encodeVarintPredicate(dAtA, i, uint64(len(m.Type)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *NativeEnrollment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *NativeEnrollment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *NativeEnrollment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPredicate(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintPredicate(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPredicate(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintPredicate(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *IbcTransferTotal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *IbcTransferTotal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *IbcTransferTotal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintPredicate(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Channel) > 0 {
+		i -= len(m.Channel)
+		copy(dAtA[i:], m.Channel)
+		i = encodeVarintPredicate(dAtA, i, uint64(len(m.Channel)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPredicate(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPredicate(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPredicate(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *TaskPredicate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Type)
+	if l > 0 {
+		n += 1 + l + sovPredicate(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPredicate(uint64(l))
+	if m.Blocks != 0 {
+		n += 1 + sovPredicate(uint64(m.Blocks))
+	}
+	l = len(m.Target)
+	if l > 0 {
+		n += 1 + l + sovPredicate(uint64(l))
+	}
+	return n
+}
+
+func (m *NativeEnrollment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovPredicate(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPredicate(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovPredicate(uint64(m.Height))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovPredicate(uint64(l))
+	return n
+}
+
+func (m *IbcTransferTotal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPredicate(uint64(l))
+	}
+	l = len(m.Channel)
+	if l > 0 {
+		n += 1 + l + sovPredicate(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPredicate(uint64(l))
+	return n
+}
+
+func sovPredicate(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPredicate(x uint64) (n int) {
+	return sovPredicate(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *TaskPredicate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPredicate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskPredicate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskPredicate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPredicate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Type = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPredicate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Blocks", wireType)
+			}
+			m.Blocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPredicate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Blocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Target", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPredicate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Target = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPredicate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *NativeEnrollment) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPredicate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: NativeEnrollment: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: NativeEnrollment: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPredicate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPredicate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPredicate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPredicate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPredicate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *IbcTransferTotal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPredicate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: IbcTransferTotal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: IbcTransferTotal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPredicate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channel", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPredicate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channel = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPredicate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPredicate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPredicate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPredicate(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPredicate
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPredicate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPredicate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPredicate
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPredicate
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPredicate
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPredicate        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPredicate          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPredicate = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,7 +5,11 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -302,509 +306,7987 @@ func (m *QueryAllClaimRecordResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "dtc.task.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "dtc.task.v1.QueryParamsResponse")
-	proto.RegisterType((*QueryGetClaimRecordRequest)(nil), "dtc.task.v1.QueryGetClaimRecordRequest")
-	proto.RegisterType((*QueryGetClaimRecordResponse)(nil), "dtc.task.v1.QueryGetClaimRecordResponse")
-	proto.RegisterType((*QueryAllClaimRecordRequest)(nil), "dtc.task.v1.QueryAllClaimRecordRequest")
-	proto.RegisterType((*QueryAllClaimRecordResponse)(nil), "dtc.task.v1.QueryAllClaimRecordResponse")
+// QueryGetTaskRequest defines the QueryGetTaskRequest message.
+type QueryGetTaskRequest struct {
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 }
 
-func init() { proto.RegisterFile("dtc/task/v1/query.proto", fileDescriptor_95d27174d3252983) }
-
-var fileDescriptor_95d27174d3252983 = []byte{
-	// 511 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0xc7, 0x73, 0x2d, 0x44, 0xca, 0x05, 0xf1, 0xe3, 0x52, 0x44, 0x70, 0xc0, 0x2d, 0x37, 0xb4,
-	0x51, 0x86, 0x3b, 0xa5, 0x48, 0x2c, 0x4c, 0x0d, 0x12, 0x65, 0x00, 0xa9, 0x78, 0x64, 0x81, 0x8b,
-	0x73, 0x72, 0x2c, 0x6c, 0x9f, 0xeb, 0xbb, 0x46, 0x54, 0x88, 0x85, 0x85, 0x15, 0xd4, 0x7f, 0x02,
-	0x36, 0xfe, 0x8c, 0x8e, 0x95, 0x58, 0x98, 0x10, 0x4a, 0x90, 0xf8, 0x37, 0x90, 0xef, 0x4e, 0x8a,
-	0x4d, 0x6c, 0x45, 0x5d, 0x22, 0xeb, 0xbd, 0xf7, 0x7d, 0xef, 0xf3, 0xf2, 0x7d, 0x07, 0xef, 0x4c,
-	0x94, 0x4f, 0x15, 0x93, 0x6f, 0xe9, 0x6c, 0x48, 0x8f, 0x4f, 0x78, 0x76, 0x4a, 0xd2, 0x4c, 0x28,
-	0x81, 0xda, 0x13, 0xe5, 0x93, 0x3c, 0x41, 0x66, 0x43, 0xe7, 0x16, 0x8b, 0xc3, 0x44, 0x50, 0xfd,
-	0x6b, 0xf2, 0xce, 0xc0, 0x17, 0x32, 0x16, 0x92, 0x8e, 0x99, 0xe4, 0x46, 0x48, 0x67, 0xc3, 0x31,
-	0x57, 0x6c, 0x48, 0x53, 0x16, 0x84, 0x09, 0x53, 0xa1, 0x48, 0x6c, 0xad, 0x5b, 0x1c, 0xe2, 0x47,
-	0x2c, 0x8c, 0x5f, 0x67, 0xdc, 0x17, 0xd9, 0xc4, 0xe6, 0xbb, 0xc5, 0x7c, 0xca, 0x32, 0x16, 0x4b,
-	0x9b, 0xd9, 0x0a, 0x44, 0x20, 0xf4, 0x27, 0xcd, 0xbf, 0x6c, 0xf4, 0x5e, 0x20, 0x44, 0x10, 0x71,
-	0xca, 0xd2, 0x90, 0xb2, 0x24, 0x11, 0x4a, 0x0f, 0xb3, 0x1a, 0xbc, 0x05, 0xd1, 0xcb, 0x9c, 0xe7,
-	0x48, 0x37, 0xf2, 0xf8, 0xf1, 0x09, 0x97, 0x0a, 0xbf, 0x80, 0x9d, 0x52, 0x54, 0xa6, 0x22, 0x91,
-	0x1c, 0x3d, 0x82, 0x4d, 0x33, 0xb0, 0x0b, 0x76, 0x40, 0xbf, 0xbd, 0xdf, 0x21, 0x85, 0xbd, 0x89,
-	0x29, 0x1e, 0xb5, 0xce, 0x7f, 0x6d, 0x37, 0xbe, 0xfe, 0xfd, 0x3e, 0x00, 0x9e, 0xad, 0xc6, 0x8f,
-	0xa1, 0xa3, 0xdb, 0x1d, 0x72, 0xf5, 0x24, 0x5f, 0xc8, 0xd3, 0xfb, 0xd8, 0x61, 0xe8, 0x3e, 0x84,
-	0x66, 0xcd, 0x29, 0x93, 0x53, 0xdd, 0xb9, 0xe5, 0xb5, 0x74, 0xe4, 0x19, 0x93, 0x53, 0xfc, 0x06,
-	0xf6, 0x2a, 0xc5, 0x96, 0xe9, 0x00, 0x5e, 0x2b, 0xfe, 0x49, 0x96, 0xac, 0x5b, 0x22, 0x2b, 0xe8,
-	0x46, 0x57, 0x72, 0x3c, 0xaf, 0xed, 0x2f, 0x43, 0x78, 0x62, 0xf1, 0x0e, 0xa2, 0xa8, 0x02, 0xef,
-	0x29, 0x84, 0x4b, 0x8f, 0x6c, 0xfb, 0x5d, 0x62, 0x0c, 0x25, 0xb9, 0xa1, 0xc4, 0x5c, 0x82, 0x35,
-	0x94, 0x1c, 0xb1, 0x80, 0x5b, 0xad, 0x57, 0x50, 0xe2, 0x6f, 0xc0, 0x2e, 0xf2, 0xff, 0x98, 0xda,
-	0x45, 0x36, 0x2f, 0xb9, 0x08, 0x3a, 0x2c, 0xa1, 0x6e, 0x68, 0xd4, 0xbd, 0xb5, 0xa8, 0x66, 0x7e,
-	0x91, 0x75, 0xff, 0xcb, 0x26, 0xbc, 0xaa, 0x59, 0xd1, 0x14, 0x36, 0x8d, 0xaf, 0x68, 0xbb, 0x44,
-	0xb2, 0x7a, 0x34, 0xce, 0x4e, 0x7d, 0x81, 0x19, 0x81, 0x7b, 0x1f, 0x7f, 0xfc, 0x39, 0xdb, 0xb8,
-	0x8d, 0x3a, 0x74, 0xf5, 0x86, 0xd1, 0x19, 0x80, 0xd7, 0xcb, 0x1e, 0xa3, 0xbd, 0xd5, 0x8e, 0x95,
-	0x27, 0xe4, 0xf4, 0xd7, 0x17, 0x5a, 0x04, 0xa2, 0x11, 0xfa, 0x68, 0x97, 0xd6, 0x3d, 0x33, 0xfa,
-	0x7e, 0x79, 0x8d, 0x1f, 0xd0, 0x27, 0x00, 0x6f, 0x3c, 0x0f, 0xe5, 0x3a, 0xac, 0xca, 0xd3, 0xa9,
-	0xc2, 0xaa, 0x36, 0x1f, 0x3f, 0xd0, 0x58, 0x3d, 0x74, 0xb7, 0x16, 0x6b, 0x34, 0x38, 0x9f, 0xbb,
-	0xe0, 0x62, 0xee, 0x82, 0xdf, 0x73, 0x17, 0x7c, 0x5e, 0xb8, 0x8d, 0x8b, 0x85, 0xdb, 0xf8, 0xb9,
-	0x70, 0x1b, 0xaf, 0x6e, 0xe6, 0x9a, 0x77, 0x46, 0xa5, 0x4e, 0x53, 0x2e, 0xc7, 0x4d, 0xfd, 0xb8,
-	0x1f, 0xfe, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x12, 0xe5, 0x35, 0x8b, 0xb1, 0x04, 0x00, 0x00,
+func (m *QueryGetTaskRequest) Reset()         { *m = QueryGetTaskRequest{} }
+func (m *QueryGetTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTaskRequest) ProtoMessage()    {}
+func (*QueryGetTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{6}
+}
+func (m *QueryGetTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryGetTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryGetTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTaskRequest.Merge(m, src)
+}
+func (m *QueryGetTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTaskRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryGetTaskRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
-	// ListClaimRecord Queries a list of ClaimRecord items.
-	GetClaimRecord(ctx context.Context, in *QueryGetClaimRecordRequest, opts ...grpc.CallOption) (*QueryGetClaimRecordResponse, error)
-	// ListClaimRecord defines the ListClaimRecord RPC.
-	ListClaimRecord(ctx context.Context, in *QueryAllClaimRecordRequest, opts ...grpc.CallOption) (*QueryAllClaimRecordResponse, error)
+func (m *QueryGetTaskRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+// QueryGetTaskResponse defines the QueryGetTaskResponse message.
+type QueryGetTaskResponse struct {
+	Task Task `protobuf:"bytes,1,opt,name=task,proto3" json:"task"`
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+func (m *QueryGetTaskResponse) Reset()         { *m = QueryGetTaskResponse{} }
+func (m *QueryGetTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryGetTaskResponse) ProtoMessage()    {}
+func (*QueryGetTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{7}
 }
-
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *QueryGetTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *queryClient) GetClaimRecord(ctx context.Context, in *QueryGetClaimRecordRequest, opts ...grpc.CallOption) (*QueryGetClaimRecordResponse, error) {
-	out := new(QueryGetClaimRecordResponse)
-	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/GetClaimRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryGetTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryGetTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryGetTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryGetTaskResponse.Merge(m, src)
+}
+func (m *QueryGetTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryGetTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryGetTaskResponse.DiscardUnknown(m)
 }
 
-func (c *queryClient) ListClaimRecord(ctx context.Context, in *QueryAllClaimRecordRequest, opts ...grpc.CallOption) (*QueryAllClaimRecordResponse, error) {
-	out := new(QueryAllClaimRecordResponse)
-	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/ListClaimRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryGetTaskResponse proto.InternalMessageInfo
+
+func (m *QueryGetTaskResponse) GetTask() Task {
+	if m != nil {
+		return m.Task
 	}
-	return out, nil
+	return Task{}
 }
 
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
-	// ListClaimRecord Queries a list of ClaimRecord items.
-	GetClaimRecord(context.Context, *QueryGetClaimRecordRequest) (*QueryGetClaimRecordResponse, error)
-	// ListClaimRecord defines the ListClaimRecord RPC.
-	ListClaimRecord(context.Context, *QueryAllClaimRecordRequest) (*QueryAllClaimRecordResponse, error)
+// QueryAllTaskRequest defines the QueryAllTaskRequest message.
+type QueryAllTaskRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+func (m *QueryAllTaskRequest) Reset()         { *m = QueryAllTaskRequest{} }
+func (m *QueryAllTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTaskRequest) ProtoMessage()    {}
+func (*QueryAllTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{8}
 }
-
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryAllTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) GetClaimRecord(ctx context.Context, req *QueryGetClaimRecordRequest) (*QueryGetClaimRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetClaimRecord not implemented")
+func (m *QueryAllTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedQueryServer) ListClaimRecord(ctx context.Context, req *QueryAllClaimRecordRequest) (*QueryAllClaimRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ListClaimRecord not implemented")
+func (m *QueryAllTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTaskRequest.Merge(m, src)
 }
-
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+func (m *QueryAllTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTaskRequest.DiscardUnknown(m)
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dtc.task.v1.Query/Params",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+var xxx_messageInfo_QueryAllTaskRequest proto.InternalMessageInfo
+
+func (m *QueryAllTaskRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _Query_GetClaimRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryGetClaimRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).GetClaimRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dtc.task.v1.Query/GetClaimRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).GetClaimRecord(ctx, req.(*QueryGetClaimRecordRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+// QueryAllTaskResponse defines the QueryAllTaskResponse message.
+type QueryAllTaskResponse struct {
+	Task       []Task              `protobuf:"bytes,1,rep,name=task,proto3" json:"task"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_ListClaimRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryAllClaimRecordRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).ListClaimRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dtc.task.v1.Query/ListClaimRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).ListClaimRecord(ctx, req.(*QueryAllClaimRecordRequest))
+func (m *QueryAllTaskResponse) Reset()         { *m = QueryAllTaskResponse{} }
+func (m *QueryAllTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAllTaskResponse) ProtoMessage()    {}
+func (*QueryAllTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{9}
+}
+func (m *QueryAllTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAllTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAllTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dtc.task.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-		{
-			MethodName: "GetClaimRecord",
-			Handler:    _Query_GetClaimRecord_Handler,
-		},
-		{
-			MethodName: "ListClaimRecord",
-			Handler:    _Query_ListClaimRecord_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dtc/task/v1/query.proto",
+func (m *QueryAllTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAllTaskResponse.Merge(m, src)
+}
+func (m *QueryAllTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAllTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAllTaskResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryAllTaskResponse proto.InternalMessageInfo
+
+func (m *QueryAllTaskResponse) GetTask() []Task {
+	if m != nil {
+		return m.Task
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryAllTaskResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryUserClaimCountRequest defines the QueryUserClaimCountRequest message.
+type QueryUserClaimCountRequest struct {
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	User   string `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUserClaimCountRequest) Reset()         { *m = QueryUserClaimCountRequest{} }
+func (m *QueryUserClaimCountRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUserClaimCountRequest) ProtoMessage()    {}
+func (*QueryUserClaimCountRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{10}
+}
+func (m *QueryUserClaimCountRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserClaimCountRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserClaimCountRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryUserClaimCountRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserClaimCountRequest.Merge(m, src)
+}
+func (m *QueryUserClaimCountRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserClaimCountRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserClaimCountRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryUserClaimCountRequest proto.InternalMessageInfo
+
+func (m *QueryUserClaimCountRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryUserClaimCountRequest) GetUser() string {
+	if m != nil {
+		return m.User
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *QueryGetClaimRecordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// QueryUserClaimCountResponse defines the QueryUserClaimCountResponse message.
+type QueryUserClaimCountResponse struct {
+	Count        uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	PerUserLimit uint64 `protobuf:"varint,2,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"`
 }
 
-func (m *QueryGetClaimRecordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *QueryUserClaimCountResponse) Reset()         { *m = QueryUserClaimCountResponse{} }
+func (m *QueryUserClaimCountResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUserClaimCountResponse) ProtoMessage()    {}
+func (*QueryUserClaimCountResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{11}
+}
+func (m *QueryUserClaimCountResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUserClaimCountResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUserClaimCountResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUserClaimCountResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUserClaimCountResponse.Merge(m, src)
+}
+func (m *QueryUserClaimCountResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUserClaimCountResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUserClaimCountResponse.DiscardUnknown(m)
 }
 
-func (m *QueryGetClaimRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClaimHash) > 0 {
-		i -= len(m.ClaimHash)
-		copy(dAtA[i:], m.ClaimHash)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClaimHash)))
-		i--
-		dAtA[i] = 0xa
+var xxx_messageInfo_QueryUserClaimCountResponse proto.InternalMessageInfo
+
+func (m *QueryUserClaimCountResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *QueryGetClaimRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUserClaimCountResponse) GetPerUserLimit() uint64 {
+	if m != nil {
+		return m.PerUserLimit
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *QueryGetClaimRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTaskEscrowRequest defines the QueryTaskEscrowRequest message.
+type QueryTaskEscrowRequest struct {
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 }
 
-func (m *QueryGetClaimRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.ClaimRecord.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryTaskEscrowRequest) Reset()         { *m = QueryTaskEscrowRequest{} }
+func (m *QueryTaskEscrowRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaskEscrowRequest) ProtoMessage()    {}
+func (*QueryTaskEscrowRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{12}
+}
+func (m *QueryTaskEscrowRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaskEscrowRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaskEscrowRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryTaskEscrowRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaskEscrowRequest.Merge(m, src)
+}
+func (m *QueryTaskEscrowRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaskEscrowRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaskEscrowRequest.DiscardUnknown(m)
 }
 
-func (m *QueryAllClaimRecordRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTaskEscrowRequest proto.InternalMessageInfo
+
+func (m *QueryTaskEscrowRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryAllClaimRecordRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryTaskEscrowResponse defines the QueryTaskEscrowResponse message.
+type QueryTaskEscrowResponse struct {
+	Escrow TaskEscrow `protobuf:"bytes,1,opt,name=escrow,proto3" json:"escrow"`
 }
 
-func (m *QueryAllClaimRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
+func (m *QueryTaskEscrowResponse) Reset()         { *m = QueryTaskEscrowResponse{} }
+func (m *QueryTaskEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaskEscrowResponse) ProtoMessage()    {}
+func (*QueryTaskEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{13}
+}
+func (m *QueryTaskEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaskEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaskEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryTaskEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaskEscrowResponse.Merge(m, src)
+}
+func (m *QueryTaskEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaskEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaskEscrowResponse.DiscardUnknown(m)
 }
 
-func (m *QueryAllClaimRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryTaskEscrowResponse proto.InternalMessageInfo
+
+func (m *QueryTaskEscrowResponse) GetEscrow() TaskEscrow {
+	if m != nil {
+		return m.Escrow
 	}
-	return dAtA[:n], nil
+	return TaskEscrow{}
 }
 
-func (m *QueryAllClaimRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryListClaimsByUserRequest defines the QueryListClaimsByUserRequest message.
+type QueryListClaimsByUserRequest struct {
+	User       string             `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllClaimRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQuery(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.ClaimRecord) > 0 {
-		for iNdEx := len(m.ClaimRecord) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.ClaimRecord[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQuery(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
+func (m *QueryListClaimsByUserRequest) Reset()         { *m = QueryListClaimsByUserRequest{} }
+func (m *QueryListClaimsByUserRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListClaimsByUserRequest) ProtoMessage()    {}
+func (*QueryListClaimsByUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{14}
+}
+func (m *QueryListClaimsByUserRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListClaimsByUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListClaimsByUserRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *QueryListClaimsByUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListClaimsByUserRequest.Merge(m, src)
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *QueryListClaimsByUserRequest) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryListClaimsByUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListClaimsByUserRequest.DiscardUnknown(m)
 }
 
-func (m *QueryGetClaimRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ClaimHash)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+var xxx_messageInfo_QueryListClaimsByUserRequest proto.InternalMessageInfo
+
+func (m *QueryListClaimsByUserRequest) GetUser() string {
+	if m != nil {
+		return m.User
 	}
-	return n
+	return ""
 }
 
-func (m *QueryGetClaimRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListClaimsByUserRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	var l int
-	_ = l
-	l = m.ClaimRecord.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return nil
 }
 
-func (m *QueryAllClaimRecordRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
+// QueryListClaimsByTaskRequest defines the QueryListClaimsByTaskRequest message.
+type QueryListClaimsByTaskRequest struct {
+	TaskId     string             `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryAllClaimRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryListClaimsByTaskRequest) Reset()         { *m = QueryListClaimsByTaskRequest{} }
+func (m *QueryListClaimsByTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListClaimsByTaskRequest) ProtoMessage()    {}
+func (*QueryListClaimsByTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{15}
+}
+func (m *QueryListClaimsByTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListClaimsByTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListClaimsByTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	if len(m.ClaimRecord) > 0 {
-		for _, e := range m.ClaimRecord {
-			l = e.Size()
-			n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryListClaimsByTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListClaimsByTaskRequest.Merge(m, src)
+}
+func (m *QueryListClaimsByTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListClaimsByTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListClaimsByTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListClaimsByTaskRequest proto.InternalMessageInfo
+
+func (m *QueryListClaimsByTaskRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *QueryListClaimsByTaskRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryListClaimsByCreatorRequest defines the QueryListClaimsByCreatorRequest message.
+type QueryListClaimsByCreatorRequest struct {
+	Creator    string             `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListClaimsByCreatorRequest) Reset()         { *m = QueryListClaimsByCreatorRequest{} }
+func (m *QueryListClaimsByCreatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryListClaimsByCreatorRequest) ProtoMessage()    {}
+func (*QueryListClaimsByCreatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{16}
+}
+func (m *QueryListClaimsByCreatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListClaimsByCreatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListClaimsByCreatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
+}
+func (m *QueryListClaimsByCreatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListClaimsByCreatorRequest.Merge(m, src)
+}
+func (m *QueryListClaimsByCreatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListClaimsByCreatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListClaimsByCreatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListClaimsByCreatorRequest proto.InternalMessageInfo
+
+func (m *QueryListClaimsByCreatorRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return n
+	return ""
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *QueryListClaimsByCreatorRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+// QueryListClaimsResponse defines the QueryListClaimsResponse message.
+type QueryListClaimsResponse struct {
+	ClaimRecord []ClaimRecord       `protobuf:"bytes,1,rep,name=claim_record,json=claimRecord,proto3" json:"claim_record"`
+	Pagination  *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryListClaimsResponse) Reset()         { *m = QueryListClaimsResponse{} }
+func (m *QueryListClaimsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryListClaimsResponse) ProtoMessage()    {}
+func (*QueryListClaimsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{17}
+}
+func (m *QueryListClaimsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryListClaimsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryListClaimsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryListClaimsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryListClaimsResponse.Merge(m, src)
+}
+func (m *QueryListClaimsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryListClaimsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryListClaimsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryListClaimsResponse proto.InternalMessageInfo
+
+func (m *QueryListClaimsResponse) GetClaimRecord() []ClaimRecord {
+	if m != nil {
+		return m.ClaimRecord
+	}
+	return nil
+}
+
+func (m *QueryListClaimsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTotalPaidByTaskRequest defines the QueryTotalPaidByTaskRequest message.
+type QueryTotalPaidByTaskRequest struct {
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (m *QueryTotalPaidByTaskRequest) Reset()         { *m = QueryTotalPaidByTaskRequest{} }
+func (m *QueryTotalPaidByTaskRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalPaidByTaskRequest) ProtoMessage()    {}
+func (*QueryTotalPaidByTaskRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{18}
+}
+func (m *QueryTotalPaidByTaskRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalPaidByTaskRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalPaidByTaskRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalPaidByTaskRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalPaidByTaskRequest.Merge(m, src)
+}
+func (m *QueryTotalPaidByTaskRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalPaidByTaskRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalPaidByTaskRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalPaidByTaskRequest proto.InternalMessageInfo
+
+func (m *QueryTotalPaidByTaskRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+// QueryTotalPaidByUserRequest defines the QueryTotalPaidByUserRequest message.
+type QueryTotalPaidByUserRequest struct {
+	User string `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (m *QueryTotalPaidByUserRequest) Reset()         { *m = QueryTotalPaidByUserRequest{} }
+func (m *QueryTotalPaidByUserRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalPaidByUserRequest) ProtoMessage()    {}
+func (*QueryTotalPaidByUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{19}
+}
+func (m *QueryTotalPaidByUserRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalPaidByUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalPaidByUserRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalPaidByUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalPaidByUserRequest.Merge(m, src)
+}
+func (m *QueryTotalPaidByUserRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalPaidByUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalPaidByUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalPaidByUserRequest proto.InternalMessageInfo
+
+func (m *QueryTotalPaidByUserRequest) GetUser() string {
+	if m != nil {
+		return m.User
+	}
+	return ""
+}
+
+// QueryTotalPaidByCreatorRequest defines the QueryTotalPaidByCreatorRequest message.
+type QueryTotalPaidByCreatorRequest struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *QueryTotalPaidByCreatorRequest) Reset()         { *m = QueryTotalPaidByCreatorRequest{} }
+func (m *QueryTotalPaidByCreatorRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalPaidByCreatorRequest) ProtoMessage()    {}
+func (*QueryTotalPaidByCreatorRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{20}
+}
+func (m *QueryTotalPaidByCreatorRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalPaidByCreatorRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalPaidByCreatorRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalPaidByCreatorRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalPaidByCreatorRequest.Merge(m, src)
+}
+func (m *QueryTotalPaidByCreatorRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalPaidByCreatorRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalPaidByCreatorRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalPaidByCreatorRequest proto.InternalMessageInfo
+
+func (m *QueryTotalPaidByCreatorRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// QueryTotalPaidResponse defines the QueryTotalPaidResponse message.
+type QueryTotalPaidResponse struct {
+	Total github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=total,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total"`
+}
+
+func (m *QueryTotalPaidResponse) Reset()         { *m = QueryTotalPaidResponse{} }
+func (m *QueryTotalPaidResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTotalPaidResponse) ProtoMessage()    {}
+func (*QueryTotalPaidResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{21}
+}
+func (m *QueryTotalPaidResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTotalPaidResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTotalPaidResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTotalPaidResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTotalPaidResponse.Merge(m, src)
+}
+func (m *QueryTotalPaidResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTotalPaidResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTotalPaidResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTotalPaidResponse proto.InternalMessageInfo
+
+func (m *QueryTotalPaidResponse) GetTotal() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Total
+	}
+	return nil
+}
+
+// QueryPayoutAllowanceRequest defines the QueryPayoutAllowanceRequest message.
+type QueryPayoutAllowanceRequest struct {
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Creator   string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+}
+
+func (m *QueryPayoutAllowanceRequest) Reset()         { *m = QueryPayoutAllowanceRequest{} }
+func (m *QueryPayoutAllowanceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPayoutAllowanceRequest) ProtoMessage()    {}
+func (*QueryPayoutAllowanceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{22}
+}
+func (m *QueryPayoutAllowanceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPayoutAllowanceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPayoutAllowanceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPayoutAllowanceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPayoutAllowanceRequest.Merge(m, src)
+}
+func (m *QueryPayoutAllowanceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPayoutAllowanceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPayoutAllowanceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPayoutAllowanceRequest proto.InternalMessageInfo
+
+func (m *QueryPayoutAllowanceRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *QueryPayoutAllowanceRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// QueryPayoutAllowanceResponse 返回各限额的剩余额度，只列出设置了限额的币种
+type QueryPayoutAllowanceResponse struct {
+	RecipientRemaining github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=recipient_remaining,json=recipientRemaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"recipient_remaining"`
+	CreatorRemaining   github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=creator_remaining,json=creatorRemaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"creator_remaining"`
+	BlockRemaining     github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=block_remaining,json=blockRemaining,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"block_remaining"`
+	// window_start_height 是滚动窗口计入的最早区块高度，窗口关闭时为 0
+	WindowStartHeight int64 `protobuf:"varint,4,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+	// bucket_end_height 是当前分桶的最后一个区块高度，此后最早的分桶移出窗口、额度随之释放，窗口关闭时为 0
+	BucketEndHeight int64 `protobuf:"varint,5,opt,name=bucket_end_height,json=bucketEndHeight,proto3" json:"bucket_end_height,omitempty"`
+}
+
+func (m *QueryPayoutAllowanceResponse) Reset()         { *m = QueryPayoutAllowanceResponse{} }
+func (m *QueryPayoutAllowanceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPayoutAllowanceResponse) ProtoMessage()    {}
+func (*QueryPayoutAllowanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{23}
+}
+func (m *QueryPayoutAllowanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPayoutAllowanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPayoutAllowanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPayoutAllowanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPayoutAllowanceResponse.Merge(m, src)
+}
+func (m *QueryPayoutAllowanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPayoutAllowanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPayoutAllowanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPayoutAllowanceResponse proto.InternalMessageInfo
+
+func (m *QueryPayoutAllowanceResponse) GetRecipientRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.RecipientRemaining
+	}
+	return nil
+}
+
+func (m *QueryPayoutAllowanceResponse) GetCreatorRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.CreatorRemaining
+	}
+	return nil
+}
+
+func (m *QueryPayoutAllowanceResponse) GetBlockRemaining() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.BlockRemaining
+	}
+	return nil
+}
+
+func (m *QueryPayoutAllowanceResponse) GetWindowStartHeight() int64 {
+	if m != nil {
+		return m.WindowStartHeight
+	}
+	return 0
+}
+
+func (m *QueryPayoutAllowanceResponse) GetBucketEndHeight() int64 {
+	if m != nil {
+		return m.BucketEndHeight
+	}
+	return 0
+}
+
+// QueryRewardStreamsByRecipientRequest defines the QueryRewardStreamsByRecipientRequest message.
+type QueryRewardStreamsByRecipientRequest struct {
+	Recipient  string             `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRewardStreamsByRecipientRequest) Reset()         { *m = QueryRewardStreamsByRecipientRequest{} }
+func (m *QueryRewardStreamsByRecipientRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardStreamsByRecipientRequest) ProtoMessage()    {}
+func (*QueryRewardStreamsByRecipientRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{24}
+}
+func (m *QueryRewardStreamsByRecipientRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardStreamsByRecipientRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardStreamsByRecipientRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardStreamsByRecipientRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardStreamsByRecipientRequest.Merge(m, src)
+}
+func (m *QueryRewardStreamsByRecipientRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardStreamsByRecipientRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardStreamsByRecipientRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardStreamsByRecipientRequest proto.InternalMessageInfo
+
+func (m *QueryRewardStreamsByRecipientRequest) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *QueryRewardStreamsByRecipientRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// RewardStreamStatus 是奖励流在当前高度的释放情况
+type RewardStreamStatus struct {
+	Stream RewardStream `protobuf:"bytes,1,opt,name=stream,proto3" json:"stream"`
+	// vested 是截至当前高度累计释放的金额（含已提取部分）
+	Vested types.Coin `protobuf:"bytes,2,opt,name=vested,proto3" json:"vested"`
+	// withdrawable 是当前可提取的金额
+	Withdrawable types.Coin `protobuf:"bytes,3,opt,name=withdrawable,proto3" json:"withdrawable"`
+}
+
+func (m *RewardStreamStatus) Reset()         { *m = RewardStreamStatus{} }
+func (m *RewardStreamStatus) String() string { return proto.CompactTextString(m) }
+func (*RewardStreamStatus) ProtoMessage()    {}
+func (*RewardStreamStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{25}
+}
+func (m *RewardStreamStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RewardStreamStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RewardStreamStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RewardStreamStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RewardStreamStatus.Merge(m, src)
+}
+func (m *RewardStreamStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RewardStreamStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RewardStreamStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RewardStreamStatus proto.InternalMessageInfo
+
+func (m *RewardStreamStatus) GetStream() RewardStream {
+	if m != nil {
+		return m.Stream
+	}
+	return RewardStream{}
+}
+
+func (m *RewardStreamStatus) GetVested() types.Coin {
+	if m != nil {
+		return m.Vested
+	}
+	return types.Coin{}
+}
+
+func (m *RewardStreamStatus) GetWithdrawable() types.Coin {
+	if m != nil {
+		return m.Withdrawable
+	}
+	return types.Coin{}
+}
+
+// QueryRewardStreamsByRecipientResponse defines the QueryRewardStreamsByRecipientResponse message.
+type QueryRewardStreamsByRecipientResponse struct {
+	Streams    []RewardStreamStatus `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRewardStreamsByRecipientResponse) Reset()         { *m = QueryRewardStreamsByRecipientResponse{} }
+func (m *QueryRewardStreamsByRecipientResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRewardStreamsByRecipientResponse) ProtoMessage()    {}
+func (*QueryRewardStreamsByRecipientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{26}
+}
+func (m *QueryRewardStreamsByRecipientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRewardStreamsByRecipientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRewardStreamsByRecipientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRewardStreamsByRecipientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRewardStreamsByRecipientResponse.Merge(m, src)
+}
+func (m *QueryRewardStreamsByRecipientResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRewardStreamsByRecipientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRewardStreamsByRecipientResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryRewardStreamsByRecipientResponse proto.InternalMessageInfo
+
+func (m *QueryRewardStreamsByRecipientResponse) GetStreams() []RewardStreamStatus {
+	if m != nil {
+		return m.Streams
+	}
+	return nil
+}
+
+func (m *QueryRewardStreamsByRecipientResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingPayoutRequest defines the QueryPendingPayoutRequest message.
+type QueryPendingPayoutRequest struct {
+	ClaimHash string `protobuf:"bytes,1,opt,name=claim_hash,json=claimHash,proto3" json:"claim_hash,omitempty"`
+}
+
+func (m *QueryPendingPayoutRequest) Reset()         { *m = QueryPendingPayoutRequest{} }
+func (m *QueryPendingPayoutRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPayoutRequest) ProtoMessage()    {}
+func (*QueryPendingPayoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{27}
+}
+func (m *QueryPendingPayoutRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPayoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPayoutRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPayoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPayoutRequest.Merge(m, src)
+}
+func (m *QueryPendingPayoutRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPayoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPayoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPayoutRequest proto.InternalMessageInfo
+
+func (m *QueryPendingPayoutRequest) GetClaimHash() string {
+	if m != nil {
+		return m.ClaimHash
+	}
+	return ""
+}
+
+// QueryPendingPayoutResponse defines the QueryPendingPayoutResponse message.
+type QueryPendingPayoutResponse struct {
+	PendingPayout PendingPayout `protobuf:"bytes,1,opt,name=pending_payout,json=pendingPayout,proto3" json:"pending_payout"`
+}
+
+func (m *QueryPendingPayoutResponse) Reset()         { *m = QueryPendingPayoutResponse{} }
+func (m *QueryPendingPayoutResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPayoutResponse) ProtoMessage()    {}
+func (*QueryPendingPayoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{28}
+}
+func (m *QueryPendingPayoutResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPayoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPayoutResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPayoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPayoutResponse.Merge(m, src)
+}
+func (m *QueryPendingPayoutResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPayoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPayoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPayoutResponse proto.InternalMessageInfo
+
+func (m *QueryPendingPayoutResponse) GetPendingPayout() PendingPayout {
+	if m != nil {
+		return m.PendingPayout
+	}
+	return PendingPayout{}
+}
+
+// QueryCreditRepayShareRequest defines the QueryCreditRepayShareRequest message.
+type QueryCreditRepayShareRequest struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryCreditRepayShareRequest) Reset()         { *m = QueryCreditRepayShareRequest{} }
+func (m *QueryCreditRepayShareRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryCreditRepayShareRequest) ProtoMessage()    {}
+func (*QueryCreditRepayShareRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{29}
+}
+func (m *QueryCreditRepayShareRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCreditRepayShareRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCreditRepayShareRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCreditRepayShareRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCreditRepayShareRequest.Merge(m, src)
+}
+func (m *QueryCreditRepayShareRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCreditRepayShareRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCreditRepayShareRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCreditRepayShareRequest proto.InternalMessageInfo
+
+func (m *QueryCreditRepayShareRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryCreditRepayShareResponse defines the QueryCreditRepayShareResponse message.
+type QueryCreditRepayShareResponse struct {
+	// governed 是治理设定的比例，opted_in 是用户自愿设定的比例，effective 是两者中的较大者
+	Governed  cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=governed,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"governed"`
+	OptedIn   cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=opted_in,json=optedIn,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"opted_in"`
+	Effective cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=effective,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"effective"`
+}
+
+func (m *QueryCreditRepayShareResponse) Reset()         { *m = QueryCreditRepayShareResponse{} }
+func (m *QueryCreditRepayShareResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryCreditRepayShareResponse) ProtoMessage()    {}
+func (*QueryCreditRepayShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{30}
+}
+func (m *QueryCreditRepayShareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryCreditRepayShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryCreditRepayShareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryCreditRepayShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryCreditRepayShareResponse.Merge(m, src)
+}
+func (m *QueryCreditRepayShareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryCreditRepayShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryCreditRepayShareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryCreditRepayShareResponse proto.InternalMessageInfo
+
+// QueryMerkleDistributionRequest defines the QueryMerkleDistributionRequest message.
+type QueryMerkleDistributionRequest struct {
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Epoch  uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+}
+
+func (m *QueryMerkleDistributionRequest) Reset()         { *m = QueryMerkleDistributionRequest{} }
+func (m *QueryMerkleDistributionRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleDistributionRequest) ProtoMessage()    {}
+func (*QueryMerkleDistributionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{31}
+}
+func (m *QueryMerkleDistributionRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkleDistributionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleDistributionRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMerkleDistributionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleDistributionRequest.Merge(m, src)
+}
+func (m *QueryMerkleDistributionRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleDistributionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleDistributionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMerkleDistributionRequest proto.InternalMessageInfo
+
+func (m *QueryMerkleDistributionRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *QueryMerkleDistributionRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+// QueryMerkleDistributionResponse defines the QueryMerkleDistributionResponse message.
+type QueryMerkleDistributionResponse struct {
+	Distribution MerkleDistribution `protobuf:"bytes,1,opt,name=distribution,proto3" json:"distribution"`
+}
+
+func (m *QueryMerkleDistributionResponse) Reset()         { *m = QueryMerkleDistributionResponse{} }
+func (m *QueryMerkleDistributionResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleDistributionResponse) ProtoMessage()    {}
+func (*QueryMerkleDistributionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{32}
+}
+func (m *QueryMerkleDistributionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkleDistributionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleDistributionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMerkleDistributionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleDistributionResponse.Merge(m, src)
+}
+func (m *QueryMerkleDistributionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleDistributionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleDistributionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMerkleDistributionResponse proto.InternalMessageInfo
+
+func (m *QueryMerkleDistributionResponse) GetDistribution() MerkleDistribution {
+	if m != nil {
+		return m.Distribution
+	}
+	return MerkleDistribution{}
+}
+
+// QueryMerkleLeafClaimedRequest defines the QueryMerkleLeafClaimedRequest message.
+type QueryMerkleLeafClaimedRequest struct {
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Epoch  uint64 `protobuf:"varint,2,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Index  uint64 `protobuf:"varint,3,opt,name=index,proto3" json:"index,omitempty"`
+}
+
+func (m *QueryMerkleLeafClaimedRequest) Reset()         { *m = QueryMerkleLeafClaimedRequest{} }
+func (m *QueryMerkleLeafClaimedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleLeafClaimedRequest) ProtoMessage()    {}
+func (*QueryMerkleLeafClaimedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{33}
+}
+func (m *QueryMerkleLeafClaimedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkleLeafClaimedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleLeafClaimedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMerkleLeafClaimedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleLeafClaimedRequest.Merge(m, src)
+}
+func (m *QueryMerkleLeafClaimedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleLeafClaimedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleLeafClaimedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMerkleLeafClaimedRequest proto.InternalMessageInfo
+
+func (m *QueryMerkleLeafClaimedRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *QueryMerkleLeafClaimedRequest) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *QueryMerkleLeafClaimedRequest) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+// QueryMerkleLeafClaimedResponse defines the QueryMerkleLeafClaimedResponse message.
+type QueryMerkleLeafClaimedResponse struct {
+	Claimed bool `protobuf:"varint,1,opt,name=claimed,proto3" json:"claimed,omitempty"`
+}
+
+func (m *QueryMerkleLeafClaimedResponse) Reset()         { *m = QueryMerkleLeafClaimedResponse{} }
+func (m *QueryMerkleLeafClaimedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMerkleLeafClaimedResponse) ProtoMessage()    {}
+func (*QueryMerkleLeafClaimedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{34}
+}
+func (m *QueryMerkleLeafClaimedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMerkleLeafClaimedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMerkleLeafClaimedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMerkleLeafClaimedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMerkleLeafClaimedResponse.Merge(m, src)
+}
+func (m *QueryMerkleLeafClaimedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMerkleLeafClaimedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMerkleLeafClaimedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMerkleLeafClaimedResponse proto.InternalMessageInfo
+
+func (m *QueryMerkleLeafClaimedResponse) GetClaimed() bool {
+	if m != nil {
+		return m.Claimed
+	}
+	return false
+}
+
+// QueryTaskPredicatesRequest defines the QueryTaskPredicatesRequest message.
+type QueryTaskPredicatesRequest struct {
+}
+
+func (m *QueryTaskPredicatesRequest) Reset()         { *m = QueryTaskPredicatesRequest{} }
+func (m *QueryTaskPredicatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTaskPredicatesRequest) ProtoMessage()    {}
+func (*QueryTaskPredicatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{35}
+}
+func (m *QueryTaskPredicatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaskPredicatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaskPredicatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaskPredicatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaskPredicatesRequest.Merge(m, src)
+}
+func (m *QueryTaskPredicatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaskPredicatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaskPredicatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTaskPredicatesRequest proto.InternalMessageInfo
+
+// QueryTaskPredicatesResponse defines the QueryTaskPredicatesResponse message.
+type QueryTaskPredicatesResponse struct {
+	Types []string `protobuf:"bytes,1,rep,name=types,proto3" json:"types,omitempty"`
+}
+
+func (m *QueryTaskPredicatesResponse) Reset()         { *m = QueryTaskPredicatesResponse{} }
+func (m *QueryTaskPredicatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTaskPredicatesResponse) ProtoMessage()    {}
+func (*QueryTaskPredicatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{36}
+}
+func (m *QueryTaskPredicatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTaskPredicatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTaskPredicatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTaskPredicatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTaskPredicatesResponse.Merge(m, src)
+}
+func (m *QueryTaskPredicatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTaskPredicatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTaskPredicatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTaskPredicatesResponse proto.InternalMessageInfo
+
+func (m *QueryTaskPredicatesResponse) GetTypes() []string {
+	if m != nil {
+		return m.Types
+	}
+	return nil
+}
+
+// QueryNativeClaimStatusRequest defines the QueryNativeClaimStatusRequest message.
+type QueryNativeClaimStatusRequest struct {
+	TaskId  string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryNativeClaimStatusRequest) Reset()         { *m = QueryNativeClaimStatusRequest{} }
+func (m *QueryNativeClaimStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryNativeClaimStatusRequest) ProtoMessage()    {}
+func (*QueryNativeClaimStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{37}
+}
+func (m *QueryNativeClaimStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNativeClaimStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNativeClaimStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNativeClaimStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNativeClaimStatusRequest.Merge(m, src)
+}
+func (m *QueryNativeClaimStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNativeClaimStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNativeClaimStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNativeClaimStatusRequest proto.InternalMessageInfo
+
+func (m *QueryNativeClaimStatusRequest) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *QueryNativeClaimStatusRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryNativeClaimStatusResponse defines the QueryNativeClaimStatusResponse message.
+type QueryNativeClaimStatusResponse struct {
+	// enrolled_height 是地址登记任务的高度，未登记为 0
+	EnrolledHeight int64 `protobuf:"varint,1,opt,name=enrolled_height,json=enrolledHeight,proto3" json:"enrolled_height,omitempty"`
+	Satisfied      bool  `protobuf:"varint,2,opt,name=satisfied,proto3" json:"satisfied,omitempty"`
+	// reason 是判定不满足的原因
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *QueryNativeClaimStatusResponse) Reset()         { *m = QueryNativeClaimStatusResponse{} }
+func (m *QueryNativeClaimStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryNativeClaimStatusResponse) ProtoMessage()    {}
+func (*QueryNativeClaimStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95d27174d3252983, []int{38}
+}
+func (m *QueryNativeClaimStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryNativeClaimStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryNativeClaimStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryNativeClaimStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryNativeClaimStatusResponse.Merge(m, src)
+}
+func (m *QueryNativeClaimStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryNativeClaimStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryNativeClaimStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryNativeClaimStatusResponse proto.InternalMessageInfo
+
+func (m *QueryNativeClaimStatusResponse) GetEnrolledHeight() int64 {
+	if m != nil {
+		return m.EnrolledHeight
+	}
+	return 0
+}
+
+func (m *QueryNativeClaimStatusResponse) GetSatisfied() bool {
+	if m != nil {
+		return m.Satisfied
+	}
+	return false
+}
+
+func (m *QueryNativeClaimStatusResponse) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*QueryParamsRequest)(nil), "dtc.task.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "dtc.task.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryGetClaimRecordRequest)(nil), "dtc.task.v1.QueryGetClaimRecordRequest")
+	proto.RegisterType((*QueryGetClaimRecordResponse)(nil), "dtc.task.v1.QueryGetClaimRecordResponse")
+	proto.RegisterType((*QueryAllClaimRecordRequest)(nil), "dtc.task.v1.QueryAllClaimRecordRequest")
+	proto.RegisterType((*QueryAllClaimRecordResponse)(nil), "dtc.task.v1.QueryAllClaimRecordResponse")
+	proto.RegisterType((*QueryGetTaskRequest)(nil), "dtc.task.v1.QueryGetTaskRequest")
+	proto.RegisterType((*QueryGetTaskResponse)(nil), "dtc.task.v1.QueryGetTaskResponse")
+	proto.RegisterType((*QueryAllTaskRequest)(nil), "dtc.task.v1.QueryAllTaskRequest")
+	proto.RegisterType((*QueryAllTaskResponse)(nil), "dtc.task.v1.QueryAllTaskResponse")
+	proto.RegisterType((*QueryUserClaimCountRequest)(nil), "dtc.task.v1.QueryUserClaimCountRequest")
+	proto.RegisterType((*QueryUserClaimCountResponse)(nil), "dtc.task.v1.QueryUserClaimCountResponse")
+	proto.RegisterType((*QueryTaskEscrowRequest)(nil), "dtc.task.v1.QueryTaskEscrowRequest")
+	proto.RegisterType((*QueryTaskEscrowResponse)(nil), "dtc.task.v1.QueryTaskEscrowResponse")
+	proto.RegisterType((*QueryListClaimsByUserRequest)(nil), "dtc.task.v1.QueryListClaimsByUserRequest")
+	proto.RegisterType((*QueryListClaimsByTaskRequest)(nil), "dtc.task.v1.QueryListClaimsByTaskRequest")
+	proto.RegisterType((*QueryListClaimsByCreatorRequest)(nil), "dtc.task.v1.QueryListClaimsByCreatorRequest")
+	proto.RegisterType((*QueryListClaimsResponse)(nil), "dtc.task.v1.QueryListClaimsResponse")
+	proto.RegisterType((*QueryTotalPaidByTaskRequest)(nil), "dtc.task.v1.QueryTotalPaidByTaskRequest")
+	proto.RegisterType((*QueryTotalPaidByUserRequest)(nil), "dtc.task.v1.QueryTotalPaidByUserRequest")
+	proto.RegisterType((*QueryTotalPaidByCreatorRequest)(nil), "dtc.task.v1.QueryTotalPaidByCreatorRequest")
+	proto.RegisterType((*QueryTotalPaidResponse)(nil), "dtc.task.v1.QueryTotalPaidResponse")
+	proto.RegisterType((*QueryPayoutAllowanceRequest)(nil), "dtc.task.v1.QueryPayoutAllowanceRequest")
+	proto.RegisterType((*QueryPayoutAllowanceResponse)(nil), "dtc.task.v1.QueryPayoutAllowanceResponse")
+	proto.RegisterType((*QueryRewardStreamsByRecipientRequest)(nil), "dtc.task.v1.QueryRewardStreamsByRecipientRequest")
+	proto.RegisterType((*RewardStreamStatus)(nil), "dtc.task.v1.RewardStreamStatus")
+	proto.RegisterType((*QueryRewardStreamsByRecipientResponse)(nil), "dtc.task.v1.QueryRewardStreamsByRecipientResponse")
+	proto.RegisterType((*QueryPendingPayoutRequest)(nil), "dtc.task.v1.QueryPendingPayoutRequest")
+	proto.RegisterType((*QueryPendingPayoutResponse)(nil), "dtc.task.v1.QueryPendingPayoutResponse")
+	proto.RegisterType((*QueryCreditRepayShareRequest)(nil), "dtc.task.v1.QueryCreditRepayShareRequest")
+	proto.RegisterType((*QueryCreditRepayShareResponse)(nil), "dtc.task.v1.QueryCreditRepayShareResponse")
+	proto.RegisterType((*QueryMerkleDistributionRequest)(nil), "dtc.task.v1.QueryMerkleDistributionRequest")
+	proto.RegisterType((*QueryMerkleDistributionResponse)(nil), "dtc.task.v1.QueryMerkleDistributionResponse")
+	proto.RegisterType((*QueryMerkleLeafClaimedRequest)(nil), "dtc.task.v1.QueryMerkleLeafClaimedRequest")
+	proto.RegisterType((*QueryMerkleLeafClaimedResponse)(nil), "dtc.task.v1.QueryMerkleLeafClaimedResponse")
+	proto.RegisterType((*QueryTaskPredicatesRequest)(nil), "dtc.task.v1.QueryTaskPredicatesRequest")
+	proto.RegisterType((*QueryTaskPredicatesResponse)(nil), "dtc.task.v1.QueryTaskPredicatesResponse")
+	proto.RegisterType((*QueryNativeClaimStatusRequest)(nil), "dtc.task.v1.QueryNativeClaimStatusRequest")
+	proto.RegisterType((*QueryNativeClaimStatusResponse)(nil), "dtc.task.v1.QueryNativeClaimStatusResponse")
+}
+
+func init() { proto.RegisterFile("dtc/task/v1/query.proto", fileDescriptor_95d27174d3252983) }
+
+var fileDescriptor_95d27174d3252983 = []byte{
+	// 2102 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x4f, 0xdb, 0x8e, 0x3f, 0x5e, 0xb2, 0x76, 0x5c, 0x36, 0xb1, 0xdd, 0x76, 0xc6, 0x49, 0xaf,
+	0x37, 0x9e, 0xd8, 0xc9, 0xf4, 0x3a, 0xc9, 0x1a, 0x14, 0x56, 0x42, 0xb1, 0xb3, 0x64, 0x23, 0x65,
+	0xc1, 0xdb, 0xc9, 0x1e, 0x40, 0x42, 0x4d, 0x4d, 0x77, 0x79, 0xa6, 0xf1, 0x4c, 0xf7, 0x6c, 0x57,
+	0x8f, 0xbd, 0xc6, 0x1a, 0x2d, 0xb0, 0x07, 0x84, 0x04, 0xd2, 0x8a, 0x15, 0x88, 0x43, 0x2e, 0x1c,
+	0x90, 0x60, 0xb9, 0x2c, 0x88, 0xff, 0x80, 0xcb, 0x4a, 0x5c, 0x56, 0x70, 0x41, 0x1c, 0x16, 0x94,
+	0x20, 0xf1, 0x2f, 0x70, 0x44, 0x55, 0xf5, 0x7a, 0xa6, 0x7b, 0xba, 0xe7, 0x63, 0x23, 0x47, 0xe2,
+	0x62, 0x77, 0x55, 0xbd, 0x8f, 0xdf, 0x7b, 0xf5, 0xde, 0xab, 0x7a, 0x35, 0xb0, 0xe0, 0x46, 0x8e,
+	0x19, 0x51, 0x7e, 0x60, 0x1e, 0x6e, 0x99, 0xef, 0x36, 0x59, 0x78, 0x5c, 0x6a, 0x84, 0x41, 0x14,
+	0x90, 0x73, 0x6e, 0xe4, 0x94, 0xc4, 0x42, 0xe9, 0x70, 0x4b, 0x9f, 0xa5, 0x75, 0xcf, 0x0f, 0x4c,
+	0xf9, 0x57, 0xad, 0xeb, 0x4b, 0x4e, 0xc0, 0xeb, 0x01, 0xb7, 0xe5, 0xc8, 0x54, 0x03, 0x5c, 0xda,
+	0x50, 0x23, 0xb3, 0x4c, 0x39, 0x53, 0x32, 0xcd, 0xc3, 0xad, 0x32, 0x8b, 0xe8, 0x96, 0xd9, 0xa0,
+	0x15, 0xcf, 0xa7, 0x91, 0x17, 0xf8, 0x48, 0x5b, 0x48, 0xd2, 0xc6, 0x54, 0x4e, 0xe0, 0xb5, 0xd7,
+	0x93, 0xf8, 0x9c, 0x1a, 0xf5, 0xea, 0x76, 0xc8, 0x9c, 0x20, 0x74, 0x71, 0x7d, 0x31, 0xb9, 0x5e,
+	0x67, 0xe1, 0x41, 0x8d, 0xe5, 0xad, 0x34, 0x68, 0x48, 0xeb, 0x3c, 0x6f, 0x85, 0x47, 0x21, 0xa3,
+	0x75, 0x5c, 0xb9, 0x98, 0x5c, 0x91, 0xc6, 0xab, 0xf9, 0xf9, 0x4a, 0x50, 0x09, 0x94, 0xa5, 0xe2,
+	0x0b, 0x67, 0x57, 0x2a, 0x41, 0x50, 0xa9, 0x31, 0x93, 0x36, 0x3c, 0x93, 0xfa, 0x7e, 0x10, 0x49,
+	0xc3, 0x50, 0x8b, 0x31, 0x0f, 0xe4, 0x6d, 0x61, 0xfb, 0x9e, 0x54, 0x6d, 0xb1, 0x77, 0x9b, 0x8c,
+	0x47, 0xc6, 0x5b, 0x30, 0x97, 0x9a, 0xe5, 0x8d, 0xc0, 0xe7, 0x8c, 0x6c, 0xc3, 0xb8, 0x82, 0xb8,
+	0xa8, 0x5d, 0xd6, 0x8a, 0xe7, 0x6e, 0xce, 0x95, 0x12, 0xee, 0x2f, 0x29, 0xe2, 0x9d, 0xa9, 0x4f,
+	0x3f, 0x5f, 0x3d, 0xf3, 0xdb, 0xff, 0x7c, 0xb2, 0xa1, 0x59, 0x48, 0x6d, 0x7c, 0x15, 0x74, 0x29,
+	0xee, 0x3e, 0x8b, 0x76, 0x85, 0x73, 0x2c, 0xe9, 0x1b, 0x54, 0x46, 0x2e, 0x01, 0x28, 0x97, 0x55,
+	0x29, 0xaf, 0x4a, 0xc9, 0x53, 0xd6, 0x94, 0x9c, 0x79, 0x93, 0xf2, 0xaa, 0xf1, 0x5d, 0x58, 0xce,
+	0x65, 0x46, 0x4c, 0x77, 0xe1, 0x7c, 0xd2, 0xe1, 0x88, 0x6c, 0x31, 0x85, 0x2c, 0xc1, 0xb7, 0x33,
+	0x26, 0xe0, 0x59, 0xe7, 0x9c, 0xce, 0x94, 0xe1, 0x22, 0xbc, 0xbb, 0xb5, 0x5a, 0x0e, 0xbc, 0xaf,
+	0x03, 0x74, 0xe2, 0x01, 0xc5, 0x5f, 0x2d, 0x61, 0x28, 0x89, 0x80, 0x28, 0xa9, 0x80, 0xc4, 0xb0,
+	0x28, 0xed, 0xd1, 0x0a, 0x43, 0x5e, 0x2b, 0xc1, 0x69, 0xfc, 0x4e, 0x83, 0xe5, 0x5c, 0x35, 0x3d,
+	0x0d, 0x19, 0xfd, 0x82, 0x86, 0x90, 0xfb, 0x29, 0xa8, 0x23, 0x12, 0xea, 0xfa, 0x40, 0xa8, 0x4a,
+	0x7f, 0x0a, 0x6b, 0x09, 0xf7, 0xff, 0x3e, 0x8b, 0x1e, 0x53, 0x7e, 0x10, 0xbb, 0x62, 0x01, 0x26,
+	0x04, 0x12, 0xdb, 0x73, 0x71, 0x9b, 0xc6, 0xc5, 0xf0, 0x81, 0x6b, 0xec, 0xc2, 0x7c, 0x9a, 0x1e,
+	0x6d, 0xda, 0x84, 0x31, 0x41, 0x81, 0x5e, 0x9b, 0x4d, 0xd9, 0x22, 0x08, 0xd1, 0x08, 0x49, 0x64,
+	0x7c, 0x07, 0x95, 0xde, 0xad, 0xd5, 0x92, 0x4a, 0x4f, 0xcb, 0xff, 0x3f, 0xd5, 0x60, 0x3e, 0x2d,
+	0x3f, 0x03, 0x72, 0x74, 0x20, 0xc8, 0xd3, 0x73, 0xf1, 0x03, 0x0c, 0xba, 0x77, 0x38, 0x0b, 0xe5,
+	0xb6, 0xee, 0x06, 0x4d, 0x3f, 0x1a, 0xe4, 0x69, 0x42, 0x60, 0xac, 0xc9, 0x59, 0x28, 0x35, 0x4f,
+	0x59, 0xf2, 0xdb, 0xf8, 0x16, 0x2c, 0xe7, 0x8a, 0x42, 0xfb, 0xe6, 0xe1, 0xac, 0x23, 0x26, 0xa4,
+	0xa4, 0x31, 0x4b, 0x0d, 0xc8, 0x1a, 0x4c, 0x37, 0x58, 0x68, 0x0b, 0x01, 0x76, 0xcd, 0xab, 0x7b,
+	0x91, 0x14, 0x39, 0x66, 0x9d, 0x6f, 0xb0, 0x50, 0x08, 0x7a, 0x28, 0xe6, 0x8c, 0x2d, 0xb8, 0x28,
+	0x45, 0x0b, 0x3f, 0xbc, 0xc1, 0x9d, 0x30, 0x38, 0x1a, 0x18, 0x0b, 0x7b, 0xb0, 0x90, 0x61, 0x41,
+	0x24, 0xaf, 0xc1, 0x38, 0x93, 0x33, 0xb8, 0x8d, 0x0b, 0x19, 0x5f, 0x2b, 0x06, 0xf4, 0x38, 0x12,
+	0x1b, 0xdf, 0x87, 0x15, 0x29, 0xf1, 0xa1, 0xc7, 0x55, 0x09, 0xe0, 0x3b, 0xd2, 0xd6, 0x18, 0x4a,
+	0xec, 0x13, 0xad, 0xe3, 0x93, 0xae, 0xa8, 0x19, 0x79, 0xee, 0xa8, 0x79, 0x3f, 0x47, 0xf7, 0x30,
+	0x29, 0x71, 0x6a, 0x00, 0x3e, 0xd0, 0x60, 0x35, 0x83, 0x60, 0x37, 0x64, 0x34, 0x0a, 0xda, 0x0e,
+	0x58, 0x84, 0x09, 0x47, 0xcd, 0x20, 0x88, 0x78, 0x78, 0x6a, 0x28, 0x7e, 0xa3, 0xc1, 0x42, 0x17,
+	0x8a, 0xff, 0xcb, 0xc2, 0xb5, 0x8d, 0xa9, 0xf0, 0x38, 0x88, 0x68, 0x6d, 0x8f, 0x7a, 0xee, 0x70,
+	0xbb, 0x65, 0x6c, 0x65, 0xf9, 0x06, 0x44, 0x98, 0x71, 0x07, 0x0a, 0xdd, 0x2c, 0xc3, 0x6e, 0x8b,
+	0xf1, 0x03, 0x0d, 0x2e, 0xa6, 0x99, 0xdb, 0xde, 0xdc, 0x87, 0xb3, 0x91, 0x98, 0x44, 0x37, 0x2e,
+	0xa5, 0xbc, 0x10, 0xdb, 0xbf, 0x1b, 0x78, 0xfe, 0xce, 0x6b, 0xc2, 0x8f, 0x1f, 0xff, 0x73, 0xb5,
+	0x58, 0xf1, 0xa2, 0x6a, 0xb3, 0x5c, 0x72, 0x82, 0x3a, 0xde, 0x70, 0xf0, 0xdf, 0x0d, 0xee, 0x1e,
+	0x98, 0xd1, 0x71, 0x83, 0x71, 0xc9, 0xc0, 0xd5, 0xa1, 0xac, 0xc4, 0x1b, 0xef, 0xa0, 0xc5, 0x7b,
+	0xf4, 0x38, 0x68, 0x46, 0x77, 0x6b, 0xb5, 0xe0, 0x88, 0xfa, 0x4e, 0xbc, 0xf9, 0x64, 0x05, 0xa6,
+	0x42, 0xe6, 0x78, 0x0d, 0x8f, 0x61, 0xe1, 0x98, 0xb2, 0x3a, 0x13, 0x49, 0xcb, 0x46, 0xd2, 0x96,
+	0xfd, 0x77, 0x14, 0x56, 0xf2, 0xe5, 0xa2, 0x7d, 0x3f, 0xd4, 0x60, 0xae, 0x2d, 0xc8, 0x0e, 0x59,
+	0x9d, 0x7a, 0xbe, 0xe7, 0x57, 0x5e, 0x98, 0xb9, 0xa4, 0xad, 0xcc, 0x8a, 0x75, 0x91, 0x16, 0xcc,
+	0x22, 0xde, 0x04, 0x80, 0x91, 0x17, 0x04, 0xe0, 0x82, 0x13, 0x47, 0x45, 0xac, 0xfe, 0x18, 0x66,
+	0xca, 0xb5, 0xc0, 0x39, 0x48, 0x28, 0x1f, 0x7d, 0x41, 0xca, 0xa7, 0xa5, 0xa2, 0x8e, 0xea, 0x12,
+	0xcc, 0x1d, 0x79, 0xbe, 0x1b, 0x1c, 0xd9, 0x3c, 0xa2, 0x61, 0x64, 0x57, 0x99, 0x57, 0xa9, 0x46,
+	0x8b, 0x63, 0x97, 0xb5, 0xe2, 0xa8, 0x35, 0xab, 0x96, 0x1e, 0x89, 0x95, 0x37, 0xe5, 0x02, 0xd9,
+	0x80, 0xd9, 0x72, 0xd3, 0x39, 0x60, 0x91, 0xcd, 0x7c, 0x37, 0xa6, 0x3e, 0x2b, 0xa9, 0x67, 0xd4,
+	0xc2, 0x1b, 0xbe, 0xab, 0x68, 0xc5, 0x01, 0xbb, 0x26, 0xb7, 0xde, 0x62, 0x47, 0x34, 0x74, 0x1f,
+	0xc9, 0x2b, 0x2b, 0xdf, 0x39, 0xb6, 0x3a, 0x1b, 0x30, 0x4c, 0x6c, 0x9d, 0x56, 0xc9, 0xfa, 0x8b,
+	0x06, 0x24, 0x89, 0xe4, 0x51, 0x44, 0xa3, 0x26, 0x27, 0x5f, 0x86, 0x71, 0x75, 0x99, 0xc6, 0x33,
+	0x68, 0x29, 0x55, 0xa7, 0x92, 0x0c, 0xf1, 0x29, 0xa4, 0xc8, 0x05, 0xe3, 0x21, 0xe3, 0x11, 0x73,
+	0x11, 0x53, 0x9f, 0xcd, 0x42, 0x46, 0x45, 0x4e, 0x76, 0xe1, 0xfc, 0x91, 0x17, 0x55, 0xdd, 0x90,
+	0x1e, 0xd1, 0x72, 0x8d, 0x2d, 0x8e, 0x0e, 0xc7, 0x9e, 0x62, 0x32, 0xfe, 0xa0, 0xc1, 0x2b, 0x03,
+	0x9c, 0x8b, 0x09, 0xf6, 0x35, 0x98, 0x50, 0x88, 0x39, 0xe6, 0xd4, 0x6a, 0x4f, 0x0b, 0x95, 0x4b,
+	0x50, 0x5f, 0xcc, 0x75, 0x7a, 0xc5, 0xf8, 0x0e, 0x2c, 0xa9, 0x52, 0xc0, 0x7c, 0xd7, 0xf3, 0x2b,
+	0xaa, 0x22, 0x0c, 0x79, 0xeb, 0x67, 0xa0, 0xe7, 0xf1, 0xa2, 0x8d, 0xf7, 0xc5, 0xe5, 0x45, 0x2e,
+	0xd8, 0x0d, 0xb9, 0x82, 0x9b, 0xa9, 0xa7, 0x1b, 0x92, 0x24, 0x2f, 0x5a, 0xf9, 0x52, 0x23, 0x39,
+	0x69, 0x7c, 0x05, 0xab, 0xd5, 0x6e, 0xc8, 0x5c, 0x2f, 0xb2, 0x58, 0x83, 0x1e, 0x3f, 0xaa, 0xd2,
+	0x90, 0x25, 0x4a, 0x38, 0x75, 0xdd, 0x90, 0x71, 0x1e, 0x97, 0x70, 0x1c, 0x1a, 0x4f, 0x46, 0xe0,
+	0x52, 0x0f, 0x56, 0x04, 0x69, 0xc1, 0x64, 0x25, 0x38, 0x64, 0xa1, 0xcf, 0xf0, 0xb4, 0xd9, 0xd9,
+	0x16, 0x10, 0xfe, 0xf1, 0xf9, 0xea, 0xb2, 0x72, 0x26, 0x77, 0x0f, 0x4a, 0x5e, 0x60, 0xd6, 0x69,
+	0x54, 0x2d, 0x3d, 0x64, 0x15, 0xea, 0x1c, 0xdf, 0x63, 0xce, 0x5f, 0xff, 0x74, 0x03, 0xd0, 0xd7,
+	0xf7, 0x98, 0xa3, 0xb2, 0xb8, 0x2d, 0x87, 0xbc, 0x0d, 0x93, 0x41, 0x23, 0x62, 0xae, 0xed, 0xa9,
+	0x9d, 0x79, 0x7e, 0x99, 0x13, 0x52, 0xce, 0x03, 0x9f, 0x3c, 0x86, 0x29, 0xb6, 0xbf, 0xcf, 0x9c,
+	0xc8, 0x3b, 0x54, 0xb1, 0xf9, 0xfc, 0x32, 0x3b, 0x82, 0x8c, 0x6f, 0xe2, 0xe9, 0xf8, 0x96, 0x6c,
+	0x76, 0xef, 0x79, 0x3c, 0x0a, 0xbd, 0x72, 0x53, 0x84, 0xc5, 0xc0, 0x9b, 0xd3, 0x3c, 0x9c, 0x65,
+	0x8d, 0xc0, 0xa9, 0xe2, 0x85, 0x54, 0x0d, 0x8c, 0x1a, 0xac, 0xf6, 0x14, 0x88, 0x0e, 0x7f, 0x00,
+	0xe7, 0xdd, 0xc4, 0x3c, 0xc6, 0x44, 0x3a, 0xfc, 0xb3, 0xec, 0x71, 0xba, 0x25, 0x59, 0x0d, 0x17,
+	0x37, 0x57, 0x91, 0x3f, 0x64, 0x74, 0x5f, 0xde, 0x60, 0x98, 0xfb, 0x7c, 0xe8, 0xc5, 0xac, 0xe7,
+	0xbb, 0xec, 0x3d, 0xe9, 0xe0, 0x31, 0x4b, 0x0d, 0xda, 0x57, 0x88, 0x1c, 0x2d, 0x68, 0x92, 0x38,
+	0x68, 0xd5, 0x94, 0x54, 0x33, 0x69, 0xc5, 0x43, 0x63, 0x05, 0x13, 0x44, 0x5c, 0x6f, 0xf6, 0x44,
+	0x08, 0x3a, 0x34, 0x62, 0xed, 0x06, 0xfe, 0x16, 0x2c, 0xe7, 0xae, 0x76, 0x5a, 0x02, 0x79, 0x54,
+	0xc8, 0x0a, 0x31, 0x65, 0xa9, 0x81, 0x61, 0xa1, 0xd1, 0xdf, 0xa0, 0x62, 0x0b, 0x25, 0x14, 0x55,
+	0x21, 0x06, 0x1a, 0x9d, 0x48, 0x93, 0x91, 0x74, 0x9a, 0xbc, 0x0f, 0x85, 0x5e, 0x32, 0x11, 0xcb,
+	0x3a, 0xcc, 0x30, 0x3f, 0x0c, 0x6a, 0x35, 0xd6, 0x3e, 0x60, 0x34, 0x79, 0xc0, 0x4c, 0xc7, 0xd3,
+	0x78, 0x16, 0xad, 0xc0, 0x14, 0xa7, 0x91, 0xc7, 0xf7, 0x3d, 0xac, 0xc1, 0x93, 0x56, 0x67, 0x82,
+	0x5c, 0x84, 0xf1, 0x90, 0x51, 0x1e, 0xf8, 0x2a, 0x86, 0x2d, 0x1c, 0xdd, 0xfc, 0xf3, 0x22, 0x9c,
+	0x95, 0x08, 0x48, 0x15, 0xc6, 0xd5, 0x13, 0x05, 0x49, 0x87, 0x44, 0xf6, 0xfd, 0x43, 0xbf, 0xdc,
+	0x9b, 0x40, 0xa1, 0x36, 0x96, 0x7f, 0xf4, 0xb7, 0x7f, 0x7f, 0x34, 0xf2, 0x25, 0x32, 0x67, 0x66,
+	0x1f, 0x70, 0xc8, 0x47, 0x1a, 0x4c, 0xa7, 0x9f, 0x2b, 0xc8, 0x7a, 0x56, 0x62, 0xee, 0x6b, 0x88,
+	0x5e, 0x1c, 0x4c, 0x88, 0x10, 0x4a, 0x12, 0x42, 0x91, 0x5c, 0x35, 0x7b, 0xbd, 0x3e, 0x99, 0x27,
+	0x9d, 0x12, 0xdb, 0x22, 0x3f, 0xd6, 0x60, 0xa6, 0x7d, 0x7d, 0xef, 0x0d, 0x2b, 0xf7, 0x15, 0x44,
+	0x2f, 0x0e, 0x26, 0x44, 0x58, 0x57, 0x24, 0xac, 0x65, 0xb2, 0xd4, 0x13, 0x16, 0xf9, 0x85, 0x06,
+	0x17, 0xba, 0x9b, 0x39, 0x72, 0x2d, 0xab, 0xa1, 0x47, 0xc3, 0xa7, 0xaf, 0xf5, 0x23, 0x6d, 0x03,
+	0x79, 0x55, 0x02, 0xd9, 0x20, 0xc5, 0xde, 0xfe, 0x29, 0x1f, 0xcb, 0x06, 0xd8, 0x3c, 0x11, 0x7f,
+	0x5b, 0xe4, 0x57, 0x5d, 0xb8, 0x44, 0xf6, 0x0c, 0xc2, 0x95, 0x68, 0x2f, 0x86, 0xc4, 0x75, 0x4b,
+	0xe2, 0xba, 0x41, 0x36, 0xfb, 0xe2, 0x92, 0xf3, 0x27, 0x98, 0x6e, 0x2d, 0xf2, 0x6b, 0x0d, 0xe6,
+	0x72, 0x3a, 0x40, 0x72, 0xbd, 0x3f, 0xba, 0x74, 0x47, 0x32, 0x24, 0xc0, 0x6d, 0x09, 0xf0, 0x55,
+	0x52, 0xea, 0x0b, 0x10, 0xaf, 0xb5, 0xe6, 0x09, 0x7e, 0xb4, 0x44, 0xd8, 0xcf, 0x74, 0x35, 0x5e,
+	0x24, 0x27, 0x6e, 0xf2, 0x7b, 0x33, 0xfd, 0xe5, 0x3e, 0x94, 0x6d, 0x68, 0x5b, 0x12, 0xda, 0x26,
+	0xb9, 0x96, 0x82, 0x26, 0x3b, 0x1a, 0xbb, 0x41, 0xbd, 0x3c, 0xcf, 0x7d, 0x98, 0x46, 0x25, 0x63,
+	0xad, 0x3f, 0xaa, 0x64, 0xa8, 0x0d, 0x85, 0x2a, 0x3f, 0x13, 0xd3, 0xa8, 0x92, 0x71, 0xf6, 0x44,
+	0x03, 0x92, 0x6d, 0x1b, 0xc9, 0x66, 0x5f, 0x54, 0x5d, 0x5b, 0x39, 0x14, 0xb0, 0xdb, 0x12, 0x58,
+	0x89, 0x5c, 0xef, 0x03, 0x2c, 0xbb, 0x8f, 0x3f, 0xd3, 0x60, 0xa6, 0xab, 0x7d, 0xcb, 0xf3, 0x58,
+	0x7e, 0xe7, 0xa8, 0x5f, 0x1b, 0x82, 0x12, 0xe1, 0xbd, 0x22, 0xe1, 0xad, 0x92, 0x4b, 0x5d, 0x45,
+	0x54, 0x50, 0xdb, 0xb4, 0xad, 0x9b, 0xc3, 0x04, 0x3e, 0x2c, 0x92, 0xcb, 0xb9, 0xd5, 0x31, 0x19,
+	0x46, 0x57, 0xfa, 0x50, 0xa0, 0xda, 0x97, 0xa5, 0xda, 0x4b, 0x64, 0xd9, 0xec, 0x7e, 0x48, 0x4f,
+	0x84, 0xcd, 0xf7, 0x60, 0x52, 0xa4, 0x46, 0x2f, 0xad, 0xe9, 0x47, 0x4a, 0xfd, 0x4a, 0x1f, 0x0a,
+	0xd4, 0xba, 0x24, 0xb5, 0xce, 0x91, 0xd9, 0x8c, 0x56, 0xf2, 0x81, 0x06, 0xd0, 0x79, 0xfd, 0x22,
+	0x79, 0x5b, 0xdb, 0xfd, 0xfe, 0xa6, 0xaf, 0xf5, 0x27, 0x42, 0xa5, 0x1b, 0x52, 0xe9, 0x1a, 0x31,
+	0xfa, 0x98, 0x6a, 0xaa, 0x67, 0x36, 0xf2, 0x4b, 0x0d, 0xa6, 0xd3, 0x4f, 0x88, 0x79, 0xc7, 0x43,
+	0xee, 0x7b, 0xa5, 0x5e, 0x1c, 0x4c, 0xd8, 0x3f, 0x83, 0xd3, 0x88, 0x64, 0xad, 0xe1, 0x71, 0xba,
+	0xfc, 0x51, 0x83, 0xc5, 0x5e, 0x6d, 0x0f, 0xd9, 0xca, 0x6a, 0x1e, 0xd0, 0x7f, 0xea, 0x37, 0xbf,
+	0x08, 0x0b, 0xc2, 0x36, 0x25, 0xec, 0x6b, 0x64, 0x3d, 0x05, 0x3b, 0x94, 0x6c, 0x36, 0x76, 0x4e,
+	0xe6, 0x49, 0xbb, 0x8b, 0x6d, 0x91, 0x9f, 0x6b, 0xf0, 0x52, 0xaa, 0x01, 0x21, 0x57, 0x73, 0x12,
+	0x23, 0xa7, 0x33, 0xd2, 0xd7, 0x07, 0xd2, 0xf5, 0x3d, 0xe0, 0xd2, 0x8d, 0x51, 0xfa, 0x0a, 0xf0,
+	0x44, 0x83, 0x0b, 0xdd, 0xfd, 0x4a, 0xde, 0x01, 0xd7, 0xa3, 0x1d, 0xd2, 0x37, 0x86, 0x21, 0xed,
+	0xbb, 0xd1, 0x8e, 0x24, 0xb7, 0x43, 0x41, 0x6f, 0x73, 0xc1, 0x60, 0x9e, 0xe0, 0x5d, 0xb1, 0x45,
+	0x7e, 0xaf, 0x01, 0xc9, 0x5e, 0xd0, 0xf3, 0xea, 0x62, 0xcf, 0xb6, 0x42, 0xbf, 0x3e, 0x1c, 0x31,
+	0x82, 0xbc, 0x23, 0x41, 0xde, 0x26, 0x37, 0xcd, 0xec, 0x2f, 0x74, 0x76, 0xb2, 0x23, 0x48, 0x04,
+	0xe7, 0x89, 0xbc, 0xd2, 0xb7, 0xc8, 0x27, 0x1a, 0xcc, 0x66, 0x6e, 0xee, 0x64, 0xa3, 0x97, 0xfe,
+	0x6c, 0x13, 0xa1, 0x6f, 0x0e, 0x45, 0x8b, 0x50, 0x77, 0x24, 0xd4, 0xd7, 0xc9, 0x9d, 0x3c, 0xa8,
+	0x35, 0x46, 0xf7, 0x6d, 0x6c, 0x0d, 0xb2, 0x50, 0xcd, 0x13, 0xd9, 0x6f, 0xb4, 0xc8, 0x4f, 0x34,
+	0x98, 0x4e, 0xb7, 0x04, 0x79, 0x29, 0x9e, 0xdb, 0x52, 0xe8, 0xc5, 0xc1, 0x84, 0x88, 0x74, 0x4d,
+	0x22, 0x2d, 0x90, 0x95, 0x4c, 0x8a, 0xdb, 0x8d, 0x8e, 0xe2, 0x8f, 0x35, 0x98, 0xcd, 0x74, 0x05,
+	0x79, 0xee, 0xeb, 0xd5, 0x8e, 0xe8, 0x9b, 0x43, 0xd1, 0x22, 0xa8, 0xd7, 0x25, 0xa8, 0x6d, 0x72,
+	0x3b, 0x05, 0xca, 0x97, 0xf4, 0xca, 0x73, 0xe2, 0x49, 0x2c, 0x6a, 0xf2, 0xa4, 0xfb, 0xe2, 0xc8,
+	0xdc, 0xd9, 0xf8, 0xf4, 0x69, 0x41, 0xfb, 0xec, 0x69, 0x41, 0xfb, 0xd7, 0xd3, 0x82, 0xf6, 0xe1,
+	0xb3, 0xc2, 0x99, 0xcf, 0x9e, 0x15, 0xce, 0xfc, 0xfd, 0x59, 0xe1, 0xcc, 0xb7, 0x2f, 0x08, 0x71,
+	0xef, 0x29, 0x81, 0xb2, 0x8d, 0x2a, 0x8f, 0xcb, 0x5f, 0x56, 0x6f, 0xfd, 0x6f, 0x00, 0xfd, 0x91,
+	0xd2, 0x59, 0xb5, 0x1e, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// ListClaimRecord Queries a list of ClaimRecord items.
+	GetClaimRecord(ctx context.Context, in *QueryGetClaimRecordRequest, opts ...grpc.CallOption) (*QueryGetClaimRecordResponse, error)
+	// ListClaimRecord defines the ListClaimRecord RPC.
+	ListClaimRecord(ctx context.Context, in *QueryAllClaimRecordRequest, opts ...grpc.CallOption) (*QueryAllClaimRecordResponse, error)
+	// ListClaimsByUser queries the claim records of a recipient.
+	ListClaimsByUser(ctx context.Context, in *QueryListClaimsByUserRequest, opts ...grpc.CallOption) (*QueryListClaimsResponse, error)
+	// ListClaimsByTask queries the claim records of a task.
+	ListClaimsByTask(ctx context.Context, in *QueryListClaimsByTaskRequest, opts ...grpc.CallOption) (*QueryListClaimsResponse, error)
+	// ListClaimsByCreator queries the claim records submitted by a creator.
+	ListClaimsByCreator(ctx context.Context, in *QueryListClaimsByCreatorRequest, opts ...grpc.CallOption) (*QueryListClaimsResponse, error)
+	// TotalPaidByTask queries the total amount paid out by a task.
+	TotalPaidByTask(ctx context.Context, in *QueryTotalPaidByTaskRequest, opts ...grpc.CallOption) (*QueryTotalPaidResponse, error)
+	// TotalPaidByUser queries the total amount paid to a recipient.
+	TotalPaidByUser(ctx context.Context, in *QueryTotalPaidByUserRequest, opts ...grpc.CallOption) (*QueryTotalPaidResponse, error)
+	// TotalPaidByCreator queries the total amount paid through a creator.
+	TotalPaidByCreator(ctx context.Context, in *QueryTotalPaidByCreatorRequest, opts ...grpc.CallOption) (*QueryTotalPaidResponse, error)
+	// PayoutAllowance queries the remaining payout allowances for a recipient and a creator.
+	PayoutAllowance(ctx context.Context, in *QueryPayoutAllowanceRequest, opts ...grpc.CallOption) (*QueryPayoutAllowanceResponse, error)
+	// GetTask queries a task by id.
+	GetTask(ctx context.Context, in *QueryGetTaskRequest, opts ...grpc.CallOption) (*QueryGetTaskResponse, error)
+	// ListTask queries all tasks.
+	ListTask(ctx context.Context, in *QueryAllTaskRequest, opts ...grpc.CallOption) (*QueryAllTaskResponse, error)
+	// TaskEscrow queries the escrow held for a task.
+	TaskEscrow(ctx context.Context, in *QueryTaskEscrowRequest, opts ...grpc.CallOption) (*QueryTaskEscrowResponse, error)
+	// UserClaimCount queries how many times a user has claimed a task.
+	UserClaimCount(ctx context.Context, in *QueryUserClaimCountRequest, opts ...grpc.CallOption) (*QueryUserClaimCountResponse, error)
+	// RewardStreamsByRecipient queries the reward streams of a recipient with their vested amounts.
+	RewardStreamsByRecipient(ctx context.Context, in *QueryRewardStreamsByRecipientRequest, opts ...grpc.CallOption) (*QueryRewardStreamsByRecipientResponse, error)
+	// PendingPayout queries a payout waiting for its dispute window to close.
+	PendingPayout(ctx context.Context, in *QueryPendingPayoutRequest, opts ...grpc.CallOption) (*QueryPendingPayoutResponse, error)
+	// CreditRepayShare queries the share of an address's rewards used to repay its credit liability.
+	CreditRepayShare(ctx context.Context, in *QueryCreditRepayShareRequest, opts ...grpc.CallOption) (*QueryCreditRepayShareResponse, error)
+	// MerkleDistribution queries the Merkle root posted for a task epoch.
+	MerkleDistribution(ctx context.Context, in *QueryMerkleDistributionRequest, opts ...grpc.CallOption) (*QueryMerkleDistributionResponse, error)
+	// MerkleLeafClaimed queries whether a leaf of a task epoch has been claimed.
+	MerkleLeafClaimed(ctx context.Context, in *QueryMerkleLeafClaimedRequest, opts ...grpc.CallOption) (*QueryMerkleLeafClaimedResponse, error)
+	// TaskPredicates queries the registered native task predicate types.
+	TaskPredicates(ctx context.Context, in *QueryTaskPredicatesRequest, opts ...grpc.CallOption) (*QueryTaskPredicatesResponse, error)
+	// NativeClaimStatus queries whether an address satisfies a native task predicate.
+	NativeClaimStatus(ctx context.Context, in *QueryNativeClaimStatusRequest, opts ...grpc.CallOption) (*QueryNativeClaimStatusResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetClaimRecord(ctx context.Context, in *QueryGetClaimRecordRequest, opts ...grpc.CallOption) (*QueryGetClaimRecordResponse, error) {
+	out := new(QueryGetClaimRecordResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/GetClaimRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListClaimRecord(ctx context.Context, in *QueryAllClaimRecordRequest, opts ...grpc.CallOption) (*QueryAllClaimRecordResponse, error) {
+	out := new(QueryAllClaimRecordResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/ListClaimRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListClaimsByUser(ctx context.Context, in *QueryListClaimsByUserRequest, opts ...grpc.CallOption) (*QueryListClaimsResponse, error) {
+	out := new(QueryListClaimsResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/ListClaimsByUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListClaimsByTask(ctx context.Context, in *QueryListClaimsByTaskRequest, opts ...grpc.CallOption) (*QueryListClaimsResponse, error) {
+	out := new(QueryListClaimsResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/ListClaimsByTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListClaimsByCreator(ctx context.Context, in *QueryListClaimsByCreatorRequest, opts ...grpc.CallOption) (*QueryListClaimsResponse, error) {
+	out := new(QueryListClaimsResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/ListClaimsByCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalPaidByTask(ctx context.Context, in *QueryTotalPaidByTaskRequest, opts ...grpc.CallOption) (*QueryTotalPaidResponse, error) {
+	out := new(QueryTotalPaidResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/TotalPaidByTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalPaidByUser(ctx context.Context, in *QueryTotalPaidByUserRequest, opts ...grpc.CallOption) (*QueryTotalPaidResponse, error) {
+	out := new(QueryTotalPaidResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/TotalPaidByUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TotalPaidByCreator(ctx context.Context, in *QueryTotalPaidByCreatorRequest, opts ...grpc.CallOption) (*QueryTotalPaidResponse, error) {
+	out := new(QueryTotalPaidResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/TotalPaidByCreator", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PayoutAllowance(ctx context.Context, in *QueryPayoutAllowanceRequest, opts ...grpc.CallOption) (*QueryPayoutAllowanceResponse, error) {
+	out := new(QueryPayoutAllowanceResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/PayoutAllowance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) GetTask(ctx context.Context, in *QueryGetTaskRequest, opts ...grpc.CallOption) (*QueryGetTaskResponse, error) {
+	out := new(QueryGetTaskResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/GetTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ListTask(ctx context.Context, in *QueryAllTaskRequest, opts ...grpc.CallOption) (*QueryAllTaskResponse, error) {
+	out := new(QueryAllTaskResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/ListTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TaskEscrow(ctx context.Context, in *QueryTaskEscrowRequest, opts ...grpc.CallOption) (*QueryTaskEscrowResponse, error) {
+	out := new(QueryTaskEscrowResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/TaskEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UserClaimCount(ctx context.Context, in *QueryUserClaimCountRequest, opts ...grpc.CallOption) (*QueryUserClaimCountResponse, error) {
+	out := new(QueryUserClaimCountResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/UserClaimCount", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RewardStreamsByRecipient(ctx context.Context, in *QueryRewardStreamsByRecipientRequest, opts ...grpc.CallOption) (*QueryRewardStreamsByRecipientResponse, error) {
+	out := new(QueryRewardStreamsByRecipientResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/RewardStreamsByRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingPayout(ctx context.Context, in *QueryPendingPayoutRequest, opts ...grpc.CallOption) (*QueryPendingPayoutResponse, error) {
+	out := new(QueryPendingPayoutResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/PendingPayout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CreditRepayShare(ctx context.Context, in *QueryCreditRepayShareRequest, opts ...grpc.CallOption) (*QueryCreditRepayShareResponse, error) {
+	out := new(QueryCreditRepayShareResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/CreditRepayShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MerkleDistribution(ctx context.Context, in *QueryMerkleDistributionRequest, opts ...grpc.CallOption) (*QueryMerkleDistributionResponse, error) {
+	out := new(QueryMerkleDistributionResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/MerkleDistribution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MerkleLeafClaimed(ctx context.Context, in *QueryMerkleLeafClaimedRequest, opts ...grpc.CallOption) (*QueryMerkleLeafClaimedResponse, error) {
+	out := new(QueryMerkleLeafClaimedResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/MerkleLeafClaimed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) TaskPredicates(ctx context.Context, in *QueryTaskPredicatesRequest, opts ...grpc.CallOption) (*QueryTaskPredicatesResponse, error) {
+	out := new(QueryTaskPredicatesResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/TaskPredicates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) NativeClaimStatus(ctx context.Context, in *QueryNativeClaimStatusRequest, opts ...grpc.CallOption) (*QueryNativeClaimStatusResponse, error) {
+	out := new(QueryNativeClaimStatusResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Query/NativeClaimStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// ListClaimRecord Queries a list of ClaimRecord items.
+	GetClaimRecord(context.Context, *QueryGetClaimRecordRequest) (*QueryGetClaimRecordResponse, error)
+	// ListClaimRecord defines the ListClaimRecord RPC.
+	ListClaimRecord(context.Context, *QueryAllClaimRecordRequest) (*QueryAllClaimRecordResponse, error)
+	// ListClaimsByUser queries the claim records of a recipient.
+	ListClaimsByUser(context.Context, *QueryListClaimsByUserRequest) (*QueryListClaimsResponse, error)
+	// ListClaimsByTask queries the claim records of a task.
+	ListClaimsByTask(context.Context, *QueryListClaimsByTaskRequest) (*QueryListClaimsResponse, error)
+	// ListClaimsByCreator queries the claim records submitted by a creator.
+	ListClaimsByCreator(context.Context, *QueryListClaimsByCreatorRequest) (*QueryListClaimsResponse, error)
+	// TotalPaidByTask queries the total amount paid out by a task.
+	TotalPaidByTask(context.Context, *QueryTotalPaidByTaskRequest) (*QueryTotalPaidResponse, error)
+	// TotalPaidByUser queries the total amount paid to a recipient.
+	TotalPaidByUser(context.Context, *QueryTotalPaidByUserRequest) (*QueryTotalPaidResponse, error)
+	// TotalPaidByCreator queries the total amount paid through a creator.
+	TotalPaidByCreator(context.Context, *QueryTotalPaidByCreatorRequest) (*QueryTotalPaidResponse, error)
+	// PayoutAllowance queries the remaining payout allowances for a recipient and a creator.
+	PayoutAllowance(context.Context, *QueryPayoutAllowanceRequest) (*QueryPayoutAllowanceResponse, error)
+	// GetTask queries a task by id.
+	GetTask(context.Context, *QueryGetTaskRequest) (*QueryGetTaskResponse, error)
+	// ListTask queries all tasks.
+	ListTask(context.Context, *QueryAllTaskRequest) (*QueryAllTaskResponse, error)
+	// TaskEscrow queries the escrow held for a task.
+	TaskEscrow(context.Context, *QueryTaskEscrowRequest) (*QueryTaskEscrowResponse, error)
+	// UserClaimCount queries how many times a user has claimed a task.
+	UserClaimCount(context.Context, *QueryUserClaimCountRequest) (*QueryUserClaimCountResponse, error)
+	// RewardStreamsByRecipient queries the reward streams of a recipient with their vested amounts.
+	RewardStreamsByRecipient(context.Context, *QueryRewardStreamsByRecipientRequest) (*QueryRewardStreamsByRecipientResponse, error)
+	// PendingPayout queries a payout waiting for its dispute window to close.
+	PendingPayout(context.Context, *QueryPendingPayoutRequest) (*QueryPendingPayoutResponse, error)
+	// CreditRepayShare queries the share of an address's rewards used to repay its credit liability.
+	CreditRepayShare(context.Context, *QueryCreditRepayShareRequest) (*QueryCreditRepayShareResponse, error)
+	// MerkleDistribution queries the Merkle root posted for a task epoch.
+	MerkleDistribution(context.Context, *QueryMerkleDistributionRequest) (*QueryMerkleDistributionResponse, error)
+	// MerkleLeafClaimed queries whether a leaf of a task epoch has been claimed.
+	MerkleLeafClaimed(context.Context, *QueryMerkleLeafClaimedRequest) (*QueryMerkleLeafClaimedResponse, error)
+	// TaskPredicates queries the registered native task predicate types.
+	TaskPredicates(context.Context, *QueryTaskPredicatesRequest) (*QueryTaskPredicatesResponse, error)
+	// NativeClaimStatus queries whether an address satisfies a native task predicate.
+	NativeClaimStatus(context.Context, *QueryNativeClaimStatusRequest) (*QueryNativeClaimStatusResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) GetClaimRecord(ctx context.Context, req *QueryGetClaimRecordRequest) (*QueryGetClaimRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetClaimRecord not implemented")
+}
+func (*UnimplementedQueryServer) ListClaimRecord(ctx context.Context, req *QueryAllClaimRecordRequest) (*QueryAllClaimRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClaimRecord not implemented")
+}
+func (*UnimplementedQueryServer) ListClaimsByUser(ctx context.Context, req *QueryListClaimsByUserRequest) (*QueryListClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClaimsByUser not implemented")
+}
+func (*UnimplementedQueryServer) ListClaimsByTask(ctx context.Context, req *QueryListClaimsByTaskRequest) (*QueryListClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClaimsByTask not implemented")
+}
+func (*UnimplementedQueryServer) ListClaimsByCreator(ctx context.Context, req *QueryListClaimsByCreatorRequest) (*QueryListClaimsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClaimsByCreator not implemented")
+}
+func (*UnimplementedQueryServer) TotalPaidByTask(ctx context.Context, req *QueryTotalPaidByTaskRequest) (*QueryTotalPaidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalPaidByTask not implemented")
+}
+func (*UnimplementedQueryServer) TotalPaidByUser(ctx context.Context, req *QueryTotalPaidByUserRequest) (*QueryTotalPaidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalPaidByUser not implemented")
+}
+func (*UnimplementedQueryServer) TotalPaidByCreator(ctx context.Context, req *QueryTotalPaidByCreatorRequest) (*QueryTotalPaidResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TotalPaidByCreator not implemented")
+}
+func (*UnimplementedQueryServer) PayoutAllowance(ctx context.Context, req *QueryPayoutAllowanceRequest) (*QueryPayoutAllowanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PayoutAllowance not implemented")
+}
+func (*UnimplementedQueryServer) GetTask(ctx context.Context, req *QueryGetTaskRequest) (*QueryGetTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTask not implemented")
+}
+func (*UnimplementedQueryServer) ListTask(ctx context.Context, req *QueryAllTaskRequest) (*QueryAllTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTask not implemented")
+}
+func (*UnimplementedQueryServer) TaskEscrow(ctx context.Context, req *QueryTaskEscrowRequest) (*QueryTaskEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaskEscrow not implemented")
+}
+func (*UnimplementedQueryServer) UserClaimCount(ctx context.Context, req *QueryUserClaimCountRequest) (*QueryUserClaimCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserClaimCount not implemented")
+}
+func (*UnimplementedQueryServer) RewardStreamsByRecipient(ctx context.Context, req *QueryRewardStreamsByRecipientRequest) (*QueryRewardStreamsByRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RewardStreamsByRecipient not implemented")
+}
+func (*UnimplementedQueryServer) PendingPayout(ctx context.Context, req *QueryPendingPayoutRequest) (*QueryPendingPayoutResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPayout not implemented")
+}
+func (*UnimplementedQueryServer) CreditRepayShare(ctx context.Context, req *QueryCreditRepayShareRequest) (*QueryCreditRepayShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreditRepayShare not implemented")
+}
+func (*UnimplementedQueryServer) MerkleDistribution(ctx context.Context, req *QueryMerkleDistributionRequest) (*QueryMerkleDistributionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MerkleDistribution not implemented")
+}
+func (*UnimplementedQueryServer) MerkleLeafClaimed(ctx context.Context, req *QueryMerkleLeafClaimedRequest) (*QueryMerkleLeafClaimedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MerkleLeafClaimed not implemented")
+}
+func (*UnimplementedQueryServer) TaskPredicates(ctx context.Context, req *QueryTaskPredicatesRequest) (*QueryTaskPredicatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TaskPredicates not implemented")
+}
+func (*UnimplementedQueryServer) NativeClaimStatus(ctx context.Context, req *QueryNativeClaimStatusRequest) (*QueryNativeClaimStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method NativeClaimStatus not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetClaimRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetClaimRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetClaimRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/GetClaimRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetClaimRecord(ctx, req.(*QueryGetClaimRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListClaimRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllClaimRecordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListClaimRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/ListClaimRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListClaimRecord(ctx, req.(*QueryAllClaimRecordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListClaimsByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListClaimsByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListClaimsByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/ListClaimsByUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListClaimsByUser(ctx, req.(*QueryListClaimsByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListClaimsByTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListClaimsByTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListClaimsByTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/ListClaimsByTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListClaimsByTask(ctx, req.(*QueryListClaimsByTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListClaimsByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryListClaimsByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListClaimsByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/ListClaimsByCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListClaimsByCreator(ctx, req.(*QueryListClaimsByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalPaidByTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalPaidByTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalPaidByTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/TotalPaidByTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalPaidByTask(ctx, req.(*QueryTotalPaidByTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalPaidByUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalPaidByUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalPaidByUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/TotalPaidByUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalPaidByUser(ctx, req.(*QueryTotalPaidByUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TotalPaidByCreator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTotalPaidByCreatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TotalPaidByCreator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/TotalPaidByCreator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TotalPaidByCreator(ctx, req.(*QueryTotalPaidByCreatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PayoutAllowance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPayoutAllowanceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PayoutAllowance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/PayoutAllowance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PayoutAllowance(ctx, req.(*QueryPayoutAllowanceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_GetTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryGetTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).GetTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/GetTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).GetTask(ctx, req.(*QueryGetTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ListTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAllTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ListTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/ListTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ListTask(ctx, req.(*QueryAllTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaskEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaskEscrowRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaskEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/TaskEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaskEscrow(ctx, req.(*QueryTaskEscrowRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UserClaimCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUserClaimCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UserClaimCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/UserClaimCount",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UserClaimCount(ctx, req.(*QueryUserClaimCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RewardStreamsByRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRewardStreamsByRecipientRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RewardStreamsByRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/RewardStreamsByRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RewardStreamsByRecipient(ctx, req.(*QueryRewardStreamsByRecipientRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingPayout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPayoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPayout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/PendingPayout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPayout(ctx, req.(*QueryPendingPayoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CreditRepayShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCreditRepayShareRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CreditRepayShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/CreditRepayShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CreditRepayShare(ctx, req.(*QueryCreditRepayShareRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MerkleDistribution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMerkleDistributionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MerkleDistribution(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/MerkleDistribution",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MerkleDistribution(ctx, req.(*QueryMerkleDistributionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MerkleLeafClaimed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMerkleLeafClaimedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MerkleLeafClaimed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/MerkleLeafClaimed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MerkleLeafClaimed(ctx, req.(*QueryMerkleLeafClaimedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_TaskPredicates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTaskPredicatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TaskPredicates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/TaskPredicates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TaskPredicates(ctx, req.(*QueryTaskPredicatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_NativeClaimStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryNativeClaimStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).NativeClaimStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Query/NativeClaimStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).NativeClaimStatus(ctx, req.(*QueryNativeClaimStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dtc.task.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "GetClaimRecord",
+			Handler:    _Query_GetClaimRecord_Handler,
+		},
+		{
+			MethodName: "ListClaimRecord",
+			Handler:    _Query_ListClaimRecord_Handler,
+		},
+		{
+			MethodName: "ListClaimsByUser",
+			Handler:    _Query_ListClaimsByUser_Handler,
+		},
+		{
+			MethodName: "ListClaimsByTask",
+			Handler:    _Query_ListClaimsByTask_Handler,
+		},
+		{
+			MethodName: "ListClaimsByCreator",
+			Handler:    _Query_ListClaimsByCreator_Handler,
+		},
+		{
+			MethodName: "TotalPaidByTask",
+			Handler:    _Query_TotalPaidByTask_Handler,
+		},
+		{
+			MethodName: "TotalPaidByUser",
+			Handler:    _Query_TotalPaidByUser_Handler,
+		},
+		{
+			MethodName: "TotalPaidByCreator",
+			Handler:    _Query_TotalPaidByCreator_Handler,
+		},
+		{
+			MethodName: "PayoutAllowance",
+			Handler:    _Query_PayoutAllowance_Handler,
+		},
+		{
+			MethodName: "GetTask",
+			Handler:    _Query_GetTask_Handler,
+		},
+		{
+			MethodName: "ListTask",
+			Handler:    _Query_ListTask_Handler,
+		},
+		{
+			MethodName: "TaskEscrow",
+			Handler:    _Query_TaskEscrow_Handler,
+		},
+		{
+			MethodName: "UserClaimCount",
+			Handler:    _Query_UserClaimCount_Handler,
+		},
+		{
+			MethodName: "RewardStreamsByRecipient",
+			Handler:    _Query_RewardStreamsByRecipient_Handler,
+		},
+		{
+			MethodName: "PendingPayout",
+			Handler:    _Query_PendingPayout_Handler,
+		},
+		{
+			MethodName: "CreditRepayShare",
+			Handler:    _Query_CreditRepayShare_Handler,
+		},
+		{
+			MethodName: "MerkleDistribution",
+			Handler:    _Query_MerkleDistribution_Handler,
+		},
+		{
+			MethodName: "MerkleLeafClaimed",
+			Handler:    _Query_MerkleLeafClaimed_Handler,
+		},
+		{
+			MethodName: "TaskPredicates",
+			Handler:    _Query_TaskPredicates_Handler,
+		},
+		{
+			MethodName: "NativeClaimStatus",
+			Handler:    _Query_NativeClaimStatus_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dtc/task/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetClaimRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetClaimRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetClaimRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimHash) > 0 {
+		i -= len(m.ClaimHash)
+		copy(dAtA[i:], m.ClaimHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClaimHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetClaimRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetClaimRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetClaimRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ClaimRecord.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllClaimRecordRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllClaimRecordRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllClaimRecordRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllClaimRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllClaimRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllClaimRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClaimRecord) > 0 {
+		for iNdEx := len(m.ClaimRecord) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimRecord[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryGetTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryGetTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryGetTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAllTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAllTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAllTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Task) > 0 {
+		for iNdEx := len(m.Task) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Task[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserClaimCountRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserClaimCountRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserClaimCountRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUserClaimCountResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUserClaimCountResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUserClaimCountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PerUserLimit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PerUserLimit))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaskEscrowRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaskEscrowRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaskEscrowRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaskEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaskEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaskEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListClaimsByUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListClaimsByUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListClaimsByUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListClaimsByTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListClaimsByTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListClaimsByTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListClaimsByCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListClaimsByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListClaimsByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryListClaimsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryListClaimsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryListClaimsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ClaimRecord) > 0 {
+		for iNdEx := len(m.ClaimRecord) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimRecord[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalPaidByTaskRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalPaidByTaskRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalPaidByTaskRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalPaidByUserRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalPaidByUserRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalPaidByUserRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.User) > 0 {
+		i -= len(m.User)
+		copy(dAtA[i:], m.User)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.User)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalPaidByCreatorRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalPaidByCreatorRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalPaidByCreatorRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTotalPaidResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTotalPaidResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTotalPaidResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for iNdEx := len(m.Total) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Total[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPayoutAllowanceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPayoutAllowanceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPayoutAllowanceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPayoutAllowanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPayoutAllowanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPayoutAllowanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.BucketEndHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BucketEndHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.BlockRemaining) > 0 {
+		for iNdEx := len(m.BlockRemaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockRemaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.CreatorRemaining) > 0 {
+		for iNdEx := len(m.CreatorRemaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CreatorRemaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.RecipientRemaining) > 0 {
+		for iNdEx := len(m.RecipientRemaining) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecipientRemaining[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardStreamsByRecipientRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardStreamsByRecipientRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardStreamsByRecipientRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RewardStreamStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RewardStreamStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RewardStreamStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Withdrawable.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.Vested.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Stream.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRewardStreamsByRecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRewardStreamsByRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRewardStreamsByRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Streams) > 0 {
+		for iNdEx := len(m.Streams) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Streams[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPayoutRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPayoutRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPayoutRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClaimHash) > 0 {
+		i -= len(m.ClaimHash)
+		copy(dAtA[i:], m.ClaimHash)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ClaimHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPayoutResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPayoutResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPayoutResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.PendingPayout.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreditRepayShareRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreditRepayShareRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreditRepayShareRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryCreditRepayShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryCreditRepayShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryCreditRepayShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Effective.Size()
+		i -= size
+		if _, err := m.Effective.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.OptedIn.Size()
+		i -= size
+		if _, err := m.OptedIn.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Governed.Size()
+		i -= size
+		if _, err := m.Governed.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleDistributionRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleDistributionRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleDistributionRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleDistributionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleDistributionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleDistributionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Distribution.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleLeafClaimedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleLeafClaimedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleLeafClaimedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMerkleLeafClaimedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMerkleLeafClaimedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMerkleLeafClaimedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Claimed {
+		i--
+		if m.Claimed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaskPredicatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaskPredicatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaskPredicatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTaskPredicatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTaskPredicatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTaskPredicatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Types) > 0 {
+		for iNdEx := len(m.Types) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Types[iNdEx])
+			copy(dAtA[i:], m.Types[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Types[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNativeClaimStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNativeClaimStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNativeClaimStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryNativeClaimStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryNativeClaimStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryNativeClaimStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Satisfied {
+		i--
+		if m.Satisfied {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EnrolledHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EnrolledHeight))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetClaimRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClaimHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetClaimRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ClaimRecord.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllClaimRecordRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllClaimRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimRecord) > 0 {
+		for _, e := range m.ClaimRecord {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Task.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAllTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAllTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Task) > 0 {
+		for _, e := range m.Task {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserClaimCountRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUserClaimCountResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if m.PerUserLimit != 0 {
+		n += 1 + sovQuery(uint64(m.PerUserLimit))
+	}
+	return n
+}
+
+func (m *QueryTaskEscrowRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTaskEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Escrow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryListClaimsByUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListClaimsByTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListClaimsByCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryListClaimsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ClaimRecord) > 0 {
+		for _, e := range m.ClaimRecord {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalPaidByTaskRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalPaidByUserRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.User)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalPaidByCreatorRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTotalPaidResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Total) > 0 {
+		for _, e := range m.Total {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPayoutAllowanceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPayoutAllowanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RecipientRemaining) > 0 {
+		for _, e := range m.RecipientRemaining {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.CreatorRemaining) > 0 {
+		for _, e := range m.CreatorRemaining {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.BlockRemaining) > 0 {
+		for _, e := range m.BlockRemaining {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovQuery(uint64(m.WindowStartHeight))
+	}
+	if m.BucketEndHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BucketEndHeight))
+	}
+	return n
+}
+
+func (m *QueryRewardStreamsByRecipientRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RewardStreamStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stream.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Vested.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Withdrawable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryRewardStreamsByRecipientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Streams) > 0 {
+		for _, e := range m.Streams {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPayoutRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClaimHash)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingPayoutResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.PendingPayout.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryCreditRepayShareRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryCreditRepayShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Governed.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.OptedIn.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Effective.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMerkleDistributionRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	return n
+}
+
+func (m *QueryMerkleDistributionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Distribution.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMerkleLeafClaimedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovQuery(uint64(m.Epoch))
+	}
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	return n
+}
+
+func (m *QueryMerkleLeafClaimedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Claimed {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryTaskPredicatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryTaskPredicatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Types) > 0 {
+		for _, s := range m.Types {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryNativeClaimStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryNativeClaimStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EnrolledHeight != 0 {
+		n += 1 + sovQuery(uint64(m.EnrolledHeight))
+	}
+	if m.Satisfied {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetClaimRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetClaimRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetClaimRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetClaimRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetClaimRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetClaimRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ClaimRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllClaimRecordRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllClaimRecordRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllClaimRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllClaimRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllClaimRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllClaimRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimRecord = append(m.ClaimRecord, ClaimRecord{})
+			if err := m.ClaimRecord[len(m.ClaimRecord)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryGetTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryGetTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryGetTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAllTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAllTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAllTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Task = append(m.Task, Task{})
+			if err := m.Task[len(m.Task)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserClaimCountRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserClaimCountRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserClaimCountRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUserClaimCountResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUserClaimCountResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUserClaimCountResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerUserLimit", wireType)
+			}
+			m.PerUserLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerUserLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaskEscrowRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaskEscrowRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaskEscrowRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaskEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaskEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaskEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListClaimsByUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListClaimsByUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListClaimsByUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListClaimsByTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListClaimsByTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListClaimsByTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListClaimsByCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListClaimsByCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListClaimsByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryListClaimsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryListClaimsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryListClaimsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecord", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimRecord = append(m.ClaimRecord, ClaimRecord{})
+			if err := m.ClaimRecord[len(m.ClaimRecord)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalPaidByTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalPaidByTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalPaidByTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalPaidByUserRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalPaidByUserRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalPaidByUserRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field User", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.User = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalPaidByCreatorRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalPaidByCreatorRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalPaidByCreatorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTotalPaidResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTotalPaidResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTotalPaidResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Total = append(m.Total, types.Coin{})
+			if err := m.Total[len(m.Total)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPayoutAllowanceRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPayoutAllowanceRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPayoutAllowanceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPayoutAllowanceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPayoutAllowanceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPayoutAllowanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecipientRemaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecipientRemaining = append(m.RecipientRemaining, types.Coin{})
+			if err := m.RecipientRemaining[len(m.RecipientRemaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatorRemaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CreatorRemaining = append(m.CreatorRemaining, types.Coin{})
+			if err := m.CreatorRemaining[len(m.CreatorRemaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockRemaining", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockRemaining = append(m.BlockRemaining, types.Coin{})
+			if err := m.BlockRemaining[len(m.BlockRemaining)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketEndHeight", wireType)
+			}
+			m.BucketEndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketEndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardStreamsByRecipientRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardStreamsByRecipientRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardStreamsByRecipientRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RewardStreamStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RewardStreamStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RewardStreamStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stream", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stream.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Vested", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Vested.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawable", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Withdrawable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRewardStreamsByRecipientResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRewardStreamsByRecipientResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRewardStreamsByRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Streams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Streams = append(m.Streams, RewardStreamStatus{})
+			if err := m.Streams[len(m.Streams)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPayoutRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPayoutRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPayoutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPayoutResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPayoutResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPayoutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPayout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingPayout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreditRepayShareRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreditRepayShareRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreditRepayShareRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCreditRepayShareResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCreditRepayShareResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCreditRepayShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Governed", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Governed.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OptedIn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OptedIn.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Effective", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Effective.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMerkleDistributionRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMerkleDistributionRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMerkleDistributionRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMerkleDistributionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -827,12 +8309,45 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMerkleDistributionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMerkleDistributionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Distribution", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Distribution.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -854,7 +8369,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMerkleLeafClaimedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -877,17 +8392,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMerkleLeafClaimedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMerkleLeafClaimedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -897,25 +8412,62 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -937,7 +8489,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetClaimRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMerkleLeafClaimedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -960,17 +8512,17 @@ func (m *QueryGetClaimRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetClaimRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMerkleLeafClaimedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetClaimRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMerkleLeafClaimedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Claimed", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -980,24 +8532,62 @@ func (m *QueryGetClaimRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
+			m.Claimed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthQuery
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClaimHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTaskPredicatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTaskPredicatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTaskPredicatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1019,7 +8609,7 @@ func (m *QueryGetClaimRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryGetClaimRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryTaskPredicatesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1042,17 +8632,17 @@ func (m *QueryGetClaimRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryGetClaimRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryTaskPredicatesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryGetClaimRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryTaskPredicatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecord", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Types", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1062,24 +8652,23 @@ func (m *QueryGetClaimRecordResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ClaimRecord.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Types = append(m.Types, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1102,7 +8691,7 @@ func (m *QueryGetClaimRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllClaimRecordRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNativeClaimStatusRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1125,17 +8714,17 @@ func (m *QueryAllClaimRecordRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllClaimRecordRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNativeClaimStatusRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllClaimRecordRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNativeClaimStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1145,27 +8734,55 @@ func (m *QueryAllClaimRecordRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageRequest{}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1188,7 +8805,7 @@ func (m *QueryAllClaimRecordRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryAllClaimRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNativeClaimStatusResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1211,17 +8828,17 @@ func (m *QueryAllClaimRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAllClaimRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNativeClaimStatusResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAllClaimRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNativeClaimStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimRecord", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnrolledHeight", wireType)
 			}
-			var msglen int
+			m.EnrolledHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1231,31 +8848,36 @@ func (m *QueryAllClaimRecordResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.EnrolledHeight |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Satisfied", wireType)
 			}
-			m.ClaimRecord = append(m.ClaimRecord, ClaimRecord{})
-			if err := m.ClaimRecord[len(m.ClaimRecord)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		case 2:
+			m.Satisfied = bool(v != 0)
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1265,27 +8887,23 @@ func (m *QueryAllClaimRecordResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Pagination == nil {
-				m.Pagination = &query.PageResponse{}
-			}
-			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
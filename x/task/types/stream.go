@@ -0,0 +1,95 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// IsImmediate 判断奖励是否在领取时立即发放
+func (s PayoutSchedule) IsImmediate() bool {
+	return s.Type == PAYOUT_SCHEDULE_TYPE_IMMEDIATE
+}
+
+// Validate 校验释放计划：立即发放不带区块数，线性释放需要正的时长，悬崖期不得超过总时长
+func (s PayoutSchedule) Validate() error {
+	switch s.Type {
+	case PAYOUT_SCHEDULE_TYPE_IMMEDIATE:
+		if s.DurationBlocks != 0 || s.CliffBlocks != 0 {
+			return fmt.Errorf("immediate payout cannot set duration or cliff")
+		}
+	case PAYOUT_SCHEDULE_TYPE_LINEAR:
+		if s.DurationBlocks <= 0 {
+			return fmt.Errorf("linear payout duration must be positive")
+		}
+		if s.CliffBlocks != 0 {
+			return fmt.Errorf("linear payout cannot set a cliff")
+		}
+	case PAYOUT_SCHEDULE_TYPE_CLIFF_LINEAR:
+		if s.DurationBlocks <= 0 {
+			return fmt.Errorf("cliff payout duration must be positive")
+		}
+		if s.CliffBlocks <= 0 || s.CliffBlocks > s.DurationBlocks {
+			return fmt.Errorf("cliff %d must be positive and not exceed duration %d", s.CliffBlocks, s.DurationBlocks)
+		}
+	default:
+		return fmt.Errorf("unknown payout schedule type %s", s.Type)
+	}
+	return nil
+}
+
+// NewRewardStream 按释放计划为一次领取创建奖励流，从领取高度开始计算
+func NewRewardStream(id uint64, schedule PayoutSchedule, taskId, recipient, claimHash string, amount sdk.Coin, height int64) RewardStream {
+	return RewardStream{
+		Id:          id,
+		TaskId:      taskId,
+		Recipient:   recipient,
+		ClaimHash:   claimHash,
+		Total:       amount,
+		Withdrawn:   sdk.NewCoin(amount.Denom, math.ZeroInt()),
+		StartHeight: height,
+		CliffHeight: height + schedule.CliffBlocks,
+		EndHeight:   height + schedule.DurationBlocks,
+	}
+}
+
+// Vested 返回截至给定高度累计释放的金额：悬崖期前为 0，之后按线性进度释放
+func (s RewardStream) Vested(height int64) sdk.Coin {
+	switch {
+	case height < s.CliffHeight:
+		return sdk.NewCoin(s.Total.Denom, math.ZeroInt())
+	case height >= s.EndHeight:
+		return s.Total
+	}
+	elapsed := math.NewInt(height - s.StartHeight)
+	duration := math.NewInt(s.EndHeight - s.StartHeight)
+	return sdk.NewCoin(s.Total.Denom, s.Total.Amount.Mul(elapsed).Quo(duration))
+}
+
+// Withdrawable 返回在给定高度可提取的金额
+func (s RewardStream) Withdrawable(height int64) sdk.Coin {
+	return s.Vested(height).Sub(s.Withdrawn)
+}
+
+// Remaining 返回模块账户仍为该奖励流持有的金额
+func (s RewardStream) Remaining() sdk.Coin {
+	return s.Total.Sub(s.Withdrawn)
+}
+
+// Validate 校验奖励流的金额与高度
+func (s RewardStream) Validate() error {
+	if err := s.Total.Validate(); err != nil {
+		return fmt.Errorf("invalid total: %w", err)
+	}
+	if err := s.Withdrawn.Validate(); err != nil {
+		return fmt.Errorf("invalid withdrawn: %w", err)
+	}
+	if s.Withdrawn.Denom != s.Total.Denom || s.Total.IsLT(s.Withdrawn) {
+		return fmt.Errorf("withdrawn %s exceeds total %s", s.Withdrawn, s.Total)
+	}
+	if s.CliffHeight < s.StartHeight || s.EndHeight < s.CliffHeight || s.EndHeight <= s.StartHeight {
+		return fmt.Errorf("invalid heights: start %d, cliff %d, end %d", s.StartHeight, s.CliffHeight, s.EndHeight)
+	}
+	return nil
+}
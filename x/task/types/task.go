@@ -0,0 +1,114 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// MaxTaskIdLength 是任务 ID 的最大长度
+const MaxTaskIdLength = 128
+
+// MaxRevokeReasonLength 是撤销原因的最大长度
+const MaxRevokeReasonLength = 256
+
+// MaxEvidenceLength 是争议证据的最大长度
+const MaxEvidenceLength = 1024
+
+// ValidateTaskId 校验任务 ID 非空、不超长且不含空白字符
+func ValidateTaskId(id string) error {
+	if id == "" {
+		return fmt.Errorf("task id cannot be empty")
+	}
+	if len(id) > MaxTaskIdLength {
+		return fmt.Errorf("task id exceeds %d characters", MaxTaskIdLength)
+	}
+	if strings.ContainsAny(id, " \t\r\n") {
+		return fmt.Errorf("task id cannot contain whitespace")
+	}
+	return nil
+}
+
+// Validate 校验任务的静态配置
+func (t Task) Validate() error {
+	if err := ValidateTaskId(t.Id); err != nil {
+		return err
+	}
+	if err := t.RewardPerClaim.Validate(); err != nil {
+		return fmt.Errorf("invalid reward per claim: %w", err)
+	}
+	if !t.RewardPerClaim.IsPositive() {
+		return fmt.Errorf("reward per claim must be positive")
+	}
+	if err := t.Budget.Validate(); err != nil {
+		return fmt.Errorf("invalid budget: %w", err)
+	}
+	if t.Budget.Denom != t.RewardPerClaim.Denom {
+		return fmt.Errorf("budget denom %s does not match reward denom %s", t.Budget.Denom, t.RewardPerClaim.Denom)
+	}
+	if t.Budget.IsLT(t.RewardPerClaim) {
+		return fmt.Errorf("budget %s is smaller than reward per claim %s", t.Budget, t.RewardPerClaim)
+	}
+	if t.StartHeight < 0 || t.EndHeight < 0 {
+		return fmt.Errorf("start and end heights cannot be negative")
+	}
+	if t.EndHeight != 0 && t.EndHeight <= t.StartHeight {
+		return fmt.Errorf("end height %d must be after start height %d", t.EndHeight, t.StartHeight)
+	}
+	// 链上判定任务不登记预言机，其余任务必须登记有效的预言机集合
+	if t.IsNative() {
+		if err := t.Predicate.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid predicate: %w", err)
+		}
+		if !t.OracleSet.IsEmpty() || !t.PreviousOracleSet.IsEmpty() {
+			return fmt.Errorf("native task cannot have oracles")
+		}
+	} else if err := t.OracleSet.Validate(); err != nil {
+		return fmt.Errorf("invalid oracle set: %w", err)
+	}
+	if !t.PreviousOracleSet.IsEmpty() {
+		if err := t.PreviousOracleSet.Validate(); err != nil {
+			return fmt.Errorf("invalid previous oracle set: %w", err)
+		}
+	}
+	if err := t.Eligibility.Validate(); err != nil {
+		return fmt.Errorf("invalid eligibility: %w", err)
+	}
+	if err := t.PayoutSchedule.Validate(); err != nil {
+		return fmt.Errorf("invalid payout schedule: %w", err)
+	}
+	if t.DisputeWindowBlocks < 0 {
+		return fmt.Errorf("dispute window cannot be negative: %d", t.DisputeWindowBlocks)
+	}
+	return nil
+}
+
+// UserLimit 返回每个用户的领取次数上限，未设置时为 1
+func (t Task) UserLimit() uint64 {
+	if t.PerUserLimit == 0 {
+		return 1
+	}
+	return t.PerUserLimit
+}
+
+// IsNative 判断任务是否由链上状态判定完成
+func (t Task) IsNative() bool {
+	return !t.Predicate.IsEmpty()
+}
+
+// IsOpen 判断任务是否处于开放状态
+func (t Task) IsOpen() bool {
+	return t.Status == TASK_STATUS_OPEN
+}
+
+// IsExhausted 判断任务是否已达到领取次数上限或剩余预算不足一次奖励
+func (t Task) IsExhausted() bool {
+	if t.MaxClaims != 0 && t.ClaimCount >= t.MaxClaims {
+		return true
+	}
+	return t.RemainingBudget.Denom != t.RewardPerClaim.Denom || t.RemainingBudget.IsLT(t.RewardPerClaim)
+}
+
+// IsExpired 判断任务在给定高度是否已过截止高度
+func (t Task) IsExpired(height int64) bool {
+	return t.EndHeight != 0 && height > t.EndHeight
+}
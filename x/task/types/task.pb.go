@@ -0,0 +1,2758 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: dtc/task/v1/task.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// TaskStatus 是任务的状态
+type TaskStatus int32
+
+const (
+	// TASK_STATUS_UNSPECIFIED 未定义
+	TASK_STATUS_UNSPECIFIED TaskStatus = 0
+	// TASK_STATUS_OPEN 任务开放领取（仍受开始高度限制）
+	TASK_STATUS_OPEN TaskStatus = 1
+	// TASK_STATUS_CLOSED 任务已关闭，不再接受领取
+	TASK_STATUS_CLOSED TaskStatus = 2
+)
+
+var TaskStatus_name = map[int32]string{
+	0: "TASK_STATUS_UNSPECIFIED",
+	1: "TASK_STATUS_OPEN",
+	2: "TASK_STATUS_CLOSED",
+}
+
+var TaskStatus_value = map[string]int32{
+	"TASK_STATUS_UNSPECIFIED": 0,
+	"TASK_STATUS_OPEN":        1,
+	"TASK_STATUS_CLOSED":      2,
+}
+
+func (x TaskStatus) String() string {
+	return proto.EnumName(TaskStatus_name, int32(x))
+}
+
+func (TaskStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7c64786f63f2f60c, []int{0}
+}
+
+// PayoutScheduleType 是任务奖励的发放方式
+type PayoutScheduleType int32
+
+const (
+	// PAYOUT_SCHEDULE_TYPE_IMMEDIATE 领取时立即发放，是旧任务的默认方式
+	PAYOUT_SCHEDULE_TYPE_IMMEDIATE PayoutScheduleType = 0
+	// PAYOUT_SCHEDULE_TYPE_LINEAR 自领取高度起在 duration_blocks 内线性释放
+	PAYOUT_SCHEDULE_TYPE_LINEAR PayoutScheduleType = 1
+	// PAYOUT_SCHEDULE_TYPE_CLIFF_LINEAR 在 cliff_blocks 之前不释放，之后按线性进度一次补足并继续线性释放
+	PAYOUT_SCHEDULE_TYPE_CLIFF_LINEAR PayoutScheduleType = 2
+)
+
+var PayoutScheduleType_name = map[int32]string{
+	0: "PAYOUT_SCHEDULE_TYPE_IMMEDIATE",
+	1: "PAYOUT_SCHEDULE_TYPE_LINEAR",
+	2: "PAYOUT_SCHEDULE_TYPE_CLIFF_LINEAR",
+}
+
+var PayoutScheduleType_value = map[string]int32{
+	"PAYOUT_SCHEDULE_TYPE_IMMEDIATE":    0,
+	"PAYOUT_SCHEDULE_TYPE_LINEAR":       1,
+	"PAYOUT_SCHEDULE_TYPE_CLIFF_LINEAR": 2,
+}
+
+func (x PayoutScheduleType) String() string {
+	return proto.EnumName(PayoutScheduleType_name, int32(x))
+}
+
+func (PayoutScheduleType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_7c64786f63f2f60c, []int{1}
+}
+
+// Task 是链上登记的奖励任务，ClaimReward 必须引用已登记的任务
+type Task struct {
+	Id    string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// reward_per_claim 是每次领取支付的金额
+	RewardPerClaim types.Coin `protobuf:"bytes,3,opt,name=reward_per_claim,json=rewardPerClaim,proto3" json:"reward_per_claim"`
+	// budget 是任务的总预算
+	Budget types.Coin `protobuf:"bytes,4,opt,name=budget,proto3" json:"budget"`
+	// remaining_budget 是尚未支付的预算
+	RemainingBudget types.Coin `protobuf:"bytes,5,opt,name=remaining_budget,json=remainingBudget,proto3" json:"remaining_budget"`
+	// max_claims 是总领取次数上限，0 表示只受预算限制
+	MaxClaims uint64 `protobuf:"varint,6,opt,name=max_claims,json=maxClaims,proto3" json:"max_claims,omitempty"`
+	// per_user_limit 是每个用户的领取次数上限
+	PerUserLimit uint64 `protobuf:"varint,7,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"`
+	// start_height 之前不接受领取
+	StartHeight int64 `protobuf:"varint,8,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// end_height 之后任务自动关闭，0 表示不设截止高度
+	EndHeight     int64      `protobuf:"varint,9,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	ClaimCount    uint64     `protobuf:"varint,10,opt,name=claim_count,json=claimCount,proto3" json:"claim_count,omitempty"`
+	Status        TaskStatus `protobuf:"varint,11,opt,name=status,proto3,enum=dtc.task.v1.TaskStatus" json:"status,omitempty"`
+	CreatedHeight int64      `protobuf:"varint,12,opt,name=created_height,json=createdHeight,proto3" json:"created_height,omitempty"`
+	ClosedHeight  int64      `protobuf:"varint,13,opt,name=closed_height,json=closedHeight,proto3" json:"closed_height,omitempty"`
+	// oracle_set 是当前为任务领取签名的预言机公钥集合
+	OracleSet OracleSet `protobuf:"bytes,14,opt,name=oracle_set,json=oracleSet,proto3" json:"oracle_set"`
+	// previous_oracle_set 是轮换前的公钥集合，在 previous_oracle_set_expiry 高度（含）之前仍然有效
+	PreviousOracleSet       OracleSet `protobuf:"bytes,15,opt,name=previous_oracle_set,json=previousOracleSet,proto3" json:"previous_oracle_set"`
+	PreviousOracleSetExpiry int64     `protobuf:"varint,16,opt,name=previous_oracle_set_expiry,json=previousOracleSetExpiry,proto3" json:"previous_oracle_set_expiry,omitempty"`
+	// eligibility 是领取者必须满足的身份条件，为空表示任何地址均可领取
+	Eligibility TaskEligibility `protobuf:"bytes,17,opt,name=eligibility,proto3" json:"eligibility"`
+	// payout_schedule 决定奖励立即发放还是进入奖励流逐步释放
+	PayoutSchedule PayoutSchedule `protobuf:"bytes,18,opt,name=payout_schedule,json=payoutSchedule,proto3" json:"payout_schedule"`
+	// dispute_window_blocks 非 0 时，领取后的奖励在该区块数内处于待定状态，可被争议撤回
+	DisputeWindowBlocks int64 `protobuf:"varint,19,opt,name=dispute_window_blocks,json=disputeWindowBlocks,proto3" json:"dispute_window_blocks,omitempty"`
+	// last_merkle_epoch 是任务最近发布 Merkle 根的周期，新的周期须大于该值
+	LastMerkleEpoch uint64 `protobuf:"varint,20,opt,name=last_merkle_epoch,json=lastMerkleEpoch,proto3" json:"last_merkle_epoch,omitempty"`
+	// predicate 非空时任务由链上状态判定完成，用户直接领取而无需预言机签名
+	Predicate TaskPredicate `protobuf:"bytes,21,opt,name=predicate,proto3" json:"predicate"`
+}
+
+func (m *Task) Reset()         { *m = Task{} }
+func (m *Task) String() string { return proto.CompactTextString(m) }
+func (*Task) ProtoMessage()    {}
+func (*Task) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c64786f63f2f60c, []int{0}
+}
+func (m *Task) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Task) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Task.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Task) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Task.Merge(m, src)
+}
+func (m *Task) XXX_Size() int {
+	return m.Size()
+}
+func (m *Task) XXX_DiscardUnknown() {
+	xxx_messageInfo_Task.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Task proto.InternalMessageInfo
+
+func (m *Task) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Task) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Task) GetRewardPerClaim() types.Coin {
+	if m != nil {
+		return m.RewardPerClaim
+	}
+	return types.Coin{}
+}
+
+func (m *Task) GetBudget() types.Coin {
+	if m != nil {
+		return m.Budget
+	}
+	return types.Coin{}
+}
+
+func (m *Task) GetRemainingBudget() types.Coin {
+	if m != nil {
+		return m.RemainingBudget
+	}
+	return types.Coin{}
+}
+
+func (m *Task) GetMaxClaims() uint64 {
+	if m != nil {
+		return m.MaxClaims
+	}
+	return 0
+}
+
+func (m *Task) GetPerUserLimit() uint64 {
+	if m != nil {
+		return m.PerUserLimit
+	}
+	return 0
+}
+
+func (m *Task) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
+	}
+	return 0
+}
+
+func (m *Task) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
+}
+
+func (m *Task) GetClaimCount() uint64 {
+	if m != nil {
+		return m.ClaimCount
+	}
+	return 0
+}
+
+func (m *Task) GetStatus() TaskStatus {
+	if m != nil {
+		return m.Status
+	}
+	return TASK_STATUS_UNSPECIFIED
+}
+
+func (m *Task) GetCreatedHeight() int64 {
+	if m != nil {
+		return m.CreatedHeight
+	}
+	return 0
+}
+
+func (m *Task) GetClosedHeight() int64 {
+	if m != nil {
+		return m.ClosedHeight
+	}
+	return 0
+}
+
+func (m *Task) GetOracleSet() OracleSet {
+	if m != nil {
+		return m.OracleSet
+	}
+	return OracleSet{}
+}
+
+func (m *Task) GetPreviousOracleSet() OracleSet {
+	if m != nil {
+		return m.PreviousOracleSet
+	}
+	return OracleSet{}
+}
+
+func (m *Task) GetPreviousOracleSetExpiry() int64 {
+	if m != nil {
+		return m.PreviousOracleSetExpiry
+	}
+	return 0
+}
+
+func (m *Task) GetEligibility() TaskEligibility {
+	if m != nil {
+		return m.Eligibility
+	}
+	return TaskEligibility{}
+}
+
+func (m *Task) GetPayoutSchedule() PayoutSchedule {
+	if m != nil {
+		return m.PayoutSchedule
+	}
+	return PayoutSchedule{}
+}
+
+func (m *Task) GetDisputeWindowBlocks() int64 {
+	if m != nil {
+		return m.DisputeWindowBlocks
+	}
+	return 0
+}
+
+func (m *Task) GetLastMerkleEpoch() uint64 {
+	if m != nil {
+		return m.LastMerkleEpoch
+	}
+	return 0
+}
+
+func (m *Task) GetPredicate() TaskPredicate {
+	if m != nil {
+		return m.Predicate
+	}
+	return TaskPredicate{}
+}
+
+// PayoutSchedule 描述奖励的释放方式
+type PayoutSchedule struct {
+	Type PayoutScheduleType `protobuf:"varint,1,opt,name=type,proto3,enum=dtc.task.v1.PayoutScheduleType" json:"type,omitempty"`
+	// duration_blocks 是从领取到全部释放的区块数
+	DurationBlocks int64 `protobuf:"varint,2,opt,name=duration_blocks,json=durationBlocks,proto3" json:"duration_blocks,omitempty"`
+	// cliff_blocks 是领取后不释放任何奖励的区块数，仅 CLIFF_LINEAR 使用
+	CliffBlocks int64 `protobuf:"varint,3,opt,name=cliff_blocks,json=cliffBlocks,proto3" json:"cliff_blocks,omitempty"`
+}
+
+func (m *PayoutSchedule) Reset()         { *m = PayoutSchedule{} }
+func (m *PayoutSchedule) String() string { return proto.CompactTextString(m) }
+func (*PayoutSchedule) ProtoMessage()    {}
+func (*PayoutSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c64786f63f2f60c, []int{1}
+}
+func (m *PayoutSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayoutSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayoutSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayoutSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutSchedule.Merge(m, src)
+}
+func (m *PayoutSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayoutSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutSchedule proto.InternalMessageInfo
+
+func (m *PayoutSchedule) GetType() PayoutScheduleType {
+	if m != nil {
+		return m.Type
+	}
+	return PAYOUT_SCHEDULE_TYPE_IMMEDIATE
+}
+
+func (m *PayoutSchedule) GetDurationBlocks() int64 {
+	if m != nil {
+		return m.DurationBlocks
+	}
+	return 0
+}
+
+func (m *PayoutSchedule) GetCliffBlocks() int64 {
+	if m != nil {
+		return m.CliffBlocks
+	}
+	return 0
+}
+
+// TaskEligibility 描述领取任务奖励的身份条件。设置任一条件即要求领取者持有已注册的 DID，
+// 且每个 DID（而非地址）的领取次数受 per_user_limit 约束
+type TaskEligibility struct {
+	RequireDid bool `protobuf:"varint,1,opt,name=require_did,json=requireDid,proto3" json:"require_did,omitempty"`
+	// require_liveness 要求 DID 已通过认证方的人格证明且人脸 nullifier 唯一
+	RequireLiveness bool `protobuf:"varint,2,opt,name=require_liveness,json=requireLiveness,proto3" json:"require_liveness,omitempty"`
+	// min_did_age_blocks 是 DID 注册后至少经过的区块数
+	MinDidAgeBlocks int64 `protobuf:"varint,3,opt,name=min_did_age_blocks,json=minDidAgeBlocks,proto3" json:"min_did_age_blocks,omitempty"`
+	// required_credentials 是 DID 必须持有的有效凭证类型
+	RequiredCredentials []string `protobuf:"bytes,4,rep,name=required_credentials,json=requiredCredentials,proto3" json:"required_credentials,omitempty"`
+}
+
+func (m *TaskEligibility) Reset()         { *m = TaskEligibility{} }
+func (m *TaskEligibility) String() string { return proto.CompactTextString(m) }
+func (*TaskEligibility) ProtoMessage()    {}
+func (*TaskEligibility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c64786f63f2f60c, []int{2}
+}
+func (m *TaskEligibility) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskEligibility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskEligibility.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskEligibility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskEligibility.Merge(m, src)
+}
+func (m *TaskEligibility) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskEligibility) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskEligibility.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskEligibility proto.InternalMessageInfo
+
+func (m *TaskEligibility) GetRequireDid() bool {
+	if m != nil {
+		return m.RequireDid
+	}
+	return false
+}
+
+func (m *TaskEligibility) GetRequireLiveness() bool {
+	if m != nil {
+		return m.RequireLiveness
+	}
+	return false
+}
+
+func (m *TaskEligibility) GetMinDidAgeBlocks() int64 {
+	if m != nil {
+		return m.MinDidAgeBlocks
+	}
+	return 0
+}
+
+func (m *TaskEligibility) GetRequiredCredentials() []string {
+	if m != nil {
+		return m.RequiredCredentials
+	}
+	return nil
+}
+
+// OracleSet 是一组 33 字节压缩 secp256k1 公钥（hex）及所需的签名数量
+type OracleSet struct {
+	Pubkeys   []string `protobuf:"bytes,1,rep,name=pubkeys,proto3" json:"pubkeys,omitempty"`
+	Threshold uint32   `protobuf:"varint,2,opt,name=threshold,proto3" json:"threshold,omitempty"`
+}
+
+func (m *OracleSet) Reset()         { *m = OracleSet{} }
+func (m *OracleSet) String() string { return proto.CompactTextString(m) }
+func (*OracleSet) ProtoMessage()    {}
+func (*OracleSet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c64786f63f2f60c, []int{3}
+}
+func (m *OracleSet) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleSet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleSet.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleSet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleSet.Merge(m, src)
+}
+func (m *OracleSet) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleSet) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleSet.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleSet proto.InternalMessageInfo
+
+func (m *OracleSet) GetPubkeys() []string {
+	if m != nil {
+		return m.Pubkeys
+	}
+	return nil
+}
+
+func (m *OracleSet) GetThreshold() uint32 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+// TaskEscrow 是为单个任务托管在模块账户中的资金，领取奖励只能从所属任务的托管中支付
+type TaskEscrow struct {
+	TaskId string     `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Amount types.Coin `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *TaskEscrow) Reset()         { *m = TaskEscrow{} }
+func (m *TaskEscrow) String() string { return proto.CompactTextString(m) }
+func (*TaskEscrow) ProtoMessage()    {}
+func (*TaskEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c64786f63f2f60c, []int{4}
+}
+func (m *TaskEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskEscrow.Merge(m, src)
+}
+func (m *TaskEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskEscrow proto.InternalMessageInfo
+
+func (m *TaskEscrow) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *TaskEscrow) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+// PayoutWindow 记录限额窗口内已支付的金额。
+// 区块限额按 window（区块高度）与 amount 计数，window 变化时重新计数；
+// 接收用户与提交者的滚动窗口按 buckets 分桶累计，移出窗口的分桶不再计入
+type PayoutWindow struct {
+	Window  int64                 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	Amount  cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	Buckets []PayoutBucket        `protobuf:"bytes,3,rep,name=buckets,proto3" json:"buckets"`
+}
+
+func (m *PayoutWindow) Reset()         { *m = PayoutWindow{} }
+func (m *PayoutWindow) String() string { return proto.CompactTextString(m) }
+func (*PayoutWindow) ProtoMessage()    {}
+func (*PayoutWindow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c64786f63f2f60c, []int{5}
+}
+func (m *PayoutWindow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayoutWindow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayoutWindow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayoutWindow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutWindow.Merge(m, src)
+}
+func (m *PayoutWindow) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayoutWindow) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutWindow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutWindow proto.InternalMessageInfo
+
+func (m *PayoutWindow) GetWindow() int64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *PayoutWindow) GetBuckets() []PayoutBucket {
+	if m != nil {
+		return m.Buckets
+	}
+	return nil
+}
+
+// PayoutBucket 是滚动窗口中一个分桶内已支付的金额
+type PayoutBucket struct {
+	Bucket int64                 `protobuf:"varint,1,opt,name=bucket,proto3" json:"bucket,omitempty"`
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *PayoutBucket) Reset()         { *m = PayoutBucket{} }
+func (m *PayoutBucket) String() string { return proto.CompactTextString(m) }
+func (*PayoutBucket) ProtoMessage()    {}
+func (*PayoutBucket) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7c64786f63f2f60c, []int{6}
+}
+func (m *PayoutBucket) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PayoutBucket) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PayoutBucket.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PayoutBucket) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PayoutBucket.Merge(m, src)
+}
+func (m *PayoutBucket) XXX_Size() int {
+	return m.Size()
+}
+func (m *PayoutBucket) XXX_DiscardUnknown() {
+	xxx_messageInfo_PayoutBucket.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PayoutBucket proto.InternalMessageInfo
+
+func (m *PayoutBucket) GetBucket() int64 {
+	if m != nil {
+		return m.Bucket
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("dtc.task.v1.TaskStatus", TaskStatus_name, TaskStatus_value)
+	proto.RegisterEnum("dtc.task.v1.PayoutScheduleType", PayoutScheduleType_name, PayoutScheduleType_value)
+	proto.RegisterType((*Task)(nil), "dtc.task.v1.Task")
+	proto.RegisterType((*PayoutSchedule)(nil), "dtc.task.v1.PayoutSchedule")
+	proto.RegisterType((*TaskEligibility)(nil), "dtc.task.v1.TaskEligibility")
+	proto.RegisterType((*OracleSet)(nil), "dtc.task.v1.OracleSet")
+	proto.RegisterType((*TaskEscrow)(nil), "dtc.task.v1.TaskEscrow")
+	proto.RegisterType((*PayoutWindow)(nil), "dtc.task.v1.PayoutWindow")
+	proto.RegisterType((*PayoutBucket)(nil), "dtc.task.v1.PayoutBucket")
+}
+
+func init() { proto.RegisterFile("dtc/task/v1/task.proto", fileDescriptor_7c64786f63f2f60c) }
+
+var fileDescriptor_7c64786f63f2f60c = []byte{
+	// 1132 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4b, 0x6f, 0x1b, 0x55,
+	0x14, 0xf6, 0x24, 0x6e, 0x12, 0x1f, 0x3b, 0xb6, 0x73, 0x93, 0x26, 0xd3, 0xa4, 0x38, 0xae, 0xa1,
+	0xc2, 0x04, 0x61, 0x93, 0x74, 0x59, 0x54, 0xc9, 0x8f, 0xa9, 0x6a, 0x70, 0x13, 0x6b, 0xec, 0x08,
+	0x15, 0x09, 0x5d, 0x8d, 0x67, 0x6e, 0xed, 0x2b, 0xcf, 0x8b, 0xb9, 0x77, 0x92, 0xf8, 0x0f, 0x20,
+	0x96, 0xf0, 0x1b, 0xd8, 0x20, 0x56, 0x5d, 0xb0, 0x65, 0xdf, 0x65, 0xc5, 0x0a, 0xb1, 0xa8, 0x50,
+	0xbb, 0xe0, 0x6f, 0xa0, 0xb9, 0x73, 0xc7, 0x0f, 0x52, 0x21, 0x22, 0xb1, 0x49, 0x7c, 0xbe, 0xef,
+	0x3b, 0xe7, 0x7c, 0xe7, 0xbe, 0x34, 0xb0, 0x6b, 0x71, 0xb3, 0xce, 0x0d, 0x36, 0xa9, 0x5f, 0x1c,
+	0x8b, 0xff, 0x35, 0x3f, 0xf0, 0xb8, 0x87, 0xb2, 0x16, 0x37, 0x6b, 0x22, 0xbe, 0x38, 0xde, 0xdf,
+	0x32, 0x1c, 0xea, 0x7a, 0x75, 0xf1, 0x37, 0xe6, 0xf7, 0x4b, 0xa6, 0xc7, 0x1c, 0x8f, 0xd5, 0x87,
+	0x06, 0x23, 0xf5, 0x8b, 0xe3, 0x21, 0xe1, 0xc6, 0x71, 0xdd, 0xf4, 0xa8, 0x2b, 0xf9, 0x3b, 0x31,
+	0x8f, 0x45, 0x54, 0x8f, 0x03, 0x49, 0x1d, 0x2c, 0xb6, 0xf4, 0x03, 0x62, 0x51, 0xd3, 0xe0, 0x44,
+	0x92, 0x3b, 0x23, 0x6f, 0xe4, 0xc5, 0x49, 0xd1, 0xaf, 0x18, 0xad, 0xfc, 0xbc, 0x01, 0xe9, 0x81,
+	0xc1, 0x26, 0x28, 0x0f, 0x2b, 0xd4, 0x52, 0x95, 0xb2, 0x52, 0xcd, 0xe8, 0x2b, 0xd4, 0x42, 0x3b,
+	0x70, 0xcb, 0xbb, 0x74, 0x49, 0xa0, 0xae, 0x08, 0x28, 0x0e, 0xd0, 0x29, 0x14, 0x03, 0x72, 0x69,
+	0x04, 0x16, 0xf6, 0x49, 0x80, 0x4d, 0xdb, 0xa0, 0x8e, 0xba, 0x5a, 0x56, 0xaa, 0xd9, 0x93, 0x3b,
+	0x35, 0x69, 0x25, 0xf2, 0x5d, 0x93, 0xbe, 0x6b, 0x2d, 0x8f, 0xba, 0xcd, 0xcc, 0xcb, 0xd7, 0x87,
+	0xa9, 0x9f, 0xfe, 0x7a, 0x71, 0xa4, 0xe8, 0xf9, 0x38, 0xbb, 0x47, 0x82, 0x56, 0x94, 0x8b, 0x3e,
+	0x83, 0xb5, 0x61, 0x68, 0x8d, 0x08, 0x57, 0xd3, 0x37, 0xa8, 0x22, 0x73, 0xd0, 0x59, 0xe4, 0xc6,
+	0x31, 0xa8, 0x4b, 0xdd, 0x11, 0x96, 0x75, 0x6e, 0xdd, 0xa0, 0x4e, 0x61, 0x96, 0xdd, 0x8c, 0x0b,
+	0xbe, 0x07, 0xe0, 0x18, 0x57, 0xf1, 0x5c, 0x4c, 0x5d, 0x2b, 0x2b, 0xd5, 0xb4, 0x9e, 0x71, 0x8c,
+	0x2b, 0x61, 0x96, 0xa1, 0x0f, 0x20, 0x1f, 0x8d, 0x1d, 0x32, 0x12, 0x60, 0x9b, 0x3a, 0x94, 0xab,
+	0xeb, 0x42, 0x92, 0xf3, 0x49, 0x70, 0xce, 0x48, 0xd0, 0x8d, 0x30, 0x74, 0x0f, 0x72, 0x8c, 0x1b,
+	0x01, 0xc7, 0x63, 0x42, 0x47, 0x63, 0xae, 0x6e, 0x94, 0x95, 0xea, 0xaa, 0x9e, 0x15, 0xd8, 0x13,
+	0x01, 0x45, 0x7d, 0x88, 0x6b, 0x25, 0x82, 0x8c, 0x10, 0x64, 0x88, 0x6b, 0x49, 0xfa, 0x10, 0xb2,
+	0xc2, 0x02, 0x36, 0xbd, 0xd0, 0xe5, 0x2a, 0x88, 0x26, 0x20, 0xa0, 0x56, 0x84, 0xa0, 0x3a, 0xac,
+	0x31, 0x6e, 0xf0, 0x90, 0xa9, 0xd9, 0xb2, 0x52, 0xcd, 0x9f, 0xec, 0xd5, 0x16, 0x0e, 0x55, 0x2d,
+	0xda, 0xcf, 0xbe, 0xa0, 0x75, 0x29, 0x43, 0xf7, 0x21, 0x6f, 0x06, 0xc4, 0xe0, 0x64, 0xd6, 0x34,
+	0x27, 0x9a, 0x6e, 0x4a, 0x54, 0x36, 0x7e, 0x1f, 0x36, 0x4d, 0xdb, 0x63, 0x73, 0xd5, 0xa6, 0x50,
+	0xe5, 0x62, 0x50, 0x8a, 0x1e, 0x02, 0x78, 0x81, 0x61, 0xda, 0x04, 0x33, 0xc2, 0xd5, 0xbc, 0x58,
+	0xef, 0xdd, 0x25, 0x03, 0x67, 0x82, 0xee, 0x13, 0xde, 0x4c, 0x47, 0x8b, 0xad, 0x67, 0xbc, 0x04,
+	0x40, 0x5d, 0xd8, 0xf6, 0x03, 0x72, 0x41, 0xbd, 0x90, 0xe1, 0x85, 0x2a, 0x85, 0xff, 0x50, 0x65,
+	0x2b, 0x49, 0x9c, 0x11, 0xe8, 0x21, 0xec, 0xbf, 0xa3, 0x1a, 0x26, 0x57, 0x3e, 0x0d, 0xa6, 0x6a,
+	0x51, 0x98, 0xdf, 0xbb, 0x96, 0xa6, 0x09, 0x1a, 0xb5, 0x21, 0x4b, 0x6c, 0x3a, 0xa2, 0x43, 0x6a,
+	0x53, 0x3e, 0x55, 0xb7, 0x84, 0x85, 0xbb, 0xd7, 0x56, 0x52, 0x9b, 0x6b, 0xa4, 0x91, 0xc5, 0x34,
+	0xf4, 0x39, 0x14, 0x7c, 0x63, 0xea, 0x85, 0x1c, 0x33, 0x73, 0x4c, 0xac, 0xd0, 0x26, 0x2a, 0x12,
+	0x95, 0x0e, 0x96, 0x2a, 0xf5, 0x84, 0xa6, 0x2f, 0x25, 0xb2, 0x50, 0xde, 0x5f, 0x42, 0xd1, 0x09,
+	0xdc, 0xb6, 0x28, 0xf3, 0x43, 0x4e, 0xf0, 0x25, 0x75, 0x2d, 0xef, 0x12, 0x0f, 0x6d, 0xcf, 0x9c,
+	0x30, 0x75, 0x5b, 0x4c, 0xb2, 0x2d, 0xc9, 0x2f, 0x05, 0xd7, 0x14, 0x14, 0x3a, 0x82, 0x2d, 0xdb,
+	0x60, 0x1c, 0x3b, 0x24, 0x98, 0xd8, 0x04, 0x13, 0xdf, 0x33, 0xc7, 0xea, 0x8e, 0x38, 0x31, 0x85,
+	0x88, 0x78, 0x2a, 0x70, 0x2d, 0x82, 0xd1, 0x23, 0xc8, 0xcc, 0x5e, 0x05, 0xf5, 0xb6, 0x70, 0xb9,
+	0x7f, 0x6d, 0xde, 0x5e, 0xa2, 0x48, 0x36, 0x6f, 0x96, 0x52, 0xf9, 0x41, 0x81, 0xfc, 0xf2, 0x20,
+	0xe8, 0x01, 0xa4, 0xf9, 0xd4, 0x27, 0xe2, 0xe1, 0xc8, 0x9f, 0x1c, 0xfe, 0xcb, 0xcc, 0x83, 0xa9,
+	0x4f, 0x74, 0x21, 0x46, 0x1f, 0x42, 0xc1, 0x0a, 0x03, 0x83, 0x53, 0xcf, 0x4d, 0x26, 0x5c, 0x11,
+	0x13, 0xe6, 0x13, 0x58, 0x0e, 0x77, 0x0f, 0x72, 0xa6, 0x4d, 0x9f, 0x3f, 0x4f, 0x54, 0xab, 0xf1,
+	0x55, 0x12, 0x58, 0x2c, 0xa9, 0xfc, 0xaa, 0x40, 0xe1, 0x1f, 0xdb, 0x14, 0xdd, 0x9f, 0x80, 0x7c,
+	0x13, 0xd2, 0x80, 0x60, 0x4b, 0x3e, 0x6a, 0x1b, 0x3a, 0x48, 0xa8, 0x4d, 0x2d, 0xf4, 0x11, 0x14,
+	0x65, 0x84, 0x6d, 0x7a, 0x41, 0x5c, 0xc2, 0x62, 0x07, 0x1b, 0x7a, 0x41, 0xe2, 0x5d, 0x09, 0xa3,
+	0x8f, 0x01, 0x39, 0xd4, 0x8d, 0xea, 0x60, 0x63, 0x44, 0x96, 0x8d, 0x14, 0x1c, 0xea, 0xb6, 0xa9,
+	0xd5, 0x18, 0x11, 0xe9, 0xf7, 0x18, 0x76, 0x64, 0xbe, 0x85, 0xcd, 0x80, 0x58, 0xc4, 0xe5, 0xd4,
+	0xb0, 0x99, 0x9a, 0x2e, 0xaf, 0x56, 0x33, 0xfa, 0x76, 0xc2, 0xb5, 0xe6, 0x54, 0xa5, 0x05, 0x99,
+	0xf9, 0x79, 0x56, 0x61, 0xdd, 0x0f, 0x87, 0x13, 0x32, 0x65, 0xaa, 0x22, 0x52, 0x92, 0x10, 0xdd,
+	0x85, 0x0c, 0x1f, 0x07, 0x84, 0x8d, 0x3d, 0xdb, 0x12, 0x56, 0x37, 0xf5, 0x39, 0x50, 0x31, 0x01,
+	0xc4, 0x1a, 0x30, 0x33, 0xf0, 0x2e, 0xd1, 0x1e, 0xac, 0x47, 0x5b, 0x80, 0x67, 0xef, 0xf9, 0x5a,
+	0x14, 0x76, 0xac, 0xe8, 0xb5, 0x35, 0x1c, 0xf1, 0xa4, 0xac, 0xdc, 0xe4, 0xb5, 0x8d, 0x73, 0x2a,
+	0x2f, 0x14, 0xc8, 0xc5, 0x5b, 0x1a, 0x1f, 0x40, 0xb4, 0x0b, 0x6b, 0xf1, 0x31, 0x15, 0x6d, 0x56,
+	0x75, 0x19, 0xa1, 0x27, 0x4b, 0x6d, 0x32, 0xcd, 0x4f, 0xa3, 0x5a, 0x7f, 0xbc, 0x3e, 0xbc, 0x1d,
+	0x77, 0x63, 0xd6, 0xa4, 0x46, 0xbd, 0xba, 0x63, 0xf0, 0x71, 0xad, 0xe3, 0xf2, 0xdf, 0x7e, 0xf9,
+	0x04, 0xa4, 0x8d, 0x8e, 0xcb, 0x97, 0x5a, 0xa2, 0x47, 0xb0, 0x3e, 0x0c, 0xcd, 0x09, 0xe1, 0xd1,
+	0x8a, 0xaf, 0x0a, 0xc7, 0xd7, 0x0f, 0x58, 0x53, 0x28, 0x16, 0x1d, 0x27, 0x49, 0x15, 0x1f, 0x72,
+	0x8b, 0x9a, 0xc8, 0x71, 0x4c, 0x25, 0x8e, 0xe3, 0xe8, 0xff, 0x73, 0x7c, 0xf4, 0x35, 0xc0, 0xfc,
+	0xf9, 0x45, 0x07, 0xb0, 0x37, 0x68, 0xf4, 0xbf, 0xc0, 0xfd, 0x41, 0x63, 0x70, 0xde, 0xc7, 0xe7,
+	0xa7, 0xfd, 0x9e, 0xd6, 0xea, 0x3c, 0xee, 0x68, 0xed, 0x62, 0x0a, 0xed, 0x40, 0x71, 0x91, 0x3c,
+	0xeb, 0x69, 0xa7, 0x45, 0x05, 0xed, 0x02, 0x5a, 0x44, 0x5b, 0xdd, 0xb3, 0xbe, 0xd6, 0x2e, 0xae,
+	0xec, 0xa7, 0xbf, 0xfb, 0xb1, 0x94, 0x3a, 0xfa, 0x56, 0x01, 0x74, 0xfd, 0x5a, 0xa1, 0x0a, 0x94,
+	0x7a, 0x8d, 0x67, 0x67, 0xe7, 0x03, 0xdc, 0x6f, 0x3d, 0xd1, 0xda, 0xe7, 0x5d, 0x0d, 0x0f, 0x9e,
+	0xf5, 0x34, 0xdc, 0x79, 0xfa, 0x54, 0x6b, 0x77, 0x1a, 0x03, 0xad, 0x98, 0x42, 0x87, 0x70, 0xf0,
+	0x4e, 0x4d, 0xb7, 0x73, 0xaa, 0x35, 0xf4, 0xa2, 0x82, 0xee, 0xc3, 0xbd, 0x77, 0x0a, 0x5a, 0xdd,
+	0xce, 0xe3, 0xc7, 0x89, 0x4c, 0x1a, 0x69, 0x1e, 0xbd, 0x7c, 0x53, 0x52, 0x5e, 0xbd, 0x29, 0x29,
+	0x7f, 0xbe, 0x29, 0x29, 0xdf, 0xbf, 0x2d, 0xa5, 0x5e, 0xbd, 0x2d, 0xa5, 0x7e, 0x7f, 0x5b, 0x4a,
+	0x7d, 0x55, 0x8c, 0x3e, 0x42, 0xae, 0xe2, 0xcf, 0x90, 0xe8, 0xb6, 0xb3, 0xe1, 0x9a, 0xf8, 0xd4,
+	0x78, 0xf0, 0xf7, 0x00, 0x1f, 0x1e, 0xff, 0xa4, 0x12, 0x09, 0x00, 0x00,
+}
+
+func (m *Task) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Task) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Task) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Predicate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xaa
+	if m.LastMerkleEpoch != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.LastMerkleEpoch))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if m.DisputeWindowBlocks != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.DisputeWindowBlocks))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	{
+		size, err := m.PayoutSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	{
+		size, err := m.Eligibility.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	if m.PreviousOracleSetExpiry != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.PreviousOracleSetExpiry))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x80
+	}
+	{
+		size, err := m.PreviousOracleSet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x7a
+	{
+		size, err := m.OracleSet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.ClosedHeight != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.ClosedHeight))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.CreatedHeight != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.CreatedHeight))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.Status != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.ClaimCount != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.ClaimCount))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.PerUserLimit != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.PerUserLimit))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxClaims != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.MaxClaims))
+		i--
+		dAtA[i] = 0x30
+	}
+	{
+		size, err := m.RemainingBudget.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	{
+		size, err := m.Budget.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.RewardPerClaim.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Id) > 0 {
+		i -= len(m.Id)
+		copy(dAtA[i:], m.Id)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Id)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PayoutSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayoutSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayoutSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CliffBlocks != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.CliffBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.DurationBlocks != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.DurationBlocks))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Type != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskEligibility) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskEligibility) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskEligibility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RequiredCredentials) > 0 {
+		for iNdEx := len(m.RequiredCredentials) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RequiredCredentials[iNdEx])
+			copy(dAtA[i:], m.RequiredCredentials[iNdEx])
+			i = encodeVarintTask(dAtA, i, uint64(len(m.RequiredCredentials[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MinDidAgeBlocks != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.MinDidAgeBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.RequireLiveness {
+		i--
+		if m.RequireLiveness {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.RequireDid {
+		i--
+		if m.RequireDid {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleSet) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleSet) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Threshold != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Threshold))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Pubkeys) > 0 {
+		for iNdEx := len(m.Pubkeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Pubkeys[iNdEx])
+			copy(dAtA[i:], m.Pubkeys[iNdEx])
+			i = encodeVarintTask(dAtA, i, uint64(len(m.Pubkeys[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PayoutWindow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayoutWindow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayoutWindow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Buckets) > 0 {
+		for iNdEx := len(m.Buckets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Buckets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Window != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PayoutBucket) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PayoutBucket) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PayoutBucket) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTask(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Bucket != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Bucket))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTask(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTask(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Task) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Id)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = m.RewardPerClaim.Size()
+	n += 1 + l + sovTask(uint64(l))
+	l = m.Budget.Size()
+	n += 1 + l + sovTask(uint64(l))
+	l = m.RemainingBudget.Size()
+	n += 1 + l + sovTask(uint64(l))
+	if m.MaxClaims != 0 {
+		n += 1 + sovTask(uint64(m.MaxClaims))
+	}
+	if m.PerUserLimit != 0 {
+		n += 1 + sovTask(uint64(m.PerUserLimit))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTask(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovTask(uint64(m.EndHeight))
+	}
+	if m.ClaimCount != 0 {
+		n += 1 + sovTask(uint64(m.ClaimCount))
+	}
+	if m.Status != 0 {
+		n += 1 + sovTask(uint64(m.Status))
+	}
+	if m.CreatedHeight != 0 {
+		n += 1 + sovTask(uint64(m.CreatedHeight))
+	}
+	if m.ClosedHeight != 0 {
+		n += 1 + sovTask(uint64(m.ClosedHeight))
+	}
+	l = m.OracleSet.Size()
+	n += 1 + l + sovTask(uint64(l))
+	l = m.PreviousOracleSet.Size()
+	n += 1 + l + sovTask(uint64(l))
+	if m.PreviousOracleSetExpiry != 0 {
+		n += 2 + sovTask(uint64(m.PreviousOracleSetExpiry))
+	}
+	l = m.Eligibility.Size()
+	n += 2 + l + sovTask(uint64(l))
+	l = m.PayoutSchedule.Size()
+	n += 2 + l + sovTask(uint64(l))
+	if m.DisputeWindowBlocks != 0 {
+		n += 2 + sovTask(uint64(m.DisputeWindowBlocks))
+	}
+	if m.LastMerkleEpoch != 0 {
+		n += 2 + sovTask(uint64(m.LastMerkleEpoch))
+	}
+	l = m.Predicate.Size()
+	n += 2 + l + sovTask(uint64(l))
+	return n
+}
+
+func (m *PayoutSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovTask(uint64(m.Type))
+	}
+	if m.DurationBlocks != 0 {
+		n += 1 + sovTask(uint64(m.DurationBlocks))
+	}
+	if m.CliffBlocks != 0 {
+		n += 1 + sovTask(uint64(m.CliffBlocks))
+	}
+	return n
+}
+
+func (m *TaskEligibility) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RequireDid {
+		n += 2
+	}
+	if m.RequireLiveness {
+		n += 2
+	}
+	if m.MinDidAgeBlocks != 0 {
+		n += 1 + sovTask(uint64(m.MinDidAgeBlocks))
+	}
+	if len(m.RequiredCredentials) > 0 {
+		for _, s := range m.RequiredCredentials {
+			l = len(s)
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *OracleSet) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Pubkeys) > 0 {
+		for _, s := range m.Pubkeys {
+			l = len(s)
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	if m.Threshold != 0 {
+		n += 1 + sovTask(uint64(m.Threshold))
+	}
+	return n
+}
+
+func (m *TaskEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTask(uint64(l))
+	return n
+}
+
+func (m *PayoutWindow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovTask(uint64(m.Window))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTask(uint64(l))
+	if len(m.Buckets) > 0 {
+		for _, e := range m.Buckets {
+			l = e.Size()
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PayoutBucket) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Bucket != 0 {
+		n += 1 + sovTask(uint64(m.Bucket))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTask(uint64(l))
+	return n
+}
+
+func sovTask(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTask(x uint64) (n int) {
+	return sovTask(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Task) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Task: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Task: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Id = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPerClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Budget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingBudget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RemainingBudget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClaims", wireType)
+			}
+			m.MaxClaims = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxClaims |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerUserLimit", wireType)
+			}
+			m.PerUserLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerUserLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimCount", wireType)
+			}
+			m.ClaimCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClaimCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= TaskStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedHeight", wireType)
+			}
+			m.CreatedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CreatedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClosedHeight", wireType)
+			}
+			m.ClosedHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ClosedHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOracleSet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PreviousOracleSet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 16:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOracleSetExpiry", wireType)
+			}
+			m.PreviousOracleSetExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousOracleSetExpiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eligibility", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Eligibility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PayoutSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeWindowBlocks", wireType)
+			}
+			m.DisputeWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeWindowBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastMerkleEpoch", wireType)
+			}
+			m.LastMerkleEpoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastMerkleEpoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Predicate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayoutSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayoutSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayoutSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= PayoutScheduleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationBlocks", wireType)
+			}
+			m.DurationBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CliffBlocks", wireType)
+			}
+			m.CliffBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CliffBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskEligibility) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskEligibility: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskEligibility: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireDid", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireDid = bool(v != 0)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequireLiveness", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.RequireLiveness = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDidAgeBlocks", wireType)
+			}
+			m.MinDidAgeBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinDidAgeBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredCredentials", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RequiredCredentials = append(m.RequiredCredentials, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleSet) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleSet: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleSet: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pubkeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pubkeys = append(m.Pubkeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Threshold", wireType)
+			}
+			m.Threshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Threshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TaskEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TaskEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TaskEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayoutWindow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayoutWindow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayoutWindow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Buckets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Buckets = append(m.Buckets, PayoutBucket{})
+			if err := m.Buckets[len(m.Buckets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PayoutBucket) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PayoutBucket: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PayoutBucket: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bucket", wireType)
+			}
+			m.Bucket = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Bucket |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTask(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTask
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTask
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTask
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTask        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTask          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTask = fmt.Errorf("proto: unexpected end of group")
+)
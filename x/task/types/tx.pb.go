@@ -5,8 +5,11 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -124,27 +127,25 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-// MsgCreateClaimRecord defines the MsgCreateClaimRecord message.
-type MsgCreateClaimRecord struct {
+// MsgRevokeClaimRecord 撤销领取记录，必须给出原因
+type MsgRevokeClaimRecord struct {
 	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
 	ClaimHash string `protobuf:"bytes,2,opt,name=claim_hash,json=claimHash,proto3" json:"claim_hash,omitempty"`
-	TaskId    string `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	UserId    string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	Reason    string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 }
 
-func (m *MsgCreateClaimRecord) Reset()         { *m = MsgCreateClaimRecord{} }
-func (m *MsgCreateClaimRecord) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateClaimRecord) ProtoMessage()    {}
-func (*MsgCreateClaimRecord) Descriptor() ([]byte, []int) {
+func (m *MsgRevokeClaimRecord) Reset()         { *m = MsgRevokeClaimRecord{} }
+func (m *MsgRevokeClaimRecord) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeClaimRecord) ProtoMessage()    {}
+func (*MsgRevokeClaimRecord) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7d4323b4f511623d, []int{2}
 }
-func (m *MsgCreateClaimRecord) XXX_Unmarshal(b []byte) error {
+func (m *MsgRevokeClaimRecord) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateClaimRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRevokeClaimRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateClaimRecord.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRevokeClaimRecord.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -154,69 +155,55 @@ func (m *MsgCreateClaimRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateClaimRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateClaimRecord.Merge(m, src)
+func (m *MsgRevokeClaimRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeClaimRecord.Merge(m, src)
 }
-func (m *MsgCreateClaimRecord) XXX_Size() int {
+func (m *MsgRevokeClaimRecord) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateClaimRecord) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateClaimRecord.DiscardUnknown(m)
+func (m *MsgRevokeClaimRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeClaimRecord.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateClaimRecord proto.InternalMessageInfo
+var xxx_messageInfo_MsgRevokeClaimRecord proto.InternalMessageInfo
 
-func (m *MsgCreateClaimRecord) GetCreator() string {
+func (m *MsgRevokeClaimRecord) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgCreateClaimRecord) GetClaimHash() string {
+func (m *MsgRevokeClaimRecord) GetClaimHash() string {
 	if m != nil {
 		return m.ClaimHash
 	}
 	return ""
 }
 
-func (m *MsgCreateClaimRecord) GetTaskId() string {
+func (m *MsgRevokeClaimRecord) GetReason() string {
 	if m != nil {
-		return m.TaskId
-	}
-	return ""
-}
-
-func (m *MsgCreateClaimRecord) GetUserId() string {
-	if m != nil {
-		return m.UserId
-	}
-	return ""
-}
-
-func (m *MsgCreateClaimRecord) GetSignature() string {
-	if m != nil {
-		return m.Signature
+		return m.Reason
 	}
 	return ""
 }
 
-// MsgCreateClaimRecordResponse defines the MsgCreateClaimRecordResponse message.
-type MsgCreateClaimRecordResponse struct {
+// MsgRevokeClaimRecordResponse defines the MsgRevokeClaimRecordResponse message.
+type MsgRevokeClaimRecordResponse struct {
 }
 
-func (m *MsgCreateClaimRecordResponse) Reset()         { *m = MsgCreateClaimRecordResponse{} }
-func (m *MsgCreateClaimRecordResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgCreateClaimRecordResponse) ProtoMessage()    {}
-func (*MsgCreateClaimRecordResponse) Descriptor() ([]byte, []int) {
+func (m *MsgRevokeClaimRecordResponse) Reset()         { *m = MsgRevokeClaimRecordResponse{} }
+func (m *MsgRevokeClaimRecordResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRevokeClaimRecordResponse) ProtoMessage()    {}
+func (*MsgRevokeClaimRecordResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7d4323b4f511623d, []int{3}
 }
-func (m *MsgCreateClaimRecordResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgRevokeClaimRecordResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgCreateClaimRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgRevokeClaimRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgCreateClaimRecordResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgRevokeClaimRecordResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -226,39 +213,42 @@ func (m *MsgCreateClaimRecordResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgCreateClaimRecordResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgCreateClaimRecordResponse.Merge(m, src)
+func (m *MsgRevokeClaimRecordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRevokeClaimRecordResponse.Merge(m, src)
 }
-func (m *MsgCreateClaimRecordResponse) XXX_Size() int {
+func (m *MsgRevokeClaimRecordResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgCreateClaimRecordResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgCreateClaimRecordResponse.DiscardUnknown(m)
+func (m *MsgRevokeClaimRecordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRevokeClaimRecordResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgCreateClaimRecordResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgRevokeClaimRecordResponse proto.InternalMessageInfo
 
-// MsgUpdateClaimRecord defines the MsgUpdateClaimRecord message.
-type MsgUpdateClaimRecord struct {
+// MsgClaimReward defines the MsgClaimReward message.
+type MsgClaimReward struct {
 	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ClaimHash string `protobuf:"bytes,2,opt,name=claim_hash,json=claimHash,proto3" json:"claim_hash,omitempty"`
-	TaskId    string `protobuf:"bytes,3,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	UserId    string `protobuf:"bytes,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Signature string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+	TaskId    string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Amount    string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
+	// recipient 是实际接收奖金的用户地址（中台代办领奖时使用）
+	Recipient string `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// dependent_did 是监护人代被监护人领取时的被监护 DID，身份条件与领取次数按该 DID 计算，奖金发给 recipient
+	DependentDid string `protobuf:"bytes,6,opt,name=dependent_did,json=dependentDid,proto3" json:"dependent_did,omitempty"`
 }
 
-func (m *MsgUpdateClaimRecord) Reset()         { *m = MsgUpdateClaimRecord{} }
-func (m *MsgUpdateClaimRecord) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateClaimRecord) ProtoMessage()    {}
-func (*MsgUpdateClaimRecord) Descriptor() ([]byte, []int) {
+func (m *MsgClaimReward) Reset()         { *m = MsgClaimReward{} }
+func (m *MsgClaimReward) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimReward) ProtoMessage()    {}
+func (*MsgClaimReward) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7d4323b4f511623d, []int{4}
 }
-func (m *MsgUpdateClaimRecord) XXX_Unmarshal(b []byte) error {
+func (m *MsgClaimReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateClaimRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgClaimReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateClaimRecord.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgClaimReward.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -268,69 +258,80 @@ func (m *MsgUpdateClaimRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateClaimRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateClaimRecord.Merge(m, src)
+func (m *MsgClaimReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimReward.Merge(m, src)
 }
-func (m *MsgUpdateClaimRecord) XXX_Size() int {
+func (m *MsgClaimReward) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateClaimRecord) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateClaimRecord.DiscardUnknown(m)
+func (m *MsgClaimReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimReward.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateClaimRecord proto.InternalMessageInfo
+var xxx_messageInfo_MsgClaimReward proto.InternalMessageInfo
 
-func (m *MsgUpdateClaimRecord) GetCreator() string {
+func (m *MsgClaimReward) GetCreator() string {
 	if m != nil {
 		return m.Creator
 	}
 	return ""
 }
 
-func (m *MsgUpdateClaimRecord) GetClaimHash() string {
+func (m *MsgClaimReward) GetTaskId() string {
 	if m != nil {
-		return m.ClaimHash
+		return m.TaskId
 	}
 	return ""
 }
 
-func (m *MsgUpdateClaimRecord) GetTaskId() string {
+func (m *MsgClaimReward) GetAmount() string {
 	if m != nil {
-		return m.TaskId
+		return m.Amount
 	}
 	return ""
 }
 
-func (m *MsgUpdateClaimRecord) GetUserId() string {
+func (m *MsgClaimReward) GetSignature() string {
 	if m != nil {
-		return m.UserId
+		return m.Signature
 	}
 	return ""
 }
 
-func (m *MsgUpdateClaimRecord) GetSignature() string {
+func (m *MsgClaimReward) GetRecipient() string {
 	if m != nil {
-		return m.Signature
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MsgClaimReward) GetDependentDid() string {
+	if m != nil {
+		return m.DependentDid
 	}
 	return ""
 }
 
-// MsgUpdateClaimRecordResponse defines the MsgUpdateClaimRecordResponse message.
-type MsgUpdateClaimRecordResponse struct {
+// MsgClaimRewardResponse defines the MsgClaimRewardResponse message.
+type MsgClaimRewardResponse struct {
+	// stream_id 是奖励进入奖励流时的流 ID，立即发放或处于争议期时为 0
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// release_height 是任务设置争议期时奖励的发放高度，否则为 0
+	ReleaseHeight int64 `protobuf:"varint,2,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
 }
 
-func (m *MsgUpdateClaimRecordResponse) Reset()         { *m = MsgUpdateClaimRecordResponse{} }
-func (m *MsgUpdateClaimRecordResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateClaimRecordResponse) ProtoMessage()    {}
-func (*MsgUpdateClaimRecordResponse) Descriptor() ([]byte, []int) {
+func (m *MsgClaimRewardResponse) Reset()         { *m = MsgClaimRewardResponse{} }
+func (m *MsgClaimRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRewardResponse) ProtoMessage()    {}
+func (*MsgClaimRewardResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7d4323b4f511623d, []int{5}
 }
-func (m *MsgUpdateClaimRecordResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgClaimRewardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateClaimRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgClaimRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateClaimRecordResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgClaimRewardResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -340,36 +341,54 @@ func (m *MsgUpdateClaimRecordResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateClaimRecordResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateClaimRecordResponse.Merge(m, src)
+func (m *MsgClaimRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRewardResponse.Merge(m, src)
 }
-func (m *MsgUpdateClaimRecordResponse) XXX_Size() int {
+func (m *MsgClaimRewardResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateClaimRecordResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateClaimRecordResponse.DiscardUnknown(m)
+func (m *MsgClaimRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRewardResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimRewardResponse proto.InternalMessageInfo
+
+func (m *MsgClaimRewardResponse) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
 }
 
-var xxx_messageInfo_MsgUpdateClaimRecordResponse proto.InternalMessageInfo
+func (m *MsgClaimRewardResponse) GetReleaseHeight() int64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
 
-// MsgDeleteClaimRecord defines the MsgDeleteClaimRecord message.
-type MsgDeleteClaimRecord struct {
-	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	ClaimHash string `protobuf:"bytes,2,opt,name=claim_hash,json=claimHash,proto3" json:"claim_hash,omitempty"`
+// BatchClaimEntry 是批量领取中的一条领取，字段含义与 MsgClaimReward 相同
+type BatchClaimEntry struct {
+	TaskId string `protobuf:"bytes,1,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	// recipient 为空时奖金发放给 creator
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
+	// sequence 是该条目的领取序号，即领取人此前已领取该任务的次数，签名覆盖序号以防重放
+	Sequence uint64 `protobuf:"varint,4,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (m *MsgDeleteClaimRecord) Reset()         { *m = MsgDeleteClaimRecord{} }
-func (m *MsgDeleteClaimRecord) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteClaimRecord) ProtoMessage()    {}
-func (*MsgDeleteClaimRecord) Descriptor() ([]byte, []int) {
+func (m *BatchClaimEntry) Reset()         { *m = BatchClaimEntry{} }
+func (m *BatchClaimEntry) String() string { return proto.CompactTextString(m) }
+func (*BatchClaimEntry) ProtoMessage()    {}
+func (*BatchClaimEntry) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7d4323b4f511623d, []int{6}
 }
-func (m *MsgDeleteClaimRecord) XXX_Unmarshal(b []byte) error {
+func (m *BatchClaimEntry) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteClaimRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchClaimEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteClaimRecord.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchClaimEntry.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -379,48 +398,66 @@ func (m *MsgDeleteClaimRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteClaimRecord) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteClaimRecord.Merge(m, src)
+func (m *BatchClaimEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchClaimEntry.Merge(m, src)
 }
-func (m *MsgDeleteClaimRecord) XXX_Size() int {
+func (m *BatchClaimEntry) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteClaimRecord) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteClaimRecord.DiscardUnknown(m)
+func (m *BatchClaimEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchClaimEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteClaimRecord proto.InternalMessageInfo
+var xxx_messageInfo_BatchClaimEntry proto.InternalMessageInfo
 
-func (m *MsgDeleteClaimRecord) GetCreator() string {
+func (m *BatchClaimEntry) GetTaskId() string {
 	if m != nil {
-		return m.Creator
+		return m.TaskId
 	}
 	return ""
 }
 
-func (m *MsgDeleteClaimRecord) GetClaimHash() string {
+func (m *BatchClaimEntry) GetRecipient() string {
 	if m != nil {
-		return m.ClaimHash
+		return m.Recipient
 	}
 	return ""
 }
 
-// MsgDeleteClaimRecordResponse defines the MsgDeleteClaimRecordResponse message.
-type MsgDeleteClaimRecordResponse struct {
+func (m *BatchClaimEntry) GetAmount() string {
+	if m != nil {
+		return m.Amount
+	}
+	return ""
+}
+
+func (m *BatchClaimEntry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
 
-func (m *MsgDeleteClaimRecordResponse) Reset()         { *m = MsgDeleteClaimRecordResponse{} }
-func (m *MsgDeleteClaimRecordResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgDeleteClaimRecordResponse) ProtoMessage()    {}
-func (*MsgDeleteClaimRecordResponse) Descriptor() ([]byte, []int) {
+// MsgBatchClaimReward 批量代领奖励。signature 是对全部条目的签名，
+// 需满足每个条目所属任务的预言机集合阈值
+type MsgBatchClaimReward struct {
+	Creator   string            `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Entries   []BatchClaimEntry `protobuf:"bytes,2,rep,name=entries,proto3" json:"entries"`
+	Signature string            `protobuf:"bytes,3,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgBatchClaimReward) Reset()         { *m = MsgBatchClaimReward{} }
+func (m *MsgBatchClaimReward) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchClaimReward) ProtoMessage()    {}
+func (*MsgBatchClaimReward) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7d4323b4f511623d, []int{7}
 }
-func (m *MsgDeleteClaimRecordResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchClaimReward) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgDeleteClaimRecordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchClaimReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgDeleteClaimRecordResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchClaimReward.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -430,40 +467,61 @@ func (m *MsgDeleteClaimRecordResponse) XXX_Marshal(b []byte, deterministic bool)
 		return b[:n], nil
 	}
 }
-func (m *MsgDeleteClaimRecordResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgDeleteClaimRecordResponse.Merge(m, src)
+func (m *MsgBatchClaimReward) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchClaimReward.Merge(m, src)
 }
-func (m *MsgDeleteClaimRecordResponse) XXX_Size() int {
+func (m *MsgBatchClaimReward) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgDeleteClaimRecordResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgDeleteClaimRecordResponse.DiscardUnknown(m)
+func (m *MsgBatchClaimReward) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchClaimReward.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgDeleteClaimRecordResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgBatchClaimReward proto.InternalMessageInfo
 
-// MsgClaimReward defines the MsgClaimReward message.
-type MsgClaimReward struct {
-	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
-	TaskId    string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
-	Amount    string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
-	Signature string `protobuf:"bytes,4,opt,name=signature,proto3" json:"signature,omitempty"`
-	// recipient 是实际接收奖金的用户地址（中台代办领奖时使用）
-	Recipient string `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+func (m *MsgBatchClaimReward) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
 }
 
-func (m *MsgClaimReward) Reset()         { *m = MsgClaimReward{} }
-func (m *MsgClaimReward) String() string { return proto.CompactTextString(m) }
-func (*MsgClaimReward) ProtoMessage()    {}
-func (*MsgClaimReward) Descriptor() ([]byte, []int) {
+func (m *MsgBatchClaimReward) GetEntries() []BatchClaimEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func (m *MsgBatchClaimReward) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+// BatchClaimResult 是单个条目的领取结果，失败时 error 记录原因且该条目不改变状态
+type BatchClaimResult struct {
+	Index         uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	Success       bool   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	ClaimHash     string `protobuf:"bytes,3,opt,name=claim_hash,json=claimHash,proto3" json:"claim_hash,omitempty"`
+	StreamId      uint64 `protobuf:"varint,4,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	ReleaseHeight int64  `protobuf:"varint,5,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
+	Error         string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *BatchClaimResult) Reset()         { *m = BatchClaimResult{} }
+func (m *BatchClaimResult) String() string { return proto.CompactTextString(m) }
+func (*BatchClaimResult) ProtoMessage()    {}
+func (*BatchClaimResult) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7d4323b4f511623d, []int{8}
 }
-func (m *MsgClaimReward) XXX_Unmarshal(b []byte) error {
+func (m *BatchClaimResult) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgClaimReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *BatchClaimResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgClaimReward.Marshal(b, m, deterministic)
+		return xxx_messageInfo_BatchClaimResult.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -473,69 +531,77 @@ func (m *MsgClaimReward) XXX_Marshal(b []byte, deterministic bool) ([]byte, erro
 		return b[:n], nil
 	}
 }
-func (m *MsgClaimReward) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgClaimReward.Merge(m, src)
+func (m *BatchClaimResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BatchClaimResult.Merge(m, src)
 }
-func (m *MsgClaimReward) XXX_Size() int {
+func (m *BatchClaimResult) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgClaimReward) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgClaimReward.DiscardUnknown(m)
+func (m *BatchClaimResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BatchClaimResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgClaimReward proto.InternalMessageInfo
+var xxx_messageInfo_BatchClaimResult proto.InternalMessageInfo
 
-func (m *MsgClaimReward) GetCreator() string {
+func (m *BatchClaimResult) GetIndex() uint32 {
 	if m != nil {
-		return m.Creator
+		return m.Index
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgClaimReward) GetTaskId() string {
+func (m *BatchClaimResult) GetSuccess() bool {
 	if m != nil {
-		return m.TaskId
+		return m.Success
 	}
-	return ""
+	return false
 }
 
-func (m *MsgClaimReward) GetAmount() string {
+func (m *BatchClaimResult) GetClaimHash() string {
 	if m != nil {
-		return m.Amount
+		return m.ClaimHash
 	}
 	return ""
 }
 
-func (m *MsgClaimReward) GetSignature() string {
+func (m *BatchClaimResult) GetStreamId() uint64 {
 	if m != nil {
-		return m.Signature
+		return m.StreamId
 	}
-	return ""
+	return 0
 }
 
-func (m *MsgClaimReward) GetRecipient() string {
+func (m *BatchClaimResult) GetReleaseHeight() int64 {
 	if m != nil {
-		return m.Recipient
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+func (m *BatchClaimResult) GetError() string {
+	if m != nil {
+		return m.Error
 	}
 	return ""
 }
 
-// MsgClaimRewardResponse defines the MsgClaimRewardResponse message.
-type MsgClaimRewardResponse struct {
+// MsgBatchClaimRewardResponse 按条目顺序返回领取结果
+type MsgBatchClaimRewardResponse struct {
+	Results []BatchClaimResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
 }
 
-func (m *MsgClaimRewardResponse) Reset()         { *m = MsgClaimRewardResponse{} }
-func (m *MsgClaimRewardResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgClaimRewardResponse) ProtoMessage()    {}
-func (*MsgClaimRewardResponse) Descriptor() ([]byte, []int) {
+func (m *MsgBatchClaimRewardResponse) Reset()         { *m = MsgBatchClaimRewardResponse{} }
+func (m *MsgBatchClaimRewardResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBatchClaimRewardResponse) ProtoMessage()    {}
+func (*MsgBatchClaimRewardResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_7d4323b4f511623d, []int{9}
 }
-func (m *MsgClaimRewardResponse) XXX_Unmarshal(b []byte) error {
+func (m *MsgBatchClaimRewardResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgClaimRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgBatchClaimRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgClaimRewardResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgBatchClaimRewardResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -545,860 +611,7536 @@ func (m *MsgClaimRewardResponse) XXX_Marshal(b []byte, deterministic bool) ([]by
 		return b[:n], nil
 	}
 }
-func (m *MsgClaimRewardResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgClaimRewardResponse.Merge(m, src)
+func (m *MsgBatchClaimRewardResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBatchClaimRewardResponse.Merge(m, src)
 }
-func (m *MsgClaimRewardResponse) XXX_Size() int {
+func (m *MsgBatchClaimRewardResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgClaimRewardResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgClaimRewardResponse.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_MsgClaimRewardResponse proto.InternalMessageInfo
-
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "dtc.task.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dtc.task.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateClaimRecord)(nil), "dtc.task.v1.MsgCreateClaimRecord")
-	proto.RegisterType((*MsgCreateClaimRecordResponse)(nil), "dtc.task.v1.MsgCreateClaimRecordResponse")
-	proto.RegisterType((*MsgUpdateClaimRecord)(nil), "dtc.task.v1.MsgUpdateClaimRecord")
-	proto.RegisterType((*MsgUpdateClaimRecordResponse)(nil), "dtc.task.v1.MsgUpdateClaimRecordResponse")
-	proto.RegisterType((*MsgDeleteClaimRecord)(nil), "dtc.task.v1.MsgDeleteClaimRecord")
-	proto.RegisterType((*MsgDeleteClaimRecordResponse)(nil), "dtc.task.v1.MsgDeleteClaimRecordResponse")
-	proto.RegisterType((*MsgClaimReward)(nil), "dtc.task.v1.MsgClaimReward")
-	proto.RegisterType((*MsgClaimRewardResponse)(nil), "dtc.task.v1.MsgClaimRewardResponse")
+func (m *MsgBatchClaimRewardResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBatchClaimRewardResponse.DiscardUnknown(m)
 }
 
-func init() { proto.RegisterFile("dtc/task/v1/tx.proto", fileDescriptor_7d4323b4f511623d) }
+var xxx_messageInfo_MsgBatchClaimRewardResponse proto.InternalMessageInfo
 
-var fileDescriptor_7d4323b4f511623d = []byte{
-	// 594 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x55, 0xbf, 0x6f, 0xd3, 0x40,
-	0x14, 0x8e, 0xfb, 0x23, 0x95, 0x2f, 0x15, 0xd0, 0x23, 0x6a, 0x5c, 0x13, 0x4c, 0x1b, 0x18, 0x4a,
-	0xa4, 0xc6, 0x6a, 0x90, 0x3a, 0x74, 0x23, 0x65, 0xa0, 0x43, 0x04, 0x32, 0x62, 0x61, 0x89, 0x0e,
-	0xfb, 0xe4, 0x58, 0xd4, 0x3e, 0xeb, 0xee, 0xd2, 0x1f, 0x1b, 0x62, 0x64, 0xe2, 0xcf, 0x60, 0xcc,
-	0xc0, 0xff, 0x40, 0xc7, 0x8a, 0x09, 0x18, 0x10, 0x4a, 0x86, 0xfc, 0x1b, 0xe8, 0x7c, 0x71, 0xe2,
-	0xf8, 0xd2, 0x54, 0x42, 0x62, 0x61, 0x89, 0xf2, 0xde, 0xf7, 0xee, 0x7d, 0xef, 0xfb, 0x72, 0xef,
-	0x02, 0xca, 0x1e, 0x77, 0x6d, 0x8e, 0xd8, 0x3b, 0xfb, 0x74, 0xdf, 0xe6, 0xe7, 0x8d, 0x98, 0x12,
-	0x4e, 0x60, 0xc9, 0xe3, 0x6e, 0x43, 0x64, 0x1b, 0xa7, 0xfb, 0xe6, 0x06, 0x0a, 0x83, 0x88, 0xd8,
-	0xc9, 0xa7, 0xc4, 0xcd, 0x8a, 0x4b, 0x58, 0x48, 0x98, 0x1d, 0x32, 0x5f, 0x9c, 0x0b, 0x99, 0x3f,
-	0x06, 0xb6, 0x24, 0xd0, 0x49, 0x22, 0x5b, 0x06, 0x63, 0xc8, 0xc8, 0x32, 0xc5, 0x88, 0xa2, 0x30,
-	0x45, 0xca, 0x3e, 0xf1, 0x89, 0x3c, 0x21, 0xbe, 0xc9, 0x6c, 0xad, 0xaf, 0x81, 0xdb, 0x6d, 0xe6,
-	0xbf, 0x8e, 0x3d, 0xc4, 0xf1, 0xcb, 0xa4, 0x1e, 0x1e, 0x00, 0x1d, 0xf5, 0x78, 0x97, 0xd0, 0x80,
-	0x5f, 0x18, 0xda, 0xb6, 0xb6, 0xab, 0xb7, 0x8c, 0x6f, 0x5f, 0xf6, 0xca, 0x63, 0xa2, 0xa7, 0x9e,
-	0x47, 0x31, 0x63, 0xaf, 0x38, 0x0d, 0x22, 0xdf, 0x99, 0x96, 0xc2, 0x03, 0x50, 0x94, 0x8c, 0xc6,
-	0xd2, 0xb6, 0xb6, 0x5b, 0x6a, 0xde, 0x6d, 0x64, 0x04, 0x36, 0x64, 0xf3, 0x96, 0x7e, 0xf9, 0xeb,
-	0x41, 0xe1, 0xf3, 0xa8, 0x5f, 0xd7, 0x9c, 0x71, 0xf5, 0xe1, 0xde, 0x87, 0x51, 0xbf, 0x3e, 0xed,
-	0xf3, 0x71, 0xd4, 0xaf, 0x9b, 0x42, 0xc6, 0xb9, 0x14, 0x92, 0x1b, 0xaf, 0xb6, 0x05, 0x2a, 0xb9,
-	0x94, 0x83, 0x59, 0x4c, 0x22, 0x86, 0x6b, 0x5f, 0x35, 0x50, 0x6e, 0x33, 0xff, 0x88, 0x62, 0xc4,
-	0xf1, 0xd1, 0x09, 0x0a, 0x42, 0x07, 0xbb, 0x84, 0x7a, 0xb0, 0x09, 0xd6, 0x5c, 0x91, 0x24, 0xf4,
-	0x46, 0x41, 0x69, 0x21, 0xbc, 0x0f, 0x80, 0x2b, 0x5a, 0x74, 0xba, 0x88, 0x75, 0x13, 0x49, 0xba,
-	0xa3, 0x27, 0x99, 0xe7, 0x88, 0x75, 0x61, 0x05, 0xac, 0x89, 0xf1, 0x3a, 0x81, 0x67, 0x2c, 0x27,
-	0x58, 0x51, 0x84, 0xc7, 0x9e, 0x00, 0x7a, 0x0c, 0x53, 0x01, 0xac, 0x48, 0x40, 0x84, 0xc7, 0x1e,
-	0xac, 0x02, 0x9d, 0x05, 0x7e, 0x84, 0x78, 0x8f, 0x62, 0x63, 0x55, 0xf6, 0x9b, 0x24, 0x0e, 0xd7,
-	0x85, 0x0b, 0x29, 0x79, 0xcd, 0x02, 0xd5, 0x79, 0x42, 0xf2, 0x4a, 0xa5, 0x0b, 0xff, 0x81, 0x52,
-	0x45, 0xc8, 0x44, 0xe9, 0x59, 0x22, 0xf4, 0x19, 0x3e, 0xc1, 0xff, 0x5a, 0xe8, 0xdc, 0xc1, 0x14,
-	0xe2, 0xc9, 0x60, 0x3f, 0x34, 0x70, 0x4b, 0xfc, 0x46, 0x12, 0x3a, 0x43, 0x7f, 0x39, 0x53, 0xc6,
-	0xdd, 0xa5, 0x19, 0x77, 0x37, 0x41, 0x11, 0x85, 0xa4, 0x17, 0xf1, 0xd4, 0x75, 0x19, 0xcd, 0x9a,
-	0xbb, 0x92, 0x33, 0x57, 0x2c, 0x2f, 0xc5, 0x6e, 0x10, 0x07, 0x38, 0xe2, 0xd2, 0xfa, 0x45, 0xcb,
-	0x3b, 0x29, 0xcd, 0x69, 0x37, 0xc0, 0xe6, 0xac, 0xb4, 0x54, 0x75, 0xf3, 0xe7, 0x32, 0x58, 0x6e,
-	0x33, 0x1f, 0x3a, 0x60, 0x7d, 0xe6, 0xd1, 0xa8, 0xce, 0x2c, 0x7b, 0x6e, 0x41, 0xcd, 0x47, 0x8b,
-	0xd0, 0xb4, 0x37, 0x44, 0x60, 0x43, 0x5d, 0xdd, 0x9d, 0xfc, 0x51, 0xa5, 0xc4, 0x7c, 0x7c, 0x63,
-	0x49, 0x96, 0x42, 0xdd, 0x99, 0x9d, 0xf9, 0xd3, 0x2d, 0xa4, 0xb8, 0xf6, 0xc2, 0x0a, 0x0a, 0xf5,
-	0xb6, 0x2a, 0x14, 0x4a, 0x89, 0x4a, 0x71, 0xed, 0xd5, 0x83, 0x2f, 0x40, 0x29, 0x7b, 0xed, 0xee,
-	0x29, 0xfa, 0xa7, 0xa0, 0xf9, 0x70, 0x01, 0x98, 0x36, 0x34, 0x57, 0xdf, 0x8b, 0x17, 0xb9, 0x55,
-	0xbf, 0x1c, 0x58, 0xda, 0xd5, 0xc0, 0xd2, 0x7e, 0x0f, 0x2c, 0xed, 0xd3, 0xd0, 0x2a, 0x5c, 0x0d,
-	0xad, 0xc2, 0xf7, 0xa1, 0x55, 0x78, 0x73, 0x27, 0xf3, 0x20, 0xf3, 0x8b, 0x18, 0xb3, 0xb7, 0xc5,
-	0xe4, 0x0f, 0xe4, 0xc9, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x9c, 0xbb, 0x52, 0x42, 0xdc, 0x06,
-	0x00, 0x00,
+func (m *MsgBatchClaimRewardResponse) GetResults() []BatchClaimResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// CreateClaimRecord defines the CreateClaimRecord RPC.
-	CreateClaimRecord(ctx context.Context, in *MsgCreateClaimRecord, opts ...grpc.CallOption) (*MsgCreateClaimRecordResponse, error)
-	// UpdateClaimRecord defines the UpdateClaimRecord RPC.
-	UpdateClaimRecord(ctx context.Context, in *MsgUpdateClaimRecord, opts ...grpc.CallOption) (*MsgUpdateClaimRecordResponse, error)
-	// DeleteClaimRecord defines the DeleteClaimRecord RPC.
-	DeleteClaimRecord(ctx context.Context, in *MsgDeleteClaimRecord, opts ...grpc.CallOption) (*MsgDeleteClaimRecordResponse, error)
-	// ClaimReward defines the ClaimReward RPC.
-	ClaimReward(ctx context.Context, in *MsgClaimReward, opts ...grpc.CallOption) (*MsgClaimRewardResponse, error)
+// MsgEnrollTask 登记参与链上判定任务，判定从登记高度起计算持续时长。
+// 持有余额的判定在登记时将要求的金额锁定在模块账户
+type MsgEnrollTask struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId  string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgEnrollTask) Reset()         { *m = MsgEnrollTask{} }
+func (m *MsgEnrollTask) String() string { return proto.CompactTextString(m) }
+func (*MsgEnrollTask) ProtoMessage()    {}
+func (*MsgEnrollTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{10}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgEnrollTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgEnrollTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnrollTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) CreateClaimRecord(ctx context.Context, in *MsgCreateClaimRecord, opts ...grpc.CallOption) (*MsgCreateClaimRecordResponse, error) {
-	out := new(MsgCreateClaimRecordResponse)
-	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/CreateClaimRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgEnrollTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnrollTask.Merge(m, src)
 }
-
-func (c *msgClient) UpdateClaimRecord(ctx context.Context, in *MsgUpdateClaimRecord, opts ...grpc.CallOption) (*MsgUpdateClaimRecordResponse, error) {
-	out := new(MsgUpdateClaimRecordResponse)
-	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/UpdateClaimRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgEnrollTask) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) DeleteClaimRecord(ctx context.Context, in *MsgDeleteClaimRecord, opts ...grpc.CallOption) (*MsgDeleteClaimRecordResponse, error) {
-	out := new(MsgDeleteClaimRecordResponse)
-	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/DeleteClaimRecord", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgEnrollTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnrollTask.DiscardUnknown(m)
 }
 
-func (c *msgClient) ClaimReward(ctx context.Context, in *MsgClaimReward, opts ...grpc.CallOption) (*MsgClaimRewardResponse, error) {
-	out := new(MsgClaimRewardResponse)
-	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/ClaimReward", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgEnrollTask proto.InternalMessageInfo
+
+func (m *MsgEnrollTask) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return out, nil
+	return ""
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// UpdateParams defines a (governance) operation for updating the module
-	// parameters. The authority defaults to the x/gov module account.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// CreateClaimRecord defines the CreateClaimRecord RPC.
-	CreateClaimRecord(context.Context, *MsgCreateClaimRecord) (*MsgCreateClaimRecordResponse, error)
-	// UpdateClaimRecord defines the UpdateClaimRecord RPC.
-	UpdateClaimRecord(context.Context, *MsgUpdateClaimRecord) (*MsgUpdateClaimRecordResponse, error)
-	// DeleteClaimRecord defines the DeleteClaimRecord RPC.
-	DeleteClaimRecord(context.Context, *MsgDeleteClaimRecord) (*MsgDeleteClaimRecordResponse, error)
-	// ClaimReward defines the ClaimReward RPC.
-	ClaimReward(context.Context, *MsgClaimReward) (*MsgClaimRewardResponse, error)
+func (m *MsgEnrollTask) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+// MsgEnrollTaskResponse defines the MsgEnrollTaskResponse message.
+type MsgEnrollTaskResponse struct {
 }
 
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
-}
-func (*UnimplementedMsgServer) CreateClaimRecord(ctx context.Context, req *MsgCreateClaimRecord) (*MsgCreateClaimRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateClaimRecord not implemented")
+func (m *MsgEnrollTaskResponse) Reset()         { *m = MsgEnrollTaskResponse{} }
+func (m *MsgEnrollTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEnrollTaskResponse) ProtoMessage()    {}
+func (*MsgEnrollTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{11}
 }
-func (*UnimplementedMsgServer) UpdateClaimRecord(ctx context.Context, req *MsgUpdateClaimRecord) (*MsgUpdateClaimRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateClaimRecord not implemented")
-}
-func (*UnimplementedMsgServer) DeleteClaimRecord(ctx context.Context, req *MsgDeleteClaimRecord) (*MsgDeleteClaimRecordResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DeleteClaimRecord not implemented")
+func (m *MsgEnrollTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ClaimReward(ctx context.Context, req *MsgClaimReward) (*MsgClaimRewardResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimReward not implemented")
+func (m *MsgEnrollTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEnrollTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEnrollTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEnrollTaskResponse.Merge(m, src)
+}
+func (m *MsgEnrollTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEnrollTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEnrollTaskResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgEnrollTaskResponse proto.InternalMessageInfo
+
+// MsgClaimNative 领取链上判定任务的奖励，奖金发放给 creator
+type MsgClaimNative struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId  string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dtc.task.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgClaimNative) Reset()         { *m = MsgClaimNative{} }
+func (m *MsgClaimNative) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimNative) ProtoMessage()    {}
+func (*MsgClaimNative) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{12}
+}
+func (m *MsgClaimNative) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimNative) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimNative.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgClaimNative) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimNative.Merge(m, src)
+}
+func (m *MsgClaimNative) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimNative) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimNative.DiscardUnknown(m)
 }
 
-func _Msg_CreateClaimRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateClaimRecord)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateClaimRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dtc.task.v1.Msg/CreateClaimRecord",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateClaimRecord(ctx, req.(*MsgCreateClaimRecord))
+var xxx_messageInfo_MsgClaimNative proto.InternalMessageInfo
+
+func (m *MsgClaimNative) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateClaimRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateClaimRecord)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateClaimRecord(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dtc.task.v1.Msg/UpdateClaimRecord",
+func (m *MsgClaimNative) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateClaimRecord(ctx, req.(*MsgUpdateClaimRecord))
+	return ""
+}
+
+// MsgClaimNativeResponse defines the MsgClaimNativeResponse message.
+type MsgClaimNativeResponse struct {
+	ClaimHash string `protobuf:"bytes,1,opt,name=claim_hash,json=claimHash,proto3" json:"claim_hash,omitempty"`
+	// stream_id 是奖励进入奖励流时的流 ID，立即发放或处于争议期时为 0
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// release_height 是任务设置争议期时奖励的发放高度，否则为 0
+	ReleaseHeight int64 `protobuf:"varint,3,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
+}
+
+func (m *MsgClaimNativeResponse) Reset()         { *m = MsgClaimNativeResponse{} }
+func (m *MsgClaimNativeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimNativeResponse) ProtoMessage()    {}
+func (*MsgClaimNativeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{13}
+}
+func (m *MsgClaimNativeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimNativeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimNativeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgClaimNativeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimNativeResponse.Merge(m, src)
+}
+func (m *MsgClaimNativeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimNativeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimNativeResponse.DiscardUnknown(m)
 }
 
-func _Msg_DeleteClaimRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDeleteClaimRecord)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimNativeResponse proto.InternalMessageInfo
+
+func (m *MsgClaimNativeResponse) GetClaimHash() string {
+	if m != nil {
+		return m.ClaimHash
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DeleteClaimRecord(ctx, in)
+	return ""
+}
+
+func (m *MsgClaimNativeResponse) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dtc.task.v1.Msg/DeleteClaimRecord",
+	return 0
+}
+
+func (m *MsgClaimNativeResponse) GetReleaseHeight() int64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+// MsgCreateTask 登记任务。per_user_limit 为 0 时按 1 处理
+type MsgCreateTask struct {
+	Creator        string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId         string     `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	RewardPerClaim types.Coin `protobuf:"bytes,3,opt,name=reward_per_claim,json=rewardPerClaim,proto3" json:"reward_per_claim"`
+	Budget         types.Coin `protobuf:"bytes,4,opt,name=budget,proto3" json:"budget"`
+	MaxClaims      uint64     `protobuf:"varint,5,opt,name=max_claims,json=maxClaims,proto3" json:"max_claims,omitempty"`
+	PerUserLimit   uint64     `protobuf:"varint,6,opt,name=per_user_limit,json=perUserLimit,proto3" json:"per_user_limit,omitempty"`
+	StartHeight    int64      `protobuf:"varint,7,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	EndHeight      int64      `protobuf:"varint,8,opt,name=end_height,json=endHeight,proto3" json:"end_height,omitempty"`
+	// oracle_pubkeys 是为该任务领取签名的预言机公钥（hex）
+	OraclePubkeys []string `protobuf:"bytes,9,rep,name=oracle_pubkeys,json=oraclePubkeys,proto3" json:"oracle_pubkeys,omitempty"`
+	// oracle_threshold 是一次领取所需的预言机签名数量，为 0 时按 1 处理
+	OracleThreshold uint32 `protobuf:"varint,10,opt,name=oracle_threshold,json=oracleThreshold,proto3" json:"oracle_threshold,omitempty"`
+	// eligibility 是领取者必须满足的身份条件
+	Eligibility TaskEligibility `protobuf:"bytes,11,opt,name=eligibility,proto3" json:"eligibility"`
+	// payout_schedule 是奖励的释放方式，默认立即发放
+	PayoutSchedule PayoutSchedule `protobuf:"bytes,12,opt,name=payout_schedule,json=payoutSchedule,proto3" json:"payout_schedule"`
+	// dispute_window_blocks 是领取后奖励可被争议的区块数，0 表示不设争议期
+	DisputeWindowBlocks int64 `protobuf:"varint,13,opt,name=dispute_window_blocks,json=disputeWindowBlocks,proto3" json:"dispute_window_blocks,omitempty"`
+	// predicate 非空时任务由链上状态判定完成，此时不能设置预言机公钥
+	Predicate TaskPredicate `protobuf:"bytes,14,opt,name=predicate,proto3" json:"predicate"`
+}
+
+func (m *MsgCreateTask) Reset()         { *m = MsgCreateTask{} }
+func (m *MsgCreateTask) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTask) ProtoMessage()    {}
+func (*MsgCreateTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{14}
+}
+func (m *MsgCreateTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DeleteClaimRecord(ctx, req.(*MsgDeleteClaimRecord))
+}
+func (m *MsgCreateTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTask.Merge(m, src)
+}
+func (m *MsgCreateTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateTask proto.InternalMessageInfo
+
+func (m *MsgCreateTask) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ClaimReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimReward)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgCreateTask) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimReward(ctx, in)
+	return ""
+}
+
+func (m *MsgCreateTask) GetRewardPerClaim() types.Coin {
+	if m != nil {
+		return m.RewardPerClaim
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/dtc.task.v1.Msg/ClaimReward",
+	return types.Coin{}
+}
+
+func (m *MsgCreateTask) GetBudget() types.Coin {
+	if m != nil {
+		return m.Budget
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimReward(ctx, req.(*MsgClaimReward))
+	return types.Coin{}
+}
+
+func (m *MsgCreateTask) GetMaxClaims() uint64 {
+	if m != nil {
+		return m.MaxClaims
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var Msg_serviceDesc = _Msg_serviceDesc
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "dtc.task.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateClaimRecord",
-			Handler:    _Msg_CreateClaimRecord_Handler,
-		},
-		{
-			MethodName: "UpdateClaimRecord",
-			Handler:    _Msg_UpdateClaimRecord_Handler,
-		},
-		{
-			MethodName: "DeleteClaimRecord",
-			Handler:    _Msg_DeleteClaimRecord_Handler,
-		},
-		{
-			MethodName: "ClaimReward",
-			Handler:    _Msg_ClaimReward_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "dtc/task/v1/tx.proto",
+func (m *MsgCreateTask) GetPerUserLimit() uint64 {
+	if m != nil {
+		return m.PerUserLimit
+	}
+	return 0
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTask) GetStartHeight() int64 {
+	if m != nil {
+		return m.StartHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateTask) GetEndHeight() int64 {
+	if m != nil {
+		return m.EndHeight
+	}
+	return 0
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgCreateTask) GetOraclePubkeys() []string {
+	if m != nil {
+		return m.OraclePubkeys
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	return nil
+}
+
+func (m *MsgCreateTask) GetOracleThreshold() uint32 {
+	if m != nil {
+		return m.OracleThreshold
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTask) GetEligibility() TaskEligibility {
+	if m != nil {
+		return m.Eligibility
 	}
-	return dAtA[:n], nil
+	return TaskEligibility{}
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCreateTask) GetPayoutSchedule() PayoutSchedule {
+	if m != nil {
+		return m.PayoutSchedule
+	}
+	return PayoutSchedule{}
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgCreateTask) GetDisputeWindowBlocks() int64 {
+	if m != nil {
+		return m.DisputeWindowBlocks
+	}
+	return 0
 }
 
-func (m *MsgCreateClaimRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCreateTask) GetPredicate() TaskPredicate {
+	if m != nil {
+		return m.Predicate
 	}
-	return dAtA[:n], nil
+	return TaskPredicate{}
 }
 
-func (m *MsgCreateClaimRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgCreateTaskResponse defines the MsgCreateTaskResponse message.
+type MsgCreateTaskResponse struct {
 }
 
-func (m *MsgCreateClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.UserId) > 0 {
-		i -= len(m.UserId)
-		copy(dAtA[i:], m.UserId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UserId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ClaimHash) > 0 {
-		i -= len(m.ClaimHash)
-		copy(dAtA[i:], m.ClaimHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClaimHash)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgCreateTaskResponse) Reset()         { *m = MsgCreateTaskResponse{} }
+func (m *MsgCreateTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCreateTaskResponse) ProtoMessage()    {}
+func (*MsgCreateTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{15}
+}
+func (m *MsgCreateTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCreateTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCreateTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgCreateTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCreateTaskResponse.Merge(m, src)
+}
+func (m *MsgCreateTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCreateTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCreateTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCreateTaskResponse proto.InternalMessageInfo
+
+// MsgCloseTask 关闭任务
+type MsgCloseTask struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId  string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (m *MsgCloseTask) Reset()         { *m = MsgCloseTask{} }
+func (m *MsgCloseTask) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseTask) ProtoMessage()    {}
+func (*MsgCloseTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{16}
+}
+func (m *MsgCloseTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgCloseTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseTask.Merge(m, src)
+}
+func (m *MsgCloseTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseTask.DiscardUnknown(m)
 }
 
-func (m *MsgCreateClaimRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgCloseTask proto.InternalMessageInfo
+
+func (m *MsgCloseTask) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateClaimRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgCloseTask) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
 }
 
-func (m *MsgCreateClaimRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgCloseTaskResponse defines the MsgCloseTaskResponse message.
+type MsgCloseTaskResponse struct {
 }
 
-func (m *MsgUpdateClaimRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgCloseTaskResponse) Reset()         { *m = MsgCloseTaskResponse{} }
+func (m *MsgCloseTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCloseTaskResponse) ProtoMessage()    {}
+func (*MsgCloseTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{17}
+}
+func (m *MsgCloseTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCloseTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCloseTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgCloseTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCloseTaskResponse.Merge(m, src)
+}
+func (m *MsgCloseTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCloseTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCloseTaskResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateClaimRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgCloseTaskResponse proto.InternalMessageInfo
+
+// MsgFundTask 向任务托管注资，币种必须与任务奖励一致且托管总额不得超过剩余预算
+type MsgFundTask struct {
+	Creator string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId  string     `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Amount  types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgUpdateClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.UserId) > 0 {
-		i -= len(m.UserId)
-		copy(dAtA[i:], m.UserId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.UserId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0x1a
+func (m *MsgFundTask) Reset()         { *m = MsgFundTask{} }
+func (m *MsgFundTask) String() string { return proto.CompactTextString(m) }
+func (*MsgFundTask) ProtoMessage()    {}
+func (*MsgFundTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{18}
+}
+func (m *MsgFundTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.ClaimHash) > 0 {
-		i -= len(m.ClaimHash)
-		copy(dAtA[i:], m.ClaimHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClaimHash)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgFundTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundTask.Merge(m, src)
+}
+func (m *MsgFundTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgFundTask proto.InternalMessageInfo
+
+func (m *MsgFundTask) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgFundTask) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateClaimRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgFundTask) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgUpdateClaimRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgFundTaskResponse defines the MsgFundTaskResponse message.
+type MsgFundTaskResponse struct {
+	Escrow types.Coin `protobuf:"bytes,1,opt,name=escrow,proto3" json:"escrow"`
 }
 
-func (m *MsgUpdateClaimRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgFundTaskResponse) Reset()         { *m = MsgFundTaskResponse{} }
+func (m *MsgFundTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgFundTaskResponse) ProtoMessage()    {}
+func (*MsgFundTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{19}
+}
+func (m *MsgFundTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgFundTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgFundTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgFundTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgFundTaskResponse.Merge(m, src)
+}
+func (m *MsgFundTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgFundTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgFundTaskResponse.DiscardUnknown(m)
 }
 
-func (m *MsgDeleteClaimRecord) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgFundTaskResponse proto.InternalMessageInfo
+
+func (m *MsgFundTaskResponse) GetEscrow() types.Coin {
+	if m != nil {
+		return m.Escrow
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgDeleteClaimRecord) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgReclaimTaskEscrow 取回已关闭任务的剩余托管资金
+type MsgReclaimTaskEscrow struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId  string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
 }
 
-func (m *MsgDeleteClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ClaimHash) > 0 {
-		i -= len(m.ClaimHash)
-		copy(dAtA[i:], m.ClaimHash)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ClaimHash)))
-		i--
-		dAtA[i] = 0x12
+func (m *MsgReclaimTaskEscrow) Reset()         { *m = MsgReclaimTaskEscrow{} }
+func (m *MsgReclaimTaskEscrow) String() string { return proto.CompactTextString(m) }
+func (*MsgReclaimTaskEscrow) ProtoMessage()    {}
+func (*MsgReclaimTaskEscrow) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{20}
+}
+func (m *MsgReclaimTaskEscrow) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReclaimTaskEscrow) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReclaimTaskEscrow.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgReclaimTaskEscrow) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReclaimTaskEscrow.Merge(m, src)
+}
+func (m *MsgReclaimTaskEscrow) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReclaimTaskEscrow) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReclaimTaskEscrow.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReclaimTaskEscrow proto.InternalMessageInfo
+
+func (m *MsgReclaimTaskEscrow) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgDeleteClaimRecordResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReclaimTaskEscrow) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgDeleteClaimRecordResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgReclaimTaskEscrowResponse defines the MsgReclaimTaskEscrowResponse message.
+type MsgReclaimTaskEscrowResponse struct {
+	Amount types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
 }
 
-func (m *MsgDeleteClaimRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgReclaimTaskEscrowResponse) Reset()         { *m = MsgReclaimTaskEscrowResponse{} }
+func (m *MsgReclaimTaskEscrowResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReclaimTaskEscrowResponse) ProtoMessage()    {}
+func (*MsgReclaimTaskEscrowResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{21}
+}
+func (m *MsgReclaimTaskEscrowResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReclaimTaskEscrowResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReclaimTaskEscrowResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReclaimTaskEscrowResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReclaimTaskEscrowResponse.Merge(m, src)
+}
+func (m *MsgReclaimTaskEscrowResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReclaimTaskEscrowResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReclaimTaskEscrowResponse.DiscardUnknown(m)
 }
 
-func (m *MsgClaimReward) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReclaimTaskEscrowResponse proto.InternalMessageInfo
+
+func (m *MsgReclaimTaskEscrowResponse) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
 	}
-	return dAtA[:n], nil
+	return types.Coin{}
 }
 
-func (m *MsgClaimReward) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgRotateTaskOracles 轮换任务的预言机公钥集合
+type MsgRotateTaskOracles struct {
+	Creator         string   `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId          string   `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	OraclePubkeys   []string `protobuf:"bytes,3,rep,name=oracle_pubkeys,json=oraclePubkeys,proto3" json:"oracle_pubkeys,omitempty"`
+	OracleThreshold uint32   `protobuf:"varint,4,opt,name=oracle_threshold,json=oracleThreshold,proto3" json:"oracle_threshold,omitempty"`
+	// overlap_blocks 是旧公钥集合继续有效的区块数，0 表示立即失效
+	OverlapBlocks int64 `protobuf:"varint,5,opt,name=overlap_blocks,json=overlapBlocks,proto3" json:"overlap_blocks,omitempty"`
 }
 
-func (m *MsgClaimReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Recipient) > 0 {
-		i -= len(m.Recipient)
-		copy(dAtA[i:], m.Recipient)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x22
+func (m *MsgRotateTaskOracles) Reset()         { *m = MsgRotateTaskOracles{} }
+func (m *MsgRotateTaskOracles) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateTaskOracles) ProtoMessage()    {}
+func (*MsgRotateTaskOracles) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{22}
+}
+func (m *MsgRotateTaskOracles) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateTaskOracles) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateTaskOracles.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	if len(m.Amount) > 0 {
-		i -= len(m.Amount)
-		copy(dAtA[i:], m.Amount)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
-		i--
-		dAtA[i] = 0x1a
+}
+func (m *MsgRotateTaskOracles) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateTaskOracles.Merge(m, src)
+}
+func (m *MsgRotateTaskOracles) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateTaskOracles) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateTaskOracles.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRotateTaskOracles proto.InternalMessageInfo
+
+func (m *MsgRotateTaskOracles) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	if len(m.TaskId) > 0 {
-		i -= len(m.TaskId)
-		copy(dAtA[i:], m.TaskId)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
-		i--
-		dAtA[i] = 0x12
+	return ""
+}
+
+func (m *MsgRotateTaskOracles) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
 	}
-	if len(m.Creator) > 0 {
-		i -= len(m.Creator)
-		copy(dAtA[i:], m.Creator)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
-		i--
-		dAtA[i] = 0xa
+	return ""
+}
+
+func (m *MsgRotateTaskOracles) GetOraclePubkeys() []string {
+	if m != nil {
+		return m.OraclePubkeys
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *MsgClaimRewardResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgRotateTaskOracles) GetOracleThreshold() uint32 {
+	if m != nil {
+		return m.OracleThreshold
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *MsgClaimRewardResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgRotateTaskOracles) GetOverlapBlocks() int64 {
+	if m != nil {
+		return m.OverlapBlocks
+	}
+	return 0
 }
 
-func (m *MsgClaimRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgRotateTaskOraclesResponse defines the MsgRotateTaskOraclesResponse message.
+type MsgRotateTaskOraclesResponse struct {
+	PreviousOracleSetExpiry int64 `protobuf:"varint,1,opt,name=previous_oracle_set_expiry,json=previousOracleSetExpiry,proto3" json:"previous_oracle_set_expiry,omitempty"`
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
+func (m *MsgRotateTaskOraclesResponse) Reset()         { *m = MsgRotateTaskOraclesResponse{} }
+func (m *MsgRotateTaskOraclesResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRotateTaskOraclesResponse) ProtoMessage()    {}
+func (*MsgRotateTaskOraclesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{23}
 }
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgRotateTaskOraclesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRotateTaskOraclesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRotateTaskOraclesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+}
+func (m *MsgRotateTaskOraclesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRotateTaskOraclesResponse.Merge(m, src)
+}
+func (m *MsgRotateTaskOraclesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRotateTaskOraclesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRotateTaskOraclesResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgRotateTaskOraclesResponse proto.InternalMessageInfo
+
+func (m *MsgRotateTaskOraclesResponse) GetPreviousOracleSetExpiry() int64 {
+	if m != nil {
+		return m.PreviousOracleSetExpiry
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *MsgCreateClaimRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ClaimHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.UserId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+// MsgWithdrawVested 由奖励流的接收用户提取已释放的奖励，stream_id 为 0 时提取其全部奖励流
+type MsgWithdrawVested struct {
+	Creator  string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	StreamId uint64 `protobuf:"varint,2,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
 }
 
-func (m *MsgCreateClaimRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawVested) Reset()         { *m = MsgWithdrawVested{} }
+func (m *MsgWithdrawVested) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawVested) ProtoMessage()    {}
+func (*MsgWithdrawVested) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{24}
+}
+func (m *MsgWithdrawVested) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawVested) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawVested.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgWithdrawVested) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawVested.Merge(m, src)
+}
+func (m *MsgWithdrawVested) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawVested) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawVested.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateClaimRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ClaimHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.UserId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+var xxx_messageInfo_MsgWithdrawVested proto.InternalMessageInfo
+
+func (m *MsgWithdrawVested) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	return n
+	return ""
 }
 
-func (m *MsgUpdateClaimRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawVested) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *MsgDeleteClaimRecord) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ClaimHash)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+// MsgWithdrawVestedResponse defines the MsgWithdrawVestedResponse message.
+type MsgWithdrawVestedResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (m *MsgDeleteClaimRecordResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgWithdrawVestedResponse) Reset()         { *m = MsgWithdrawVestedResponse{} }
+func (m *MsgWithdrawVestedResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgWithdrawVestedResponse) ProtoMessage()    {}
+func (*MsgWithdrawVestedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{25}
+}
+func (m *MsgWithdrawVestedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgWithdrawVestedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgWithdrawVestedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	var l int
-	_ = l
-	return n
+}
+func (m *MsgWithdrawVestedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgWithdrawVestedResponse.Merge(m, src)
+}
+func (m *MsgWithdrawVestedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgWithdrawVestedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgWithdrawVestedResponse.DiscardUnknown(m)
 }
 
-func (m *MsgClaimReward) Size() (n int) {
-	if m == nil {
-		return 0
+var xxx_messageInfo_MsgWithdrawVestedResponse proto.InternalMessageInfo
+
+func (m *MsgWithdrawVestedResponse) GetAmount() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Amount
 	}
-	var l int
-	_ = l
-	l = len(m.Creator)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+
+// MsgDisputeClaim 由任务 owner 或持有 ROLE_TASK_ARBITRATOR 角色的地址在争议期内提出争议，
+// 待定奖励退回任务托管，领取记录被撤销
+type MsgDisputeClaim struct {
+	Creator   string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	ClaimHash string `protobuf:"bytes,2,opt,name=claim_hash,json=claimHash,proto3" json:"claim_hash,omitempty"`
+	// evidence 是争议证据，例如链下证明的哈希或链接
+	Evidence string `protobuf:"bytes,3,opt,name=evidence,proto3" json:"evidence,omitempty"`
+}
+
+func (m *MsgDisputeClaim) Reset()         { *m = MsgDisputeClaim{} }
+func (m *MsgDisputeClaim) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeClaim) ProtoMessage()    {}
+func (*MsgDisputeClaim) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{26}
+}
+func (m *MsgDisputeClaim) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisputeClaim) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisputeClaim.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	l = len(m.TaskId)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+}
+func (m *MsgDisputeClaim) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeClaim.Merge(m, src)
+}
+func (m *MsgDisputeClaim) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisputeClaim) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeClaim.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisputeClaim proto.InternalMessageInfo
+
+func (m *MsgDisputeClaim) GetCreator() string {
+	if m != nil {
+		return m.Creator
 	}
-	l = len(m.Amount)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgDisputeClaim) GetClaimHash() string {
+	if m != nil {
+		return m.ClaimHash
 	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+func (m *MsgDisputeClaim) GetEvidence() string {
+	if m != nil {
+		return m.Evidence
 	}
-	l = len(m.Recipient)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return ""
+}
+
+// MsgDisputeClaimResponse defines the MsgDisputeClaimResponse message.
+type MsgDisputeClaimResponse struct {
+	Returned types.Coin `protobuf:"bytes,1,opt,name=returned,proto3" json:"returned"`
+}
+
+func (m *MsgDisputeClaimResponse) Reset()         { *m = MsgDisputeClaimResponse{} }
+func (m *MsgDisputeClaimResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDisputeClaimResponse) ProtoMessage()    {}
+func (*MsgDisputeClaimResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{27}
+}
+func (m *MsgDisputeClaimResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDisputeClaimResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDisputeClaimResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgDisputeClaimResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDisputeClaimResponse.Merge(m, src)
+}
+func (m *MsgDisputeClaimResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDisputeClaimResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDisputeClaimResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgDisputeClaimResponse proto.InternalMessageInfo
+
+func (m *MsgDisputeClaimResponse) GetReturned() types.Coin {
+	if m != nil {
+		return m.Returned
+	}
+	return types.Coin{}
+}
+
+// MsgSetCreditRepayShare 设置发送者每笔奖励用于偿还信用负债的比例。
+// 实际比例取该值与治理比例中的较大者，设置为 0 即取消自愿比例
+type MsgSetCreditRepayShare struct {
+	Creator string                      `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	Share   cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share"`
+}
+
+func (m *MsgSetCreditRepayShare) Reset()         { *m = MsgSetCreditRepayShare{} }
+func (m *MsgSetCreditRepayShare) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCreditRepayShare) ProtoMessage()    {}
+func (*MsgSetCreditRepayShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{28}
+}
+func (m *MsgSetCreditRepayShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCreditRepayShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCreditRepayShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCreditRepayShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCreditRepayShare.Merge(m, src)
+}
+func (m *MsgSetCreditRepayShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCreditRepayShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCreditRepayShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCreditRepayShare proto.InternalMessageInfo
+
+func (m *MsgSetCreditRepayShare) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+// MsgSetCreditRepayShareResponse defines the MsgSetCreditRepayShareResponse message.
+type MsgSetCreditRepayShareResponse struct {
+}
+
+func (m *MsgSetCreditRepayShareResponse) Reset()         { *m = MsgSetCreditRepayShareResponse{} }
+func (m *MsgSetCreditRepayShareResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetCreditRepayShareResponse) ProtoMessage()    {}
+func (*MsgSetCreditRepayShareResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{29}
+}
+func (m *MsgSetCreditRepayShareResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetCreditRepayShareResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetCreditRepayShareResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetCreditRepayShareResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetCreditRepayShareResponse.Merge(m, src)
+}
+func (m *MsgSetCreditRepayShareResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetCreditRepayShareResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetCreditRepayShareResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetCreditRepayShareResponse proto.InternalMessageInfo
+
+// MsgPostMerkleRoot 发布任务某一周期的 Merkle 根。signature 是任务预言机对
+// task_id/epoch/root/leaf_count/total/expiry_height 的签名，任何地址均可代为提交
+type MsgPostMerkleRoot struct {
+	Creator      string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId       string     `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Epoch        uint64     `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Root         string     `protobuf:"bytes,4,opt,name=root,proto3" json:"root,omitempty"`
+	LeafCount    uint64     `protobuf:"varint,5,opt,name=leaf_count,json=leafCount,proto3" json:"leaf_count,omitempty"`
+	Total        types.Coin `protobuf:"bytes,6,opt,name=total,proto3" json:"total"`
+	ExpiryHeight int64      `protobuf:"varint,7,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	Signature    string     `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgPostMerkleRoot) Reset()         { *m = MsgPostMerkleRoot{} }
+func (m *MsgPostMerkleRoot) String() string { return proto.CompactTextString(m) }
+func (*MsgPostMerkleRoot) ProtoMessage()    {}
+func (*MsgPostMerkleRoot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{30}
+}
+func (m *MsgPostMerkleRoot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPostMerkleRoot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPostMerkleRoot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPostMerkleRoot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPostMerkleRoot.Merge(m, src)
+}
+func (m *MsgPostMerkleRoot) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPostMerkleRoot) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPostMerkleRoot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPostMerkleRoot proto.InternalMessageInfo
+
+func (m *MsgPostMerkleRoot) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgPostMerkleRoot) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *MsgPostMerkleRoot) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *MsgPostMerkleRoot) GetRoot() string {
+	if m != nil {
+		return m.Root
+	}
+	return ""
+}
+
+func (m *MsgPostMerkleRoot) GetLeafCount() uint64 {
+	if m != nil {
+		return m.LeafCount
+	}
+	return 0
+}
+
+func (m *MsgPostMerkleRoot) GetTotal() types.Coin {
+	if m != nil {
+		return m.Total
+	}
+	return types.Coin{}
+}
+
+func (m *MsgPostMerkleRoot) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *MsgPostMerkleRoot) GetSignature() string {
+	if m != nil {
+		return m.Signature
+	}
+	return ""
+}
+
+// MsgPostMerkleRootResponse defines the MsgPostMerkleRootResponse message.
+type MsgPostMerkleRootResponse struct {
+}
+
+func (m *MsgPostMerkleRootResponse) Reset()         { *m = MsgPostMerkleRootResponse{} }
+func (m *MsgPostMerkleRootResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPostMerkleRootResponse) ProtoMessage()    {}
+func (*MsgPostMerkleRootResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{31}
+}
+func (m *MsgPostMerkleRootResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPostMerkleRootResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPostMerkleRootResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPostMerkleRootResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPostMerkleRootResponse.Merge(m, src)
+}
+func (m *MsgPostMerkleRootResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPostMerkleRootResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPostMerkleRootResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPostMerkleRootResponse proto.InternalMessageInfo
+
+// MsgClaimWithProof 凭叶子 (index, recipient, amount) 与自下而上的兄弟节点证明领取周期奖励，
+// 任何地址均可代为提交，奖金发放给 recipient
+type MsgClaimWithProof struct {
+	Creator   string     `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId    string     `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+	Epoch     uint64     `protobuf:"varint,3,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	Index     uint64     `protobuf:"varint,4,opt,name=index,proto3" json:"index,omitempty"`
+	Recipient string     `protobuf:"bytes,5,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    types.Coin `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount"`
+	// proof 是 hex 编码的兄弟节点哈希
+	Proof []string `protobuf:"bytes,7,rep,name=proof,proto3" json:"proof,omitempty"`
+}
+
+func (m *MsgClaimWithProof) Reset()         { *m = MsgClaimWithProof{} }
+func (m *MsgClaimWithProof) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimWithProof) ProtoMessage()    {}
+func (*MsgClaimWithProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{32}
+}
+func (m *MsgClaimWithProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimWithProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimWithProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimWithProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimWithProof.Merge(m, src)
+}
+func (m *MsgClaimWithProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimWithProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimWithProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimWithProof proto.InternalMessageInfo
+
+func (m *MsgClaimWithProof) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgClaimWithProof) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+func (m *MsgClaimWithProof) GetEpoch() uint64 {
+	if m != nil {
+		return m.Epoch
+	}
+	return 0
+}
+
+func (m *MsgClaimWithProof) GetIndex() uint64 {
+	if m != nil {
+		return m.Index
+	}
+	return 0
+}
+
+func (m *MsgClaimWithProof) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MsgClaimWithProof) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *MsgClaimWithProof) GetProof() []string {
+	if m != nil {
+		return m.Proof
+	}
+	return nil
+}
+
+// MsgClaimWithProofResponse defines the MsgClaimWithProofResponse message.
+type MsgClaimWithProofResponse struct {
+	// stream_id 是奖励进入奖励流时的流 ID，立即发放或处于争议期时为 0
+	StreamId uint64 `protobuf:"varint,1,opt,name=stream_id,json=streamId,proto3" json:"stream_id,omitempty"`
+	// release_height 是任务设置争议期时奖励的发放高度，否则为 0
+	ReleaseHeight int64 `protobuf:"varint,2,opt,name=release_height,json=releaseHeight,proto3" json:"release_height,omitempty"`
+}
+
+func (m *MsgClaimWithProofResponse) Reset()         { *m = MsgClaimWithProofResponse{} }
+func (m *MsgClaimWithProofResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimWithProofResponse) ProtoMessage()    {}
+func (*MsgClaimWithProofResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{33}
+}
+func (m *MsgClaimWithProofResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimWithProofResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimWithProofResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgClaimWithProofResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimWithProofResponse.Merge(m, src)
+}
+func (m *MsgClaimWithProofResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimWithProofResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimWithProofResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgClaimWithProofResponse proto.InternalMessageInfo
+
+func (m *MsgClaimWithProofResponse) GetStreamId() uint64 {
+	if m != nil {
+		return m.StreamId
+	}
+	return 0
+}
+
+func (m *MsgClaimWithProofResponse) GetReleaseHeight() int64 {
+	if m != nil {
+		return m.ReleaseHeight
+	}
+	return 0
+}
+
+// MsgUnenrollTask 取消链上判定任务的登记，任务关闭后仍可取消
+type MsgUnenrollTask struct {
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty"`
+	TaskId  string `protobuf:"bytes,2,opt,name=task_id,json=taskId,proto3" json:"task_id,omitempty"`
+}
+
+func (m *MsgUnenrollTask) Reset()         { *m = MsgUnenrollTask{} }
+func (m *MsgUnenrollTask) String() string { return proto.CompactTextString(m) }
+func (*MsgUnenrollTask) ProtoMessage()    {}
+func (*MsgUnenrollTask) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{34}
+}
+func (m *MsgUnenrollTask) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnenrollTask) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnenrollTask.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnenrollTask) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnenrollTask.Merge(m, src)
+}
+func (m *MsgUnenrollTask) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnenrollTask) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnenrollTask.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnenrollTask proto.InternalMessageInfo
+
+func (m *MsgUnenrollTask) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *MsgUnenrollTask) GetTaskId() string {
+	if m != nil {
+		return m.TaskId
+	}
+	return ""
+}
+
+// MsgUnenrollTaskResponse defines the MsgUnenrollTaskResponse message.
+type MsgUnenrollTaskResponse struct {
+	// refund 是退回的登记锁定资金，未锁定时为空
+	Refund types.Coin `protobuf:"bytes,1,opt,name=refund,proto3" json:"refund"`
+}
+
+func (m *MsgUnenrollTaskResponse) Reset()         { *m = MsgUnenrollTaskResponse{} }
+func (m *MsgUnenrollTaskResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUnenrollTaskResponse) ProtoMessage()    {}
+func (*MsgUnenrollTaskResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7d4323b4f511623d, []int{35}
+}
+func (m *MsgUnenrollTaskResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUnenrollTaskResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUnenrollTaskResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUnenrollTaskResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUnenrollTaskResponse.Merge(m, src)
+}
+func (m *MsgUnenrollTaskResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUnenrollTaskResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUnenrollTaskResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUnenrollTaskResponse proto.InternalMessageInfo
+
+func (m *MsgUnenrollTaskResponse) GetRefund() types.Coin {
+	if m != nil {
+		return m.Refund
+	}
+	return types.Coin{}
+}
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "dtc.task.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "dtc.task.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgRevokeClaimRecord)(nil), "dtc.task.v1.MsgRevokeClaimRecord")
+	proto.RegisterType((*MsgRevokeClaimRecordResponse)(nil), "dtc.task.v1.MsgRevokeClaimRecordResponse")
+	proto.RegisterType((*MsgClaimReward)(nil), "dtc.task.v1.MsgClaimReward")
+	proto.RegisterType((*MsgClaimRewardResponse)(nil), "dtc.task.v1.MsgClaimRewardResponse")
+	proto.RegisterType((*BatchClaimEntry)(nil), "dtc.task.v1.BatchClaimEntry")
+	proto.RegisterType((*MsgBatchClaimReward)(nil), "dtc.task.v1.MsgBatchClaimReward")
+	proto.RegisterType((*BatchClaimResult)(nil), "dtc.task.v1.BatchClaimResult")
+	proto.RegisterType((*MsgBatchClaimRewardResponse)(nil), "dtc.task.v1.MsgBatchClaimRewardResponse")
+	proto.RegisterType((*MsgEnrollTask)(nil), "dtc.task.v1.MsgEnrollTask")
+	proto.RegisterType((*MsgEnrollTaskResponse)(nil), "dtc.task.v1.MsgEnrollTaskResponse")
+	proto.RegisterType((*MsgClaimNative)(nil), "dtc.task.v1.MsgClaimNative")
+	proto.RegisterType((*MsgClaimNativeResponse)(nil), "dtc.task.v1.MsgClaimNativeResponse")
+	proto.RegisterType((*MsgCreateTask)(nil), "dtc.task.v1.MsgCreateTask")
+	proto.RegisterType((*MsgCreateTaskResponse)(nil), "dtc.task.v1.MsgCreateTaskResponse")
+	proto.RegisterType((*MsgCloseTask)(nil), "dtc.task.v1.MsgCloseTask")
+	proto.RegisterType((*MsgCloseTaskResponse)(nil), "dtc.task.v1.MsgCloseTaskResponse")
+	proto.RegisterType((*MsgFundTask)(nil), "dtc.task.v1.MsgFundTask")
+	proto.RegisterType((*MsgFundTaskResponse)(nil), "dtc.task.v1.MsgFundTaskResponse")
+	proto.RegisterType((*MsgReclaimTaskEscrow)(nil), "dtc.task.v1.MsgReclaimTaskEscrow")
+	proto.RegisterType((*MsgReclaimTaskEscrowResponse)(nil), "dtc.task.v1.MsgReclaimTaskEscrowResponse")
+	proto.RegisterType((*MsgRotateTaskOracles)(nil), "dtc.task.v1.MsgRotateTaskOracles")
+	proto.RegisterType((*MsgRotateTaskOraclesResponse)(nil), "dtc.task.v1.MsgRotateTaskOraclesResponse")
+	proto.RegisterType((*MsgWithdrawVested)(nil), "dtc.task.v1.MsgWithdrawVested")
+	proto.RegisterType((*MsgWithdrawVestedResponse)(nil), "dtc.task.v1.MsgWithdrawVestedResponse")
+	proto.RegisterType((*MsgDisputeClaim)(nil), "dtc.task.v1.MsgDisputeClaim")
+	proto.RegisterType((*MsgDisputeClaimResponse)(nil), "dtc.task.v1.MsgDisputeClaimResponse")
+	proto.RegisterType((*MsgSetCreditRepayShare)(nil), "dtc.task.v1.MsgSetCreditRepayShare")
+	proto.RegisterType((*MsgSetCreditRepayShareResponse)(nil), "dtc.task.v1.MsgSetCreditRepayShareResponse")
+	proto.RegisterType((*MsgPostMerkleRoot)(nil), "dtc.task.v1.MsgPostMerkleRoot")
+	proto.RegisterType((*MsgPostMerkleRootResponse)(nil), "dtc.task.v1.MsgPostMerkleRootResponse")
+	proto.RegisterType((*MsgClaimWithProof)(nil), "dtc.task.v1.MsgClaimWithProof")
+	proto.RegisterType((*MsgClaimWithProofResponse)(nil), "dtc.task.v1.MsgClaimWithProofResponse")
+	proto.RegisterType((*MsgUnenrollTask)(nil), "dtc.task.v1.MsgUnenrollTask")
+	proto.RegisterType((*MsgUnenrollTaskResponse)(nil), "dtc.task.v1.MsgUnenrollTaskResponse")
+}
+
+func init() { proto.RegisterFile("dtc/task/v1/tx.proto", fileDescriptor_7d4323b4f511623d) }
+
+var fileDescriptor_7d4323b4f511623d = []byte{
+	// 1929 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4b, 0x6f, 0x1c, 0xc7,
+	0x11, 0xd6, 0x68, 0x97, 0x8f, 0xad, 0x5d, 0x52, 0xd2, 0x88, 0x26, 0x87, 0x43, 0x6a, 0x45, 0xad,
+	0x64, 0x81, 0x62, 0xa0, 0x5d, 0x88, 0x01, 0x74, 0x50, 0x84, 0x00, 0x26, 0x29, 0xc1, 0x0a, 0x44,
+	0x9b, 0x18, 0xda, 0x56, 0x90, 0x18, 0x59, 0x34, 0x67, 0x5a, 0xb3, 0x13, 0xce, 0x4e, 0x8f, 0xbb,
+	0x7b, 0xf9, 0x40, 0x2e, 0x41, 0x8e, 0x39, 0x24, 0x39, 0xf9, 0x90, 0x1f, 0x10, 0x18, 0x39, 0xf1,
+	0x60, 0x04, 0x01, 0xf2, 0x07, 0x7c, 0x34, 0x7c, 0xc9, 0xe3, 0xe0, 0x04, 0xd2, 0x41, 0x40, 0x7e,
+	0x44, 0x10, 0xf4, 0x63, 0x67, 0xe7, 0xb1, 0xdc, 0x15, 0x65, 0xca, 0x17, 0x89, 0x5d, 0x5f, 0x55,
+	0x75, 0x55, 0x75, 0x55, 0x75, 0xf5, 0x2c, 0xcc, 0x79, 0xdc, 0x6d, 0x71, 0xc4, 0xf6, 0x5b, 0x07,
+	0xf7, 0x5a, 0xfc, 0xa8, 0x19, 0x53, 0xc2, 0x89, 0x59, 0xf5, 0xb8, 0xdb, 0x14, 0xd4, 0xe6, 0xc1,
+	0x3d, 0xfb, 0x0a, 0xea, 0x06, 0x11, 0x69, 0xc9, 0x7f, 0x15, 0x6e, 0xd7, 0x5d, 0xc2, 0xba, 0x84,
+	0xb5, 0xf6, 0x10, 0xc3, 0xad, 0x83, 0x7b, 0x7b, 0x98, 0xa3, 0x7b, 0x2d, 0x97, 0x04, 0x91, 0xc6,
+	0x17, 0x34, 0xde, 0x65, 0xbe, 0xd0, 0xdb, 0x65, 0xbe, 0x06, 0x16, 0x15, 0xd0, 0x96, 0xab, 0x96,
+	0x5a, 0x68, 0xc8, 0x4a, 0x5b, 0x12, 0x23, 0x8a, 0xba, 0x7d, 0x64, 0x29, 0x83, 0x50, 0xec, 0x05,
+	0x2e, 0xe2, 0x58, 0x83, 0xf3, 0x19, 0x07, 0x84, 0xc9, 0x8a, 0x3e, 0xe7, 0x13, 0x9f, 0xa8, 0x6d,
+	0xc4, 0x5f, 0x8a, 0xda, 0x38, 0x31, 0xe0, 0xd2, 0x36, 0xf3, 0x3f, 0x8e, 0x3d, 0xc4, 0xf1, 0x8e,
+	0xdc, 0xc4, 0xbc, 0x0f, 0x15, 0xd4, 0xe3, 0x1d, 0x42, 0x03, 0x7e, 0x6c, 0x19, 0x2b, 0xc6, 0x6a,
+	0x65, 0xc3, 0xfa, 0xe6, 0xcb, 0xbb, 0x73, 0xda, 0xba, 0xf7, 0x3c, 0x8f, 0x62, 0xc6, 0x76, 0x39,
+	0x0d, 0x22, 0xdf, 0x19, 0xb0, 0x9a, 0xf7, 0x61, 0x52, 0x99, 0x69, 0x5d, 0x5c, 0x31, 0x56, 0xab,
+	0xeb, 0x57, 0x9b, 0xa9, 0xa8, 0x35, 0x95, 0xf2, 0x8d, 0xca, 0x57, 0xdf, 0x5e, 0xbf, 0xf0, 0xc5,
+	0xab, 0x93, 0x35, 0xc3, 0xd1, 0xdc, 0x0f, 0xee, 0xfe, 0xe6, 0xd5, 0xc9, 0xda, 0x40, 0xcf, 0x6f,
+	0x5f, 0x9d, 0xac, 0xd9, 0xc2, 0x89, 0x23, 0xe5, 0x46, 0xce, 0xbc, 0xc6, 0x22, 0x2c, 0xe4, 0x48,
+	0x0e, 0x66, 0x31, 0x89, 0x18, 0x6e, 0xfc, 0xde, 0x80, 0xb9, 0x6d, 0xe6, 0x3b, 0xf8, 0x80, 0xec,
+	0xe3, 0xcd, 0x10, 0x05, 0x5d, 0x07, 0xbb, 0x84, 0x7a, 0xe6, 0x3a, 0x4c, 0xb9, 0x14, 0x23, 0x4e,
+	0xe8, 0x58, 0x87, 0xfa, 0x8c, 0xe6, 0x35, 0x00, 0x57, 0xa8, 0x68, 0x77, 0x10, 0xeb, 0x48, 0x97,
+	0x2a, 0x4e, 0x45, 0x52, 0xde, 0x47, 0xac, 0x63, 0xce, 0xc3, 0x24, 0xc5, 0x88, 0x91, 0xc8, 0x2a,
+	0x49, 0x48, 0xaf, 0x1e, 0xd4, 0x84, 0x37, 0x7d, 0x25, 0x8d, 0x3a, 0x2c, 0x0f, 0x33, 0x28, 0xb1,
+	0xf8, 0x7f, 0x06, 0xcc, 0x6e, 0x33, 0x5f, 0x43, 0x87, 0xe8, 0x0d, 0x6d, 0x5d, 0x80, 0x29, 0x11,
+	0xab, 0x76, 0xe0, 0x69, 0x43, 0x27, 0xc5, 0xf2, 0x89, 0x27, 0xac, 0x44, 0x5d, 0xd2, 0x8b, 0x78,
+	0xdf, 0x4a, 0xb5, 0x32, 0x97, 0xa1, 0xc2, 0x02, 0x3f, 0x42, 0xbc, 0x47, 0xb1, 0x55, 0x56, 0xbe,
+	0x25, 0x04, 0x91, 0x01, 0x14, 0xbb, 0x41, 0x1c, 0xe0, 0x88, 0x5b, 0x13, 0xe3, 0x32, 0x20, 0x61,
+	0x35, 0x6f, 0xc2, 0x8c, 0x87, 0x63, 0x1c, 0x79, 0x38, 0xe2, 0x6d, 0x2f, 0xf0, 0xac, 0x49, 0xa9,
+	0xb9, 0x96, 0x10, 0xb7, 0x02, 0x2f, 0x17, 0xa0, 0x4f, 0x61, 0x3e, 0xeb, 0x7f, 0x3f, 0x34, 0xe6,
+	0x12, 0x54, 0x18, 0xa7, 0x18, 0x75, 0x85, 0x57, 0x22, 0x12, 0x65, 0x67, 0x5a, 0x11, 0x9e, 0x78,
+	0xe6, 0xbb, 0x30, 0x4b, 0x71, 0x88, 0x11, 0xc3, 0xed, 0x0e, 0x0e, 0xfc, 0x0e, 0x97, 0x7e, 0x97,
+	0x9c, 0x19, 0x4d, 0x7d, 0x5f, 0x12, 0x1b, 0x9f, 0x1b, 0x70, 0x69, 0x03, 0x71, 0xb7, 0x23, 0x37,
+	0x78, 0x14, 0x71, 0x7a, 0x9c, 0x8e, 0x95, 0x91, 0x89, 0x55, 0xc6, 0xeb, 0x8b, 0xaf, 0xef, 0xf5,
+	0x69, 0x31, 0xb6, 0x61, 0x9a, 0xe1, 0xcf, 0x7a, 0x38, 0x72, 0x55, 0x88, 0xcb, 0x4e, 0xb2, 0x6e,
+	0xfc, 0xd5, 0x80, 0xab, 0xdb, 0xcc, 0x1f, 0xd8, 0xf6, 0x1d, 0x0e, 0xff, 0x3d, 0x98, 0xc2, 0x11,
+	0xa7, 0x01, 0x16, 0x85, 0x57, 0x5a, 0xad, 0xae, 0x2f, 0x67, 0x0a, 0x2f, 0xe7, 0x7f, 0xba, 0x02,
+	0xfb, 0x72, 0xd9, 0x74, 0x28, 0xe5, 0xd2, 0x21, 0x77, 0x62, 0x7f, 0x33, 0xe0, 0x72, 0xda, 0x6e,
+	0xd6, 0x0b, 0xb9, 0x39, 0x07, 0x13, 0x41, 0xe4, 0xe1, 0x23, 0x69, 0xf5, 0x8c, 0xa3, 0x16, 0xa6,
+	0x05, 0x53, 0xac, 0xe7, 0xba, 0x98, 0xa9, 0x96, 0x30, 0xed, 0xf4, 0x97, 0xb9, 0xe2, 0x2a, 0xe5,
+	0x8b, 0x2b, 0x73, 0xf6, 0xe5, 0xb1, 0x67, 0x3f, 0x31, 0xe4, 0xec, 0x85, 0x49, 0x98, 0x52, 0x42,
+	0x75, 0x12, 0xaa, 0x45, 0x03, 0xc1, 0xd2, 0x90, 0xb8, 0x27, 0x49, 0xb7, 0x01, 0x53, 0x54, 0x7a,
+	0xc4, 0x2c, 0x43, 0xc6, 0xf2, 0xda, 0x29, 0xb1, 0x54, 0x7e, 0x67, 0x82, 0xa9, 0x05, 0x1b, 0xbf,
+	0x84, 0x99, 0x6d, 0xe6, 0x3f, 0x8a, 0x28, 0x09, 0xc3, 0x8f, 0x10, 0xdb, 0x3f, 0xd7, 0x8a, 0xce,
+	0x1d, 0xc6, 0x02, 0xbc, 0x93, 0xd9, 0x2b, 0x69, 0x2c, 0xfb, 0x83, 0xbe, 0xf2, 0x01, 0xe2, 0xc1,
+	0x01, 0x7e, 0x9b, 0x56, 0xfc, 0x0a, 0xe6, 0xb3, 0x9b, 0x25, 0xf1, 0xcc, 0x9e, 0xb3, 0x31, 0xf2,
+	0x9c, 0x2f, 0x8e, 0x3d, 0xe7, 0xd2, 0xb0, 0x1a, 0xff, 0xfb, 0x84, 0x8c, 0xf7, 0xa6, 0xb0, 0x05,
+	0x9f, 0x7b, 0xbc, 0xcd, 0x0f, 0xe0, 0x32, 0x95, 0x39, 0xd2, 0x8e, 0x31, 0x6d, 0x4b, 0xd3, 0xa5,
+	0x1d, 0xd5, 0xf5, 0xc5, 0xa6, 0x56, 0x29, 0x6e, 0xfd, 0xa6, 0xbe, 0xf5, 0x9b, 0x9b, 0x24, 0x88,
+	0xd2, 0x69, 0x31, 0xab, 0xa4, 0x77, 0x30, 0x95, 0xf1, 0x31, 0x1f, 0xc2, 0xe4, 0x5e, 0xcf, 0xf3,
+	0x31, 0xb7, 0xca, 0x67, 0xd0, 0xa2, 0x65, 0x44, 0x3c, 0xbb, 0xe8, 0x48, 0x99, 0xc1, 0x64, 0xde,
+	0x97, 0x9d, 0x4a, 0x17, 0x1d, 0x49, 0xdd, 0xcc, 0xbc, 0x05, 0xb3, 0xc2, 0xca, 0x1e, 0xc3, 0xb4,
+	0x1d, 0x06, 0xdd, 0x80, 0xcb, 0xe4, 0x2f, 0x3b, 0xb5, 0x18, 0xd3, 0x8f, 0x19, 0xa6, 0x4f, 0x05,
+	0xcd, 0xbc, 0x01, 0x35, 0xc6, 0x11, 0xe5, 0xfd, 0xb0, 0x4e, 0xc9, 0xb0, 0x56, 0x25, 0x4d, 0x17,
+	0xcf, 0x35, 0x00, 0x1c, 0x79, 0x7d, 0x86, 0x69, 0xc9, 0x50, 0xc1, 0x91, 0xa7, 0xe1, 0x77, 0x61,
+	0x96, 0x50, 0xe4, 0x86, 0xb8, 0x1d, 0xf7, 0xf6, 0xf6, 0xf1, 0x31, 0xb3, 0x2a, 0x2b, 0xa5, 0xd5,
+	0x8a, 0x33, 0xa3, 0xa8, 0x3b, 0x8a, 0x68, 0xde, 0x81, 0xcb, 0x9a, 0x8d, 0x77, 0x28, 0x66, 0x1d,
+	0x12, 0x7a, 0x16, 0xc8, 0x06, 0x71, 0x49, 0xd1, 0x3f, 0xea, 0x93, 0xcd, 0x2d, 0xa8, 0xe2, 0x30,
+	0xf0, 0x83, 0xbd, 0x20, 0x14, 0x63, 0x47, 0x75, 0xc5, 0x28, 0x34, 0x32, 0x71, 0xb6, 0x8f, 0x06,
+	0x3c, 0x1b, 0x65, 0x11, 0x1e, 0x27, 0x2d, 0x66, 0xfe, 0x04, 0x2e, 0xc5, 0xe8, 0x98, 0xf4, 0x78,
+	0x9b, 0xb9, 0x1d, 0xec, 0xf5, 0x42, 0x6c, 0xd5, 0xa4, 0xa6, 0xa5, 0xdc, 0x2c, 0x22, 0x78, 0x76,
+	0x35, 0x8b, 0x56, 0x34, 0x1b, 0x67, 0xa8, 0xe6, 0x3a, 0xbc, 0xe3, 0x05, 0x2c, 0xee, 0x71, 0xdc,
+	0x3e, 0x0c, 0x22, 0x8f, 0x1c, 0xb6, 0xf7, 0x42, 0xe2, 0xee, 0x33, 0x6b, 0x46, 0x46, 0xe3, 0xaa,
+	0x06, 0x9f, 0x49, 0x6c, 0x43, 0x42, 0xe6, 0x8f, 0xa1, 0x92, 0xcc, 0x63, 0xd6, 0xac, 0xdc, 0xd9,
+	0x2e, 0xf8, 0xb0, 0xd3, 0xe7, 0xd0, 0x1b, 0x0f, 0x44, 0x86, 0x16, 0xf7, 0x20, 0xb1, 0x93, 0xe2,
+	0x0e, 0xa0, 0x26, 0xeb, 0x8d, 0x30, 0xfc, 0xb6, 0x1b, 0xcc, 0x3c, 0xcc, 0xa5, 0xb7, 0x4a, 0x4c,
+	0xf8, 0x93, 0x01, 0xd5, 0x6d, 0xe6, 0x3f, 0xee, 0x45, 0xde, 0xf9, 0xd7, 0xdc, 0xc3, 0xcc, 0x8d,
+	0xfa, 0xda, 0x35, 0xa2, 0x64, 0x72, 0x0e, 0xec, 0xc2, 0xd5, 0x94, 0x9d, 0x49, 0x63, 0x7a, 0x08,
+	0x93, 0x98, 0xb9, 0x94, 0x1c, 0x5a, 0xc6, 0x59, 0xb6, 0x50, 0x32, 0x8d, 0xcf, 0xf4, 0x9c, 0x29,
+	0xeb, 0x50, 0xe6, 0xa5, 0xa4, 0xbf, 0xcd, 0x83, 0xf8, 0x14, 0x96, 0x87, 0x6d, 0x99, 0x76, 0x48,
+	0xc7, 0xcc, 0x38, 0x7b, 0xcc, 0x1a, 0xff, 0xd5, 0x93, 0x33, 0xe1, 0x3a, 0xd7, 0x3e, 0x94, 0xf5,
+	0xc9, 0xce, 0xf7, 0x5c, 0x8b, 0x6d, 0xa3, 0xf4, 0xba, 0x6d, 0xa3, 0x3c, 0xbc, 0x6d, 0x08, 0x8d,
+	0x07, 0x98, 0x86, 0x28, 0xee, 0x57, 0xa7, 0x9e, 0x05, 0x34, 0x55, 0xd5, 0x65, 0x2e, 0x94, 0x3f,
+	0x87, 0xe5, 0x61, 0xbe, 0x26, 0xa1, 0xfc, 0x11, 0xd8, 0x31, 0xc5, 0x07, 0x01, 0xe9, 0xb1, 0xb6,
+	0x36, 0x84, 0x61, 0xde, 0xc6, 0x47, 0x71, 0x40, 0xd5, 0x8b, 0xa8, 0xe4, 0x2c, 0xf4, 0x39, 0x94,
+	0xf0, 0x2e, 0xe6, 0x8f, 0x24, 0xdc, 0xa0, 0x70, 0x65, 0x9b, 0xf9, 0xcf, 0x02, 0xde, 0xf1, 0x28,
+	0x3a, 0xfc, 0x04, 0x33, 0x8e, 0xdf, 0x6c, 0xac, 0x1b, 0x75, 0x37, 0xe6, 0x1c, 0xfa, 0xdc, 0x80,
+	0xc5, 0xc2, 0xa6, 0x89, 0x3b, 0xc7, 0xa9, 0xcc, 0x28, 0x8d, 0xce, 0x8c, 0xc7, 0x22, 0x33, 0xfe,
+	0xfc, 0xef, 0xeb, 0xab, 0x7e, 0xc0, 0x3b, 0xbd, 0xbd, 0xa6, 0x4b, 0xba, 0xfa, 0x51, 0xaa, 0xff,
+	0xbb, 0xcb, 0xbc, 0xfd, 0x16, 0x3f, 0x8e, 0x31, 0x93, 0x02, 0xec, 0x8f, 0xaf, 0x4e, 0xd6, 0x6a,
+	0x21, 0xf6, 0x91, 0x7b, 0xdc, 0x16, 0xef, 0x5d, 0x96, 0x4d, 0xab, 0xdf, 0xa9, 0xe7, 0xe5, 0x96,
+	0x6a, 0x95, 0xea, 0x02, 0x7c, 0x0b, 0x6f, 0x31, 0x1b, 0xa6, 0xf1, 0x41, 0xe0, 0xc9, 0x49, 0x5b,
+	0xcd, 0x92, 0xc9, 0x3a, 0x17, 0xa9, 0x4f, 0x60, 0x21, 0x67, 0x4f, 0xea, 0xd4, 0xa7, 0x29, 0xe6,
+	0x3d, 0x1a, 0x61, 0x6f, 0x7c, 0x09, 0xa9, 0xce, 0x9d, 0x08, 0x34, 0xbe, 0x30, 0xe4, 0x08, 0xb4,
+	0x8b, 0xf9, 0xa6, 0x68, 0xe6, 0xdc, 0xc1, 0x31, 0x3a, 0xde, 0xed, 0x20, 0xfa, 0x66, 0x73, 0xd7,
+	0x53, 0x98, 0x60, 0x42, 0x58, 0x3f, 0x43, 0xee, 0x8b, 0xdd, 0xfe, 0xf5, 0xed, 0xf5, 0x25, 0x25,
+	0xc5, 0xbc, 0xfd, 0x66, 0x40, 0x5a, 0x5d, 0xc4, 0x3b, 0xcd, 0xa7, 0x32, 0xf6, 0x5b, 0xd8, 0xfd,
+	0xe6, 0xcb, 0xbb, 0xa0, 0x95, 0x6e, 0x61, 0x57, 0x1d, 0x83, 0x52, 0x92, 0x0b, 0xc1, 0x0a, 0xd4,
+	0x87, 0x5b, 0x9a, 0xf4, 0xf6, 0xbf, 0x5c, 0x94, 0x39, 0xbc, 0x43, 0x18, 0xdf, 0xc6, 0x74, 0x3f,
+	0xc4, 0x0e, 0x21, 0xfc, 0x7c, 0x3b, 0x81, 0x18, 0xce, 0x63, 0xe2, 0xaa, 0xd1, 0xbf, 0xec, 0xa8,
+	0x85, 0x69, 0x42, 0x99, 0x12, 0xc2, 0xf5, 0x83, 0x54, 0xfe, 0x2d, 0x8e, 0x3e, 0xc4, 0xe8, 0x79,
+	0xdb, 0x95, 0x19, 0xac, 0x27, 0x1e, 0x41, 0xd9, 0x14, 0x04, 0xf3, 0x01, 0x4c, 0x70, 0xc2, 0x51,
+	0x68, 0x4d, 0x8e, 0x3b, 0xb2, 0x54, 0xd7, 0x53, 0x22, 0xe2, 0xb9, 0xaa, 0x6a, 0x3a, 0x3b, 0x08,
+	0xd5, 0x14, 0x51, 0x8f, 0x3a, 0x99, 0xa7, 0xd1, 0xf4, 0xe8, 0xa7, 0xd1, 0x12, 0x2c, 0x16, 0xe2,
+	0x36, 0xb8, 0x31, 0x55, 0x54, 0x65, 0xd2, 0x89, 0x4a, 0xdd, 0xa1, 0x84, 0x3c, 0xff, 0x3e, 0xa2,
+	0x9a, 0xbc, 0xcd, 0xd4, 0x43, 0x4a, 0x2d, 0xde, 0xf8, 0x8d, 0x3f, 0xb8, 0x67, 0x26, 0xcf, 0x7e,
+	0xcf, 0x08, 0x5b, 0x62, 0xe1, 0xb7, 0x35, 0x25, 0x1b, 0xbf, 0x5a, 0xe4, 0xa2, 0xd8, 0x86, 0xc5,
+	0x42, 0x9c, 0xce, 0xf5, 0xab, 0x40, 0xa8, 0xbe, 0x79, 0x45, 0xf8, 0x7b, 0x79, 0xa2, 0x3d, 0x83,
+	0x85, 0xdc, 0x6e, 0xe9, 0x3b, 0x9b, 0xe2, 0xe7, 0xbd, 0xc8, 0x3b, 0xdb, 0x9d, 0xad, 0x64, 0xd6,
+	0xff, 0x59, 0x85, 0xd2, 0x36, 0xf3, 0x4d, 0x07, 0x6a, 0x99, 0xef, 0x77, 0xd9, 0xa9, 0x39, 0xf7,
+	0xad, 0xcc, 0xbe, 0x35, 0x0a, 0x4d, 0x2c, 0x43, 0x70, 0xa5, 0xf8, 0x15, 0xed, 0x46, 0x5e, 0xb4,
+	0xc0, 0x62, 0xdf, 0x19, 0xcb, 0x92, 0x6c, 0xf1, 0x21, 0x54, 0xd3, 0x5f, 0x3e, 0x96, 0xf2, 0x92,
+	0x29, 0xd0, 0xbe, 0x39, 0x02, 0x4c, 0x14, 0x3e, 0x05, 0x48, 0x3d, 0x02, 0xed, 0x82, 0x48, 0x82,
+	0xd9, 0x8d, 0xd3, 0xb1, 0x44, 0xdb, 0x13, 0xa8, 0x0c, 0x06, 0xec, 0xc5, 0xe2, 0xfe, 0x1a, 0xb2,
+	0x6f, 0x9c, 0x0a, 0x65, 0x82, 0x59, 0x18, 0xac, 0x8a, 0xc1, 0xcc, 0xb3, 0xd8, 0x77, 0xc6, 0xb2,
+	0x24, 0x5b, 0x3c, 0x86, 0xe9, 0x64, 0x14, 0xb7, 0xf2, 0x62, 0x7d, 0xc4, 0x5e, 0x39, 0x0d, 0xc9,
+	0x9e, 0x7b, 0x7e, 0xaa, 0x1d, 0x72, 0xee, 0x39, 0x16, 0xfb, 0xce, 0x58, 0x96, 0x64, 0x8b, 0x9f,
+	0xc2, 0x6c, 0x6e, 0x3a, 0xaa, 0xe7, 0x85, 0xb3, 0xb8, 0x7d, 0x7b, 0x34, 0x9e, 0x68, 0x76, 0xa0,
+	0x96, 0x99, 0x34, 0x0a, 0x85, 0x90, 0x46, 0xed, 0x5b, 0xa3, 0xd0, 0x44, 0xa7, 0x0f, 0x57, 0x87,
+	0x5d, 0xea, 0x85, 0x84, 0x1c, 0xc2, 0x64, 0xff, 0xe0, 0x35, 0x98, 0xd2, 0x61, 0xc9, 0x5d, 0xb8,
+	0x85, 0xb0, 0x64, 0x71, 0xfb, 0xf6, 0x68, 0x3c, 0xad, 0x39, 0x77, 0xe9, 0xd4, 0x87, 0x96, 0x53,
+	0x82, 0xdb, 0xb7, 0x47, 0xe3, 0x89, 0xe6, 0x5f, 0x64, 0xbf, 0x04, 0xca, 0x3a, 0x2e, 0xe4, 0x58,
+	0x9e, 0xc3, 0x5e, 0x1d, 0xc7, 0x91, 0xae, 0xe8, 0xd4, 0x67, 0xb4, 0x42, 0x45, 0x0f, 0x30, 0xbb,
+	0x71, 0x3a, 0x56, 0x68, 0x38, 0xfa, 0x7b, 0xd8, 0xf0, 0x86, 0xa3, 0x40, 0xfb, 0xe6, 0x08, 0x30,
+	0x9d, 0x6f, 0x99, 0x4b, 0xa4, 0xd8, 0x78, 0x53, 0xa8, 0x7d, 0x6b, 0x14, 0xda, 0xd7, 0x69, 0x4f,
+	0xfc, 0x5a, 0xf4, 0xf8, 0x8d, 0xb5, 0xaf, 0x5e, 0xd4, 0x8d, 0xaf, 0x5f, 0xd4, 0x8d, 0xff, 0xbc,
+	0xa8, 0x1b, 0x7f, 0x78, 0x59, 0xbf, 0xf0, 0xf5, 0xcb, 0xfa, 0x85, 0x7f, 0xbc, 0xac, 0x5f, 0xf8,
+	0xd9, 0xe5, 0xd4, 0x4f, 0x23, 0x72, 0x10, 0xdf, 0x9b, 0x94, 0x3f, 0xe5, 0xfc, 0xf0, 0xff, 0x03,
+	0x00, 0x05, 0x99, 0x32, 0x89, 0xbb, 0x1a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RevokeClaimRecord 由持有领取撤销角色的账户将领取记录标记为已撤销，记录本身不会被删除
+	RevokeClaimRecord(ctx context.Context, in *MsgRevokeClaimRecord, opts ...grpc.CallOption) (*MsgRevokeClaimRecordResponse, error)
+	// ClaimReward defines the ClaimReward RPC.
+	ClaimReward(ctx context.Context, in *MsgClaimReward, opts ...grpc.CallOption) (*MsgClaimRewardResponse, error)
+	// CreateTask 登记一个新任务，creator 成为任务 owner
+	CreateTask(ctx context.Context, in *MsgCreateTask, opts ...grpc.CallOption) (*MsgCreateTaskResponse, error)
+	// CloseTask 由任务 owner 提前关闭任务
+	CloseTask(ctx context.Context, in *MsgCloseTask, opts ...grpc.CallOption) (*MsgCloseTaskResponse, error)
+	// RotateTaskOracles 由任务 owner 轮换预言机公钥，旧公钥在重叠期内仍然有效
+	RotateTaskOracles(ctx context.Context, in *MsgRotateTaskOracles, opts ...grpc.CallOption) (*MsgRotateTaskOraclesResponse, error)
+	// FundTask 由任务 owner 向任务托管注资
+	FundTask(ctx context.Context, in *MsgFundTask, opts ...grpc.CallOption) (*MsgFundTaskResponse, error)
+	// ReclaimTaskEscrow 在任务结束后将未支付的托管资金退还 owner
+	ReclaimTaskEscrow(ctx context.Context, in *MsgReclaimTaskEscrow, opts ...grpc.CallOption) (*MsgReclaimTaskEscrowResponse, error)
+	// WithdrawVested 提取接收用户奖励流中已释放的部分
+	WithdrawVested(ctx context.Context, in *MsgWithdrawVested, opts ...grpc.CallOption) (*MsgWithdrawVestedResponse, error)
+	// DisputeClaim 在争议期内撤回待定奖励并退回任务托管
+	DisputeClaim(ctx context.Context, in *MsgDisputeClaim, opts ...grpc.CallOption) (*MsgDisputeClaimResponse, error)
+	// SetCreditRepayShare 设置发送者自愿用于偿还信用负债的奖励比例
+	SetCreditRepayShare(ctx context.Context, in *MsgSetCreditRepayShare, opts ...grpc.CallOption) (*MsgSetCreditRepayShareResponse, error)
+	// PostMerkleRoot 发布任务预言机签名的周期 Merkle 根，并从任务托管划出该周期的奖励总额
+	PostMerkleRoot(ctx context.Context, in *MsgPostMerkleRoot, opts ...grpc.CallOption) (*MsgPostMerkleRootResponse, error)
+	// ClaimWithProof 凭叶子数据与 Merkle 证明领取周期奖励
+	ClaimWithProof(ctx context.Context, in *MsgClaimWithProof, opts ...grpc.CallOption) (*MsgClaimWithProofResponse, error)
+	// BatchClaimReward 以一个预言机签名覆盖多条领取，各条目独立成功或失败
+	BatchClaimReward(ctx context.Context, in *MsgBatchClaimReward, opts ...grpc.CallOption) (*MsgBatchClaimRewardResponse, error)
+	// EnrollTask 登记参与依赖持续时长的链上判定任务
+	EnrollTask(ctx context.Context, in *MsgEnrollTask, opts ...grpc.CallOption) (*MsgEnrollTaskResponse, error)
+	// ClaimNative 由链上判定任务完成后直接领取奖励，无需预言机签名
+	ClaimNative(ctx context.Context, in *MsgClaimNative, opts ...grpc.CallOption) (*MsgClaimNativeResponse, error)
+	// UnenrollTask 取消链上判定任务的登记并退回登记时锁定的资金
+	UnenrollTask(ctx context.Context, in *MsgUnenrollTask, opts ...grpc.CallOption) (*MsgUnenrollTaskResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RevokeClaimRecord(ctx context.Context, in *MsgRevokeClaimRecord, opts ...grpc.CallOption) (*MsgRevokeClaimRecordResponse, error) {
+	out := new(MsgRevokeClaimRecordResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/RevokeClaimRecord", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimReward(ctx context.Context, in *MsgClaimReward, opts ...grpc.CallOption) (*MsgClaimRewardResponse, error) {
+	out := new(MsgClaimRewardResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/ClaimReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CreateTask(ctx context.Context, in *MsgCreateTask, opts ...grpc.CallOption) (*MsgCreateTaskResponse, error) {
+	out := new(MsgCreateTaskResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/CreateTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) CloseTask(ctx context.Context, in *MsgCloseTask, opts ...grpc.CallOption) (*MsgCloseTaskResponse, error) {
+	out := new(MsgCloseTaskResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/CloseTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RotateTaskOracles(ctx context.Context, in *MsgRotateTaskOracles, opts ...grpc.CallOption) (*MsgRotateTaskOraclesResponse, error) {
+	out := new(MsgRotateTaskOraclesResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/RotateTaskOracles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) FundTask(ctx context.Context, in *MsgFundTask, opts ...grpc.CallOption) (*MsgFundTaskResponse, error) {
+	out := new(MsgFundTaskResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/FundTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReclaimTaskEscrow(ctx context.Context, in *MsgReclaimTaskEscrow, opts ...grpc.CallOption) (*MsgReclaimTaskEscrowResponse, error) {
+	out := new(MsgReclaimTaskEscrowResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/ReclaimTaskEscrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) WithdrawVested(ctx context.Context, in *MsgWithdrawVested, opts ...grpc.CallOption) (*MsgWithdrawVestedResponse, error) {
+	out := new(MsgWithdrawVestedResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/WithdrawVested", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DisputeClaim(ctx context.Context, in *MsgDisputeClaim, opts ...grpc.CallOption) (*MsgDisputeClaimResponse, error) {
+	out := new(MsgDisputeClaimResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/DisputeClaim", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetCreditRepayShare(ctx context.Context, in *MsgSetCreditRepayShare, opts ...grpc.CallOption) (*MsgSetCreditRepayShareResponse, error) {
+	out := new(MsgSetCreditRepayShareResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/SetCreditRepayShare", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PostMerkleRoot(ctx context.Context, in *MsgPostMerkleRoot, opts ...grpc.CallOption) (*MsgPostMerkleRootResponse, error) {
+	out := new(MsgPostMerkleRootResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/PostMerkleRoot", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimWithProof(ctx context.Context, in *MsgClaimWithProof, opts ...grpc.CallOption) (*MsgClaimWithProofResponse, error) {
+	out := new(MsgClaimWithProofResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/ClaimWithProof", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) BatchClaimReward(ctx context.Context, in *MsgBatchClaimReward, opts ...grpc.CallOption) (*MsgBatchClaimRewardResponse, error) {
+	out := new(MsgBatchClaimRewardResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/BatchClaimReward", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EnrollTask(ctx context.Context, in *MsgEnrollTask, opts ...grpc.CallOption) (*MsgEnrollTaskResponse, error) {
+	out := new(MsgEnrollTaskResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/EnrollTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimNative(ctx context.Context, in *MsgClaimNative, opts ...grpc.CallOption) (*MsgClaimNativeResponse, error) {
+	out := new(MsgClaimNativeResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/ClaimNative", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UnenrollTask(ctx context.Context, in *MsgUnenrollTask, opts ...grpc.CallOption) (*MsgUnenrollTaskResponse, error) {
+	out := new(MsgUnenrollTaskResponse)
+	err := c.cc.Invoke(ctx, "/dtc.task.v1.Msg/UnenrollTask", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// UpdateParams defines a (governance) operation for updating the module
+	// parameters. The authority defaults to the x/gov module account.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RevokeClaimRecord 由持有领取撤销角色的账户将领取记录标记为已撤销，记录本身不会被删除
+	RevokeClaimRecord(context.Context, *MsgRevokeClaimRecord) (*MsgRevokeClaimRecordResponse, error)
+	// ClaimReward defines the ClaimReward RPC.
+	ClaimReward(context.Context, *MsgClaimReward) (*MsgClaimRewardResponse, error)
+	// CreateTask 登记一个新任务，creator 成为任务 owner
+	CreateTask(context.Context, *MsgCreateTask) (*MsgCreateTaskResponse, error)
+	// CloseTask 由任务 owner 提前关闭任务
+	CloseTask(context.Context, *MsgCloseTask) (*MsgCloseTaskResponse, error)
+	// RotateTaskOracles 由任务 owner 轮换预言机公钥，旧公钥在重叠期内仍然有效
+	RotateTaskOracles(context.Context, *MsgRotateTaskOracles) (*MsgRotateTaskOraclesResponse, error)
+	// FundTask 由任务 owner 向任务托管注资
+	FundTask(context.Context, *MsgFundTask) (*MsgFundTaskResponse, error)
+	// ReclaimTaskEscrow 在任务结束后将未支付的托管资金退还 owner
+	ReclaimTaskEscrow(context.Context, *MsgReclaimTaskEscrow) (*MsgReclaimTaskEscrowResponse, error)
+	// WithdrawVested 提取接收用户奖励流中已释放的部分
+	WithdrawVested(context.Context, *MsgWithdrawVested) (*MsgWithdrawVestedResponse, error)
+	// DisputeClaim 在争议期内撤回待定奖励并退回任务托管
+	DisputeClaim(context.Context, *MsgDisputeClaim) (*MsgDisputeClaimResponse, error)
+	// SetCreditRepayShare 设置发送者自愿用于偿还信用负债的奖励比例
+	SetCreditRepayShare(context.Context, *MsgSetCreditRepayShare) (*MsgSetCreditRepayShareResponse, error)
+	// PostMerkleRoot 发布任务预言机签名的周期 Merkle 根，并从任务托管划出该周期的奖励总额
+	PostMerkleRoot(context.Context, *MsgPostMerkleRoot) (*MsgPostMerkleRootResponse, error)
+	// ClaimWithProof 凭叶子数据与 Merkle 证明领取周期奖励
+	ClaimWithProof(context.Context, *MsgClaimWithProof) (*MsgClaimWithProofResponse, error)
+	// BatchClaimReward 以一个预言机签名覆盖多条领取，各条目独立成功或失败
+	BatchClaimReward(context.Context, *MsgBatchClaimReward) (*MsgBatchClaimRewardResponse, error)
+	// EnrollTask 登记参与依赖持续时长的链上判定任务
+	EnrollTask(context.Context, *MsgEnrollTask) (*MsgEnrollTaskResponse, error)
+	// ClaimNative 由链上判定任务完成后直接领取奖励，无需预言机签名
+	ClaimNative(context.Context, *MsgClaimNative) (*MsgClaimNativeResponse, error)
+	// UnenrollTask 取消链上判定任务的登记并退回登记时锁定的资金
+	UnenrollTask(context.Context, *MsgUnenrollTask) (*MsgUnenrollTaskResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) RevokeClaimRecord(ctx context.Context, req *MsgRevokeClaimRecord) (*MsgRevokeClaimRecordResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeClaimRecord not implemented")
+}
+func (*UnimplementedMsgServer) ClaimReward(ctx context.Context, req *MsgClaimReward) (*MsgClaimRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimReward not implemented")
+}
+func (*UnimplementedMsgServer) CreateTask(ctx context.Context, req *MsgCreateTask) (*MsgCreateTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTask not implemented")
+}
+func (*UnimplementedMsgServer) CloseTask(ctx context.Context, req *MsgCloseTask) (*MsgCloseTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CloseTask not implemented")
+}
+func (*UnimplementedMsgServer) RotateTaskOracles(ctx context.Context, req *MsgRotateTaskOracles) (*MsgRotateTaskOraclesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateTaskOracles not implemented")
+}
+func (*UnimplementedMsgServer) FundTask(ctx context.Context, req *MsgFundTask) (*MsgFundTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FundTask not implemented")
+}
+func (*UnimplementedMsgServer) ReclaimTaskEscrow(ctx context.Context, req *MsgReclaimTaskEscrow) (*MsgReclaimTaskEscrowResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReclaimTaskEscrow not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawVested(ctx context.Context, req *MsgWithdrawVested) (*MsgWithdrawVestedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawVested not implemented")
+}
+func (*UnimplementedMsgServer) DisputeClaim(ctx context.Context, req *MsgDisputeClaim) (*MsgDisputeClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisputeClaim not implemented")
+}
+func (*UnimplementedMsgServer) SetCreditRepayShare(ctx context.Context, req *MsgSetCreditRepayShare) (*MsgSetCreditRepayShareResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCreditRepayShare not implemented")
+}
+func (*UnimplementedMsgServer) PostMerkleRoot(ctx context.Context, req *MsgPostMerkleRoot) (*MsgPostMerkleRootResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PostMerkleRoot not implemented")
+}
+func (*UnimplementedMsgServer) ClaimWithProof(ctx context.Context, req *MsgClaimWithProof) (*MsgClaimWithProofResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimWithProof not implemented")
+}
+func (*UnimplementedMsgServer) BatchClaimReward(ctx context.Context, req *MsgBatchClaimReward) (*MsgBatchClaimRewardResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BatchClaimReward not implemented")
+}
+func (*UnimplementedMsgServer) EnrollTask(ctx context.Context, req *MsgEnrollTask) (*MsgEnrollTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnrollTask not implemented")
+}
+func (*UnimplementedMsgServer) ClaimNative(ctx context.Context, req *MsgClaimNative) (*MsgClaimNativeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimNative not implemented")
+}
+func (*UnimplementedMsgServer) UnenrollTask(ctx context.Context, req *MsgUnenrollTask) (*MsgUnenrollTaskResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnenrollTask not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RevokeClaimRecord_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeClaimRecord)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeClaimRecord(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Msg/RevokeClaimRecord",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeClaimRecord(ctx, req.(*MsgRevokeClaimRecord))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Msg/ClaimReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimReward(ctx, req.(*MsgClaimReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CreateTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CreateTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Msg/CreateTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateTask(ctx, req.(*MsgCreateTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_CloseTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCloseTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CloseTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Msg/CloseTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CloseTask(ctx, req.(*MsgCloseTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RotateTaskOracles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateTaskOracles)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateTaskOracles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Msg/RotateTaskOracles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateTaskOracles(ctx, req.(*MsgRotateTaskOracles))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_FundTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgFundTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).FundTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Msg/FundTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).FundTask(ctx, req.(*MsgFundTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReclaimTaskEscrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReclaimTaskEscrow)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReclaimTaskEscrow(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Msg/ReclaimTaskEscrow",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReclaimTaskEscrow(ctx, req.(*MsgReclaimTaskEscrow))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_WithdrawVested_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawVested)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawVested(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Msg/WithdrawVested",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawVested(ctx, req.(*MsgWithdrawVested))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DisputeClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDisputeClaim)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DisputeClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Msg/DisputeClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DisputeClaim(ctx, req.(*MsgDisputeClaim))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetCreditRepayShare_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetCreditRepayShare)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetCreditRepayShare(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Msg/SetCreditRepayShare",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetCreditRepayShare(ctx, req.(*MsgSetCreditRepayShare))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PostMerkleRoot_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPostMerkleRoot)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PostMerkleRoot(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Msg/PostMerkleRoot",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PostMerkleRoot(ctx, req.(*MsgPostMerkleRoot))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimWithProof_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimWithProof)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimWithProof(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Msg/ClaimWithProof",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimWithProof(ctx, req.(*MsgClaimWithProof))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_BatchClaimReward_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBatchClaimReward)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BatchClaimReward(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Msg/BatchClaimReward",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BatchClaimReward(ctx, req.(*MsgBatchClaimReward))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EnrollTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEnrollTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EnrollTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Msg/EnrollTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EnrollTask(ctx, req.(*MsgEnrollTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimNative_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimNative)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimNative(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Msg/ClaimNative",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimNative(ctx, req.(*MsgClaimNative))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UnenrollTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUnenrollTask)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UnenrollTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/dtc.task.v1.Msg/UnenrollTask",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UnenrollTask(ctx, req.(*MsgUnenrollTask))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Msg_serviceDesc = _Msg_serviceDesc
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "dtc.task.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "RevokeClaimRecord",
+			Handler:    _Msg_RevokeClaimRecord_Handler,
+		},
+		{
+			MethodName: "ClaimReward",
+			Handler:    _Msg_ClaimReward_Handler,
+		},
+		{
+			MethodName: "CreateTask",
+			Handler:    _Msg_CreateTask_Handler,
+		},
+		{
+			MethodName: "CloseTask",
+			Handler:    _Msg_CloseTask_Handler,
+		},
+		{
+			MethodName: "RotateTaskOracles",
+			Handler:    _Msg_RotateTaskOracles_Handler,
+		},
+		{
+			MethodName: "FundTask",
+			Handler:    _Msg_FundTask_Handler,
+		},
+		{
+			MethodName: "ReclaimTaskEscrow",
+			Handler:    _Msg_ReclaimTaskEscrow_Handler,
+		},
+		{
+			MethodName: "WithdrawVested",
+			Handler:    _Msg_WithdrawVested_Handler,
+		},
+		{
+			MethodName: "DisputeClaim",
+			Handler:    _Msg_DisputeClaim_Handler,
+		},
+		{
+			MethodName: "SetCreditRepayShare",
+			Handler:    _Msg_SetCreditRepayShare_Handler,
+		},
+		{
+			MethodName: "PostMerkleRoot",
+			Handler:    _Msg_PostMerkleRoot_Handler,
+		},
+		{
+			MethodName: "ClaimWithProof",
+			Handler:    _Msg_ClaimWithProof_Handler,
+		},
+		{
+			MethodName: "BatchClaimReward",
+			Handler:    _Msg_BatchClaimReward_Handler,
+		},
+		{
+			MethodName: "EnrollTask",
+			Handler:    _Msg_EnrollTask_Handler,
+		},
+		{
+			MethodName: "ClaimNative",
+			Handler:    _Msg_ClaimNative_Handler,
+		},
+		{
+			MethodName: "UnenrollTask",
+			Handler:    _Msg_UnenrollTask_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "dtc/task/v1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeClaimRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeClaimRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeClaimRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClaimHash) > 0 {
+		i -= len(m.ClaimHash)
+		copy(dAtA[i:], m.ClaimHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClaimHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRevokeClaimRecordResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRevokeClaimRecordResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRevokeClaimRecordResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DependentDid) > 0 {
+		i -= len(m.DependentDid)
+		copy(dAtA[i:], m.DependentDid)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.DependentDid)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchClaimEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchClaimEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchClaimEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Amount) > 0 {
+		i -= len(m.Amount)
+		copy(dAtA[i:], m.Amount)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Amount)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchClaimReward) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchClaimReward) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchClaimReward) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BatchClaimResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BatchClaimResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BatchClaimResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ClaimHash) > 0 {
+		i -= len(m.ClaimHash)
+		copy(dAtA[i:], m.ClaimHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClaimHash)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgBatchClaimRewardResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBatchClaimRewardResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBatchClaimRewardResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnrollTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnrollTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnrollTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEnrollTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEnrollTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEnrollTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimNative) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimNative) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimNative) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimNativeResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimNativeResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimNativeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ClaimHash) > 0 {
+		i -= len(m.ClaimHash)
+		copy(dAtA[i:], m.ClaimHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClaimHash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Predicate.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.DisputeWindowBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.DisputeWindowBlocks))
+		i--
+		dAtA[i] = 0x68
+	}
+	{
+		size, err := m.PayoutSchedule.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.Eligibility.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	if m.OracleThreshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OracleThreshold))
+		i--
+		dAtA[i] = 0x50
+	}
+	if len(m.OraclePubkeys) > 0 {
+		for iNdEx := len(m.OraclePubkeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OraclePubkeys[iNdEx])
+			copy(dAtA[i:], m.OraclePubkeys[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.OraclePubkeys[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if m.EndHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.EndHeight))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.PerUserLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PerUserLimit))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxClaims != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxClaims))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.Budget.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.RewardPerClaim.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCloseTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCloseTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCloseTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgFundTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgFundTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgFundTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Escrow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReclaimTaskEscrow) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReclaimTaskEscrow) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReclaimTaskEscrow) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReclaimTaskEscrowResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReclaimTaskEscrowResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReclaimTaskEscrowResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateTaskOracles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateTaskOracles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateTaskOracles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OverlapBlocks != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OverlapBlocks))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.OracleThreshold != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OracleThreshold))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.OraclePubkeys) > 0 {
+		for iNdEx := len(m.OraclePubkeys) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.OraclePubkeys[iNdEx])
+			copy(dAtA[i:], m.OraclePubkeys[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.OraclePubkeys[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRotateTaskOraclesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRotateTaskOraclesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRotateTaskOraclesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PreviousOracleSetExpiry != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.PreviousOracleSetExpiry))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawVested) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawVested) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawVested) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWithdrawVestedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWithdrawVestedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWithdrawVestedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisputeClaim) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeClaim) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Evidence) > 0 {
+		i -= len(m.Evidence)
+		copy(dAtA[i:], m.Evidence)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Evidence)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ClaimHash) > 0 {
+		i -= len(m.ClaimHash)
+		copy(dAtA[i:], m.ClaimHash)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ClaimHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDisputeClaimResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDisputeClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDisputeClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Returned.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCreditRepayShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCreditRepayShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCreditRepayShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetCreditRepayShareResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetCreditRepayShareResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetCreditRepayShareResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPostMerkleRoot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPostMerkleRoot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPostMerkleRoot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x38
+	}
+	{
+		size, err := m.Total.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if m.LeafCount != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.LeafCount))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Root) > 0 {
+		i -= len(m.Root)
+		copy(dAtA[i:], m.Root)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Root)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPostMerkleRootResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPostMerkleRootResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPostMerkleRootResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimWithProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimWithProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimWithProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Proof) > 0 {
+		for iNdEx := len(m.Proof) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Proof[iNdEx])
+			copy(dAtA[i:], m.Proof[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Proof[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Index != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimWithProofResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimWithProofResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimWithProofResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReleaseHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ReleaseHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.StreamId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.StreamId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnenrollTask) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnenrollTask) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnenrollTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TaskId) > 0 {
+		i -= len(m.TaskId)
+		copy(dAtA[i:], m.TaskId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.TaskId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUnenrollTaskResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUnenrollTaskResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUnenrollTaskResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Refund.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeClaimRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClaimHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRevokeClaimRecordResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.DependentDid)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovTx(uint64(m.ReleaseHeight))
+	}
+	return n
+}
+
+func (m *BatchClaimEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Amount)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovTx(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *MsgBatchClaimReward) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Entries) > 0 {
+		for _, e := range m.Entries {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *BatchClaimResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	if m.Success {
+		n += 2
+	}
+	l = len(m.ClaimHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovTx(uint64(m.ReleaseHeight))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgBatchClaimRewardResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgEnrollTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgEnrollTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimNative) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimNativeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ClaimHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovTx(uint64(m.ReleaseHeight))
+	}
+	return n
+}
+
+func (m *MsgCreateTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RewardPerClaim.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Budget.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.MaxClaims != 0 {
+		n += 1 + sovTx(uint64(m.MaxClaims))
+	}
+	if m.PerUserLimit != 0 {
+		n += 1 + sovTx(uint64(m.PerUserLimit))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTx(uint64(m.StartHeight))
+	}
+	if m.EndHeight != 0 {
+		n += 1 + sovTx(uint64(m.EndHeight))
+	}
+	if len(m.OraclePubkeys) > 0 {
+		for _, s := range m.OraclePubkeys {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.OracleThreshold != 0 {
+		n += 1 + sovTx(uint64(m.OracleThreshold))
+	}
+	l = m.Eligibility.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.PayoutSchedule.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.DisputeWindowBlocks != 0 {
+		n += 1 + sovTx(uint64(m.DisputeWindowBlocks))
+	}
+	l = m.Predicate.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCloseTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCloseTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgFundTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgFundTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Escrow.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgReclaimTaskEscrow) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReclaimTaskEscrowResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRotateTaskOracles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.OraclePubkeys) > 0 {
+		for _, s := range m.OraclePubkeys {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.OracleThreshold != 0 {
+		n += 1 + sovTx(uint64(m.OracleThreshold))
+	}
+	if m.OverlapBlocks != 0 {
+		n += 1 + sovTx(uint64(m.OverlapBlocks))
+	}
+	return n
+}
+
+func (m *MsgRotateTaskOraclesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PreviousOracleSetExpiry != 0 {
+		n += 1 + sovTx(uint64(m.PreviousOracleSetExpiry))
+	}
+	return n
+}
+
+func (m *MsgWithdrawVested) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	return n
+}
+
+func (m *MsgWithdrawVestedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgDisputeClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ClaimHash)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Evidence)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDisputeClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Returned.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetCreditRepayShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Share.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetCreditRepayShareResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPostMerkleRoot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	l = len(m.Root)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.LeafCount != 0 {
+		n += 1 + sovTx(uint64(m.LeafCount))
+	}
+	l = m.Total.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPostMerkleRootResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimWithProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Epoch != 0 {
+		n += 1 + sovTx(uint64(m.Epoch))
+	}
+	if m.Index != 0 {
+		n += 1 + sovTx(uint64(m.Index))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.Proof) > 0 {
+		for _, s := range m.Proof {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimWithProofResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.StreamId != 0 {
+		n += 1 + sovTx(uint64(m.StreamId))
+	}
+	if m.ReleaseHeight != 0 {
+		n += 1 + sovTx(uint64(m.ReleaseHeight))
+	}
+	return n
+}
+
+func (m *MsgUnenrollTask) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.TaskId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUnenrollTaskResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Refund.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeClaimRecord) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeClaimRecord: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeClaimRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRevokeClaimRecordResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRevokeClaimRecordResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRevokeClaimRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DependentDid", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DependentDid = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
+			}
+			m.ReleaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchClaimEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchClaimEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchClaimEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchClaimReward) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchClaimReward: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchClaimReward: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Entries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Entries = append(m.Entries, BatchClaimEntry{})
+			if err := m.Entries[len(m.Entries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BatchClaimResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BatchClaimResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BatchClaimResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
+			}
+			m.ReleaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgBatchClaimRewardResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgBatchClaimRewardResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgBatchClaimRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Results = append(m.Results, BatchClaimResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnrollTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnrollTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnrollTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgEnrollTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgEnrollTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgEnrollTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimNative) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimNative: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimNative: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimNativeResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimNativeResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimNativeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
+			}
+			m.ReleaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardPerClaim", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardPerClaim.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Budget", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Budget.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxClaims", wireType)
+			}
+			m.MaxClaims = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxClaims |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerUserLimit", wireType)
+			}
+			m.PerUserLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PerUserLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndHeight", wireType)
+			}
+			m.EndHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePubkeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OraclePubkeys = append(m.OraclePubkeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleThreshold", wireType)
+			}
+			m.OracleThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Eligibility", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Eligibility.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PayoutSchedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DisputeWindowBlocks", wireType)
+			}
+			m.DisputeWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DisputeWindowBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Predicate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Predicate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCloseTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCloseTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCloseTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundTask) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundTask: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundTask: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgFundTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgFundTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgFundTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Escrow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Escrow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReclaimTaskEscrow) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReclaimTaskEscrow: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReclaimTaskEscrow: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReclaimTaskEscrowResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReclaimTaskEscrowResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReclaimTaskEscrowResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRotateTaskOracles) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateTaskOracles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateTaskOracles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OraclePubkeys", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OraclePubkeys = append(m.OraclePubkeys, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleThreshold", wireType)
+			}
+			m.OracleThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverlapBlocks", wireType)
+			}
+			m.OverlapBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OverlapBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgClaimRewardResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
+func (m *MsgRotateTaskOraclesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRotateTaskOraclesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRotateTaskOraclesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousOracleSetExpiry", wireType)
+			}
+			m.PreviousOracleSetExpiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PreviousOracleSetExpiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawVested) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1421,15 +8163,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawVested: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawVested: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1457,11 +8199,80 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgWithdrawVestedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgWithdrawVestedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgWithdrawVestedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1488,7 +8299,8 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1513,7 +8325,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDisputeClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1536,12 +8348,108 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisputeClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisputeClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Evidence", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Evidence = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1563,7 +8471,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateClaimRecord) Unmarshal(dAtA []byte) error {
+func (m *MsgDisputeClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1586,17 +8494,17 @@ func (m *MsgCreateClaimRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClaimRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDisputeClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClaimRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDisputeClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Returned", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1606,91 +8514,78 @@ func (m *MsgCreateClaimRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if err := m.Returned.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClaimHash = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetCreditRepayShare) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetCreditRepayShare: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetCreditRepayShare: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1718,11 +8613,11 @@ func (m *MsgCreateClaimRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserId = string(dAtA[iNdEx:postIndex])
+			m.Creator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1750,7 +8645,9 @@ func (m *MsgCreateClaimRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1773,7 +8670,7 @@ func (m *MsgCreateClaimRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateClaimRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetCreditRepayShareResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1796,10 +8693,10 @@ func (m *MsgCreateClaimRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateClaimRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetCreditRepayShareResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateClaimRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetCreditRepayShareResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1823,7 +8720,7 @@ func (m *MsgCreateClaimRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateClaimRecord) Unmarshal(dAtA []byte) error {
+func (m *MsgPostMerkleRoot) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1846,10 +8743,10 @@ func (m *MsgUpdateClaimRecord) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateClaimRecord: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPostMerkleRoot: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateClaimRecord: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPostMerkleRoot: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1886,7 +8783,7 @@ func (m *MsgUpdateClaimRecord) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1914,11 +8811,30 @@ func (m *MsgUpdateClaimRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClaimHash = string(dAtA[iNdEx:postIndex])
+			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Root", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1946,13 +8862,32 @@ func (m *MsgUpdateClaimRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TaskId = string(dAtA[iNdEx:postIndex])
+			m.Root = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LeafCount", wireType)
+			}
+			m.LeafCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LeafCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UserId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Total", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1962,25 +8897,45 @@ func (m *MsgUpdateClaimRecord) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.UserId = string(dAtA[iNdEx:postIndex])
+			if err := m.Total.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
@@ -2033,7 +8988,7 @@ func (m *MsgUpdateClaimRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateClaimRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPostMerkleRootResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2056,10 +9011,10 @@ func (m *MsgUpdateClaimRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateClaimRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPostMerkleRootResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateClaimRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPostMerkleRootResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2083,7 +9038,7 @@ func (m *MsgUpdateClaimRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteClaimRecord) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimWithProof) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2093,28 +9048,130 @@ func (m *MsgDeleteClaimRecord) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimWithProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimWithProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.TaskId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteClaimRecord: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteClaimRecord: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2142,11 +9199,44 @@ func (m *MsgDeleteClaimRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Creator = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ClaimHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2174,7 +9264,7 @@ func (m *MsgDeleteClaimRecord) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ClaimHash = string(dAtA[iNdEx:postIndex])
+			m.Proof = append(m.Proof, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2197,7 +9287,7 @@ func (m *MsgDeleteClaimRecord) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDeleteClaimRecordResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimWithProofResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2220,12 +9310,50 @@ func (m *MsgDeleteClaimRecordResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDeleteClaimRecordResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimWithProofResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDeleteClaimRecordResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimWithProofResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StreamId", wireType)
+			}
+			m.StreamId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StreamId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleaseHeight", wireType)
+			}
+			m.ReleaseHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReleaseHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2247,7 +9375,7 @@ func (m *MsgDeleteClaimRecordResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimReward) Unmarshal(dAtA []byte) error {
+func (m *MsgUnenrollTask) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2270,10 +9398,10 @@ func (m *MsgClaimReward) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimReward: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnenrollTask: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimReward: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnenrollTask: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2340,102 +9468,6 @@ func (m *MsgClaimReward) Unmarshal(dAtA []byte) error {
 			}
 			m.TaskId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Amount = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Recipient = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2457,7 +9489,7 @@ func (m *MsgClaimReward) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimRewardResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUnenrollTaskResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2480,12 +9512,45 @@ func (m *MsgClaimRewardResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimRewardResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnenrollTaskResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimRewardResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnenrollTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Refund", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Refund.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])